@@ -2780,6 +2780,92 @@ func (m *RefreshWorkflowTasksResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RefreshWorkflowTasksResponse proto.InternalMessageInfo
 
+type RearchiveWorkflowExecutionRequest struct {
+	NamespaceId string                `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Execution   *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+}
+
+func (m *RearchiveWorkflowExecutionRequest) Reset()      { *m = RearchiveWorkflowExecutionRequest{} }
+func (*RearchiveWorkflowExecutionRequest) ProtoMessage() {}
+func (*RearchiveWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{49}
+}
+func (m *RearchiveWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RearchiveWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RearchiveWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RearchiveWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RearchiveWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *RearchiveWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RearchiveWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RearchiveWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RearchiveWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *RearchiveWorkflowExecutionRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *RearchiveWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.Execution
+	}
+	return nil
+}
+
+type RearchiveWorkflowExecutionResponse struct {
+}
+
+func (m *RearchiveWorkflowExecutionResponse) Reset()      { *m = RearchiveWorkflowExecutionResponse{} }
+func (*RearchiveWorkflowExecutionResponse) ProtoMessage() {}
+func (*RearchiveWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{50}
+}
+func (m *RearchiveWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RearchiveWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RearchiveWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RearchiveWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RearchiveWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *RearchiveWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RearchiveWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RearchiveWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RearchiveWorkflowExecutionResponse proto.InternalMessageInfo
+
 type ResendReplicationTasksRequest struct {
 	NamespaceId   string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowId    string `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
@@ -2794,7 +2880,7 @@ type ResendReplicationTasksRequest struct {
 func (m *ResendReplicationTasksRequest) Reset()      { *m = ResendReplicationTasksRequest{} }
 func (*ResendReplicationTasksRequest) ProtoMessage() {}
 func (*ResendReplicationTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{49}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{51}
 }
 func (m *ResendReplicationTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2885,7 +2971,7 @@ type ResendReplicationTasksResponse struct {
 func (m *ResendReplicationTasksResponse) Reset()      { *m = ResendReplicationTasksResponse{} }
 func (*ResendReplicationTasksResponse) ProtoMessage() {}
 func (*ResendReplicationTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{50}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{52}
 }
 func (m *ResendReplicationTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2927,7 +3013,7 @@ type GetTaskQueueTasksRequest struct {
 func (m *GetTaskQueueTasksRequest) Reset()      { *m = GetTaskQueueTasksRequest{} }
 func (*GetTaskQueueTasksRequest) ProtoMessage() {}
 func (*GetTaskQueueTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{51}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{53}
 }
 func (m *GetTaskQueueTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3013,7 +3099,7 @@ type GetTaskQueueTasksResponse struct {
 func (m *GetTaskQueueTasksResponse) Reset()      { *m = GetTaskQueueTasksResponse{} }
 func (*GetTaskQueueTasksResponse) ProtoMessage() {}
 func (*GetTaskQueueTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{52}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{54}
 }
 func (m *GetTaskQueueTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3064,7 +3150,7 @@ type DeleteWorkflowExecutionRequest struct {
 func (m *DeleteWorkflowExecutionRequest) Reset()      { *m = DeleteWorkflowExecutionRequest{} }
 func (*DeleteWorkflowExecutionRequest) ProtoMessage() {}
 func (*DeleteWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{53}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{55}
 }
 func (m *DeleteWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3114,7 +3200,7 @@ type DeleteWorkflowExecutionResponse struct {
 func (m *DeleteWorkflowExecutionResponse) Reset()      { *m = DeleteWorkflowExecutionResponse{} }
 func (*DeleteWorkflowExecutionResponse) ProtoMessage() {}
 func (*DeleteWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{54}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{56}
 }
 func (m *DeleteWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3150,497 +3236,952 @@ func (m *DeleteWorkflowExecutionResponse) GetWarnings() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*RebuildMutableStateRequest)(nil), "temporal.server.api.adminservice.v1.RebuildMutableStateRequest")
-	proto.RegisterType((*RebuildMutableStateResponse)(nil), "temporal.server.api.adminservice.v1.RebuildMutableStateResponse")
-	proto.RegisterType((*DescribeMutableStateRequest)(nil), "temporal.server.api.adminservice.v1.DescribeMutableStateRequest")
-	proto.RegisterType((*DescribeMutableStateResponse)(nil), "temporal.server.api.adminservice.v1.DescribeMutableStateResponse")
-	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "temporal.server.api.adminservice.v1.DescribeHistoryHostRequest")
-	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "temporal.server.api.adminservice.v1.DescribeHistoryHostResponse")
-	proto.RegisterType((*CloseShardRequest)(nil), "temporal.server.api.adminservice.v1.CloseShardRequest")
-	proto.RegisterType((*CloseShardResponse)(nil), "temporal.server.api.adminservice.v1.CloseShardResponse")
-	proto.RegisterType((*GetShardRequest)(nil), "temporal.server.api.adminservice.v1.GetShardRequest")
-	proto.RegisterType((*GetShardResponse)(nil), "temporal.server.api.adminservice.v1.GetShardResponse")
-	proto.RegisterType((*ListHistoryTasksRequest)(nil), "temporal.server.api.adminservice.v1.ListHistoryTasksRequest")
-	proto.RegisterType((*ListHistoryTasksResponse)(nil), "temporal.server.api.adminservice.v1.ListHistoryTasksResponse")
-	proto.RegisterType((*Task)(nil), "temporal.server.api.adminservice.v1.Task")
-	proto.RegisterType((*RemoveTaskRequest)(nil), "temporal.server.api.adminservice.v1.RemoveTaskRequest")
-	proto.RegisterType((*RemoveTaskResponse)(nil), "temporal.server.api.adminservice.v1.RemoveTaskResponse")
-	proto.RegisterType((*GetWorkflowExecutionRawHistoryV2Request)(nil), "temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request")
-	proto.RegisterType((*GetWorkflowExecutionRawHistoryV2Response)(nil), "temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response")
-	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesRequest")
-	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesResponse")
-	proto.RegisterMapType((map[int32]*v15.ReplicationMessages)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
-	proto.RegisterType((*GetNamespaceReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest")
-	proto.RegisterType((*GetNamespaceReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse")
-	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest")
-	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse")
-	proto.RegisterType((*ReapplyEventsRequest)(nil), "temporal.server.api.adminservice.v1.ReapplyEventsRequest")
-	proto.RegisterType((*ReapplyEventsResponse)(nil), "temporal.server.api.adminservice.v1.ReapplyEventsResponse")
-	proto.RegisterType((*AddSearchAttributesRequest)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributesRequest")
-	proto.RegisterMapType((map[string]v16.IndexedValueType)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry")
-	proto.RegisterType((*AddSearchAttributesResponse)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributesResponse")
-	proto.RegisterType((*RemoveSearchAttributesRequest)(nil), "temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest")
-	proto.RegisterType((*RemoveSearchAttributesResponse)(nil), "temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse")
-	proto.RegisterType((*GetSearchAttributesRequest)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesRequest")
-	proto.RegisterType((*GetSearchAttributesResponse)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse")
-	proto.RegisterMapType((map[string]v16.IndexedValueType)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry")
-	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry")
-	proto.RegisterMapType((map[string]v16.IndexedValueType)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry")
-	proto.RegisterType((*DescribeClusterRequest)(nil), "temporal.server.api.adminservice.v1.DescribeClusterRequest")
-	proto.RegisterType((*DescribeClusterResponse)(nil), "temporal.server.api.adminservice.v1.DescribeClusterResponse")
-	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry")
-	proto.RegisterType((*ListClustersRequest)(nil), "temporal.server.api.adminservice.v1.ListClustersRequest")
-	proto.RegisterType((*ListClustersResponse)(nil), "temporal.server.api.adminservice.v1.ListClustersResponse")
-	proto.RegisterType((*AddOrUpdateRemoteClusterRequest)(nil), "temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest")
-	proto.RegisterType((*AddOrUpdateRemoteClusterResponse)(nil), "temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse")
-	proto.RegisterType((*RemoveRemoteClusterRequest)(nil), "temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest")
-	proto.RegisterType((*RemoveRemoteClusterResponse)(nil), "temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse")
-	proto.RegisterType((*ListClusterMembersRequest)(nil), "temporal.server.api.adminservice.v1.ListClusterMembersRequest")
-	proto.RegisterType((*ListClusterMembersResponse)(nil), "temporal.server.api.adminservice.v1.ListClusterMembersResponse")
-	proto.RegisterType((*GetDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetDLQMessagesRequest")
-	proto.RegisterType((*GetDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetDLQMessagesResponse")
-	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest")
-	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse")
-	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.MergeDLQMessagesRequest")
-	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.MergeDLQMessagesResponse")
-	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest")
-	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse")
-	proto.RegisterType((*ResendReplicationTasksRequest)(nil), "temporal.server.api.adminservice.v1.ResendReplicationTasksRequest")
-	proto.RegisterType((*ResendReplicationTasksResponse)(nil), "temporal.server.api.adminservice.v1.ResendReplicationTasksResponse")
-	proto.RegisterType((*GetTaskQueueTasksRequest)(nil), "temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest")
-	proto.RegisterType((*GetTaskQueueTasksResponse)(nil), "temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse")
-	proto.RegisterType((*DeleteWorkflowExecutionRequest)(nil), "temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest")
-	proto.RegisterType((*DeleteWorkflowExecutionResponse)(nil), "temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse")
+type GetReplicationStatusRequest struct {
+	// Remote clusters to report on. All remote clusters are reported if empty.
+	RemoteClusters []string `protobuf:"bytes,1,rep,name=remote_clusters,json=remoteClusters,proto3" json:"remote_clusters,omitempty"`
+	// Adds the per shard details to the response.
+	IncludeShards bool `protobuf:"varint,2,opt,name=include_shards,json=includeShards,proto3" json:"include_shards,omitempty"`
+	// Bounds how many DLQ tasks are counted per shard and cluster. The server default is used if not positive.
+	MaxDlqSize int32 `protobuf:"varint,3,opt,name=max_dlq_size,json=maxDlqSize,proto3" json:"max_dlq_size,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("temporal/server/api/adminservice/v1/request_response.proto", fileDescriptor_cc07c1a2abe7cb51)
+func (m *GetReplicationStatusRequest) Reset()      { *m = GetReplicationStatusRequest{} }
+func (*GetReplicationStatusRequest) ProtoMessage() {}
+func (*GetReplicationStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{57}
 }
-
-var fileDescriptor_cc07c1a2abe7cb51 = []byte{
-	// 2985 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3a, 0xcd, 0x6f, 0x1b, 0xc7,
-	0xf5, 0x5e, 0x7e, 0x89, 0x7c, 0x92, 0x28, 0x69, 0x6d, 0x59, 0x34, 0x15, 0x51, 0x0a, 0xe3, 0x38,
-	0xb6, 0x7f, 0x09, 0xf5, 0xb3, 0xd2, 0x36, 0x4e, 0x52, 0x23, 0x90, 0x65, 0x47, 0x56, 0x2a, 0xe5,
-	0x63, 0xe5, 0xd8, 0x45, 0x80, 0x60, 0x33, 0xdc, 0x1d, 0x51, 0x0b, 0x2f, 0x77, 0x37, 0x3b, 0x43,
-	0x5a, 0x0a, 0xd0, 0x0f, 0x34, 0x2d, 0x7a, 0x2a, 0x6a, 0xa0, 0x28, 0x10, 0xe4, 0xd4, 0x63, 0x0b,
-	0xb4, 0xe8, 0xad, 0xf7, 0xde, 0x7a, 0x0c, 0xda, 0x4b, 0xd0, 0x16, 0x4d, 0xa3, 0x5c, 0xda, 0x5b,
-	0xfe, 0x84, 0x62, 0xbe, 0x96, 0xbb, 0xe4, 0x92, 0xa2, 0x6a, 0x3b, 0x05, 0x72, 0xe3, 0xbe, 0x79,
-	0xef, 0xcd, 0x9b, 0xf7, 0x35, 0xef, 0xbd, 0x21, 0xbc, 0x44, 0x71, 0x3b, 0xf0, 0x43, 0xe4, 0xae,
-	0x12, 0x1c, 0x76, 0x71, 0xb8, 0x8a, 0x02, 0x67, 0x15, 0xd9, 0x6d, 0xc7, 0x63, 0xdf, 0x8e, 0x85,
-	0x57, 0xbb, 0x57, 0x56, 0x43, 0xfc, 0x7e, 0x07, 0x13, 0x6a, 0x86, 0x98, 0x04, 0xbe, 0x47, 0x70,
-	0x23, 0x08, 0x7d, 0xea, 0xeb, 0x4f, 0x29, 0xda, 0x86, 0xa0, 0x6d, 0xa0, 0xc0, 0x69, 0xc4, 0x69,
-	0x1b, 0xdd, 0x2b, 0xd5, 0xe5, 0x96, 0xef, 0xb7, 0x5c, 0xbc, 0xca, 0x49, 0x9a, 0x9d, 0xbd, 0x55,
-	0xea, 0xb4, 0x31, 0xa1, 0xa8, 0x1d, 0x08, 0x2e, 0xd5, 0x5a, 0x3f, 0x82, 0xdd, 0x09, 0x11, 0x75,
-	0x7c, 0x4f, 0xae, 0x3f, 0x69, 0xe3, 0x00, 0x7b, 0x36, 0xf6, 0x2c, 0x07, 0x93, 0xd5, 0x96, 0xdf,
-	0xf2, 0x39, 0x9c, 0xff, 0x92, 0x28, 0xf5, 0xe8, 0x10, 0x4c, 0x7a, 0xec, 0x75, 0xda, 0x84, 0x89,
-	0x6d, 0xf9, 0xed, 0x76, 0xc4, 0xe6, 0x42, 0x3a, 0x0e, 0x45, 0xe4, 0x9e, 0xf9, 0x7e, 0x07, 0x77,
-	0xe4, 0xa1, 0xaa, 0xe7, 0x13, 0x78, 0x82, 0x05, 0x43, 0x6c, 0x63, 0x42, 0x50, 0x4b, 0x61, 0x3d,
-	0x9d, 0xc0, 0xea, 0xe2, 0x90, 0x38, 0x69, 0x68, 0xc9, 0x4d, 0xef, 0xfb, 0xe1, 0xbd, 0x3d, 0xd7,
-	0xbf, 0x3f, 0x88, 0xf7, 0x6c, 0x9a, 0x15, 0x2c, 0xb7, 0x43, 0x28, 0x0e, 0x07, 0xb1, 0x2f, 0xa5,
-	0x61, 0xa7, 0x9f, 0xfa, 0xf2, 0x68, 0x54, 0xb1, 0x83, 0xc4, 0x7d, 0x66, 0x24, 0x2e, 0x53, 0xd4,
-	0x28, 0x69, 0xf7, 0x1d, 0x42, 0xfd, 0xf0, 0x70, 0x50, 0xda, 0x46, 0x1a, 0xb6, 0x87, 0xda, 0x98,
-	0x04, 0x48, 0xb8, 0x57, 0x12, 0xff, 0xff, 0xd3, 0xf0, 0x43, 0x1c, 0xb8, 0x8e, 0xc5, 0xdd, 0x62,
-	0x90, 0xe2, 0xc5, 0x34, 0x8a, 0x80, 0xd9, 0x84, 0x50, 0xec, 0x89, 0x3d, 0xe4, 0x51, 0xcd, 0x36,
-	0xa6, 0xc8, 0x46, 0x14, 0x49, 0xd2, 0xe7, 0xc7, 0x20, 0xc5, 0x07, 0xd8, 0xea, 0xb0, 0x9d, 0x89,
-	0x24, 0x7a, 0x65, 0x0c, 0x22, 0x65, 0x6b, 0xb3, 0xdd, 0xa1, 0xa8, 0xe9, 0x62, 0x93, 0x50, 0x44,
-	0x47, 0xaa, 0xa4, 0x8f, 0x01, 0xd3, 0xb7, 0xdc, 0xb0, 0xfe, 0xa1, 0x06, 0x55, 0x03, 0x37, 0x3b,
-	0x8e, 0x6b, 0xef, 0x08, 0x76, 0xbb, 0x8c, 0x9b, 0x21, 0xc2, 0x52, 0x7f, 0x02, 0x4a, 0x91, 0x3e,
-	0x2b, 0xda, 0x8a, 0x76, 0xb1, 0x64, 0xf4, 0x00, 0xfa, 0x26, 0x94, 0xa2, 0x13, 0x54, 0x32, 0x2b,
-	0xda, 0xc5, 0xc9, 0xb5, 0x4b, 0x91, 0x00, 0x3c, 0x64, 0xa5, 0xc7, 0x74, 0xaf, 0x34, 0xee, 0x4a,
-	0xa9, 0x6f, 0x2a, 0x02, 0xa3, 0x47, 0x5b, 0x5f, 0x82, 0xc5, 0x54, 0x21, 0x44, 0x4e, 0xa8, 0xff,
-	0x58, 0x83, 0xc5, 0x1b, 0x98, 0x58, 0xa1, 0xd3, 0xc4, 0xff, 0x43, 0x29, 0xff, 0x90, 0x81, 0x27,
-	0xd2, 0xc5, 0x10, 0x72, 0xea, 0xe7, 0xa0, 0x48, 0xf6, 0x51, 0x68, 0x9b, 0x8e, 0x2d, 0xc5, 0x98,
-	0xe0, 0xdf, 0x5b, 0xb6, 0xfe, 0x24, 0x4c, 0x49, 0x37, 0x36, 0x91, 0x6d, 0x87, 0x5c, 0x8e, 0x92,
-	0x31, 0x29, 0x61, 0xeb, 0xb6, 0x1d, 0xea, 0xfb, 0x70, 0xda, 0x42, 0xd6, 0x3e, 0x4e, 0xda, 0xb5,
-	0x92, 0xe5, 0x12, 0x5f, 0x6d, 0xa4, 0x65, 0xc4, 0x98, 0x61, 0xe3, 0xd2, 0x27, 0x84, 0x9b, 0xe3,
-	0x4c, 0xe3, 0x20, 0xdd, 0x83, 0xb3, 0xcc, 0x51, 0x9b, 0x88, 0xf4, 0x6f, 0x96, 0x7b, 0xc8, 0xcd,
-	0xce, 0x28, 0xbe, 0x71, 0x68, 0xfd, 0xcf, 0x1a, 0x54, 0x95, 0xe2, 0x6e, 0x89, 0x13, 0xdf, 0xf2,
-	0x09, 0x55, 0xe6, 0x63, 0xba, 0xf1, 0x09, 0xe5, 0x8a, 0xc1, 0x84, 0x48, 0xd5, 0x4d, 0x32, 0xd8,
-	0xba, 0x00, 0x25, 0x34, 0xcb, 0x54, 0x97, 0xef, 0x69, 0x36, 0x61, 0xfc, 0x6c, 0xbf, 0xf1, 0xbf,
-	0x0b, 0x7a, 0x14, 0x2f, 0x3d, 0x2f, 0xc8, 0x9d, 0xd4, 0x0b, 0xe6, 0xee, 0xf7, 0x83, 0xea, 0xff,
-	0x88, 0x39, 0x65, 0xe2, 0x50, 0xd2, 0x19, 0x9e, 0x82, 0x69, 0x2e, 0x22, 0x31, 0xbd, 0x4e, 0xbb,
-	0x89, 0x43, 0x7e, 0xac, 0xbc, 0x31, 0x25, 0x80, 0xaf, 0x73, 0x98, 0xbe, 0x08, 0x25, 0x75, 0x2e,
-	0x52, 0xc9, 0xac, 0x64, 0x2f, 0xe6, 0x8d, 0xa2, 0x3c, 0x18, 0xd1, 0xdf, 0x85, 0x99, 0xe8, 0x20,
-	0x26, 0xb7, 0xa2, 0x74, 0x86, 0x6f, 0xa4, 0xda, 0x27, 0xc2, 0x65, 0x47, 0x78, 0x5d, 0x7d, 0x6c,
-	0x30, 0xba, 0x2d, 0x6f, 0xcf, 0x37, 0xca, 0x5e, 0x02, 0xa6, 0x57, 0x60, 0x42, 0x69, 0x3c, 0x2f,
-	0x9c, 0x55, 0x7e, 0xbe, 0x96, 0x2b, 0xe6, 0x66, 0xf3, 0xf5, 0x06, 0xcc, 0x6d, 0xb8, 0x3e, 0xc1,
-	0xbb, 0x4c, 0x1e, 0x65, 0xab, 0x7e, 0x17, 0xef, 0x19, 0xa2, 0x7e, 0x06, 0xf4, 0x38, 0xbe, 0x8c,
-	0xdd, 0x67, 0x61, 0x66, 0x13, 0xd3, 0x71, 0x79, 0xbc, 0x07, 0xb3, 0x3d, 0x6c, 0xa9, 0xc8, 0x6d,
-	0x00, 0x89, 0xee, 0xed, 0xf9, 0x9c, 0x60, 0x72, 0xed, 0xb9, 0x71, 0x3c, 0x94, 0xb3, 0xe1, 0x47,
-	0x17, 0x4a, 0x66, 0x3f, 0xeb, 0x3f, 0xcb, 0xc0, 0xc2, 0xb6, 0x43, 0xa8, 0x34, 0xd9, 0x6d, 0x96,
-	0x0b, 0x8f, 0x17, 0x4c, 0x7f, 0x15, 0x8a, 0x16, 0xa2, 0xb8, 0xe5, 0x87, 0x87, 0xdc, 0x01, 0xcb,
-	0x6b, 0x97, 0x53, 0x45, 0xe0, 0x97, 0x1a, 0xdb, 0x9c, 0x31, 0xde, 0x90, 0x14, 0x46, 0x44, 0xab,
-	0xdf, 0x02, 0xe0, 0x75, 0x41, 0x88, 0xbc, 0x96, 0x32, 0xe7, 0xa5, 0x54, 0x4e, 0x32, 0x35, 0x28,
-	0x5e, 0x06, 0x23, 0x30, 0x4a, 0x54, 0xfd, 0xd4, 0x97, 0x00, 0x9a, 0x88, 0x5a, 0xfb, 0x26, 0x71,
-	0x3e, 0x10, 0x81, 0x9b, 0x37, 0x4a, 0x1c, 0xb2, 0xeb, 0x7c, 0x80, 0xf5, 0x0b, 0x30, 0xe3, 0xe1,
-	0x03, 0x6a, 0x06, 0xa8, 0x85, 0x4d, 0xea, 0xdf, 0xc3, 0x1e, 0xb7, 0xf2, 0x94, 0x31, 0xcd, 0xc0,
-	0x6f, 0xa2, 0x16, 0xbe, 0xcd, 0x80, 0xec, 0x02, 0xa8, 0x0c, 0xea, 0x43, 0xaa, 0xfe, 0x15, 0xc8,
-	0xf3, 0xcb, 0xa2, 0xa2, 0xad, 0x64, 0x87, 0x0a, 0xda, 0x57, 0x96, 0x09, 0x69, 0x05, 0x5d, 0x9a,
-	0x14, 0x99, 0x34, 0x29, 0x3e, 0xca, 0x40, 0x8e, 0xd1, 0xb1, 0x5c, 0xd0, 0xf3, 0xf9, 0x28, 0x8d,
-	0x4e, 0x46, 0xb0, 0x2d, 0x5b, 0x5f, 0x86, 0xc9, 0x28, 0xa4, 0x65, 0x3a, 0x28, 0x19, 0xa0, 0x40,
-	0x5b, 0xb6, 0x3e, 0x0f, 0x85, 0xb0, 0xe3, 0xb1, 0x35, 0x91, 0x0e, 0xf2, 0x61, 0xc7, 0xdb, 0xb2,
-	0xf5, 0x05, 0x98, 0xe0, 0xaa, 0x77, 0x6c, 0xae, 0xad, 0xac, 0x51, 0x60, 0x9f, 0x5b, 0xb6, 0xbe,
-	0x01, 0x5c, 0xad, 0x26, 0x3d, 0x0c, 0x30, 0x57, 0x52, 0x79, 0xed, 0xc2, 0xf1, 0xc6, 0xbd, 0x7d,
-	0x18, 0x60, 0xa3, 0x48, 0xe5, 0x2f, 0xfd, 0x1a, 0x94, 0xf6, 0x9c, 0x10, 0x9b, 0xac, 0x06, 0xad,
-	0x14, 0xb8, 0x5d, 0xab, 0x0d, 0x51, 0x7f, 0x36, 0x54, 0xfd, 0xd9, 0xb8, 0xad, 0x0a, 0xd4, 0xeb,
-	0xb9, 0x07, 0x9f, 0x2d, 0x6b, 0x46, 0x91, 0x91, 0x30, 0x20, 0x0b, 0x46, 0x59, 0xea, 0x55, 0x26,
-	0xb8, 0x70, 0xea, 0xb3, 0xfe, 0x57, 0x0d, 0xe6, 0x0c, 0xdc, 0xf6, 0xbb, 0x98, 0x2b, 0xf6, 0xab,
-	0x73, 0xd5, 0x98, 0xbe, 0xb2, 0x09, 0x7d, 0x6d, 0xc1, 0x4c, 0xd7, 0x21, 0x4e, 0xd3, 0x71, 0x1d,
-	0x7a, 0x28, 0x0e, 0x9c, 0x1b, 0xf3, 0xc0, 0xe5, 0x1e, 0x21, 0x5b, 0x62, 0x39, 0x23, 0x7e, 0x36,
-	0x99, 0x33, 0x7e, 0x91, 0x85, 0x67, 0x36, 0x31, 0x1d, 0x4c, 0xc3, 0xe8, 0xbe, 0x74, 0xd3, 0x3b,
-	0x6b, 0xb1, 0xcb, 0x23, 0xe1, 0x30, 0xa5, 0x41, 0x87, 0x79, 0x54, 0x05, 0x80, 0x7e, 0x1e, 0xca,
-	0x84, 0xa2, 0x90, 0x9a, 0xb8, 0x8b, 0x3d, 0xda, 0x53, 0xcc, 0x14, 0x87, 0xde, 0x64, 0xc0, 0x2d,
-	0x5b, 0x6f, 0xc0, 0xe9, 0x38, 0x96, 0x32, 0xab, 0xf0, 0xb9, 0xb9, 0x1e, 0xea, 0x1d, 0xb1, 0xa0,
-	0xaf, 0xc0, 0x14, 0xf6, 0xec, 0x1e, 0xcf, 0x3c, 0x47, 0x04, 0xec, 0xd9, 0x8a, 0xe3, 0x65, 0x98,
-	0xeb, 0x61, 0x28, 0x7e, 0x05, 0x8e, 0x36, 0xa3, 0xd0, 0x14, 0xb7, 0xcb, 0x30, 0xd7, 0x46, 0x07,
-	0x4e, 0xbb, 0xd3, 0x16, 0x41, 0xc7, 0xb3, 0xc3, 0x04, 0xf7, 0x90, 0x19, 0xb9, 0xc0, 0xc2, 0x6e,
-	0x58, 0x8e, 0x28, 0xa6, 0x44, 0xe7, 0x6b, 0xb9, 0xa2, 0x36, 0x9b, 0xa9, 0xff, 0x2a, 0x03, 0x17,
-	0x8f, 0xb7, 0x8a, 0xcc, 0x1c, 0x29, 0xac, 0xb5, 0x14, 0xd6, 0xcc, 0x97, 0x54, 0x5d, 0xc4, 0x73,
-	0x17, 0x16, 0xd7, 0xe0, 0xe4, 0xda, 0xca, 0x30, 0x0b, 0xdd, 0x40, 0x14, 0x5d, 0x77, 0xfd, 0xa6,
-	0x51, 0x96, 0x84, 0xd7, 0x05, 0x9d, 0x7e, 0x17, 0x66, 0xa4, 0x6e, 0x4c, 0xb9, 0x22, 0xf3, 0x6b,
-	0xe3, 0xb8, 0xfc, 0x2a, 0x75, 0x27, 0x4f, 0x61, 0x94, 0xbb, 0x89, 0x6f, 0xfd, 0x22, 0xcc, 0x2a,
-	0x19, 0x3d, 0xdf, 0xc6, 0xfc, 0xae, 0xce, 0xad, 0x64, 0x2f, 0x66, 0x23, 0x11, 0x5e, 0xf7, 0x6d,
-	0xbc, 0x65, 0x93, 0xfa, 0x03, 0x0d, 0x96, 0x36, 0x31, 0x35, 0x7a, 0x2d, 0xc5, 0x8e, 0x68, 0x27,
-	0xa2, 0x2b, 0x66, 0x1b, 0x0a, 0x5c, 0x1b, 0x2a, 0xa5, 0xa6, 0x5f, 0xe5, 0xb1, 0x9e, 0x84, 0xc9,
-	0x17, 0xe3, 0xc7, 0xb5, 0x66, 0x48, 0x1e, 0xcc, 0xf9, 0x55, 0xf7, 0xc1, 0x1c, 0x5e, 0x55, 0x95,
-	0x12, 0xc6, 0x6a, 0x80, 0xfa, 0xc7, 0x19, 0xa8, 0x0d, 0x13, 0x49, 0xda, 0xea, 0x7b, 0x50, 0x16,
-	0xb9, 0x44, 0xf6, 0x3e, 0x4a, 0xb6, 0x3b, 0x63, 0xa5, 0xfb, 0xd1, 0xcc, 0xc5, 0x25, 0xac, 0xa0,
-	0x37, 0x3d, 0x1a, 0x1e, 0x1a, 0xa2, 0x2e, 0x52, 0xb0, 0xea, 0x21, 0xe8, 0x83, 0x48, 0xfa, 0x2c,
-	0x64, 0xef, 0xe1, 0x43, 0x99, 0xdb, 0xd8, 0x4f, 0x7d, 0x07, 0xf2, 0x5d, 0xe4, 0x76, 0xb0, 0x0c,
-	0xe1, 0x17, 0x4e, 0xa8, 0xb9, 0x48, 0x32, 0xc1, 0xe5, 0xa5, 0xcc, 0x55, 0xad, 0xfe, 0x47, 0x0d,
-	0x2e, 0x6c, 0x62, 0x1a, 0x15, 0x4b, 0x23, 0x0c, 0xf7, 0x22, 0x9c, 0x73, 0x11, 0x1f, 0x54, 0xd0,
-	0xd0, 0xc1, 0x5d, 0x1c, 0x69, 0x4b, 0x65, 0xe0, 0xac, 0x71, 0x96, 0x21, 0x18, 0x6a, 0x5d, 0x32,
-	0xd8, 0xb2, 0x23, 0xd2, 0x20, 0xf4, 0x2d, 0x4c, 0x48, 0x92, 0x34, 0xd3, 0x23, 0x7d, 0x53, 0xad,
-	0xf7, 0x48, 0xfb, 0x0d, 0x9c, 0x1d, 0x34, 0xf0, 0xf7, 0x79, 0xae, 0x1c, 0x7d, 0x04, 0x69, 0xe8,
-	0x5d, 0x28, 0xc6, 0x4c, 0xfc, 0x50, 0x4a, 0x8c, 0x18, 0xd5, 0x3f, 0x80, 0x95, 0x4d, 0x4c, 0x6f,
-	0x6c, 0xbf, 0x35, 0x42, 0x79, 0x77, 0x64, 0xd5, 0xc3, 0x2a, 0x38, 0xe5, 0x5d, 0x27, 0xdd, 0x9a,
-	0xdd, 0x10, 0xa2, 0x98, 0xa3, 0xf2, 0x17, 0xa9, 0xff, 0x44, 0x83, 0x27, 0x47, 0x6c, 0x2e, 0x8f,
-	0xfd, 0x1e, 0xcc, 0xc5, 0xd8, 0x9a, 0xf1, 0x8a, 0xe6, 0xf9, 0xff, 0x42, 0x08, 0x63, 0x36, 0x4c,
-	0x02, 0x48, 0xfd, 0x2f, 0x1a, 0x9c, 0x31, 0x30, 0x0a, 0x02, 0xf7, 0x90, 0x27, 0x63, 0x32, 0xec,
-	0x76, 0xca, 0x0d, 0xde, 0x4e, 0xe9, 0x1d, 0x4a, 0xe6, 0xe1, 0x3b, 0x14, 0xfd, 0x2a, 0x14, 0xf8,
-	0x95, 0x41, 0x64, 0x1e, 0x3c, 0x3e, 0xa5, 0x4a, 0x7c, 0x99, 0xf0, 0x17, 0x60, 0xbe, 0xef, 0x50,
-	0xf2, 0x7e, 0xfe, 0x7b, 0x06, 0xaa, 0xeb, 0xb6, 0xbd, 0x8b, 0x51, 0x68, 0xed, 0xaf, 0x53, 0x1a,
-	0x3a, 0xcd, 0x0e, 0xed, 0x59, 0xfb, 0x47, 0x1a, 0xcc, 0x11, 0xbe, 0x66, 0xa2, 0x68, 0x51, 0x2a,
-	0xfc, 0xed, 0xb1, 0x72, 0xca, 0x70, 0xe6, 0x8d, 0x7e, 0xb8, 0x48, 0x29, 0xb3, 0xa4, 0x0f, 0xcc,
-	0xca, 0x63, 0xc7, 0xb3, 0xf1, 0x41, 0x3c, 0x31, 0x96, 0x38, 0x84, 0x85, 0x8a, 0xfe, 0x2c, 0xe8,
-	0xe4, 0x9e, 0x13, 0x98, 0xc4, 0xda, 0xc7, 0x6d, 0x64, 0x76, 0x02, 0x5b, 0xf5, 0xda, 0x45, 0x63,
-	0x96, 0xad, 0xec, 0xf2, 0x85, 0xb7, 0x39, 0xbc, 0xea, 0xc2, 0x7c, 0xea, 0xbe, 0xf1, 0x2c, 0x55,
-	0x12, 0x59, 0xea, 0x5a, 0x3c, 0x4b, 0x95, 0xd7, 0x9e, 0x49, 0xea, 0x3c, 0xaa, 0xb9, 0xb6, 0x98,
-	0x24, 0xd8, 0xbe, 0xc3, 0x50, 0x79, 0x25, 0x19, 0xcb, 0x4a, 0x4b, 0xb0, 0x98, 0xaa, 0x00, 0xa9,
-	0xfd, 0x7b, 0xb0, 0x24, 0x6a, 0xa6, 0x61, 0xfa, 0xff, 0xbf, 0x61, 0xea, 0x2f, 0x9d, 0x58, 0x4f,
-	0xf5, 0x15, 0xa8, 0x0d, 0xdb, 0x4c, 0x8a, 0xf3, 0x32, 0x54, 0x59, 0xcb, 0x36, 0x44, 0x96, 0x24,
-	0x7b, 0xad, 0x9f, 0xfd, 0xc7, 0x05, 0x58, 0x4c, 0xa5, 0x96, 0xa1, 0xfb, 0xa1, 0x06, 0x73, 0x56,
-	0x87, 0x50, 0xbf, 0x3d, 0xe8, 0x4a, 0x63, 0x5f, 0x4f, 0xc3, 0xb8, 0x37, 0x36, 0x38, 0xe7, 0x01,
-	0x5f, 0xb2, 0xfa, 0xc0, 0x5c, 0x0a, 0x72, 0x48, 0x28, 0x4e, 0x48, 0x91, 0x79, 0x44, 0x52, 0xec,
-	0x72, 0xce, 0x83, 0x1e, 0xdd, 0x07, 0xd6, 0x5b, 0x30, 0xd1, 0x46, 0x41, 0xe0, 0x78, 0xad, 0x4a,
-	0x96, 0x6f, 0xbd, 0xf3, 0xd0, 0x5b, 0xef, 0x08, 0x7e, 0x62, 0x47, 0xc5, 0x5d, 0xf7, 0x60, 0x11,
-	0xd9, 0xb6, 0x39, 0x98, 0x95, 0x44, 0x07, 0x2e, 0x6a, 0xfd, 0xd5, 0xa4, 0x63, 0x2b, 0xe4, 0xd4,
-	0xe4, 0xc4, 0xd3, 0x76, 0x05, 0xd9, 0x76, 0xea, 0x0a, 0x8b, 0xae, 0x54, 0x4b, 0x3c, 0x96, 0xe8,
-	0xe2, 0xb1, 0x9c, 0xa6, 0xf1, 0xc7, 0xb3, 0xdb, 0x4b, 0x30, 0x15, 0x57, 0x72, 0xca, 0x26, 0x67,
-	0xe2, 0x9b, 0x94, 0xe2, 0x79, 0xe0, 0x65, 0x38, 0xab, 0x06, 0x4c, 0x1b, 0xe2, 0xc2, 0x8f, 0x5d,
-	0x2b, 0x89, 0xb2, 0x40, 0x1b, 0x2c, 0x0b, 0x7e, 0x53, 0x80, 0x85, 0x01, 0x6a, 0x19, 0x55, 0x3f,
-	0x80, 0x39, 0xd2, 0x09, 0x02, 0x3f, 0xa4, 0xd8, 0x36, 0x2d, 0xd7, 0xe1, 0x77, 0x84, 0x08, 0x2a,
-	0x63, 0x2c, 0x9f, 0x1a, 0xc2, 0xb8, 0xb1, 0xab, 0xb8, 0x6e, 0x08, 0xa6, 0xca, 0x95, 0xfb, 0xc0,
-	0xfa, 0xd3, 0x50, 0x16, 0xdc, 0xa3, 0x6e, 0x46, 0x1c, 0x7e, 0x5a, 0x40, 0x55, 0x2f, 0x73, 0x17,
-	0x66, 0xda, 0xb8, 0xdd, 0xc4, 0x21, 0xd9, 0x77, 0x02, 0xe1, 0x7c, 0xa3, 0x2a, 0x7a, 0xf5, 0xe6,
-	0xd0, 0xbd, 0xd2, 0xd8, 0x89, 0xc8, 0xc4, 0xe8, 0xab, 0x9d, 0xf8, 0x66, 0x59, 0x49, 0xe9, 0x2f,
-	0xba, 0x94, 0x4b, 0x12, 0x92, 0x52, 0x75, 0xe5, 0x07, 0xd4, 0xcb, 0x9a, 0x3c, 0xd5, 0x13, 0x88,
-	0xda, 0xd9, 0xf2, 0x3b, 0x1e, 0xe5, 0x4d, 0x59, 0xde, 0x98, 0x93, 0x4b, 0xbc, 0xac, 0xdd, 0x60,
-	0x0b, 0x2c, 0x27, 0xc7, 0xa6, 0x53, 0x26, 0x5b, 0x16, 0x6d, 0x59, 0xc9, 0x98, 0x8d, 0x2d, 0xec,
-	0x32, 0xb8, 0x7e, 0x09, 0x66, 0x63, 0x0d, 0xb6, 0xc0, 0x2d, 0x72, 0xdc, 0x58, 0xe3, 0x2d, 0x50,
-	0x37, 0x61, 0x4a, 0x35, 0x3d, 0x5c, 0x3f, 0x25, 0xae, 0x9f, 0xf3, 0x49, 0x4f, 0x95, 0x18, 0xb1,
-	0x56, 0x87, 0x6b, 0x65, 0xb2, 0xdb, 0xfb, 0xd0, 0xbf, 0x0d, 0xd5, 0x3d, 0xe4, 0xb8, 0x7e, 0xcc,
-	0x28, 0xa6, 0xe3, 0x59, 0x21, 0x6e, 0x63, 0x8f, 0x56, 0x80, 0x57, 0xa9, 0x15, 0x85, 0x11, 0x71,
-	0x91, 0xeb, 0xfa, 0x55, 0xa8, 0x38, 0x9e, 0x43, 0x1d, 0xe4, 0x9a, 0xfd, 0x5c, 0x2a, 0x93, 0xa2,
-	0xc2, 0x95, 0xeb, 0xaf, 0x26, 0x59, 0xe8, 0xd7, 0x60, 0xd1, 0x21, 0x66, 0xcb, 0xf5, 0x9b, 0xc8,
-	0x35, 0x7b, 0xb5, 0x12, 0xf6, 0x50, 0xd3, 0xc5, 0x76, 0x65, 0x8a, 0xdf, 0xc8, 0x15, 0x87, 0x6c,
-	0x72, 0x8c, 0xa8, 0xcc, 0xbd, 0x29, 0xd6, 0xab, 0x1b, 0x30, 0x9f, 0xea, 0x74, 0x27, 0x0a, 0xb4,
-	0x77, 0xe0, 0xf4, 0xb6, 0x43, 0xa8, 0xf4, 0xe6, 0xe8, 0xee, 0x5a, 0x84, 0x52, 0xaf, 0x85, 0x16,
-	0x8d, 0x48, 0x31, 0x18, 0xd1, 0x3b, 0xa7, 0x4e, 0xb6, 0x7e, 0xae, 0xc1, 0x99, 0x24, 0x73, 0x19,
-	0x84, 0x6f, 0x40, 0x51, 0x3a, 0xd4, 0xe8, 0x62, 0xb4, 0x6f, 0xa8, 0x29, 0xf9, 0xec, 0xc8, 0xc7,
-	0x26, 0x23, 0x62, 0x32, 0xb6, 0x44, 0xbf, 0xd4, 0x60, 0x79, 0xdd, 0xb6, 0xdf, 0x08, 0x45, 0x71,
-	0xc3, 0xae, 0x77, 0xda, 0x9f, 0x60, 0x2e, 0xc1, 0xec, 0x5e, 0xe8, 0x7b, 0x14, 0x7b, 0x76, 0xdf,
-	0x58, 0x7e, 0x46, 0xc1, 0xd5, 0x68, 0x7e, 0x13, 0x56, 0x84, 0xb1, 0xcc, 0x90, 0x73, 0x32, 0x55,
-	0xe8, 0x58, 0xbe, 0xe7, 0x61, 0x2b, 0xaa, 0x66, 0x8b, 0xc6, 0x92, 0xc0, 0x4b, 0x6c, 0xb8, 0x11,
-	0x21, 0xd5, 0xeb, 0xb0, 0x32, 0x5c, 0x2c, 0x59, 0x6c, 0xbc, 0x02, 0x55, 0x51, 0x8e, 0xa4, 0x4a,
-	0x3d, 0x46, 0x5a, 0xe4, 0x2f, 0x4d, 0x29, 0x0c, 0x7a, 0x93, 0xa7, 0x73, 0x31, 0x6b, 0xc9, 0x34,
-	0xa2, 0xf8, 0xef, 0xc2, 0x3c, 0x6f, 0xe4, 0xf6, 0x31, 0x0a, 0x69, 0x13, 0x23, 0x6a, 0xde, 0x77,
-	0xe8, 0xbe, 0xe3, 0xc9, 0x66, 0xea, 0xdc, 0xc0, 0xf8, 0xeb, 0x86, 0x7c, 0x6f, 0xbe, 0x9e, 0xfb,
-	0xe8, 0xb3, 0x65, 0xcd, 0x38, 0xcd, 0xa8, 0x6f, 0x29, 0xe2, 0xbb, 0x9c, 0x56, 0x5f, 0x86, 0xc9,
-	0x30, 0xb0, 0x22, 0x2d, 0xcb, 0x71, 0x66, 0x18, 0x58, 0x4a, 0xc1, 0x0b, 0x30, 0xc1, 0x9f, 0x47,
-	0xa2, 0x79, 0x66, 0x81, 0x7d, 0xf2, 0xb9, 0x65, 0x2e, 0xf4, 0x5d, 0x31, 0x7c, 0x2b, 0xc7, 0x2f,
-	0xe4, 0xb4, 0x21, 0x5f, 0xe2, 0x44, 0x86, 0xef, 0x62, 0x83, 0x13, 0xeb, 0xef, 0x42, 0x95, 0x60,
-	0xc2, 0xc3, 0x9d, 0x8f, 0xa6, 0xb0, 0x6d, 0xa2, 0x3d, 0xa6, 0x41, 0x3e, 0xd7, 0xcb, 0x8f, 0x39,
-	0xd7, 0x5b, 0x90, 0x3c, 0x76, 0x05, 0x8b, 0x75, 0xc6, 0x81, 0xcf, 0x35, 0x13, 0x31, 0x54, 0x38,
-	0x3e, 0x86, 0x26, 0xd2, 0x3c, 0xf6, 0x63, 0x0d, 0xaa, 0x69, 0x56, 0x91, 0x91, 0x74, 0x1b, 0xca,
-	0xc8, 0xa2, 0x4e, 0x17, 0x9b, 0x32, 0xcd, 0xcb, 0x78, 0x7a, 0xee, 0xb8, 0x5b, 0x22, 0xa9, 0x93,
-	0x69, 0xc1, 0x44, 0x72, 0x1f, 0x3b, 0x9c, 0x7e, 0x97, 0x81, 0x79, 0xd1, 0x83, 0xf6, 0x77, 0xbd,
-	0x37, 0x21, 0xc7, 0x47, 0xca, 0x1a, 0xb7, 0xcf, 0x95, 0xd1, 0xf6, 0xb9, 0x81, 0x91, 0xbd, 0x8d,
-	0x29, 0xc5, 0xe1, 0x5b, 0x1d, 0x2c, 0xeb, 0x08, 0x4e, 0x3e, 0xea, 0xed, 0x8b, 0xdd, 0xa3, 0x7e,
-	0x27, 0xb4, 0xa2, 0xa0, 0x93, 0x1e, 0x32, 0x2d, 0xa0, 0xf2, 0x7c, 0xfa, 0x0b, 0x2c, 0x3b, 0x33,
-	0x0c, 0xa6, 0x23, 0x16, 0xd2, 0xb1, 0xf9, 0x83, 0x18, 0x4b, 0xce, 0x47, 0xeb, 0x37, 0xbd, 0xd8,
-	0xf8, 0x21, 0x75, 0x98, 0x98, 0x1f, 0x7b, 0x98, 0x58, 0x48, 0xd3, 0xd7, 0xbf, 0x35, 0x38, 0xdb,
-	0xaf, 0x2f, 0x69, 0xc8, 0x47, 0xa4, 0xb0, 0xd4, 0x7e, 0x3f, 0xf3, 0x08, 0xfb, 0xfd, 0xb4, 0xb3,
-	0x66, 0xd3, 0xce, 0xfa, 0x37, 0x0d, 0x16, 0xde, 0xec, 0x84, 0x2d, 0xfc, 0x75, 0xf4, 0x8e, 0x7a,
-	0x15, 0x2a, 0x83, 0x87, 0x93, 0x89, 0xf4, 0xf7, 0x19, 0x58, 0xd8, 0xc1, 0x5f, 0xd3, 0x93, 0x3f,
-	0x96, 0xb8, 0xb8, 0x0e, 0x95, 0x41, 0x85, 0x9d, 0x6c, 0x9a, 0xce, 0x8a, 0x8d, 0x45, 0x03, 0xef,
-	0x85, 0x98, 0xec, 0xab, 0x56, 0x2b, 0xf1, 0xc0, 0xd9, 0x3f, 0x8e, 0xca, 0x3e, 0xbe, 0xc7, 0x12,
-	0x39, 0x43, 0xaa, 0xc1, 0x13, 0xe9, 0x02, 0xf5, 0xfc, 0x64, 0xc9, 0xc0, 0x04, 0x7b, 0x76, 0x5f,
-	0xd4, 0x0d, 0x95, 0xf9, 0x11, 0xbe, 0x08, 0x3e, 0x0d, 0xe5, 0x64, 0xcd, 0x22, 0x5b, 0x81, 0xe9,
-	0x30, 0x5e, 0x1c, 0xa4, 0x3c, 0xfb, 0xe4, 0x53, 0x9e, 0x7d, 0x9e, 0x82, 0x69, 0x81, 0x95, 0x7c,
-	0xa0, 0x11, 0x48, 0xc3, 0xde, 0x7a, 0x26, 0x06, 0xde, 0x7a, 0x96, 0x61, 0x92, 0x61, 0x28, 0x26,
-	0xc5, 0x08, 0x41, 0xb2, 0x10, 0x13, 0x99, 0x74, 0x85, 0x49, 0x9d, 0xfe, 0x36, 0x03, 0x95, 0x4d,
-	0x4c, 0x19, 0x50, 0xc4, 0x4c, 0x5c, 0x9d, 0xa3, 0xff, 0x2b, 0xb3, 0x24, 0x07, 0xb5, 0xfc, 0x6f,
-	0x6b, 0x6a, 0x1a, 0x44, 0x15, 0x23, 0x7d, 0x1b, 0x66, 0x7a, 0xcb, 0xe2, 0xbd, 0x34, 0xcb, 0x83,
-	0xf8, 0xfc, 0x90, 0xd6, 0xb8, 0x27, 0x03, 0x8b, 0xdb, 0x69, 0x1a, 0xff, 0xd4, 0x6b, 0x30, 0xd9,
-	0x76, 0x44, 0x7e, 0xee, 0x45, 0x5c, 0xa9, 0xed, 0x88, 0x51, 0xaf, 0xcd, 0xd7, 0xd1, 0x41, 0xb4,
-	0x9e, 0x97, 0xeb, 0xe8, 0x40, 0xae, 0x27, 0x5f, 0xc0, 0x0b, 0x63, 0xbc, 0x80, 0xa7, 0x56, 0x17,
-	0x0f, 0x34, 0x38, 0x97, 0xa2, 0x2e, 0x19, 0x7a, 0xdf, 0x49, 0x3e, 0x81, 0x7f, 0x73, 0x9c, 0x1a,
-	0x7d, 0xdd, 0x75, 0x7d, 0x0b, 0x51, 0x6c, 0x47, 0x33, 0xeb, 0x13, 0x3e, 0x87, 0xff, 0x54, 0x83,
-	0xda, 0x0d, 0xec, 0x62, 0x8a, 0x07, 0x43, 0xec, 0xab, 0xfd, 0xcf, 0xd3, 0x35, 0x58, 0x1e, 0x2a,
-	0x88, 0xd4, 0x50, 0x15, 0x8a, 0xf7, 0x51, 0xe8, 0x39, 0x5e, 0x4b, 0x4d, 0x19, 0xa3, 0xef, 0xeb,
-	0xee, 0x27, 0x9f, 0xd7, 0x4e, 0x7d, 0xfa, 0x79, 0xed, 0xd4, 0x97, 0x9f, 0xd7, 0xb4, 0x1f, 0x1e,
-	0xd5, 0xb4, 0x5f, 0x1f, 0xd5, 0xb4, 0x3f, 0x1d, 0xd5, 0xb4, 0x4f, 0x8e, 0x6a, 0xda, 0x3f, 0x8f,
-	0x6a, 0xda, 0xbf, 0x8e, 0x6a, 0xa7, 0xbe, 0x3c, 0xaa, 0x69, 0x0f, 0xbe, 0xa8, 0x9d, 0xfa, 0xe4,
-	0x8b, 0xda, 0xa9, 0x4f, 0xbf, 0xa8, 0x9d, 0x7a, 0xe7, 0x5b, 0x2d, 0xbf, 0x27, 0xac, 0xe3, 0x8f,
-	0xf8, 0xff, 0xe8, 0xcb, 0xf1, 0xef, 0x66, 0x81, 0xd7, 0xa7, 0xcf, 0xff, 0x27, 0x00, 0x00, 0xff,
-	0xff, 0x14, 0xfe, 0x56, 0xf5, 0x7a, 0x2a, 0x00, 0x00,
+func (m *GetReplicationStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (this *RebuildMutableStateRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *GetReplicationStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReplicationStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
+}
+func (m *GetReplicationStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicationStatusRequest.Merge(m, src)
+}
+func (m *GetReplicationStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReplicationStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicationStatusRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*RebuildMutableStateRequest)
-	if !ok {
-		that2, ok := that.(RebuildMutableStateRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_GetReplicationStatusRequest proto.InternalMessageInfo
+
+func (m *GetReplicationStatusRequest) GetRemoteClusters() []string {
+	if m != nil {
+		return m.RemoteClusters
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *GetReplicationStatusRequest) GetIncludeShards() bool {
+	if m != nil {
+		return m.IncludeShards
 	}
-	if this.Namespace != that1.Namespace {
-		return false
+	return false
+}
+
+func (m *GetReplicationStatusRequest) GetMaxDlqSize() int32 {
+	if m != nil {
+		return m.MaxDlqSize
 	}
-	if !this.Execution.Equal(that1.Execution) {
-		return false
+	return 0
+}
+
+type GetReplicationStatusResponse struct {
+	Clusters []*ClusterReplicationStatus `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+}
+
+func (m *GetReplicationStatusResponse) Reset()      { *m = GetReplicationStatusResponse{} }
+func (*GetReplicationStatusResponse) ProtoMessage() {}
+func (*GetReplicationStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{58}
+}
+func (m *GetReplicationStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReplicationStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReplicationStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *RebuildMutableStateResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *GetReplicationStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicationStatusResponse.Merge(m, src)
+}
+func (m *GetReplicationStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReplicationStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicationStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicationStatusResponse proto.InternalMessageInfo
+
+func (m *GetReplicationStatusResponse) GetClusters() []*ClusterReplicationStatus {
+	if m != nil {
+		return m.Clusters
 	}
+	return nil
+}
 
-	that1, ok := that.(*RebuildMutableStateResponse)
-	if !ok {
-		that2, ok := that.(RebuildMutableStateResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ClusterReplicationStatus is the replication status of this cluster towards one remote cluster.
+type ClusterReplicationStatus struct {
+	ClusterName string `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	ShardCount  int32  `protobuf:"varint,2,opt,name=shard_count,json=shardCount,proto3" json:"shard_count,omitempty"`
+	// Number of shards which have no ack level for the cluster yet. They are not part of the lag percentiles.
+	MissingShardCount int32          `protobuf:"varint,3,opt,name=missing_shard_count,json=missingShardCount,proto3" json:"missing_shard_count,omitempty"`
+	MaxLagTaskIds     int64          `protobuf:"varint,4,opt,name=max_lag_task_ids,json=maxLagTaskIds,proto3" json:"max_lag_task_ids,omitempty"`
+	P99LagTaskIds     int64          `protobuf:"varint,5,opt,name=p99_lag_task_ids,json=p99LagTaskIds,proto3" json:"p99_lag_task_ids,omitempty"`
+	MaxLag            *time.Duration `protobuf:"bytes,6,opt,name=max_lag,json=maxLag,proto3,stdduration" json:"max_lag,omitempty"`
+	P99Lag            *time.Duration `protobuf:"bytes,7,opt,name=p99_lag,json=p99Lag,proto3,stdduration" json:"p99_lag,omitempty"`
+	// Number of replication tasks from the remote cluster which failed to apply on this cluster.
+	DlqSize int64 `protobuf:"varint,8,opt,name=dlq_size,json=dlqSize,proto3" json:"dlq_size,omitempty"`
+	// Set when the DLQ of at least one shard has more than max_dlq_size tasks.
+	DlqSizeIsLowerBound bool                      `protobuf:"varint,9,opt,name=dlq_size_is_lower_bound,json=dlqSizeIsLowerBound,proto3" json:"dlq_size_is_lower_bound,omitempty"`
+	Shards              []*ShardReplicationStatus `protobuf:"bytes,10,rep,name=shards,proto3" json:"shards,omitempty"`
+}
+
+func (m *ClusterReplicationStatus) Reset()      { *m = ClusterReplicationStatus{} }
+func (*ClusterReplicationStatus) ProtoMessage() {}
+func (*ClusterReplicationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{59}
+}
+func (m *ClusterReplicationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterReplicationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterReplicationStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *ClusterReplicationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterReplicationStatus.Merge(m, src)
+}
+func (m *ClusterReplicationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterReplicationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterReplicationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterReplicationStatus proto.InternalMessageInfo
+
+func (m *ClusterReplicationStatus) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
 	}
-	return true
+	return ""
 }
-func (this *DescribeMutableStateRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ClusterReplicationStatus) GetShardCount() int32 {
+	if m != nil {
+		return m.ShardCount
 	}
+	return 0
+}
 
-	that1, ok := that.(*DescribeMutableStateRequest)
-	if !ok {
-		that2, ok := that.(DescribeMutableStateRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ClusterReplicationStatus) GetMissingShardCount() int32 {
+	if m != nil {
+		return m.MissingShardCount
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Namespace != that1.Namespace {
-		return false
-	}
-	if !this.Execution.Equal(that1.Execution) {
-		return false
-	}
-	return true
+	return 0
 }
-func (this *DescribeMutableStateResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*DescribeMutableStateResponse)
-	if !ok {
-		that2, ok := that.(DescribeMutableStateResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ClusterReplicationStatus) GetMaxLagTaskIds() int64 {
+	if m != nil {
+		return m.MaxLagTaskIds
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *ClusterReplicationStatus) GetP99LagTaskIds() int64 {
+	if m != nil {
+		return m.P99LagTaskIds
 	}
-	if this.ShardId != that1.ShardId {
-		return false
+	return 0
+}
+
+func (m *ClusterReplicationStatus) GetMaxLag() *time.Duration {
+	if m != nil {
+		return m.MaxLag
 	}
-	if this.HistoryAddr != that1.HistoryAddr {
-		return false
+	return nil
+}
+
+func (m *ClusterReplicationStatus) GetP99Lag() *time.Duration {
+	if m != nil {
+		return m.P99Lag
 	}
-	if !this.CacheMutableState.Equal(that1.CacheMutableState) {
-		return false
+	return nil
+}
+
+func (m *ClusterReplicationStatus) GetDlqSize() int64 {
+	if m != nil {
+		return m.DlqSize
 	}
-	if !this.DatabaseMutableState.Equal(that1.DatabaseMutableState) {
-		return false
+	return 0
+}
+
+func (m *ClusterReplicationStatus) GetDlqSizeIsLowerBound() bool {
+	if m != nil {
+		return m.DlqSizeIsLowerBound
 	}
-	return true
+	return false
 }
-func (this *DescribeHistoryHostRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ClusterReplicationStatus) GetShards() []*ShardReplicationStatus {
+	if m != nil {
+		return m.Shards
 	}
+	return nil
+}
 
-	that1, ok := that.(*DescribeHistoryHostRequest)
-	if !ok {
-		that2, ok := that.(DescribeHistoryHostRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ShardReplicationStatus struct {
+	ShardId int32 `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// Set when the shard has no ack level for the cluster yet.
+	Missing             bool           `protobuf:"varint,2,opt,name=missing,proto3" json:"missing,omitempty"`
+	MaxTaskId           int64          `protobuf:"varint,3,opt,name=max_task_id,json=maxTaskId,proto3" json:"max_task_id,omitempty"`
+	MaxTaskTime         *time.Time     `protobuf:"bytes,4,opt,name=max_task_time,json=maxTaskTime,proto3,stdtime" json:"max_task_time,omitempty"`
+	AckedTaskId         int64          `protobuf:"varint,5,opt,name=acked_task_id,json=ackedTaskId,proto3" json:"acked_task_id,omitempty"`
+	AckedTaskTime       *time.Time     `protobuf:"bytes,6,opt,name=acked_task_time,json=ackedTaskTime,proto3,stdtime" json:"acked_task_time,omitempty"`
+	LagTaskIds          int64          `protobuf:"varint,7,opt,name=lag_task_ids,json=lagTaskIds,proto3" json:"lag_task_ids,omitempty"`
+	Lag                 *time.Duration `protobuf:"bytes,8,opt,name=lag,proto3,stdduration" json:"lag,omitempty"`
+	DlqSize             int64          `protobuf:"varint,9,opt,name=dlq_size,json=dlqSize,proto3" json:"dlq_size,omitempty"`
+	DlqSizeIsLowerBound bool           `protobuf:"varint,10,opt,name=dlq_size_is_lower_bound,json=dlqSizeIsLowerBound,proto3" json:"dlq_size_is_lower_bound,omitempty"`
+}
+
+func (m *ShardReplicationStatus) Reset()      { *m = ShardReplicationStatus{} }
+func (*ShardReplicationStatus) ProtoMessage() {}
+func (*ShardReplicationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{60}
+}
+func (m *ShardReplicationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardReplicationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardReplicationStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *ShardReplicationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardReplicationStatus.Merge(m, src)
+}
+func (m *ShardReplicationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardReplicationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardReplicationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardReplicationStatus proto.InternalMessageInfo
+
+func (m *ShardReplicationStatus) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
 	}
-	if this.HostAddress != that1.HostAddress {
-		return false
+	return 0
+}
+
+func (m *ShardReplicationStatus) GetMissing() bool {
+	if m != nil {
+		return m.Missing
 	}
-	if this.ShardId != that1.ShardId {
-		return false
+	return false
+}
+
+func (m *ShardReplicationStatus) GetMaxTaskId() int64 {
+	if m != nil {
+		return m.MaxTaskId
 	}
-	if this.Namespace != that1.Namespace {
-		return false
+	return 0
+}
+
+func (m *ShardReplicationStatus) GetMaxTaskTime() *time.Time {
+	if m != nil {
+		return m.MaxTaskTime
 	}
-	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
-		return false
+	return nil
+}
+
+func (m *ShardReplicationStatus) GetAckedTaskId() int64 {
+	if m != nil {
+		return m.AckedTaskId
 	}
-	return true
+	return 0
 }
-func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ShardReplicationStatus) GetAckedTaskTime() *time.Time {
+	if m != nil {
+		return m.AckedTaskTime
 	}
+	return nil
+}
 
-	that1, ok := that.(*DescribeHistoryHostResponse)
-	if !ok {
-		that2, ok := that.(DescribeHistoryHostResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ShardReplicationStatus) GetLagTaskIds() int64 {
+	if m != nil {
+		return m.LagTaskIds
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *ShardReplicationStatus) GetLag() *time.Duration {
+	if m != nil {
+		return m.Lag
 	}
-	if this.ShardsNumber != that1.ShardsNumber {
-		return false
+	return nil
+}
+
+func (m *ShardReplicationStatus) GetDlqSize() int64 {
+	if m != nil {
+		return m.DlqSize
 	}
-	if len(this.ShardIds) != len(that1.ShardIds) {
-		return false
+	return 0
+}
+
+func (m *ShardReplicationStatus) GetDlqSizeIsLowerBound() bool {
+	if m != nil {
+		return m.DlqSizeIsLowerBound
 	}
-	for i := range this.ShardIds {
-		if this.ShardIds[i] != that1.ShardIds[i] {
-			return false
+	return false
+}
+
+type CountWorkflowExecutionsRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Visibility query, which may end with a GROUP BY clause on keyword search attributes.
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+}
+
+func (m *CountWorkflowExecutionsRequest) Reset()      { *m = CountWorkflowExecutionsRequest{} }
+func (*CountWorkflowExecutionsRequest) ProtoMessage() {}
+func (*CountWorkflowExecutionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{61}
+}
+func (m *CountWorkflowExecutionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CountWorkflowExecutionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CountWorkflowExecutionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !this.NamespaceCache.Equal(that1.NamespaceCache) {
-		return false
-	}
-	if this.Address != that1.Address {
-		return false
+}
+func (m *CountWorkflowExecutionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountWorkflowExecutionsRequest.Merge(m, src)
+}
+func (m *CountWorkflowExecutionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CountWorkflowExecutionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountWorkflowExecutionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountWorkflowExecutionsRequest proto.InternalMessageInfo
+
+func (m *CountWorkflowExecutionsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return true
+	return ""
 }
-func (this *CloseShardRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *CountWorkflowExecutionsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
 	}
+	return ""
+}
 
-	that1, ok := that.(*CloseShardRequest)
-	if !ok {
-		that2, ok := that.(CloseShardRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type CountWorkflowExecutionsResponse struct {
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// Set only if the query has a GROUP BY clause.
+	Groups []*AggregationGroup `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	// Number of executions which are not counted in groups, because the number of groups exceeded the limit.
+	OtherCount int64 `protobuf:"varint,3,opt,name=other_count,json=otherCount,proto3" json:"other_count,omitempty"`
+}
+
+func (m *CountWorkflowExecutionsResponse) Reset()      { *m = CountWorkflowExecutionsResponse{} }
+func (*CountWorkflowExecutionsResponse) ProtoMessage() {}
+func (*CountWorkflowExecutionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{62}
+}
+func (m *CountWorkflowExecutionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CountWorkflowExecutionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CountWorkflowExecutionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
-		return false
-	}
-	return true
 }
-func (this *CloseShardResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CountWorkflowExecutionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountWorkflowExecutionsResponse.Merge(m, src)
+}
+func (m *CountWorkflowExecutionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CountWorkflowExecutionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountWorkflowExecutionsResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*CloseShardResponse)
-	if !ok {
-		that2, ok := that.(CloseShardResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_CountWorkflowExecutionsResponse proto.InternalMessageInfo
+
+func (m *CountWorkflowExecutionsResponse) GetCount() int64 {
+	if m != nil {
+		return m.Count
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *CountWorkflowExecutionsResponse) GetGroups() []*AggregationGroup {
+	if m != nil {
+		return m.Groups
 	}
-	return true
+	return nil
 }
-func (this *GetShardRequest) Equal(that interface{}) bool {
+
+func (m *CountWorkflowExecutionsResponse) GetOtherCount() int64 {
+	if m != nil {
+		return m.OtherCount
+	}
+	return 0
+}
+
+type AggregationGroup struct {
+	// Values of the GROUP BY fields, in the order of the GROUP BY clause.
+	GroupValues []string `protobuf:"bytes,1,rep,name=group_values,json=groupValues,proto3" json:"group_values,omitempty"`
+	Count       int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *AggregationGroup) Reset()      { *m = AggregationGroup{} }
+func (*AggregationGroup) ProtoMessage() {}
+func (*AggregationGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{63}
+}
+func (m *AggregationGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregationGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregationGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregationGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregationGroup.Merge(m, src)
+}
+func (m *AggregationGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregationGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregationGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregationGroup proto.InternalMessageInfo
+
+func (m *AggregationGroup) GetGroupValues() []string {
+	if m != nil {
+		return m.GroupValues
+	}
+	return nil
+}
+
+func (m *AggregationGroup) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type RenameNamespaceRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NewName   string `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	// Keep the current name as an alias which still resolves to the renamed namespace.
+	KeepAlias bool `protobuf:"varint,3,opt,name=keep_alias,json=keepAlias,proto3" json:"keep_alias,omitempty"`
+	// Replace the archival URIs of the namespace. Empty means the URI is kept as is.
+	HistoryArchivalUri    string `protobuf:"bytes,4,opt,name=history_archival_uri,json=historyArchivalUri,proto3" json:"history_archival_uri,omitempty"`
+	VisibilityArchivalUri string `protobuf:"bytes,5,opt,name=visibility_archival_uri,json=visibilityArchivalUri,proto3" json:"visibility_archival_uri,omitempty"`
+}
+
+func (m *RenameNamespaceRequest) Reset()      { *m = RenameNamespaceRequest{} }
+func (*RenameNamespaceRequest) ProtoMessage() {}
+func (*RenameNamespaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{64}
+}
+func (m *RenameNamespaceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RenameNamespaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RenameNamespaceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RenameNamespaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameNamespaceRequest.Merge(m, src)
+}
+func (m *RenameNamespaceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RenameNamespaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameNamespaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenameNamespaceRequest proto.InternalMessageInfo
+
+func (m *RenameNamespaceRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *RenameNamespaceRequest) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+func (m *RenameNamespaceRequest) GetKeepAlias() bool {
+	if m != nil {
+		return m.KeepAlias
+	}
+	return false
+}
+
+func (m *RenameNamespaceRequest) GetHistoryArchivalUri() string {
+	if m != nil {
+		return m.HistoryArchivalUri
+	}
+	return ""
+}
+
+func (m *RenameNamespaceRequest) GetVisibilityArchivalUri() string {
+	if m != nil {
+		return m.VisibilityArchivalUri
+	}
+	return ""
+}
+
+type RenameNamespaceResponse struct {
+	NamespaceId string   `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Aliases     []string `protobuf:"bytes,2,rep,name=aliases,proto3" json:"aliases,omitempty"`
+}
+
+func (m *RenameNamespaceResponse) Reset()      { *m = RenameNamespaceResponse{} }
+func (*RenameNamespaceResponse) ProtoMessage() {}
+func (*RenameNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{65}
+}
+func (m *RenameNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RenameNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RenameNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RenameNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameNamespaceResponse.Merge(m, src)
+}
+func (m *RenameNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RenameNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenameNamespaceResponse proto.InternalMessageInfo
+
+func (m *RenameNamespaceResponse) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *RenameNamespaceResponse) GetAliases() []string {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RebuildMutableStateRequest)(nil), "temporal.server.api.adminservice.v1.RebuildMutableStateRequest")
+	proto.RegisterType((*RebuildMutableStateResponse)(nil), "temporal.server.api.adminservice.v1.RebuildMutableStateResponse")
+	proto.RegisterType((*DescribeMutableStateRequest)(nil), "temporal.server.api.adminservice.v1.DescribeMutableStateRequest")
+	proto.RegisterType((*DescribeMutableStateResponse)(nil), "temporal.server.api.adminservice.v1.DescribeMutableStateResponse")
+	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "temporal.server.api.adminservice.v1.DescribeHistoryHostRequest")
+	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "temporal.server.api.adminservice.v1.DescribeHistoryHostResponse")
+	proto.RegisterType((*CloseShardRequest)(nil), "temporal.server.api.adminservice.v1.CloseShardRequest")
+	proto.RegisterType((*CloseShardResponse)(nil), "temporal.server.api.adminservice.v1.CloseShardResponse")
+	proto.RegisterType((*GetShardRequest)(nil), "temporal.server.api.adminservice.v1.GetShardRequest")
+	proto.RegisterType((*GetShardResponse)(nil), "temporal.server.api.adminservice.v1.GetShardResponse")
+	proto.RegisterType((*ListHistoryTasksRequest)(nil), "temporal.server.api.adminservice.v1.ListHistoryTasksRequest")
+	proto.RegisterType((*ListHistoryTasksResponse)(nil), "temporal.server.api.adminservice.v1.ListHistoryTasksResponse")
+	proto.RegisterType((*Task)(nil), "temporal.server.api.adminservice.v1.Task")
+	proto.RegisterType((*RemoveTaskRequest)(nil), "temporal.server.api.adminservice.v1.RemoveTaskRequest")
+	proto.RegisterType((*RemoveTaskResponse)(nil), "temporal.server.api.adminservice.v1.RemoveTaskResponse")
+	proto.RegisterType((*GetWorkflowExecutionRawHistoryV2Request)(nil), "temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request")
+	proto.RegisterType((*GetWorkflowExecutionRawHistoryV2Response)(nil), "temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response")
+	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesRequest")
+	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesResponse")
+	proto.RegisterMapType((map[int32]*v15.ReplicationMessages)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
+	proto.RegisterType((*GetNamespaceReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest")
+	proto.RegisterType((*GetNamespaceReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse")
+	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest")
+	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse")
+	proto.RegisterType((*ReapplyEventsRequest)(nil), "temporal.server.api.adminservice.v1.ReapplyEventsRequest")
+	proto.RegisterType((*ReapplyEventsResponse)(nil), "temporal.server.api.adminservice.v1.ReapplyEventsResponse")
+	proto.RegisterType((*AddSearchAttributesRequest)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributesRequest")
+	proto.RegisterMapType((map[string]v16.IndexedValueType)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry")
+	proto.RegisterType((*AddSearchAttributesResponse)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributesResponse")
+	proto.RegisterType((*RemoveSearchAttributesRequest)(nil), "temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest")
+	proto.RegisterType((*RemoveSearchAttributesResponse)(nil), "temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse")
+	proto.RegisterType((*GetSearchAttributesRequest)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesRequest")
+	proto.RegisterType((*GetSearchAttributesResponse)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse")
+	proto.RegisterMapType((map[string]v16.IndexedValueType)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry")
+	proto.RegisterMapType((map[string]v16.IndexedValueType)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry")
+	proto.RegisterType((*DescribeClusterRequest)(nil), "temporal.server.api.adminservice.v1.DescribeClusterRequest")
+	proto.RegisterType((*DescribeClusterResponse)(nil), "temporal.server.api.adminservice.v1.DescribeClusterResponse")
+	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry")
+	proto.RegisterType((*ListClustersRequest)(nil), "temporal.server.api.adminservice.v1.ListClustersRequest")
+	proto.RegisterType((*ListClustersResponse)(nil), "temporal.server.api.adminservice.v1.ListClustersResponse")
+	proto.RegisterType((*AddOrUpdateRemoteClusterRequest)(nil), "temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest")
+	proto.RegisterType((*AddOrUpdateRemoteClusterResponse)(nil), "temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse")
+	proto.RegisterType((*RemoveRemoteClusterRequest)(nil), "temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest")
+	proto.RegisterType((*RemoveRemoteClusterResponse)(nil), "temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse")
+	proto.RegisterType((*ListClusterMembersRequest)(nil), "temporal.server.api.adminservice.v1.ListClusterMembersRequest")
+	proto.RegisterType((*ListClusterMembersResponse)(nil), "temporal.server.api.adminservice.v1.ListClusterMembersResponse")
+	proto.RegisterType((*GetDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetDLQMessagesRequest")
+	proto.RegisterType((*GetDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetDLQMessagesResponse")
+	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest")
+	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse")
+	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.MergeDLQMessagesRequest")
+	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.MergeDLQMessagesResponse")
+	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest")
+	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse")
+	proto.RegisterType((*RearchiveWorkflowExecutionRequest)(nil), "temporal.server.api.adminservice.v1.RearchiveWorkflowExecutionRequest")
+	proto.RegisterType((*RearchiveWorkflowExecutionResponse)(nil), "temporal.server.api.adminservice.v1.RearchiveWorkflowExecutionResponse")
+	proto.RegisterType((*ResendReplicationTasksRequest)(nil), "temporal.server.api.adminservice.v1.ResendReplicationTasksRequest")
+	proto.RegisterType((*ResendReplicationTasksResponse)(nil), "temporal.server.api.adminservice.v1.ResendReplicationTasksResponse")
+	proto.RegisterType((*GetTaskQueueTasksRequest)(nil), "temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest")
+	proto.RegisterType((*GetTaskQueueTasksResponse)(nil), "temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse")
+	proto.RegisterType((*DeleteWorkflowExecutionRequest)(nil), "temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest")
+	proto.RegisterType((*DeleteWorkflowExecutionResponse)(nil), "temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse")
+	proto.RegisterType((*GetReplicationStatusRequest)(nil), "temporal.server.api.adminservice.v1.GetReplicationStatusRequest")
+	proto.RegisterType((*GetReplicationStatusResponse)(nil), "temporal.server.api.adminservice.v1.GetReplicationStatusResponse")
+	proto.RegisterType((*ClusterReplicationStatus)(nil), "temporal.server.api.adminservice.v1.ClusterReplicationStatus")
+	proto.RegisterType((*ShardReplicationStatus)(nil), "temporal.server.api.adminservice.v1.ShardReplicationStatus")
+	proto.RegisterType((*CountWorkflowExecutionsRequest)(nil), "temporal.server.api.adminservice.v1.CountWorkflowExecutionsRequest")
+	proto.RegisterType((*CountWorkflowExecutionsResponse)(nil), "temporal.server.api.adminservice.v1.CountWorkflowExecutionsResponse")
+	proto.RegisterType((*AggregationGroup)(nil), "temporal.server.api.adminservice.v1.AggregationGroup")
+	proto.RegisterType((*RenameNamespaceRequest)(nil), "temporal.server.api.adminservice.v1.RenameNamespaceRequest")
+	proto.RegisterType((*RenameNamespaceResponse)(nil), "temporal.server.api.adminservice.v1.RenameNamespaceResponse")
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/adminservice/v1/request_response.proto", fileDescriptor_cc07c1a2abe7cb51)
+}
+
+var fileDescriptor_cc07c1a2abe7cb51 = []byte{
+	// 3545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3b, 0x4b, 0x6c, 0x1b, 0xd7,
+	0xb5, 0x1e, 0x52, 0xa4, 0xc8, 0xa3, 0xff, 0x58, 0xb2, 0x68, 0xca, 0xa2, 0xe5, 0x89, 0xe3, 0xdf,
+	0x4b, 0xa8, 0x58, 0xf9, 0xd9, 0xf1, 0x33, 0x02, 0x59, 0x72, 0x64, 0x25, 0x52, 0x3e, 0x23, 0xc7,
+	0x7e, 0x2f, 0x40, 0x30, 0x19, 0xcd, 0x5c, 0x51, 0x03, 0x0d, 0x67, 0xc6, 0x73, 0x2f, 0x29, 0x29,
+	0xc0, 0x7b, 0x2d, 0x9a, 0x16, 0x5d, 0x15, 0x31, 0x50, 0x14, 0x08, 0xb2, 0xea, 0xae, 0x2d, 0xd0,
+	0xa2, 0xbb, 0xee, 0xbb, 0x6a, 0x97, 0x41, 0xbb, 0x09, 0xda, 0xa2, 0x6d, 0x9c, 0x4d, 0xbb, 0xcb,
+	0xba, 0xab, 0xe2, 0xfe, 0xe6, 0x43, 0x0e, 0x69, 0x2a, 0xb6, 0x53, 0x20, 0x3b, 0xcd, 0xb9, 0xe7,
+	0x9c, 0x7b, 0xee, 0xf9, 0xdd, 0x73, 0xce, 0xa5, 0xe0, 0x15, 0x82, 0x9a, 0x81, 0x1f, 0x9a, 0xee,
+	0x22, 0x46, 0x61, 0x1b, 0x85, 0x8b, 0x66, 0xe0, 0x2c, 0x9a, 0x76, 0xd3, 0xf1, 0xe8, 0xb7, 0x63,
+	0xa1, 0xc5, 0xf6, 0xe5, 0xc5, 0x10, 0xdd, 0x6b, 0x21, 0x4c, 0x8c, 0x10, 0xe1, 0xc0, 0xf7, 0x30,
+	0xaa, 0x07, 0xa1, 0x4f, 0x7c, 0xf5, 0x29, 0x49, 0x5b, 0xe7, 0xb4, 0x75, 0x33, 0x70, 0xea, 0x49,
+	0xda, 0x7a, 0xfb, 0x72, 0xf5, 0x74, 0xc3, 0xf7, 0x1b, 0x2e, 0x5a, 0x64, 0x24, 0xdb, 0xad, 0x9d,
+	0x45, 0xe2, 0x34, 0x11, 0x26, 0x66, 0x33, 0xe0, 0x5c, 0xaa, 0xb5, 0x4e, 0x04, 0xbb, 0x15, 0x9a,
+	0xc4, 0xf1, 0x3d, 0xb1, 0x7e, 0xc6, 0x46, 0x01, 0xf2, 0x6c, 0xe4, 0x59, 0x0e, 0xc2, 0x8b, 0x0d,
+	0xbf, 0xe1, 0x33, 0x38, 0xfb, 0x4b, 0xa0, 0x68, 0xd1, 0x21, 0xa8, 0xf4, 0xc8, 0x6b, 0x35, 0x31,
+	0x15, 0xdb, 0xf2, 0x9b, 0xcd, 0x88, 0xcd, 0xb9, 0x6c, 0x1c, 0x62, 0xe2, 0x3d, 0xe3, 0x5e, 0x0b,
+	0xb5, 0xc4, 0xa1, 0xaa, 0x67, 0x53, 0x78, 0x9c, 0x05, 0x45, 0x6c, 0x22, 0x8c, 0xcd, 0x86, 0xc4,
+	0x7a, 0x3a, 0x85, 0xd5, 0x46, 0x21, 0x76, 0xb2, 0xd0, 0xd2, 0x9b, 0xee, 0xfb, 0xe1, 0xde, 0x8e,
+	0xeb, 0xef, 0x77, 0xe3, 0x3d, 0x93, 0x65, 0x05, 0xcb, 0x6d, 0x61, 0x82, 0xc2, 0x6e, 0xec, 0x8b,
+	0x59, 0xd8, 0xd9, 0xa7, 0xbe, 0xd4, 0x1f, 0x95, 0xef, 0x20, 0x70, 0xcf, 0xf7, 0xc5, 0xa5, 0x8a,
+	0xea, 0x27, 0xed, 0xae, 0x83, 0x89, 0x1f, 0x1e, 0x76, 0x4b, 0x5b, 0xcf, 0xc2, 0xf6, 0xcc, 0x26,
+	0xc2, 0x81, 0x69, 0xa1, 0x6e, 0xfc, 0xe7, 0xb2, 0xf0, 0x43, 0x14, 0xb8, 0x8e, 0xc5, 0xdc, 0xa2,
+	0x9b, 0xe2, 0x6a, 0x16, 0x45, 0x40, 0x6d, 0x82, 0x09, 0xf2, 0x2c, 0x94, 0x38, 0xaa, 0xd1, 0x44,
+	0xc4, 0xb4, 0x4d, 0x62, 0x0a, 0xd2, 0xe7, 0x07, 0x20, 0x45, 0x07, 0xc8, 0x6a, 0xd1, 0x9d, 0xb1,
+	0x20, 0x7a, 0x75, 0x00, 0x22, 0x69, 0x6b, 0xa3, 0xd9, 0x22, 0xe6, 0xb6, 0x8b, 0x0c, 0x4c, 0x4c,
+	0xd2, 0x57, 0x25, 0x1d, 0x0c, 0xa8, 0xbe, 0xc5, 0x86, 0xda, 0x47, 0x0a, 0x54, 0x75, 0xb4, 0xdd,
+	0x72, 0x5c, 0x7b, 0x93, 0xb3, 0xdb, 0xa2, 0xdc, 0x74, 0x1e, 0x96, 0xea, 0x29, 0x28, 0x47, 0xfa,
+	0xac, 0x28, 0x0b, 0xca, 0x85, 0xb2, 0x1e, 0x03, 0xd4, 0x35, 0x28, 0x47, 0x27, 0xa8, 0xe4, 0x16,
+	0x94, 0x0b, 0x23, 0x4b, 0x17, 0x23, 0x01, 0x58, 0xc8, 0x0a, 0x8f, 0x69, 0x5f, 0xae, 0xdf, 0x15,
+	0x52, 0xdf, 0x94, 0x04, 0x7a, 0x4c, 0xab, 0xcd, 0xc3, 0x5c, 0xa6, 0x10, 0x3c, 0x27, 0x68, 0xdf,
+	0x57, 0x60, 0x6e, 0x15, 0x61, 0x2b, 0x74, 0xb6, 0xd1, 0x7f, 0x50, 0xca, 0xdf, 0xe4, 0xe0, 0x54,
+	0xb6, 0x18, 0x5c, 0x4e, 0xf5, 0x24, 0x94, 0xf0, 0xae, 0x19, 0xda, 0x86, 0x63, 0x0b, 0x31, 0x86,
+	0xd9, 0xf7, 0xba, 0xad, 0x9e, 0x81, 0x51, 0xe1, 0xc6, 0x86, 0x69, 0xdb, 0x21, 0x93, 0xa3, 0xac,
+	0x8f, 0x08, 0xd8, 0xb2, 0x6d, 0x87, 0xea, 0x2e, 0x1c, 0xb7, 0x4c, 0x6b, 0x17, 0xa5, 0xed, 0x5a,
+	0xc9, 0x33, 0x89, 0xaf, 0xd4, 0xb3, 0x32, 0x62, 0xc2, 0xb0, 0x49, 0xe9, 0x53, 0xc2, 0x4d, 0x31,
+	0xa6, 0x49, 0x90, 0xea, 0xc1, 0x09, 0xea, 0xa8, 0xdb, 0x26, 0xee, 0xdc, 0x6c, 0xe8, 0x11, 0x37,
+	0x9b, 0x96, 0x7c, 0x93, 0x50, 0xed, 0x0f, 0x0a, 0x54, 0xa5, 0xe2, 0x6e, 0xf1, 0x13, 0xdf, 0xf2,
+	0x31, 0x91, 0xe6, 0xa3, 0xba, 0xf1, 0x31, 0x61, 0x8a, 0x41, 0x18, 0x0b, 0xd5, 0x8d, 0x50, 0xd8,
+	0x32, 0x07, 0xa5, 0x34, 0x4b, 0x55, 0x57, 0x88, 0x35, 0x9b, 0x32, 0x7e, 0xbe, 0xd3, 0xf8, 0xff,
+	0x03, 0x6a, 0x14, 0x2f, 0xb1, 0x17, 0x0c, 0x1d, 0xd5, 0x0b, 0xa6, 0xf6, 0x3b, 0x41, 0xda, 0x5f,
+	0x13, 0x4e, 0x99, 0x3a, 0x94, 0x70, 0x86, 0xa7, 0x60, 0x8c, 0x89, 0x88, 0x0d, 0xaf, 0xd5, 0xdc,
+	0x46, 0x21, 0x3b, 0x56, 0x41, 0x1f, 0xe5, 0xc0, 0x37, 0x19, 0x4c, 0x9d, 0x83, 0xb2, 0x3c, 0x17,
+	0xae, 0xe4, 0x16, 0xf2, 0x17, 0x0a, 0x7a, 0x49, 0x1c, 0x0c, 0xab, 0xef, 0xc3, 0x44, 0x74, 0x10,
+	0x83, 0x59, 0x51, 0x38, 0xc3, 0x0b, 0x99, 0xf6, 0x89, 0x70, 0xe9, 0x11, 0xde, 0x94, 0x1f, 0x2b,
+	0x94, 0x6e, 0xdd, 0xdb, 0xf1, 0xf5, 0x71, 0x2f, 0x05, 0x53, 0x2b, 0x30, 0x2c, 0x35, 0x5e, 0xe0,
+	0xce, 0x2a, 0x3e, 0x5f, 0x1f, 0x2a, 0x0d, 0x4d, 0x16, 0xb4, 0x3a, 0x4c, 0xad, 0xb8, 0x3e, 0x46,
+	0x5b, 0x54, 0x1e, 0x69, 0xab, 0x4e, 0x17, 0x8f, 0x0d, 0xa1, 0x4d, 0x83, 0x9a, 0xc4, 0x17, 0xb1,
+	0xfb, 0x0c, 0x4c, 0xac, 0x21, 0x32, 0x28, 0x8f, 0x0f, 0x60, 0x32, 0xc6, 0x16, 0x8a, 0xdc, 0x00,
+	0x10, 0xe8, 0xde, 0x8e, 0xcf, 0x08, 0x46, 0x96, 0x9e, 0x1d, 0xc4, 0x43, 0x19, 0x1b, 0x76, 0xf4,
+	0x32, 0x96, 0x7f, 0x6a, 0x3f, 0xca, 0xc1, 0xec, 0x86, 0x83, 0x89, 0x30, 0xd9, 0x6d, 0x9a, 0x0b,
+	0x1f, 0x2e, 0x98, 0xfa, 0x1a, 0x94, 0x2c, 0x93, 0xa0, 0x86, 0x1f, 0x1e, 0x32, 0x07, 0x1c, 0x5f,
+	0xba, 0x94, 0x29, 0x02, 0xbb, 0xd4, 0xe8, 0xe6, 0x94, 0xf1, 0x8a, 0xa0, 0xd0, 0x23, 0x5a, 0xf5,
+	0x16, 0x00, 0xab, 0x0b, 0x42, 0xd3, 0x6b, 0x48, 0x73, 0x5e, 0xcc, 0xe4, 0x24, 0x52, 0x83, 0xe4,
+	0xa5, 0x53, 0x02, 0xbd, 0x4c, 0xe4, 0x9f, 0xea, 0x3c, 0xc0, 0xb6, 0x49, 0xac, 0x5d, 0x03, 0x3b,
+	0x1f, 0xf2, 0xc0, 0x2d, 0xe8, 0x65, 0x06, 0xd9, 0x72, 0x3e, 0x44, 0xea, 0x39, 0x98, 0xf0, 0xd0,
+	0x01, 0x31, 0x02, 0xb3, 0x81, 0x0c, 0xe2, 0xef, 0x21, 0x8f, 0x59, 0x79, 0x54, 0x1f, 0xa3, 0xe0,
+	0xb7, 0xcd, 0x06, 0xba, 0x4d, 0x81, 0xf4, 0x02, 0xa8, 0x74, 0xeb, 0x43, 0xa8, 0xfe, 0x55, 0x28,
+	0xd0, 0x0d, 0x69, 0x48, 0xe6, 0x7b, 0x0a, 0xda, 0x51, 0x96, 0x71, 0x69, 0x39, 0x5d, 0x96, 0x14,
+	0xb9, 0x2c, 0x29, 0x3e, 0xc9, 0xc1, 0x10, 0xa5, 0xa3, 0xb9, 0x20, 0xf6, 0xf9, 0x28, 0x8d, 0x8e,
+	0x44, 0xb0, 0x75, 0x5b, 0x3d, 0x0d, 0x23, 0x51, 0x48, 0x8b, 0x74, 0x50, 0xd6, 0x41, 0x82, 0xd6,
+	0x6d, 0x75, 0x06, 0x8a, 0x61, 0xcb, 0xa3, 0x6b, 0x3c, 0x1d, 0x14, 0xc2, 0x96, 0xb7, 0x6e, 0xab,
+	0xb3, 0x30, 0xcc, 0x54, 0xef, 0xd8, 0x4c, 0x5b, 0x79, 0xbd, 0x48, 0x3f, 0xd7, 0x6d, 0x75, 0x05,
+	0x98, 0x5a, 0x0d, 0x72, 0x18, 0x20, 0xa6, 0xa4, 0xf1, 0xa5, 0x73, 0x0f, 0x37, 0xee, 0xed, 0xc3,
+	0x00, 0xe9, 0x25, 0x22, 0xfe, 0x52, 0xaf, 0x43, 0x79, 0xc7, 0x09, 0x91, 0x41, 0x9c, 0x26, 0xaa,
+	0x14, 0x99, 0x5d, 0xab, 0x75, 0x5e, 0x7f, 0xd6, 0x65, 0xfd, 0x59, 0xbf, 0x2d, 0x0b, 0xd4, 0x1b,
+	0x43, 0xf7, 0xff, 0x76, 0x5a, 0xd1, 0x4b, 0x94, 0x84, 0x02, 0x69, 0x30, 0x8a, 0x52, 0xaf, 0x32,
+	0xcc, 0x84, 0x93, 0x9f, 0xda, 0x9f, 0x14, 0x98, 0xd2, 0x51, 0xd3, 0x6f, 0x23, 0xa6, 0xd8, 0x6f,
+	0xce, 0x55, 0x13, 0xfa, 0xca, 0xa7, 0xf4, 0xb5, 0x0e, 0x13, 0x6d, 0x07, 0x3b, 0xdb, 0x8e, 0xeb,
+	0x90, 0x43, 0x7e, 0xe0, 0xa1, 0x01, 0x0f, 0x3c, 0x1e, 0x13, 0xd2, 0x25, 0x9a, 0x33, 0x92, 0x67,
+	0x13, 0x39, 0xe3, 0xc7, 0x79, 0x38, 0xbf, 0x86, 0x48, 0x77, 0x1a, 0x36, 0xf7, 0x85, 0x9b, 0xde,
+	0x59, 0x4a, 0x5c, 0x1e, 0x29, 0x87, 0x29, 0x77, 0x3b, 0xcc, 0xe3, 0x2a, 0x00, 0xd4, 0xb3, 0x30,
+	0x8e, 0x89, 0x19, 0x12, 0x03, 0xb5, 0x91, 0x47, 0x62, 0xc5, 0x8c, 0x32, 0xe8, 0x4d, 0x0a, 0x5c,
+	0xb7, 0xd5, 0x3a, 0x1c, 0x4f, 0x62, 0x49, 0xb3, 0x72, 0x9f, 0x9b, 0x8a, 0x51, 0xef, 0xf0, 0x05,
+	0x75, 0x01, 0x46, 0x91, 0x67, 0xc7, 0x3c, 0x0b, 0x0c, 0x11, 0x90, 0x67, 0x4b, 0x8e, 0x97, 0x60,
+	0x2a, 0xc6, 0x90, 0xfc, 0x8a, 0x0c, 0x6d, 0x42, 0xa2, 0x49, 0x6e, 0x97, 0x60, 0xaa, 0x69, 0x1e,
+	0x38, 0xcd, 0x56, 0x93, 0x07, 0x1d, 0xcb, 0x0e, 0xc3, 0xcc, 0x43, 0x26, 0xc4, 0x02, 0x0d, 0xbb,
+	0x5e, 0x39, 0xa2, 0x94, 0x11, 0x9d, 0xaf, 0x0f, 0x95, 0x94, 0xc9, 0x9c, 0xf6, 0xd3, 0x1c, 0x5c,
+	0x78, 0xb8, 0x55, 0x44, 0xe6, 0xc8, 0x60, 0xad, 0x64, 0xb0, 0xa6, 0xbe, 0x24, 0xeb, 0x22, 0x96,
+	0xbb, 0x10, 0xbf, 0x06, 0x47, 0x96, 0x16, 0x7a, 0x59, 0x68, 0xd5, 0x24, 0xe6, 0x0d, 0xd7, 0xdf,
+	0xd6, 0xc7, 0x05, 0xe1, 0x0d, 0x4e, 0xa7, 0xde, 0x85, 0x09, 0xa1, 0x1b, 0x43, 0xac, 0x88, 0xfc,
+	0x5a, 0x7f, 0x58, 0x7e, 0x15, 0xba, 0x13, 0xa7, 0xd0, 0xc7, 0xdb, 0xa9, 0x6f, 0xf5, 0x02, 0x4c,
+	0x4a, 0x19, 0x3d, 0xdf, 0x46, 0xec, 0xae, 0x1e, 0x5a, 0xc8, 0x5f, 0xc8, 0x47, 0x22, 0xbc, 0xe9,
+	0xdb, 0x68, 0xdd, 0xc6, 0xda, 0x7d, 0x05, 0xe6, 0xd7, 0x10, 0xd1, 0xe3, 0x96, 0x62, 0x93, 0xb7,
+	0x13, 0xd1, 0x15, 0xb3, 0x01, 0x45, 0xa6, 0x0d, 0x99, 0x52, 0xb3, 0xaf, 0xf2, 0x44, 0x4f, 0x42,
+	0xe5, 0x4b, 0xf0, 0x63, 0x5a, 0xd3, 0x05, 0x0f, 0xea, 0xfc, 0xb2, 0xfb, 0xa0, 0x0e, 0x2f, 0xab,
+	0x4a, 0x01, 0xa3, 0x35, 0x80, 0xf6, 0x69, 0x0e, 0x6a, 0xbd, 0x44, 0x12, 0xb6, 0xfa, 0x3f, 0x18,
+	0xe7, 0xb9, 0x44, 0xf4, 0x3e, 0x52, 0xb6, 0x3b, 0x03, 0xa5, 0xfb, 0xfe, 0xcc, 0xf9, 0x25, 0x2c,
+	0xa1, 0x37, 0x3d, 0x12, 0x1e, 0xea, 0x63, 0x38, 0x09, 0xab, 0x1e, 0x82, 0xda, 0x8d, 0xa4, 0x4e,
+	0x42, 0x7e, 0x0f, 0x1d, 0x8a, 0xdc, 0x46, 0xff, 0x54, 0x37, 0xa1, 0xd0, 0x36, 0xdd, 0x16, 0x12,
+	0x21, 0xfc, 0xf2, 0x11, 0x35, 0x17, 0x49, 0xc6, 0xb9, 0xbc, 0x92, 0xbb, 0xa2, 0x68, 0xbf, 0x55,
+	0xe0, 0xdc, 0x1a, 0x22, 0x51, 0xb1, 0xd4, 0xc7, 0x70, 0x57, 0xe1, 0xa4, 0x6b, 0xb2, 0x41, 0x05,
+	0x09, 0x1d, 0xd4, 0x46, 0x91, 0xb6, 0x64, 0x06, 0xce, 0xeb, 0x27, 0x28, 0x82, 0x2e, 0xd7, 0x05,
+	0x83, 0x75, 0x3b, 0x22, 0x0d, 0x42, 0xdf, 0x42, 0x18, 0xa7, 0x49, 0x73, 0x31, 0xe9, 0xdb, 0x72,
+	0x3d, 0x26, 0xed, 0x34, 0x70, 0xbe, 0xdb, 0xc0, 0xff, 0xcf, 0x72, 0x65, 0xff, 0x23, 0x08, 0x43,
+	0x6f, 0x41, 0x29, 0x61, 0xe2, 0x47, 0x52, 0x62, 0xc4, 0x48, 0xfb, 0x10, 0x16, 0xd6, 0x10, 0x59,
+	0xdd, 0x78, 0xa7, 0x8f, 0xf2, 0xee, 0x88, 0xaa, 0x87, 0x56, 0x70, 0xd2, 0xbb, 0x8e, 0xba, 0x35,
+	0xbd, 0x21, 0x78, 0x31, 0x47, 0xc4, 0x5f, 0x58, 0xfb, 0x81, 0x02, 0x67, 0xfa, 0x6c, 0x2e, 0x8e,
+	0xfd, 0x01, 0x4c, 0x25, 0xd8, 0x1a, 0xc9, 0x8a, 0xe6, 0xf9, 0xaf, 0x21, 0x84, 0x3e, 0x19, 0xa6,
+	0x01, 0x58, 0xfb, 0xa3, 0x02, 0xd3, 0x3a, 0x32, 0x83, 0xc0, 0x3d, 0x64, 0xc9, 0x18, 0xf7, 0xba,
+	0x9d, 0x86, 0xba, 0x6f, 0xa7, 0xec, 0x0e, 0x25, 0xf7, 0xe8, 0x1d, 0x8a, 0x7a, 0x05, 0x8a, 0xec,
+	0xca, 0xc0, 0x22, 0x0f, 0x3e, 0x3c, 0xa5, 0x0a, 0x7c, 0x91, 0xf0, 0x67, 0x61, 0xa6, 0xe3, 0x50,
+	0xe2, 0x7e, 0xfe, 0x4b, 0x0e, 0xaa, 0xcb, 0xb6, 0xbd, 0x85, 0xcc, 0xd0, 0xda, 0x5d, 0x26, 0x24,
+	0x74, 0xb6, 0x5b, 0x24, 0xb6, 0xf6, 0xf7, 0x14, 0x98, 0xc2, 0x6c, 0xcd, 0x30, 0xa3, 0x45, 0xa1,
+	0xf0, 0x77, 0x07, 0xca, 0x29, 0xbd, 0x99, 0xd7, 0x3b, 0xe1, 0x3c, 0xa5, 0x4c, 0xe2, 0x0e, 0x30,
+	0x2d, 0x8f, 0x1d, 0xcf, 0x46, 0x07, 0xc9, 0xc4, 0x58, 0x66, 0x10, 0x1a, 0x2a, 0xea, 0x33, 0xa0,
+	0xe2, 0x3d, 0x27, 0x30, 0xb0, 0xb5, 0x8b, 0x9a, 0xa6, 0xd1, 0x0a, 0x6c, 0xd9, 0x6b, 0x97, 0xf4,
+	0x49, 0xba, 0xb2, 0xc5, 0x16, 0xde, 0x65, 0xf0, 0xaa, 0x0b, 0x33, 0x99, 0xfb, 0x26, 0xb3, 0x54,
+	0x99, 0x67, 0xa9, 0xeb, 0xc9, 0x2c, 0x35, 0xbe, 0x74, 0x3e, 0xad, 0xf3, 0xa8, 0xe6, 0x5a, 0xa7,
+	0x92, 0x20, 0xfb, 0x0e, 0x45, 0x65, 0x95, 0x64, 0x22, 0x2b, 0xcd, 0xc3, 0x5c, 0xa6, 0x02, 0x84,
+	0xf6, 0xf7, 0x60, 0x9e, 0xd7, 0x4c, 0xbd, 0xf4, 0xff, 0x5f, 0xbd, 0xd4, 0x5f, 0x3e, 0xb2, 0x9e,
+	0xb4, 0x05, 0xa8, 0xf5, 0xda, 0x4c, 0x88, 0x73, 0x0d, 0xaa, 0xb4, 0x65, 0xeb, 0x21, 0x4b, 0x9a,
+	0xbd, 0xd2, 0xc9, 0xfe, 0xd3, 0x22, 0xcc, 0x65, 0x52, 0x8b, 0xd0, 0xfd, 0x48, 0x81, 0x29, 0xab,
+	0x85, 0x89, 0xdf, 0xec, 0x76, 0xa5, 0x81, 0xaf, 0xa7, 0x5e, 0xdc, 0xeb, 0x2b, 0x8c, 0x73, 0x97,
+	0x2f, 0x59, 0x1d, 0x60, 0x26, 0x05, 0x3e, 0xc4, 0x04, 0xa5, 0xa4, 0xc8, 0x3d, 0x26, 0x29, 0xb6,
+	0x18, 0xe7, 0x6e, 0x8f, 0xee, 0x00, 0xab, 0x0d, 0x18, 0x6e, 0x9a, 0x41, 0xe0, 0x78, 0x8d, 0x4a,
+	0x9e, 0x6d, 0xbd, 0xf9, 0xc8, 0x5b, 0x6f, 0x72, 0x7e, 0x7c, 0x47, 0xc9, 0x5d, 0xf5, 0x60, 0xce,
+	0xb4, 0x6d, 0xa3, 0x3b, 0x2b, 0xf1, 0x0e, 0x9c, 0xd7, 0xfa, 0x8b, 0x69, 0xc7, 0x96, 0xc8, 0x99,
+	0xc9, 0x89, 0xa5, 0xed, 0x8a, 0x69, 0xdb, 0x99, 0x2b, 0x34, 0xba, 0x32, 0x2d, 0xf1, 0x44, 0xa2,
+	0x8b, 0xc5, 0x72, 0x96, 0xc6, 0x9f, 0xcc, 0x6e, 0xaf, 0xc0, 0x68, 0x52, 0xc9, 0x19, 0x9b, 0x4c,
+	0x27, 0x37, 0x29, 0x27, 0xf3, 0xc0, 0x35, 0x38, 0x21, 0x07, 0x4c, 0x2b, 0xfc, 0xc2, 0x4f, 0x5c,
+	0x2b, 0xa9, 0xb2, 0x40, 0xe9, 0x2e, 0x0b, 0x7e, 0x51, 0x84, 0xd9, 0x2e, 0x6a, 0x11, 0x55, 0xdf,
+	0x81, 0x29, 0xdc, 0x0a, 0x02, 0x3f, 0x24, 0xc8, 0x36, 0x2c, 0xd7, 0x61, 0x77, 0x04, 0x0f, 0x2a,
+	0x7d, 0x20, 0x9f, 0xea, 0xc1, 0xb8, 0xbe, 0x25, 0xb9, 0xae, 0x70, 0xa6, 0xd2, 0x95, 0x3b, 0xc0,
+	0xea, 0xd3, 0x30, 0xce, 0xb9, 0x47, 0xdd, 0x0c, 0x3f, 0xfc, 0x18, 0x87, 0xca, 0x5e, 0xe6, 0x2e,
+	0x4c, 0x34, 0x11, 0x9d, 0x93, 0xe1, 0x5d, 0x27, 0xe0, 0xce, 0xd7, 0xaf, 0xa2, 0x17, 0xc7, 0xa7,
+	0x02, 0x6e, 0x46, 0x64, 0x7c, 0xf4, 0xd5, 0x4c, 0x7d, 0xd3, 0xac, 0x24, 0xf5, 0x17, 0x5d, 0xca,
+	0x65, 0x01, 0xc9, 0xa8, 0xba, 0x0a, 0x5d, 0xea, 0xa5, 0x4d, 0x9e, 0xec, 0x09, 0x78, 0xed, 0x6c,
+	0xf9, 0x2d, 0x8f, 0xb0, 0xa6, 0xac, 0xa0, 0x4f, 0x89, 0x25, 0x56, 0xd6, 0xae, 0xd0, 0x05, 0x9a,
+	0x93, 0x13, 0xd3, 0x29, 0x83, 0x2e, 0xf3, 0xb6, 0xac, 0xac, 0x4f, 0x26, 0x16, 0xb6, 0x28, 0x5c,
+	0xbd, 0x08, 0x93, 0x89, 0x06, 0x9b, 0xe3, 0x96, 0x18, 0x6e, 0xa2, 0xf1, 0xe6, 0xa8, 0x6b, 0x30,
+	0x2a, 0x9b, 0x1e, 0xa6, 0x9f, 0x32, 0xd3, 0xcf, 0xd9, 0xb4, 0xa7, 0x0a, 0x8c, 0x44, 0xab, 0xc3,
+	0xb4, 0x32, 0xd2, 0x8e, 0x3f, 0xd4, 0xff, 0x86, 0xea, 0x8e, 0xe9, 0xb8, 0x7e, 0xc2, 0x28, 0x86,
+	0xe3, 0x59, 0x21, 0x6a, 0x22, 0x8f, 0x54, 0x80, 0x55, 0xa9, 0x15, 0x89, 0x11, 0x71, 0x11, 0xeb,
+	0xea, 0x15, 0xa8, 0x38, 0x9e, 0x43, 0x1c, 0xd3, 0x35, 0x3a, 0xb9, 0x54, 0x46, 0x78, 0x85, 0x2b,
+	0xd6, 0x5f, 0x4b, 0xb3, 0x50, 0xaf, 0xc3, 0x9c, 0x83, 0x8d, 0x86, 0xeb, 0x6f, 0x9b, 0xae, 0x11,
+	0xd7, 0x4a, 0xc8, 0xa3, 0xe3, 0x63, 0xbb, 0x32, 0xca, 0x6e, 0xe4, 0x8a, 0x83, 0xd7, 0x18, 0x46,
+	0x54, 0xe6, 0xde, 0xe4, 0xeb, 0xd5, 0x15, 0x98, 0xc9, 0x74, 0xba, 0x23, 0x05, 0xda, 0x7b, 0x70,
+	0x9c, 0x8e, 0xc0, 0x84, 0x37, 0x47, 0x77, 0xd7, 0x1c, 0x94, 0xe3, 0x16, 0x9a, 0x37, 0x22, 0xa5,
+	0xa0, 0x4f, 0xef, 0x9c, 0x39, 0xd9, 0xfa, 0x58, 0x81, 0xe9, 0x34, 0x73, 0x11, 0x84, 0x6f, 0x41,
+	0x49, 0x38, 0x54, 0xff, 0x62, 0xb4, 0x63, 0xa8, 0x29, 0xf8, 0x6c, 0x8a, 0xc7, 0x26, 0x3d, 0x62,
+	0x32, 0xb0, 0x44, 0x3f, 0x51, 0xe0, 0xf4, 0xb2, 0x6d, 0xbf, 0x15, 0xf2, 0xe2, 0x86, 0x5e, 0xef,
+	0xa4, 0x33, 0xc1, 0x5c, 0x84, 0xc9, 0x9d, 0xd0, 0xf7, 0x08, 0x1d, 0x3b, 0xa4, 0xc7, 0xf2, 0x13,
+	0x12, 0x2e, 0x47, 0xf3, 0x6b, 0xb0, 0xc0, 0x8d, 0x65, 0x84, 0x8c, 0x93, 0x21, 0x43, 0xc7, 0xf2,
+	0x3d, 0x0f, 0x59, 0x51, 0x35, 0x5b, 0xd2, 0xe7, 0x39, 0x5e, 0x6a, 0xc3, 0x95, 0x08, 0x49, 0xd3,
+	0x60, 0xa1, 0xb7, 0x58, 0xa2, 0xd8, 0x78, 0x15, 0xaa, 0xbc, 0x1c, 0xc9, 0x94, 0x7a, 0x80, 0xb4,
+	0xc8, 0x5e, 0x9a, 0x32, 0x18, 0xc4, 0x93, 0xa7, 0x93, 0x09, 0x6b, 0x89, 0x34, 0x22, 0xf9, 0x6f,
+	0xc1, 0x0c, 0x6b, 0xe4, 0x76, 0x91, 0x19, 0x92, 0x6d, 0x64, 0x12, 0x63, 0xdf, 0x21, 0xbb, 0x8e,
+	0x27, 0x9a, 0xa9, 0x93, 0x5d, 0xe3, 0xaf, 0x55, 0xf1, 0xde, 0x7c, 0x63, 0xe8, 0x13, 0x3a, 0xfd,
+	0x3a, 0x4e, 0xa9, 0x6f, 0x49, 0xe2, 0xbb, 0x8c, 0x96, 0x8e, 0x33, 0xc3, 0xc0, 0x8a, 0xb4, 0x2c,
+	0xc6, 0x99, 0x61, 0x60, 0x49, 0x05, 0xcf, 0xc2, 0x30, 0x7b, 0x1e, 0x89, 0xe6, 0x99, 0x45, 0xfa,
+	0xc9, 0xe6, 0x96, 0x43, 0xa1, 0xef, 0xf2, 0xe1, 0xdb, 0xf8, 0xd2, 0x62, 0xa6, 0xf7, 0x44, 0x97,
+	0x54, 0xea, 0x44, 0xba, 0xef, 0x22, 0x9d, 0x11, 0xab, 0xef, 0x43, 0x15, 0x23, 0xcc, 0xc2, 0x9d,
+	0x8d, 0xa6, 0x90, 0x6d, 0x98, 0x3b, 0x54, 0x83, 0xc4, 0x11, 0x99, 0x6f, 0x90, 0xb9, 0xde, 0xac,
+	0xe0, 0xb1, 0xc5, 0x59, 0x2c, 0x53, 0x0e, 0x14, 0x27, 0x1d, 0x43, 0xc5, 0x87, 0xc7, 0xd0, 0x70,
+	0x96, 0xc7, 0x7e, 0xaa, 0x40, 0x35, 0xcb, 0x2a, 0x22, 0x92, 0x6e, 0xc3, 0xb8, 0x69, 0x11, 0xa7,
+	0x8d, 0x0c, 0x91, 0xe6, 0x45, 0x3c, 0x3d, 0xfb, 0xb0, 0x5b, 0x22, 0xad, 0x93, 0x31, 0xce, 0x44,
+	0x70, 0x1f, 0x38, 0x9c, 0x7e, 0x95, 0x83, 0x19, 0xde, 0x83, 0x76, 0x76, 0xbd, 0x37, 0x61, 0x88,
+	0x8d, 0x94, 0x15, 0x66, 0x9f, 0xcb, 0xfd, 0xed, 0xb3, 0x8a, 0x4c, 0x7b, 0x03, 0x11, 0x82, 0xc2,
+	0x77, 0x5a, 0x48, 0xd4, 0x11, 0x8c, 0xbc, 0xdf, 0xdb, 0x17, 0xbd, 0x47, 0xfd, 0x56, 0x68, 0x45,
+	0x41, 0x27, 0x3c, 0x64, 0x8c, 0x43, 0xc5, 0xf9, 0xd4, 0x97, 0x69, 0x76, 0xa6, 0x18, 0x54, 0x47,
+	0x34, 0xa4, 0x13, 0xf3, 0x07, 0x3e, 0x96, 0x9c, 0x89, 0xd6, 0x6f, 0x7a, 0x89, 0xf1, 0x43, 0xe6,
+	0x30, 0xb1, 0x30, 0xf0, 0x30, 0xb1, 0x98, 0xa5, 0xaf, 0x7f, 0x2a, 0x70, 0xa2, 0x53, 0x5f, 0xc2,
+	0x90, 0x8f, 0x49, 0x61, 0x99, 0xfd, 0x7e, 0xee, 0x31, 0xf6, 0xfb, 0x59, 0x67, 0xcd, 0x67, 0x9d,
+	0xf5, 0xcf, 0x0a, 0xcc, 0xbe, 0xdd, 0x0a, 0x1b, 0xe8, 0xdb, 0xe8, 0x1d, 0x5a, 0x15, 0x2a, 0xdd,
+	0x87, 0x13, 0x89, 0xf4, 0xd7, 0x39, 0x98, 0xdd, 0x44, 0xdf, 0xd2, 0x93, 0x3f, 0x91, 0xb8, 0xb8,
+	0x01, 0x95, 0x4d, 0x94, 0xad, 0xcd, 0x41, 0xa7, 0xe9, 0xb4, 0xd8, 0x98, 0xd3, 0xd1, 0x4e, 0x88,
+	0xf0, 0xae, 0x6c, 0xb5, 0x52, 0x0f, 0x9c, 0x9d, 0xe3, 0xa8, 0xfc, 0x93, 0x7b, 0x2c, 0x11, 0x33,
+	0xa4, 0x1a, 0x9c, 0xca, 0x16, 0x48, 0xf8, 0xc9, 0xc7, 0x0a, 0x9c, 0xd1, 0x59, 0x7b, 0xea, 0xb4,
+	0x51, 0x37, 0xbf, 0x1e, 0x72, 0x2b, 0x4f, 0x4e, 0x6e, 0xed, 0x2c, 0x68, 0xfd, 0x04, 0x8a, 0xfd,
+	0x7b, 0x5e, 0x47, 0x18, 0x79, 0x76, 0x47, 0xb6, 0xc0, 0x47, 0x90, 0xf9, 0xeb, 0xbe, 0x64, 0x3e,
+	0x0d, 0xe3, 0xe9, 0x5a, 0x4b, 0xb4, 0x30, 0x63, 0x61, 0xb2, 0xa8, 0xc9, 0x78, 0xae, 0x2a, 0x64,
+	0x3c, 0x57, 0xd1, 0xdf, 0x29, 0x30, 0xac, 0xf4, 0xc3, 0x12, 0x47, 0xea, 0xf5, 0x46, 0x35, 0xdc,
+	0xf5, 0x46, 0x75, 0x1a, 0x46, 0x28, 0x86, 0x64, 0x52, 0x8a, 0x10, 0x04, 0x0b, 0x3e, 0x49, 0xca,
+	0x56, 0x98, 0xd0, 0xe9, 0x2f, 0x73, 0x50, 0x59, 0x43, 0x84, 0x02, 0x79, 0xac, 0x27, 0xd5, 0xd9,
+	0xff, 0x37, 0x3e, 0xf3, 0x00, 0xf1, 0xcf, 0xed, 0xe4, 0x14, 0x8b, 0x48, 0x46, 0xea, 0x06, 0x4c,
+	0xc4, 0xcb, 0xfc, 0x9d, 0x37, 0xcf, 0x92, 0xcf, 0xd9, 0x1e, 0x2d, 0x7d, 0x2c, 0x03, 0xcd, 0x37,
+	0x63, 0x24, 0xf9, 0xa9, 0xd6, 0x60, 0xa4, 0xe9, 0xf0, 0x7b, 0x25, 0xce, 0x14, 0xe5, 0xa6, 0xc3,
+	0x47, 0xd4, 0x36, 0x5b, 0x37, 0x0f, 0xa2, 0xf5, 0x82, 0x58, 0x37, 0x0f, 0xc4, 0x7a, 0xfa, 0xe5,
+	0xbe, 0x38, 0xc0, 0xcb, 0x7d, 0x66, 0x55, 0x74, 0x5f, 0x81, 0x93, 0x19, 0xea, 0x12, 0x29, 0xe3,
+	0x8d, 0xf4, 0xd3, 0xfd, 0x8b, 0x83, 0xf4, 0x16, 0xcb, 0xae, 0xeb, 0x5b, 0x26, 0x41, 0x76, 0x34,
+	0x6b, 0x3f, 0xe2, 0x33, 0xfe, 0x0f, 0x15, 0xa8, 0xad, 0x22, 0x17, 0x91, 0xde, 0xa1, 0xfc, 0x0d,
+	0xfd, 0x56, 0xeb, 0x3a, 0x9c, 0xee, 0x29, 0x88, 0xd0, 0x50, 0x15, 0x4a, 0xfb, 0x66, 0xe8, 0x39,
+	0x5e, 0x43, 0x4e, 0x47, 0xa3, 0x6f, 0x96, 0x48, 0xd3, 0x0f, 0x5b, 0xf4, 0x97, 0x4c, 0xad, 0xc8,
+	0x1b, 0xcf, 0xc3, 0x44, 0x3a, 0x02, 0x25, 0x8b, 0xf1, 0x54, 0x08, 0xb2, 0x49, 0x07, 0xbb, 0x42,
+	0x6c, 0xc4, 0xe7, 0x04, 0x58, 0xf4, 0x42, 0x63, 0x02, 0xca, 0x46, 0x04, 0x98, 0xc6, 0x17, 0x75,
+	0x19, 0xdb, 0xbd, 0xc7, 0x9d, 0x22, 0xcf, 0x9c, 0x02, 0x9a, 0xe6, 0xc1, 0xaa, 0x7b, 0x8f, 0x7a,
+	0x85, 0x76, 0x08, 0xa7, 0xb2, 0x05, 0x12, 0xa7, 0xf9, 0xdf, 0xae, 0x76, 0xf2, 0xfa, 0x40, 0xa3,
+	0x9c, 0xa8, 0x03, 0xea, 0x64, 0x1c, 0xb1, 0xd3, 0xfe, 0x95, 0x87, 0x4a, 0x2f, 0xb4, 0x01, 0x7a,
+	0x2e, 0x9a, 0x1a, 0x92, 0x33, 0x12, 0x7e, 0x57, 0x03, 0x8e, 0x87, 0x23, 0x75, 0x38, 0xde, 0x74,
+	0x30, 0x76, 0xbc, 0x46, 0x6a, 0x98, 0xc2, 0x95, 0x30, 0x25, 0x96, 0x12, 0xc3, 0x94, 0xf3, 0x30,
+	0x49, 0xb5, 0xe5, 0x9a, 0x0d, 0x19, 0x64, 0x58, 0x44, 0xe1, 0x58, 0xd3, 0x3c, 0xd8, 0x30, 0x1b,
+	0x3c, 0xd0, 0x30, 0x45, 0x0c, 0xae, 0x5e, 0x4d, 0x23, 0xf2, 0x70, 0x1c, 0x0b, 0xae, 0x5e, 0x4d,
+	0x20, 0x5e, 0x81, 0x61, 0xc1, 0xb1, 0x52, 0x1c, 0xac, 0x97, 0x2b, 0xf2, 0x9d, 0x28, 0xa5, 0xd8,
+	0xa2, 0x32, 0x3c, 0x20, 0x25, 0xdf, 0x9a, 0xd6, 0x2f, 0x91, 0xbd, 0x79, 0xba, 0x1c, 0xb6, 0xb9,
+	0xb1, 0xd5, 0x17, 0x60, 0x56, 0x2e, 0x19, 0x0e, 0x36, 0x5c, 0x7f, 0x1f, 0x85, 0xc6, 0xb6, 0xdf,
+	0xf2, 0xf8, 0xef, 0x1b, 0x4a, 0xfa, 0x71, 0x81, 0xb9, 0x8e, 0x37, 0xe8, 0xda, 0x0d, 0xba, 0xa4,
+	0x6e, 0x41, 0x51, 0xf8, 0x18, 0x30, 0x07, 0xb8, 0x36, 0x90, 0x03, 0x88, 0x1f, 0x5b, 0x75, 0x9a,
+	0x5f, 0xb0, 0xd2, 0x7e, 0x97, 0x87, 0x13, 0xd9, 0x28, 0xfd, 0x7e, 0x83, 0x52, 0x81, 0x61, 0x61,
+	0x36, 0xe1, 0xef, 0xf2, 0xb3, 0x33, 0x39, 0xe6, 0x3b, 0x93, 0xe3, 0x2a, 0x8c, 0x45, 0xeb, 0x47,
+	0xfa, 0x69, 0xc9, 0x88, 0xe0, 0x41, 0xe1, 0xaa, 0x06, 0x63, 0xa6, 0xb5, 0x87, 0xec, 0x8e, 0x24,
+	0x3c, 0xc2, 0x80, 0x62, 0xa7, 0x5b, 0x30, 0x91, 0xc0, 0x39, 0xd2, 0xef, 0x76, 0xc6, 0x22, 0x3e,
+	0x6c, 0xb7, 0x05, 0x18, 0x4d, 0xb9, 0x98, 0xb8, 0x1d, 0xdd, 0xd8, 0xbf, 0x2e, 0x43, 0x9e, 0x7a,
+	0x48, 0x69, 0x30, 0x0f, 0xa1, 0xb8, 0x29, 0xf7, 0x28, 0x0f, 0xec, 0x1e, 0xd0, 0xd3, 0x3d, 0xb4,
+	0xdb, 0x50, 0x63, 0xe1, 0xd3, 0x95, 0x11, 0x07, 0xbc, 0x63, 0xa7, 0xa1, 0x70, 0xaf, 0x85, 0xc4,
+	0x8f, 0x8a, 0xca, 0x3a, 0xff, 0xd0, 0x7e, 0xa6, 0xc0, 0xe9, 0x9e, 0x6c, 0x45, 0x6e, 0x9a, 0x86,
+	0x02, 0x8f, 0x68, 0xfe, 0x4e, 0xce, 0x3f, 0xd4, 0x4d, 0x28, 0x36, 0x42, 0xbf, 0x15, 0xc8, 0xde,
+	0xec, 0xc5, 0xc1, 0x9e, 0x06, 0x1b, 0x8d, 0x10, 0x35, 0x98, 0xb6, 0xd6, 0x28, 0xb5, 0x2e, 0x98,
+	0xd0, 0x2c, 0xe3, 0x93, 0x5d, 0x36, 0x77, 0x92, 0xc9, 0x23, 0xaf, 0x03, 0x03, 0x31, 0xf9, 0xb4,
+	0x37, 0x60, 0xb2, 0x93, 0x98, 0x66, 0x2f, 0x46, 0x6e, 0xb0, 0x61, 0xa0, 0x4c, 0xe2, 0x23, 0x0c,
+	0xc6, 0xe6, 0xf9, 0x38, 0x16, 0x3e, 0x97, 0x10, 0x9e, 0xfe, 0x2a, 0xeb, 0x84, 0x8e, 0xa8, 0x72,
+	0x12, 0x2f, 0xef, 0x83, 0x68, 0xf1, 0x24, 0x94, 0x3c, 0xb4, 0x9f, 0x7c, 0x6d, 0x1b, 0xf6, 0xd0,
+	0x3e, 0xcb, 0x93, 0xf3, 0x00, 0x7b, 0x08, 0x05, 0x86, 0xe9, 0x3a, 0x26, 0x16, 0x6f, 0x91, 0x65,
+	0x0a, 0x59, 0xa6, 0x00, 0xf5, 0x39, 0x98, 0x8e, 0x7e, 0x42, 0xcc, 0xca, 0x53, 0xd3, 0x35, 0x5a,
+	0xa1, 0x23, 0x6a, 0x3f, 0x55, 0xac, 0x2d, 0x8b, 0xa5, 0x77, 0x43, 0x47, 0x7d, 0x09, 0x66, 0x13,
+	0x73, 0xe4, 0x14, 0x11, 0x1f, 0x69, 0xcf, 0xc4, 0xcb, 0x09, 0x3a, 0xed, 0x0e, 0xcc, 0x76, 0x9d,
+	0x4d, 0x98, 0x72, 0x80, 0xaa, 0x96, 0xfe, 0xae, 0x94, 0x0a, 0x2c, 0x9e, 0xc8, 0xca, 0xba, 0xfc,
+	0xbc, 0xe1, 0x7e, 0xf6, 0x45, 0xed, 0xd8, 0xe7, 0x5f, 0xd4, 0x8e, 0x7d, 0xf5, 0x45, 0x4d, 0xf9,
+	0xee, 0x83, 0x9a, 0xf2, 0xf3, 0x07, 0x35, 0xe5, 0xf7, 0x0f, 0x6a, 0xca, 0x67, 0x0f, 0x6a, 0xca,
+	0xdf, 0x1f, 0xd4, 0x94, 0x7f, 0x3c, 0xa8, 0x1d, 0xfb, 0xea, 0x41, 0x4d, 0xb9, 0xff, 0x65, 0xed,
+	0xd8, 0x67, 0x5f, 0xd6, 0x8e, 0x7d, 0xfe, 0x65, 0xed, 0xd8, 0x7b, 0x2f, 0x35, 0xfc, 0xd8, 0x33,
+	0x1c, 0xbf, 0xcf, 0x7f, 0x93, 0x5c, 0x4b, 0x7e, 0x6f, 0x17, 0x59, 0x78, 0x3d, 0xff, 0xef, 0x01,
+	0x00, 0x83, 0x1b, 0x72, 0x8d, 0x88, 0x32, 0x00, 0x00,
+}
+
+func (this *RebuildMutableStateRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetShardRequest)
+	that1, ok := that.(*RebuildMutableStateRequest)
 	if !ok {
-		that2, ok := that.(GetShardRequest)
+		that2, ok := that.(RebuildMutableStateRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3652,19 +4193,22 @@ func (this *GetShardRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ShardId != that1.ShardId {
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if !this.Execution.Equal(that1.Execution) {
 		return false
 	}
 	return true
 }
-func (this *GetShardResponse) Equal(that interface{}) bool {
+func (this *RebuildMutableStateResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetShardResponse)
+	that1, ok := that.(*RebuildMutableStateResponse)
 	if !ok {
-		that2, ok := that.(GetShardResponse)
+		that2, ok := that.(RebuildMutableStateResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3676,19 +4220,16 @@ func (this *GetShardResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ShardInfo.Equal(that1.ShardInfo) {
-		return false
-	}
 	return true
 }
-func (this *ListHistoryTasksRequest) Equal(that interface{}) bool {
+func (this *DescribeMutableStateRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ListHistoryTasksRequest)
+	that1, ok := that.(*DescribeMutableStateRequest)
 	if !ok {
-		that2, ok := that.(ListHistoryTasksRequest)
+		that2, ok := that.(DescribeMutableStateRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3700,31 +4241,22 @@ func (this *ListHistoryTasksRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ShardId != that1.ShardId {
-		return false
-	}
-	if this.Category != that1.Category {
-		return false
-	}
-	if !this.TaskRange.Equal(that1.TaskRange) {
-		return false
-	}
-	if this.BatchSize != that1.BatchSize {
+	if this.Namespace != that1.Namespace {
 		return false
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+	if !this.Execution.Equal(that1.Execution) {
 		return false
 	}
 	return true
 }
-func (this *ListHistoryTasksResponse) Equal(that interface{}) bool {
+func (this *DescribeMutableStateResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ListHistoryTasksResponse)
+	that1, ok := that.(*DescribeMutableStateResponse)
 	if !ok {
-		that2, ok := that.(ListHistoryTasksResponse)
+		that2, ok := that.(DescribeMutableStateResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3736,27 +4268,28 @@ func (this *ListHistoryTasksResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Tasks) != len(that1.Tasks) {
+	if this.ShardId != that1.ShardId {
 		return false
 	}
-	for i := range this.Tasks {
-		if !this.Tasks[i].Equal(that1.Tasks[i]) {
-			return false
-		}
+	if this.HistoryAddr != that1.HistoryAddr {
+		return false
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+	if !this.CacheMutableState.Equal(that1.CacheMutableState) {
+		return false
+	}
+	if !this.DatabaseMutableState.Equal(that1.DatabaseMutableState) {
 		return false
 	}
 	return true
 }
-func (this *Task) Equal(that interface{}) bool {
+func (this *DescribeHistoryHostRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Task)
+	that1, ok := that.(*DescribeHistoryHostRequest)
 	if !ok {
-		that2, ok := that.(Task)
+		that2, ok := that.(DescribeHistoryHostRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3768,13 +4301,245 @@ func (this *Task) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.NamespaceId != that1.NamespaceId {
+	if this.HostAddress != that1.HostAddress {
 		return false
 	}
-	if this.WorkflowId != that1.WorkflowId {
+	if this.ShardId != that1.ShardId {
 		return false
 	}
-	if this.RunId != that1.RunId {
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
+		return false
+	}
+	return true
+}
+func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DescribeHistoryHostResponse)
+	if !ok {
+		that2, ok := that.(DescribeHistoryHostResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardsNumber != that1.ShardsNumber {
+		return false
+	}
+	if len(this.ShardIds) != len(that1.ShardIds) {
+		return false
+	}
+	for i := range this.ShardIds {
+		if this.ShardIds[i] != that1.ShardIds[i] {
+			return false
+		}
+	}
+	if !this.NamespaceCache.Equal(that1.NamespaceCache) {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	return true
+}
+func (this *CloseShardRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CloseShardRequest)
+	if !ok {
+		that2, ok := that.(CloseShardRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	return true
+}
+func (this *CloseShardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CloseShardResponse)
+	if !ok {
+		that2, ok := that.(CloseShardResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *GetShardRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetShardRequest)
+	if !ok {
+		that2, ok := that.(GetShardRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	return true
+}
+func (this *GetShardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetShardResponse)
+	if !ok {
+		that2, ok := that.(GetShardResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ShardInfo.Equal(that1.ShardInfo) {
+		return false
+	}
+	return true
+}
+func (this *ListHistoryTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListHistoryTasksRequest)
+	if !ok {
+		that2, ok := that.(ListHistoryTasksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.Category != that1.Category {
+		return false
+	}
+	if !this.TaskRange.Equal(that1.TaskRange) {
+		return false
+	}
+	if this.BatchSize != that1.BatchSize {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
+}
+func (this *ListHistoryTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListHistoryTasksResponse)
+	if !ok {
+		that2, ok := that.(ListHistoryTasksResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Tasks) != len(that1.Tasks) {
+		return false
+	}
+	for i := range this.Tasks {
+		if !this.Tasks[i].Equal(that1.Tasks[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
+}
+func (this *Task) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Task)
+	if !ok {
+		that2, ok := that.(Task)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.WorkflowId != that1.WorkflowId {
+		return false
+	}
+	if this.RunId != that1.RunId {
 		return false
 	}
 	if this.TaskId != that1.TaskId {
@@ -4907,14 +5672,14 @@ func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *ResendReplicationTasksRequest) Equal(that interface{}) bool {
+func (this *RearchiveWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ResendReplicationTasksRequest)
+	that1, ok := that.(*RearchiveWorkflowExecutionRequest)
 	if !ok {
-		that2, ok := that.(ResendReplicationTasksRequest)
+		that2, ok := that.(RearchiveWorkflowExecutionRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4929,13 +5694,61 @@ func (this *ResendReplicationTasksRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if this.WorkflowId != that1.WorkflowId {
+	if !this.Execution.Equal(that1.Execution) {
 		return false
 	}
-	if this.RunId != that1.RunId {
-		return false
+	return true
+}
+func (this *RearchiveWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.RemoteCluster != that1.RemoteCluster {
+
+	that1, ok := that.(*RearchiveWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(RearchiveWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *ResendReplicationTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResendReplicationTasksRequest)
+	if !ok {
+		that2, ok := that.(ResendReplicationTasksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.WorkflowId != that1.WorkflowId {
+		return false
+	}
+	if this.RunId != that1.RunId {
+		return false
+	}
+	if this.RemoteCluster != that1.RemoteCluster {
 		return false
 	}
 	if this.StartEventId != that1.StartEventId {
@@ -5103,257 +5916,616 @@ func (this *DeleteWorkflowExecutionResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *RebuildMutableStateRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *GetReplicationStatusRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.RebuildMutableStateRequest{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+
+	that1, ok := that.(*GetReplicationStatusRequest)
+	if !ok {
+		that2, ok := that.(GetReplicationStatusRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RebuildMutableStateResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.RebuildMutableStateResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DescribeMutableStateRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.RemoteClusters) != len(that1.RemoteClusters) {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.DescribeMutableStateRequest{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	for i := range this.RemoteClusters {
+		if this.RemoteClusters[i] != that1.RemoteClusters[i] {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if this.IncludeShards != that1.IncludeShards {
+		return false
+	}
+	if this.MaxDlqSize != that1.MaxDlqSize {
+		return false
+	}
+	return true
 }
-func (this *DescribeMutableStateResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *GetReplicationStatusResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&adminservice.DescribeMutableStateResponse{")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "HistoryAddr: "+fmt.Sprintf("%#v", this.HistoryAddr)+",\n")
-	if this.CacheMutableState != nil {
-		s = append(s, "CacheMutableState: "+fmt.Sprintf("%#v", this.CacheMutableState)+",\n")
+
+	that1, ok := that.(*GetReplicationStatusResponse)
+	if !ok {
+		that2, ok := that.(GetReplicationStatusResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.DatabaseMutableState != nil {
-		s = append(s, "DatabaseMutableState: "+fmt.Sprintf("%#v", this.DatabaseMutableState)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DescribeHistoryHostRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.Clusters) != len(that1.Clusters) {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&adminservice.DescribeHistoryHostRequest{")
-	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	for i := range this.Clusters {
+		if !this.Clusters[i].Equal(that1.Clusters[i]) {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *DescribeHistoryHostResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ClusterReplicationStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&adminservice.DescribeHistoryHostResponse{")
-	s = append(s, "ShardsNumber: "+fmt.Sprintf("%#v", this.ShardsNumber)+",\n")
-	s = append(s, "ShardIds: "+fmt.Sprintf("%#v", this.ShardIds)+",\n")
-	if this.NamespaceCache != nil {
-		s = append(s, "NamespaceCache: "+fmt.Sprintf("%#v", this.NamespaceCache)+",\n")
+
+	that1, ok := that.(*ClusterReplicationStatus)
+	if !ok {
+		that2, ok := that.(ClusterReplicationStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *CloseShardRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.CloseShardRequest{")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *CloseShardResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ClusterName != that1.ClusterName {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.CloseShardResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetShardRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ShardCount != that1.ShardCount {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.GetShardRequest{")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetShardResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.MissingShardCount != that1.MissingShardCount {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.GetShardResponse{")
-	if this.ShardInfo != nil {
-		s = append(s, "ShardInfo: "+fmt.Sprintf("%#v", this.ShardInfo)+",\n")
+	if this.MaxLagTaskIds != that1.MaxLagTaskIds {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ListHistoryTasksRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.P99LagTaskIds != that1.P99LagTaskIds {
+		return false
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&adminservice.ListHistoryTasksRequest{")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "Category: "+fmt.Sprintf("%#v", this.Category)+",\n")
-	if this.TaskRange != nil {
-		s = append(s, "TaskRange: "+fmt.Sprintf("%#v", this.TaskRange)+",\n")
+	if this.MaxLag != nil && that1.MaxLag != nil {
+		if *this.MaxLag != *that1.MaxLag {
+			return false
+		}
+	} else if this.MaxLag != nil {
+		return false
+	} else if that1.MaxLag != nil {
+		return false
 	}
-	s = append(s, "BatchSize: "+fmt.Sprintf("%#v", this.BatchSize)+",\n")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ListHistoryTasksResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.P99Lag != nil && that1.P99Lag != nil {
+		if *this.P99Lag != *that1.P99Lag {
+			return false
+		}
+	} else if this.P99Lag != nil {
+		return false
+	} else if that1.P99Lag != nil {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.ListHistoryTasksResponse{")
-	if this.Tasks != nil {
-		s = append(s, "Tasks: "+fmt.Sprintf("%#v", this.Tasks)+",\n")
+	if this.DlqSize != that1.DlqSize {
+		return false
 	}
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Task) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.DlqSizeIsLowerBound != that1.DlqSizeIsLowerBound {
+		return false
 	}
-	s := make([]string, 0, 11)
-	s = append(s, "&adminservice.Task{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
-	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
-	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
-	s = append(s, "TaskType: "+fmt.Sprintf("%#v", this.TaskType)+",\n")
-	s = append(s, "FireTime: "+fmt.Sprintf("%#v", this.FireTime)+",\n")
-	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RemoveTaskRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.Shards) != len(that1.Shards) {
+		return false
+	}
+	for i := range this.Shards {
+		if !this.Shards[i].Equal(that1.Shards[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ShardReplicationStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShardReplicationStatus)
+	if !ok {
+		that2, ok := that.(ShardReplicationStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.Missing != that1.Missing {
+		return false
+	}
+	if this.MaxTaskId != that1.MaxTaskId {
+		return false
+	}
+	if that1.MaxTaskTime == nil {
+		if this.MaxTaskTime != nil {
+			return false
+		}
+	} else if !this.MaxTaskTime.Equal(*that1.MaxTaskTime) {
+		return false
+	}
+	if this.AckedTaskId != that1.AckedTaskId {
+		return false
+	}
+	if that1.AckedTaskTime == nil {
+		if this.AckedTaskTime != nil {
+			return false
+		}
+	} else if !this.AckedTaskTime.Equal(*that1.AckedTaskTime) {
+		return false
+	}
+	if this.LagTaskIds != that1.LagTaskIds {
+		return false
+	}
+	if this.Lag != nil && that1.Lag != nil {
+		if *this.Lag != *that1.Lag {
+			return false
+		}
+	} else if this.Lag != nil {
+		return false
+	} else if that1.Lag != nil {
+		return false
+	}
+	if this.DlqSize != that1.DlqSize {
+		return false
+	}
+	if this.DlqSizeIsLowerBound != that1.DlqSizeIsLowerBound {
+		return false
+	}
+	return true
+}
+func (this *CountWorkflowExecutionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CountWorkflowExecutionsRequest)
+	if !ok {
+		that2, ok := that.(CountWorkflowExecutionsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if this.Query != that1.Query {
+		return false
+	}
+	return true
+}
+func (this *CountWorkflowExecutionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CountWorkflowExecutionsResponse)
+	if !ok {
+		that2, ok := that.(CountWorkflowExecutionsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if len(this.Groups) != len(that1.Groups) {
+		return false
+	}
+	for i := range this.Groups {
+		if !this.Groups[i].Equal(that1.Groups[i]) {
+			return false
+		}
+	}
+	if this.OtherCount != that1.OtherCount {
+		return false
+	}
+	return true
+}
+func (this *AggregationGroup) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AggregationGroup)
+	if !ok {
+		that2, ok := that.(AggregationGroup)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.GroupValues) != len(that1.GroupValues) {
+		return false
+	}
+	for i := range this.GroupValues {
+		if this.GroupValues[i] != that1.GroupValues[i] {
+			return false
+		}
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	return true
+}
+func (this *RenameNamespaceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RenameNamespaceRequest)
+	if !ok {
+		that2, ok := that.(RenameNamespaceRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if this.NewName != that1.NewName {
+		return false
+	}
+	if this.KeepAlias != that1.KeepAlias {
+		return false
+	}
+	if this.HistoryArchivalUri != that1.HistoryArchivalUri {
+		return false
+	}
+	if this.VisibilityArchivalUri != that1.VisibilityArchivalUri {
+		return false
+	}
+	return true
+}
+func (this *RenameNamespaceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RenameNamespaceResponse)
+	if !ok {
+		that2, ok := that.(RenameNamespaceResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if len(this.Aliases) != len(that1.Aliases) {
+		return false
+	}
+	for i := range this.Aliases {
+		if this.Aliases[i] != that1.Aliases[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *RebuildMutableStateRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.RebuildMutableStateRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&adminservice.RemoveTaskRequest{")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "Category: "+fmt.Sprintf("%#v", this.Category)+",\n")
-	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
-	s = append(s, "VisibilityTime: "+fmt.Sprintf("%#v", this.VisibilityTime)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *RemoveTaskResponse) GoString() string {
+func (this *RebuildMutableStateResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.RemoveTaskResponse{")
+	s = append(s, "&adminservice.RebuildMutableStateResponse{")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *GetWorkflowExecutionRawHistoryV2Request) GoString() string {
+func (this *DescribeMutableStateRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
-	s = append(s, "&adminservice.GetWorkflowExecutionRawHistoryV2Request{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.DescribeMutableStateRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
 	if this.Execution != nil {
 		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
-	s = append(s, "StartEventId: "+fmt.Sprintf("%#v", this.StartEventId)+",\n")
-	s = append(s, "StartEventVersion: "+fmt.Sprintf("%#v", this.StartEventVersion)+",\n")
-	s = append(s, "EndEventId: "+fmt.Sprintf("%#v", this.EndEventId)+",\n")
-	s = append(s, "EndEventVersion: "+fmt.Sprintf("%#v", this.EndEventVersion)+",\n")
-	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *GetWorkflowExecutionRawHistoryV2Response) GoString() string {
+func (this *DescribeMutableStateResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 8)
-	s = append(s, "&adminservice.GetWorkflowExecutionRawHistoryV2Response{")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	if this.HistoryBatches != nil {
-		s = append(s, "HistoryBatches: "+fmt.Sprintf("%#v", this.HistoryBatches)+",\n")
+	s = append(s, "&adminservice.DescribeMutableStateResponse{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "HistoryAddr: "+fmt.Sprintf("%#v", this.HistoryAddr)+",\n")
+	if this.CacheMutableState != nil {
+		s = append(s, "CacheMutableState: "+fmt.Sprintf("%#v", this.CacheMutableState)+",\n")
 	}
-	if this.VersionHistory != nil {
-		s = append(s, "VersionHistory: "+fmt.Sprintf("%#v", this.VersionHistory)+",\n")
+	if this.DatabaseMutableState != nil {
+		s = append(s, "DatabaseMutableState: "+fmt.Sprintf("%#v", this.DatabaseMutableState)+",\n")
 	}
-	s = append(s, "HistoryNodeIds: "+fmt.Sprintf("%#v", this.HistoryNodeIds)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *GetReplicationMessagesRequest) GoString() string {
+func (this *DescribeHistoryHostRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.GetReplicationMessagesRequest{")
-	if this.Tokens != nil {
-		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.DescribeHistoryHostRequest{")
+	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
 	}
-	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *GetReplicationMessagesResponse) GoString() string {
+func (this *DescribeHistoryHostResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.GetReplicationMessagesResponse{")
-	keysForShardMessages := make([]int32, 0, len(this.ShardMessages))
-	for k, _ := range this.ShardMessages {
-		keysForShardMessages = append(keysForShardMessages, k)
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.DescribeHistoryHostResponse{")
+	s = append(s, "ShardsNumber: "+fmt.Sprintf("%#v", this.ShardsNumber)+",\n")
+	s = append(s, "ShardIds: "+fmt.Sprintf("%#v", this.ShardIds)+",\n")
+	if this.NamespaceCache != nil {
+		s = append(s, "NamespaceCache: "+fmt.Sprintf("%#v", this.NamespaceCache)+",\n")
 	}
-	github_com_gogo_protobuf_sortkeys.Int32s(keysForShardMessages)
-	mapStringForShardMessages := "map[int32]*v15.ReplicationMessages{"
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CloseShardRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.CloseShardRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CloseShardResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.CloseShardResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetShardRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.GetShardRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetShardResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.GetShardResponse{")
+	if this.ShardInfo != nil {
+		s = append(s, "ShardInfo: "+fmt.Sprintf("%#v", this.ShardInfo)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListHistoryTasksRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&adminservice.ListHistoryTasksRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "Category: "+fmt.Sprintf("%#v", this.Category)+",\n")
+	if this.TaskRange != nil {
+		s = append(s, "TaskRange: "+fmt.Sprintf("%#v", this.TaskRange)+",\n")
+	}
+	s = append(s, "BatchSize: "+fmt.Sprintf("%#v", this.BatchSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListHistoryTasksResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.ListHistoryTasksResponse{")
+	if this.Tasks != nil {
+		s = append(s, "Tasks: "+fmt.Sprintf("%#v", this.Tasks)+",\n")
+	}
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Task) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&adminservice.Task{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
+	s = append(s, "TaskType: "+fmt.Sprintf("%#v", this.TaskType)+",\n")
+	s = append(s, "FireTime: "+fmt.Sprintf("%#v", this.FireTime)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RemoveTaskRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.RemoveTaskRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "Category: "+fmt.Sprintf("%#v", this.Category)+",\n")
+	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
+	s = append(s, "VisibilityTime: "+fmt.Sprintf("%#v", this.VisibilityTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RemoveTaskResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.RemoveTaskResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetWorkflowExecutionRawHistoryV2Request) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&adminservice.GetWorkflowExecutionRawHistoryV2Request{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	s = append(s, "StartEventId: "+fmt.Sprintf("%#v", this.StartEventId)+",\n")
+	s = append(s, "StartEventVersion: "+fmt.Sprintf("%#v", this.StartEventVersion)+",\n")
+	s = append(s, "EndEventId: "+fmt.Sprintf("%#v", this.EndEventId)+",\n")
+	s = append(s, "EndEventVersion: "+fmt.Sprintf("%#v", this.EndEventVersion)+",\n")
+	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetWorkflowExecutionRawHistoryV2Response) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.GetWorkflowExecutionRawHistoryV2Response{")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	if this.HistoryBatches != nil {
+		s = append(s, "HistoryBatches: "+fmt.Sprintf("%#v", this.HistoryBatches)+",\n")
+	}
+	if this.VersionHistory != nil {
+		s = append(s, "VersionHistory: "+fmt.Sprintf("%#v", this.VersionHistory)+",\n")
+	}
+	s = append(s, "HistoryNodeIds: "+fmt.Sprintf("%#v", this.HistoryNodeIds)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetReplicationMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.GetReplicationMessagesRequest{")
+	if this.Tokens != nil {
+		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	}
+	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetReplicationMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.GetReplicationMessagesResponse{")
+	keysForShardMessages := make([]int32, 0, len(this.ShardMessages))
+	for k, _ := range this.ShardMessages {
+		keysForShardMessages = append(keysForShardMessages, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Int32s(keysForShardMessages)
+	mapStringForShardMessages := "map[int32]*v15.ReplicationMessages{"
 	for _, k := range keysForShardMessages {
 		mapStringForShardMessages += fmt.Sprintf("%#v: %#v,", k, this.ShardMessages[k])
 	}
@@ -5788,6 +6960,28 @@ func (this *RefreshWorkflowTasksResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *RearchiveWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.RearchiveWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RearchiveWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.RearchiveWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *ResendReplicationTasksRequest) GoString() string {
 	if this == nil {
 		return "nil"
@@ -5866,62 +7060,187 @@ func (this *DeleteWorkflowExecutionResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func valueToGoStringRequestResponse(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *GetReplicationStatusRequest) GoString() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.GetReplicationStatusRequest{")
+	s = append(s, "RemoteClusters: "+fmt.Sprintf("%#v", this.RemoteClusters)+",\n")
+	s = append(s, "IncludeShards: "+fmt.Sprintf("%#v", this.IncludeShards)+",\n")
+	s = append(s, "MaxDlqSize: "+fmt.Sprintf("%#v", this.MaxDlqSize)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (m *RebuildMutableStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *GetReplicationStatusResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RebuildMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.GetReplicationStatusResponse{")
+	if this.Clusters != nil {
+		s = append(s, "Clusters: "+fmt.Sprintf("%#v", this.Clusters)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RebuildMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (this *ClusterReplicationStatus) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+	s := make([]string, 0, 14)
+	s = append(s, "&adminservice.ClusterReplicationStatus{")
+	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
+	s = append(s, "ShardCount: "+fmt.Sprintf("%#v", this.ShardCount)+",\n")
+	s = append(s, "MissingShardCount: "+fmt.Sprintf("%#v", this.MissingShardCount)+",\n")
+	s = append(s, "MaxLagTaskIds: "+fmt.Sprintf("%#v", this.MaxLagTaskIds)+",\n")
+	s = append(s, "P99LagTaskIds: "+fmt.Sprintf("%#v", this.P99LagTaskIds)+",\n")
+	s = append(s, "MaxLag: "+fmt.Sprintf("%#v", this.MaxLag)+",\n")
+	s = append(s, "P99Lag: "+fmt.Sprintf("%#v", this.P99Lag)+",\n")
+	s = append(s, "DlqSize: "+fmt.Sprintf("%#v", this.DlqSize)+",\n")
+	s = append(s, "DlqSizeIsLowerBound: "+fmt.Sprintf("%#v", this.DlqSizeIsLowerBound)+",\n")
+	if this.Shards != nil {
+		s = append(s, "Shards: "+fmt.Sprintf("%#v", this.Shards)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RebuildMutableStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ShardReplicationStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&adminservice.ShardReplicationStatus{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "Missing: "+fmt.Sprintf("%#v", this.Missing)+",\n")
+	s = append(s, "MaxTaskId: "+fmt.Sprintf("%#v", this.MaxTaskId)+",\n")
+	s = append(s, "MaxTaskTime: "+fmt.Sprintf("%#v", this.MaxTaskTime)+",\n")
+	s = append(s, "AckedTaskId: "+fmt.Sprintf("%#v", this.AckedTaskId)+",\n")
+	s = append(s, "AckedTaskTime: "+fmt.Sprintf("%#v", this.AckedTaskTime)+",\n")
+	s = append(s, "LagTaskIds: "+fmt.Sprintf("%#v", this.LagTaskIds)+",\n")
+	s = append(s, "Lag: "+fmt.Sprintf("%#v", this.Lag)+",\n")
+	s = append(s, "DlqSize: "+fmt.Sprintf("%#v", this.DlqSize)+",\n")
+	s = append(s, "DlqSizeIsLowerBound: "+fmt.Sprintf("%#v", this.DlqSizeIsLowerBound)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CountWorkflowExecutionsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.CountWorkflowExecutionsRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "Query: "+fmt.Sprintf("%#v", this.Query)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CountWorkflowExecutionsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.CountWorkflowExecutionsResponse{")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	if this.Groups != nil {
+		s = append(s, "Groups: "+fmt.Sprintf("%#v", this.Groups)+",\n")
+	}
+	s = append(s, "OtherCount: "+fmt.Sprintf("%#v", this.OtherCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AggregationGroup) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.AggregationGroup{")
+	s = append(s, "GroupValues: "+fmt.Sprintf("%#v", this.GroupValues)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RenameNamespaceRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&adminservice.RenameNamespaceRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "NewName: "+fmt.Sprintf("%#v", this.NewName)+",\n")
+	s = append(s, "KeepAlias: "+fmt.Sprintf("%#v", this.KeepAlias)+",\n")
+	s = append(s, "HistoryArchivalUri: "+fmt.Sprintf("%#v", this.HistoryArchivalUri)+",\n")
+	s = append(s, "VisibilityArchivalUri: "+fmt.Sprintf("%#v", this.VisibilityArchivalUri)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RenameNamespaceResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.RenameNamespaceResponse{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "Aliases: "+fmt.Sprintf("%#v", this.Aliases)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringRequestResponse(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *RebuildMutableStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RebuildMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RebuildMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RebuildMutableStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
@@ -8038,6 +9357,71 @@ func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *RearchiveWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RearchiveWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RearchiveWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RearchiveWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RearchiveWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RearchiveWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *ResendReplicationTasksRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8314,284 +9698,537 @@ func (m *DeleteWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRequestResponse(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GetReplicationStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *RebuildMutableStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GetReplicationStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReplicationStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.MaxDlqSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaxDlqSize))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.IncludeShards {
+		i--
+		if m.IncludeShards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.RemoteClusters) > 0 {
+		for iNdEx := len(m.RemoteClusters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoteClusters[iNdEx])
+			copy(dAtA[i:], m.RemoteClusters[iNdEx])
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RemoteClusters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RebuildMutableStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetReplicationStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DescribeMutableStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetReplicationStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReplicationStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clusters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DescribeMutableStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClusterReplicationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterReplicationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterReplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ShardId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.Shards) > 0 {
+		for iNdEx := len(m.Shards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	l = len(m.HistoryAddr)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.DlqSizeIsLowerBound {
+		i--
+		if m.DlqSizeIsLowerBound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.CacheMutableState != nil {
-		l = m.CacheMutableState.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.DlqSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.DlqSize))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.DatabaseMutableState != nil {
-		l = m.DatabaseMutableState.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.P99Lag != nil {
+		n29, err29 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.P99Lag, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.P99Lag):])
+		if err29 != nil {
+			return 0, err29
+		}
+		i -= n29
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n29))
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
-}
-
-func (m *DescribeHistoryHostRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.MaxLag != nil {
+		n30, err30 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxLag, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxLag):])
+		if err30 != nil {
+			return 0, err30
+		}
+		i -= n30
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n30))
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	l = len(m.HostAddress)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.P99LagTaskIds != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.P99LagTaskIds))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	if m.MaxLagTaskIds != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaxLagTaskIds))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.MissingShardCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MissingShardCount))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.ShardCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardCount))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DescribeHistoryHostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ShardReplicationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardReplicationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardReplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ShardsNumber != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardsNumber))
+	if m.DlqSizeIsLowerBound {
+		i--
+		if m.DlqSizeIsLowerBound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	if len(m.ShardIds) > 0 {
-		l = 0
-		for _, e := range m.ShardIds {
-			l += sovRequestResponse(uint64(e))
+	if m.DlqSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.DlqSize))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Lag != nil {
+		n31, err31 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Lag, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Lag):])
+		if err31 != nil {
+			return 0, err31
 		}
-		n += 1 + sovRequestResponse(uint64(l)) + l
+		i -= n31
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n31))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.NamespaceCache != nil {
-		l = m.NamespaceCache.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.LagTaskIds != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.LagTaskIds))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.AckedTaskTime != nil {
+		n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AckedTaskTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AckedTaskTime):])
+		if err32 != nil {
+			return 0, err32
+		}
+		i -= n32
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n32))
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
-}
-
-func (m *CloseShardRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.AckedTaskId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.AckedTaskId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxTaskTime != nil {
+		n33, err33 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.MaxTaskTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.MaxTaskTime):])
+		if err33 != nil {
+			return 0, err33
+		}
+		i -= n33
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n33))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxTaskId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaxTaskId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Missing {
+		i--
+		if m.Missing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
 	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CloseShardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CountWorkflowExecutionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetShardRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
-	}
-	return n
+func (m *CountWorkflowExecutionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetShardResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CountWorkflowExecutionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ShardInfo != nil {
-		l = m.ShardInfo.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ListHistoryTasksRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CountWorkflowExecutionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CountWorkflowExecutionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CountWorkflowExecutionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
-	}
-	if m.Category != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Category))
-	}
-	if m.TaskRange != nil {
-		l = m.TaskRange.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.OtherCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.OtherCount))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.BatchSize != 0 {
-		n += 1 + sovRequestResponse(uint64(m.BatchSize))
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Count != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ListHistoryTasksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AggregationGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregationGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregationGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Tasks) > 0 {
-		for _, e := range m.Tasks {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
+	if m.Count != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.GroupValues) > 0 {
+		for iNdEx := len(m.GroupValues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GroupValues[iNdEx])
+			copy(dAtA[i:], m.GroupValues[iNdEx])
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.GroupValues[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Task) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RenameNamespaceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RenameNamespaceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenameNamespaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.VisibilityArchivalUri) > 0 {
+		i -= len(m.VisibilityArchivalUri)
+		copy(dAtA[i:], m.VisibilityArchivalUri)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.VisibilityArchivalUri)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.WorkflowId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.HistoryArchivalUri) > 0 {
+		i -= len(m.HistoryArchivalUri)
+		copy(dAtA[i:], m.HistoryArchivalUri)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.HistoryArchivalUri)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.KeepAlias {
+		i--
+		if m.KeepAlias {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.TaskId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.TaskId))
+	if len(m.NewName) > 0 {
+		i -= len(m.NewName)
+		copy(dAtA[i:], m.NewName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NewName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.TaskType != 0 {
-		n += 1 + sovRequestResponse(uint64(m.TaskType))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.FireTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FireTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *RenameNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Version != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Version))
+	return dAtA[:n], nil
+}
+
+func (m *RenameNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenameNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aliases) > 0 {
+		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Aliases[iNdEx])
+			copy(dAtA[i:], m.Aliases[iNdEx])
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Aliases[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveTaskRequest) Size() (n int) {
+func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRequestResponse(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RebuildMutableStateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
-	}
-	if m.Category != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Category))
-	}
-	if m.TaskId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.TaskId))
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.VisibilityTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime)
+	if m.Execution != nil {
+		l = m.Execution.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *RemoveTaskResponse) Size() (n int) {
+func (m *RebuildMutableStateResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -8600,121 +10237,370 @@ func (m *RemoveTaskResponse) Size() (n int) {
 	return n
 }
 
-func (m *GetWorkflowExecutionRawHistoryV2Request) Size() (n int) {
+func (m *DescribeMutableStateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	if m.Execution != nil {
 		l = m.Execution.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.StartEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.StartEventId))
-	}
-	if m.StartEventVersion != 0 {
-		n += 1 + sovRequestResponse(uint64(m.StartEventVersion))
-	}
-	if m.EndEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.EndEventId))
-	}
-	if m.EndEventVersion != 0 {
-		n += 1 + sovRequestResponse(uint64(m.EndEventVersion))
-	}
-	if m.MaximumPageSize != 0 {
-		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	return n
+}
+
+func (m *DescribeMutableStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.NextPageToken)
+	var l int
+	_ = l
+	l = len(m.ShardId)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	l = len(m.NamespaceId)
+	l = len(m.HistoryAddr)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.CacheMutableState != nil {
+		l = m.CacheMutableState.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.DatabaseMutableState != nil {
+		l = m.DatabaseMutableState.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
-func (m *GetWorkflowExecutionRawHistoryV2Response) Size() (n int) {
+func (m *DescribeHistoryHostRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.NextPageToken)
+	l = len(m.HostAddress)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if len(m.HistoryBatches) > 0 {
-		for _, e := range m.HistoryBatches {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
 	}
-	if m.VersionHistory != nil {
-		l = m.VersionHistory.Size()
+	l = len(m.Namespace)
+	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if len(m.HistoryNodeIds) > 0 {
-		l = 0
-		for _, e := range m.HistoryNodeIds {
-			l += sovRequestResponse(uint64(e))
-		}
-		n += 1 + sovRequestResponse(uint64(l)) + l
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *GetReplicationMessagesRequest) Size() (n int) {
+func (m *DescribeHistoryHostResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
+	if m.ShardsNumber != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardsNumber))
+	}
+	if len(m.ShardIds) > 0 {
+		l = 0
+		for _, e := range m.ShardIds {
+			l += sovRequestResponse(uint64(e))
 		}
+		n += 1 + sovRequestResponse(uint64(l)) + l
 	}
-	l = len(m.ClusterName)
+	if m.NamespaceCache != nil {
+		l = m.NamespaceCache.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *GetReplicationMessagesResponse) Size() (n int) {
+func (m *CloseShardRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ShardMessages) > 0 {
-		for k, v := range m.ShardMessages {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovRequestResponse(uint64(l))
-			}
-			mapEntrySize := 1 + sovRequestResponse(uint64(k)) + l
-			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
-		}
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
 	}
 	return n
 }
 
-func (m *GetNamespaceReplicationMessagesRequest) Size() (n int) {
+func (m *CloseShardResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.LastRetrievedMessageId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.LastRetrievedMessageId))
+	return n
+}
+
+func (m *GetShardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	return n
+}
+
+func (m *GetShardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardInfo != nil {
+		l = m.ShardInfo.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ListHistoryTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	if m.Category != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Category))
+	}
+	if m.TaskRange != nil {
+		l = m.TaskRange.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.BatchSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ListHistoryTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *Task) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskId))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskType))
+	}
+	if m.FireTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FireTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *RemoveTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	if m.Category != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Category))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskId))
+	}
+	if m.VisibilityTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StartEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StartEventId))
+	}
+	if m.StartEventVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StartEventVersion))
+	}
+	if m.EndEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.EndEventId))
+	}
+	if m.EndEventVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.EndEventVersion))
+	}
+	if m.MaximumPageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.HistoryBatches) > 0 {
+		for _, e := range m.HistoryBatches {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if m.VersionHistory != nil {
+		l = m.VersionHistory.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.HistoryNodeIds) > 0 {
+		l = 0
+		for _, e := range m.HistoryNodeIds {
+			l += sovRequestResponse(uint64(e))
+		}
+		n += 1 + sovRequestResponse(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *GetReplicationMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetReplicationMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ShardMessages) > 0 {
+		for k, v := range m.ShardMessages {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRequestResponse(uint64(l))
+			}
+			mapEntrySize := 1 + sovRequestResponse(uint64(k)) + l
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *GetNamespaceReplicationMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastRetrievedMessageId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.LastRetrievedMessageId))
 	}
 	if m.LastProcessedMessageId != 0 {
 		n += 1 + sovRequestResponse(uint64(m.LastProcessedMessageId))
@@ -9266,7 +11152,7 @@ func (m *RefreshWorkflowTasksResponse) Size() (n int) {
 	return n
 }
 
-func (m *ResendReplicationTasksRequest) Size() (n int) {
+func (m *RearchiveWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -9276,12 +11162,38 @@ func (m *ResendReplicationTasksRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	l = len(m.WorkflowId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.RunId)
-	if l > 0 {
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RearchiveWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ResendReplicationTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	l = len(m.RemoteCluster)
@@ -9396,6 +11308,232 @@ func (m *DeleteWorkflowExecutionResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetReplicationStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RemoteClusters) > 0 {
+		for _, s := range m.RemoteClusters {
+			l = len(s)
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if m.IncludeShards {
+		n += 2
+	}
+	if m.MaxDlqSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaxDlqSize))
+	}
+	return n
+}
+
+func (m *GetReplicationStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Clusters) > 0 {
+		for _, e := range m.Clusters {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClusterReplicationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ShardCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardCount))
+	}
+	if m.MissingShardCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MissingShardCount))
+	}
+	if m.MaxLagTaskIds != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaxLagTaskIds))
+	}
+	if m.P99LagTaskIds != 0 {
+		n += 1 + sovRequestResponse(uint64(m.P99LagTaskIds))
+	}
+	if m.MaxLag != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxLag)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.P99Lag != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.P99Lag)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.DlqSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.DlqSize))
+	}
+	if m.DlqSizeIsLowerBound {
+		n += 2
+	}
+	if len(m.Shards) > 0 {
+		for _, e := range m.Shards {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ShardReplicationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	if m.Missing {
+		n += 2
+	}
+	if m.MaxTaskId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaxTaskId))
+	}
+	if m.MaxTaskTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.MaxTaskTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.AckedTaskId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.AckedTaskId))
+	}
+	if m.AckedTaskTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.AckedTaskTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.LagTaskIds != 0 {
+		n += 1 + sovRequestResponse(uint64(m.LagTaskIds))
+	}
+	if m.Lag != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Lag)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.DlqSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.DlqSize))
+	}
+	if m.DlqSizeIsLowerBound {
+		n += 2
+	}
+	return n
+}
+
+func (m *CountWorkflowExecutionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *CountWorkflowExecutionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Count))
+	}
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if m.OtherCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.OtherCount))
+	}
+	return n
+}
+
+func (m *AggregationGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GroupValues) > 0 {
+		for _, s := range m.GroupValues {
+			l = len(s)
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *RenameNamespaceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.NewName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.KeepAlias {
+		n += 2
+	}
+	l = len(m.HistoryArchivalUri)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.VisibilityArchivalUri)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RenameNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.Aliases) > 0 {
+		for _, s := range m.Aliases {
+			l = len(s)
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovRequestResponse(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -10065,6 +12203,26 @@ func (this *RefreshWorkflowTasksResponse) String() string {
 	}, "")
 	return s
 }
+func (this *RearchiveWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RearchiveWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RearchiveWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RearchiveWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ResendReplicationTasksRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -10144,52 +12302,186 @@ func (this *DeleteWorkflowExecutionResponse) String() string {
 	}, "")
 	return s
 }
-func valueToStringRequestResponse(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *GetReplicationStatusRequest) String() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	s := strings.Join([]string{`&GetReplicationStatusRequest{`,
+		`RemoteClusters:` + fmt.Sprintf("%v", this.RemoteClusters) + `,`,
+		`IncludeShards:` + fmt.Sprintf("%v", this.IncludeShards) + `,`,
+		`MaxDlqSize:` + fmt.Sprintf("%v", this.MaxDlqSize) + `,`,
+		`}`,
+	}, "")
+	return s
 }
-func (m *RebuildMutableStateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RebuildMutableStateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RebuildMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
+func (this *GetReplicationStatusResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForClusters := "[]*ClusterReplicationStatus{"
+	for _, f := range this.Clusters {
+		repeatedStringForClusters += strings.Replace(f.String(), "ClusterReplicationStatus", "ClusterReplicationStatus", 1) + ","
+	}
+	repeatedStringForClusters += "}"
+	s := strings.Join([]string{`&GetReplicationStatusResponse{`,
+		`Clusters:` + repeatedStringForClusters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterReplicationStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForShards := "[]*ShardReplicationStatus{"
+	for _, f := range this.Shards {
+		repeatedStringForShards += strings.Replace(f.String(), "ShardReplicationStatus", "ShardReplicationStatus", 1) + ","
+	}
+	repeatedStringForShards += "}"
+	s := strings.Join([]string{`&ClusterReplicationStatus{`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`ShardCount:` + fmt.Sprintf("%v", this.ShardCount) + `,`,
+		`MissingShardCount:` + fmt.Sprintf("%v", this.MissingShardCount) + `,`,
+		`MaxLagTaskIds:` + fmt.Sprintf("%v", this.MaxLagTaskIds) + `,`,
+		`P99LagTaskIds:` + fmt.Sprintf("%v", this.P99LagTaskIds) + `,`,
+		`MaxLag:` + strings.Replace(fmt.Sprintf("%v", this.MaxLag), "Duration", "types.Duration", 1) + `,`,
+		`P99Lag:` + strings.Replace(fmt.Sprintf("%v", this.P99Lag), "Duration", "types.Duration", 1) + `,`,
+		`DlqSize:` + fmt.Sprintf("%v", this.DlqSize) + `,`,
+		`DlqSizeIsLowerBound:` + fmt.Sprintf("%v", this.DlqSizeIsLowerBound) + `,`,
+		`Shards:` + repeatedStringForShards + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShardReplicationStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShardReplicationStatus{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`Missing:` + fmt.Sprintf("%v", this.Missing) + `,`,
+		`MaxTaskId:` + fmt.Sprintf("%v", this.MaxTaskId) + `,`,
+		`MaxTaskTime:` + strings.Replace(fmt.Sprintf("%v", this.MaxTaskTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AckedTaskId:` + fmt.Sprintf("%v", this.AckedTaskId) + `,`,
+		`AckedTaskTime:` + strings.Replace(fmt.Sprintf("%v", this.AckedTaskTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LagTaskIds:` + fmt.Sprintf("%v", this.LagTaskIds) + `,`,
+		`Lag:` + strings.Replace(fmt.Sprintf("%v", this.Lag), "Duration", "types.Duration", 1) + `,`,
+		`DlqSize:` + fmt.Sprintf("%v", this.DlqSize) + `,`,
+		`DlqSizeIsLowerBound:` + fmt.Sprintf("%v", this.DlqSizeIsLowerBound) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CountWorkflowExecutionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CountWorkflowExecutionsRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CountWorkflowExecutionsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForGroups := "[]*AggregationGroup{"
+	for _, f := range this.Groups {
+		repeatedStringForGroups += strings.Replace(f.String(), "AggregationGroup", "AggregationGroup", 1) + ","
+	}
+	repeatedStringForGroups += "}"
+	s := strings.Join([]string{`&CountWorkflowExecutionsResponse{`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Groups:` + repeatedStringForGroups + `,`,
+		`OtherCount:` + fmt.Sprintf("%v", this.OtherCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AggregationGroup) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AggregationGroup{`,
+		`GroupValues:` + fmt.Sprintf("%v", this.GroupValues) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RenameNamespaceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RenameNamespaceRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`NewName:` + fmt.Sprintf("%v", this.NewName) + `,`,
+		`KeepAlias:` + fmt.Sprintf("%v", this.KeepAlias) + `,`,
+		`HistoryArchivalUri:` + fmt.Sprintf("%v", this.HistoryArchivalUri) + `,`,
+		`VisibilityArchivalUri:` + fmt.Sprintf("%v", this.VisibilityArchivalUri) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RenameNamespaceResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RenameNamespaceResponse{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Aliases:` + fmt.Sprintf("%v", this.Aliases) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringRequestResponse(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *RebuildMutableStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RebuildMutableStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RebuildMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
@@ -14340,86 +16632,1673 @@ func (m *GetSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AddWorkflowExecutionInfo == nil {
+				m.AddWorkflowExecutionInfo = &v17.WorkflowExecutionInfo{}
+			}
+			if err := m.AddWorkflowExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeClusterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeClusterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeClusterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeClusterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportedClients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SupportedClients == nil {
+				m.SupportedClients = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SupportedClients[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MembershipInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MembershipInfo == nil {
+				m.MembershipInfo = &v18.MembershipInfo{}
+			}
+			if err := m.MembershipInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryShardCount", wireType)
+			}
+			m.HistoryShardCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryShardCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistenceStore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PersistenceStore = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityStore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VisibilityStore = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionInfo == nil {
+				m.VersionInfo = &v19.VersionInfo{}
+			}
+			if err := m.VersionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailoverVersionIncrement", wireType)
+			}
+			m.FailoverVersionIncrement = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailoverVersionIncrement |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialFailoverVersion", wireType)
+			}
+			m.InitialFailoverVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialFailoverVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsGlobalNamespaceEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsGlobalNamespaceEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListClustersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListClustersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListClustersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListClustersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListClustersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListClustersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clusters = append(m.Clusters, &v11.ClusterMetadata{})
+			if err := m.Clusters[len(m.Clusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddOrUpdateRemoteClusterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddOrUpdateRemoteClusterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddOrUpdateRemoteClusterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrontendAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrontendAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableRemoteClusterConnection", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableRemoteClusterConnection = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddOrUpdateRemoteClusterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddOrUpdateRemoteClusterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddOrUpdateRemoteClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveRemoteClusterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveRemoteClusterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveRemoteClusterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveRemoteClusterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveRemoteClusterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveRemoteClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListClusterMembersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListClusterMembersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListClusterMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatWithin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastHeartbeatWithin == nil {
+				m.LastHeartbeatWithin = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.LastHeartbeatWithin, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RpcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RpcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= v13.ClusterMemberRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionStartedAfterTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SessionStartedAfterTime == nil {
+				m.SessionStartedAfterTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.SessionStartedAfterTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListClusterMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListClusterMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListClusterMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveMembers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveMembers = append(m.ActiveMembers, &v18.ClusterMember{})
+			if err := m.ActiveMembers[len(m.ActiveMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDLQMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v13.DeadLetterQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AddWorkflowExecutionInfo == nil {
-				m.AddWorkflowExecutionInfo = &v17.WorkflowExecutionInfo{}
-			}
-			if err := m.AddWorkflowExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DescribeClusterRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			m.InclusiveEndMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MaximumPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeClusterRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeClusterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14429,23 +18308,25 @@ func (m *DescribeClusterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14471,7 +18352,7 @@ func (m *DescribeClusterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
+func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14494,144 +18375,17 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeClusterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDLQMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupportedClients", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SupportedClients == nil {
-				m.SupportedClients = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.SupportedClients[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var stringLen uint64
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14641,27 +18395,14 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Type |= v13.DeadLetterQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ServerVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MembershipInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14688,18 +18429,16 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MembershipInfo == nil {
-				m.MembershipInfo = &v18.MembershipInfo{}
-			}
-			if err := m.MembershipInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReplicationTasks = append(m.ReplicationTasks, &v15.ReplicationTask{})
+			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14709,61 +18448,84 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeDLQMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoryShardCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.HistoryShardCount = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14773,16 +18535,16 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HistoryShardCount |= int32(b&0x7F) << shift
+				m.Type |= v13.DeadLetterQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PersistenceStore", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			var stringLen uint64
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14792,27 +18554,14 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PersistenceStore = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityStore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14840,13 +18589,13 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VisibilityStore = string(dAtA[iNdEx:postIndex])
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionInfo", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
 			}
-			var msglen int
+			m.InclusiveEndMessageId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14856,86 +18605,64 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionInfo == nil {
-				m.VersionInfo = &v19.VersionInfo{}
-			}
-			if err := m.VersionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailoverVersionIncrement", wireType)
-			}
-			m.FailoverVersionIncrement = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FailoverVersionIncrement |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialFailoverVersion", wireType)
-			}
-			m.InitialFailoverVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InitialFailoverVersion |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsGlobalNamespaceEnabled", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.IsGlobalNamespaceEnabled = bool(v != 0)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeDLQMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -14960,7 +18687,7 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListClustersRequest) Unmarshal(dAtA []byte) error {
+func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14983,17 +18710,17 @@ func (m *ListClustersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListClustersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListClustersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.PageSize = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15003,12 +18730,101 @@ func (m *ListClustersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
+				m.Type |= v13.DeadLetterQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			}
+			m.InclusiveEndMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+			}
+			m.MaximumPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
@@ -15066,7 +18882,7 @@ func (m *ListClustersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListClustersResponse) Unmarshal(dAtA []byte) error {
+func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15089,47 +18905,13 @@ func (m *ListClustersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListClustersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListClustersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Clusters = append(m.Clusters, &v11.ClusterMetadata{})
-			if err := m.Clusters[len(m.Clusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
@@ -15187,7 +18969,7 @@ func (m *ListClustersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddOrUpdateRemoteClusterRequest) Unmarshal(dAtA []byte) error {
+func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15210,17 +18992,17 @@ func (m *AddOrUpdateRemoteClusterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddOrUpdateRemoteClusterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddOrUpdateRemoteClusterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrontendAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15230,29 +19012,33 @@ func (m *AddOrUpdateRemoteClusterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FrontendAddress = string(dAtA[iNdEx:postIndex])
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableRemoteClusterConnection", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15262,12 +19048,24 @@ func (m *AddOrUpdateRemoteClusterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableRemoteClusterConnection = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -15292,7 +19090,7 @@ func (m *AddOrUpdateRemoteClusterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddOrUpdateRemoteClusterResponse) Unmarshal(dAtA []byte) error {
+func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15315,10 +19113,10 @@ func (m *AddOrUpdateRemoteClusterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddOrUpdateRemoteClusterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddOrUpdateRemoteClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15345,7 +19143,7 @@ func (m *AddOrUpdateRemoteClusterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveRemoteClusterRequest) Unmarshal(dAtA []byte) error {
+func (m *RearchiveWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15368,15 +19166,15 @@ func (m *RemoveRemoteClusterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveRemoteClusterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RearchiveWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveRemoteClusterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RearchiveWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15404,7 +19202,43 @@ func (m *RemoveRemoteClusterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15430,7 +19264,7 @@ func (m *RemoveRemoteClusterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveRemoteClusterResponse) Unmarshal(dAtA []byte) error {
+func (m *RearchiveWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15453,10 +19287,10 @@ func (m *RemoveRemoteClusterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveRemoteClusterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RearchiveWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveRemoteClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RearchiveWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15483,7 +19317,7 @@ func (m *RemoveRemoteClusterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListClusterMembersRequest) Unmarshal(dAtA []byte) error {
+func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15506,17 +19340,17 @@ func (m *ListClusterMembersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListClusterMembersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResendReplicationTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListClusterMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResendReplicationTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatWithin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15526,31 +19360,27 @@ func (m *ListClusterMembersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastHeartbeatWithin == nil {
-				m.LastHeartbeatWithin = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.LastHeartbeatWithin, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RpcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15578,11 +19408,11 @@ func (m *ListClusterMembersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RpcAddress = string(dAtA[iNdEx:postIndex])
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15610,13 +19440,13 @@ func (m *ListClusterMembersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostId = string(dAtA[iNdEx:postIndex])
+			m.RunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCluster", wireType)
 			}
-			m.Role = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15626,16 +19456,29 @@ func (m *ListClusterMembersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= v13.ClusterMemberRole(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionStartedAfterTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEventId", wireType)
 			}
-			var msglen int
+			m.StartEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15645,33 +19488,16 @@ func (m *ListClusterMembersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StartEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SessionStartedAfterTime == nil {
-				m.SessionStartedAfterTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.SessionStartedAfterTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartVersion", wireType)
 			}
-			m.PageSize = 0
+			m.StartVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15681,16 +19507,16 @@ func (m *ListClusterMembersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
+				m.StartVersion |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEventId", wireType)
 			}
-			var byteLen int
+			m.EndEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15700,26 +19526,30 @@ func (m *ListClusterMembersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.EndEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndVersion", wireType)
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+			m.EndVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -15744,7 +19574,7 @@ func (m *ListClusterMembersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListClusterMembersResponse) Unmarshal(dAtA []byte) error {
+func (m *ResendReplicationTasksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15767,80 +19597,12 @@ func (m *ListClusterMembersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListClusterMembersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResendReplicationTasksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListClusterMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResendReplicationTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveMembers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActiveMembers = append(m.ActiveMembers, &v18.ClusterMember{})
-			if err := m.ActiveMembers[len(m.ActiveMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -15865,7 +19627,7 @@ func (m *ListClusterMembersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *GetTaskQueueTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15888,17 +19650,17 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTaskQueueTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTaskQueueTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15908,16 +19670,61 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v13.DeadLetterQueueType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
 			}
-			m.ShardId = 0
+			m.TaskQueueType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15927,16 +19734,16 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				m.TaskQueueType |= v16.TaskQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTaskId", wireType)
 			}
-			var stringLen uint64
+			m.MinTaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15946,29 +19753,16 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MinTaskId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTaskId", wireType)
 			}
-			m.InclusiveEndMessageId = 0
+			m.MaxTaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15978,16 +19772,16 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				m.MaxTaskId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
 			}
-			m.MaximumPageSize = 0
+			m.BatchSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15997,12 +19791,12 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaximumPageSize |= int32(b&0x7F) << shift
+				m.BatchSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
@@ -16060,7 +19854,7 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *GetTaskQueueTasksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16083,34 +19877,15 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTaskQueueTasksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTaskQueueTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v13.DeadLetterQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16137,12 +19912,12 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &v15.ReplicationTask{})
-			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tasks = append(m.Tasks, &v11.AllocatedTaskInfo{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
@@ -16200,7 +19975,7 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16223,53 +19998,15 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v13.DeadLetterQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16297,13 +20034,13 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
-			m.InclusiveEndMessageId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16313,11 +20050,28 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -16342,7 +20096,7 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16365,12 +20119,44 @@ func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warnings = append(m.Warnings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -16395,7 +20181,7 @@ func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *GetReplicationStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16418,53 +20204,15 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetReplicationStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetReplicationStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v13.DeadLetterQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteClusters", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16492,32 +20240,13 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.RemoteClusters = append(m.RemoteClusters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
-			}
-			m.InclusiveEndMessageId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeShards", wireType)
 			}
-			m.MaximumPageSize = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16527,16 +20256,17 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaximumPageSize |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			m.IncludeShards = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDlqSize", wireType)
 			}
-			var byteLen int
+			m.MaxDlqSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16546,26 +20276,11 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.MaxDlqSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -16590,7 +20305,7 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *GetReplicationStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16613,17 +20328,17 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetReplicationStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetReplicationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16633,24 +20348,24 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+			m.Clusters = append(m.Clusters, &ClusterReplicationStatus{})
+			if err := m.Clusters[len(m.Clusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -16677,7 +20392,7 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *ClusterReplicationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16700,15 +20415,123 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterReplicationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterReplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardCount", wireType)
+			}
+			m.ShardCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingShardCount", wireType)
+			}
+			m.MissingShardCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissingShardCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLagTaskIds", wireType)
+			}
+			m.MaxLagTaskIds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLagTaskIds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P99LagTaskIds", wireType)
+			}
+			m.P99LagTaskIds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.P99LagTaskIds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLag", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16735,18 +20558,18 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v1.WorkflowExecution{}
+			if m.MaxLag == nil {
+				m.MaxLag = new(time.Duration)
 			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.MaxLag, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field P99Lag", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16756,77 +20579,101 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
+			if m.P99Lag == nil {
+				m.P99Lag = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.P99Lag, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DlqSize", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
+			m.DlqSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DlqSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DlqSizeIsLowerBound", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.DlqSizeIsLowerBound = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Shards = append(m.Shards, &ShardReplicationStatus{})
+			if err := m.Shards[len(m.Shards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -16851,7 +20698,7 @@ func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *ShardReplicationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16874,17 +20721,17 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResendReplicationTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShardReplicationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResendReplicationTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShardReplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			var stringLen uint64
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16894,29 +20741,16 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missing", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16926,29 +20760,17 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WorkflowId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Missing = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTaskId", wireType)
 			}
-			var stringLen uint64
+			m.MaxTaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16958,29 +20780,16 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxTaskId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTaskTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16990,29 +20799,33 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteCluster = string(dAtA[iNdEx:postIndex])
+			if m.MaxTaskTime == nil {
+				m.MaxTaskTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.MaxTaskTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AckedTaskId", wireType)
 			}
-			m.StartEventId = 0
+			m.AckedTaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17022,16 +20835,16 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartEventId |= int64(b&0x7F) << shift
+				m.AckedTaskId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartVersion", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckedTaskTime", wireType)
 			}
-			m.StartVersion = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17041,16 +20854,33 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartVersion |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AckedTaskTime == nil {
+				m.AckedTaskTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.AckedTaskTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LagTaskIds", wireType)
 			}
-			m.EndEventId = 0
+			m.LagTaskIds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17060,16 +20890,16 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndEventId |= int64(b&0x7F) << shift
+				m.LagTaskIds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndVersion", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lag", wireType)
 			}
-			m.EndVersion = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17079,64 +20909,67 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndVersion |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResendReplicationTasksResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			if m.Lag == nil {
+				m.Lag = new(time.Duration)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Lag, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DlqSize", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResendReplicationTasksResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResendReplicationTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.DlqSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DlqSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DlqSizeIsLowerBound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DlqSizeIsLowerBound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -17161,7 +20994,7 @@ func (m *ResendReplicationTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTaskQueueTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *CountWorkflowExecutionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17184,10 +21017,10 @@ func (m *GetTaskQueueTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTaskQueueTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CountWorkflowExecutionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTaskQueueTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CountWorkflowExecutionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17224,7 +21057,7 @@ func (m *GetTaskQueueTasksRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17252,13 +21085,66 @@ func (m *GetTaskQueueTasksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskQueue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CountWorkflowExecutionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CountWorkflowExecutionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CountWorkflowExecutionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.TaskQueueType = 0
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17268,16 +21154,16 @@ func (m *GetTaskQueueTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskQueueType |= v16.TaskQueueType(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinTaskId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			m.MinTaskId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17287,54 +21173,31 @@ func (m *GetTaskQueueTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinTaskId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTaskId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.MaxTaskId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxTaskId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.BatchSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BatchSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Groups = append(m.Groups, &AggregationGroup{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherCount", wireType)
 			}
-			var byteLen int
+			m.OtherCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17344,26 +21207,11 @@ func (m *GetTaskQueueTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.OtherCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -17388,7 +21236,7 @@ func (m *GetTaskQueueTasksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTaskQueueTasksResponse) Unmarshal(dAtA []byte) error {
+func (m *AggregationGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17411,17 +21259,17 @@ func (m *GetTaskQueueTasksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTaskQueueTasksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregationGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTaskQueueTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregationGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupValues", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17431,31 +21279,29 @@ func (m *GetTaskQueueTasksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, &v11.AllocatedTaskInfo{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GroupValues = append(m.GroupValues, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var byteLen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17465,26 +21311,11 @@ func (m *GetTaskQueueTasksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -17509,7 +21340,7 @@ func (m *GetTaskQueueTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *RenameNamespaceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17532,10 +21363,10 @@ func (m *DeleteWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RenameNamespaceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RenameNamespaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17572,9 +21403,9 @@ func (m *DeleteWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17584,27 +21415,107 @@ func (m *DeleteWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v1.WorkflowExecution{}
+			m.NewName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepAlias", wireType)
 			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeepAlias = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryArchivalUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoryArchivalUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityArchivalUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VisibilityArchivalUri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17630,7 +21541,7 @@ func (m *DeleteWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *RenameNamespaceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17653,15 +21564,15 @@ func (m *DeleteWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RenameNamespaceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RenameNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17689,7 +21600,39 @@ func (m *DeleteWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Warnings = append(m.Warnings, string(dAtA[iNdEx:postIndex]))
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aliases = append(m.Aliases, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
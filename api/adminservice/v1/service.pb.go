@@ -54,61 +54,66 @@ func init() {
 }
 
 var fileDescriptor_cf5ca5e0c737570d = []byte{
-	// 862 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0xcd, 0x6b, 0x13, 0x4f,
-	0x18, 0xc7, 0x33, 0x97, 0x1f, 0x3f, 0x86, 0xfe, 0x5e, 0x5c, 0xc5, 0x97, 0x1e, 0xd6, 0xa2, 0xf7,
-	0x84, 0x56, 0xad, 0xf6, 0xbd, 0x69, 0x12, 0x53, 0x30, 0x51, 0x9b, 0xf8, 0x02, 0x5e, 0x64, 0x93,
-	0x7d, 0xda, 0x2e, 0xdd, 0x64, 0xd7, 0x99, 0xd9, 0xd4, 0x9e, 0xf4, 0x22, 0x08, 0x82, 0x28, 0x08,
-	0x82, 0xe0, 0x49, 0x10, 0x05, 0xff, 0x06, 0xc1, 0x9b, 0xc7, 0x1e, 0x7b, 0xb4, 0xe9, 0xc5, 0x63,
-	0x4f, 0x9e, 0x65, 0xbb, 0x99, 0xe9, 0x6e, 0x32, 0x96, 0x99, 0x4d, 0x6f, 0x4d, 0x77, 0x3f, 0xdf,
-	0xe7, 0x93, 0x27, 0x3b, 0xf3, 0x4c, 0x82, 0xc7, 0x19, 0xb4, 0x7c, 0x8f, 0x58, 0x6e, 0x8e, 0x02,
-	0xe9, 0x00, 0xc9, 0x59, 0xbe, 0x93, 0xb3, 0xec, 0x96, 0xd3, 0x0e, 0x5f, 0x3b, 0x4d, 0xc8, 0x75,
-	0xc6, 0x73, 0xbd, 0x3f, 0xb3, 0x3e, 0xf1, 0x98, 0x67, 0x5c, 0xe4, 0x48, 0x36, 0x42, 0xb2, 0x96,
-	0xef, 0x64, 0xe3, 0x48, 0xb6, 0x33, 0x3e, 0x3a, 0xad, 0x92, 0x4b, 0xe0, 0x51, 0x00, 0x94, 0x3d,
-	0x24, 0x40, 0x7d, 0xaf, 0x4d, 0x7b, 0x05, 0x26, 0x7e, 0x8d, 0xe1, 0x91, 0x7c, 0x78, 0x6b, 0x3d,
-	0xba, 0xd5, 0x78, 0x87, 0xf0, 0xc9, 0x1a, 0x34, 0x02, 0xc7, 0xb5, 0xab, 0x01, 0xb3, 0x1a, 0x2e,
-	0xd4, 0x99, 0xc5, 0xc0, 0x58, 0xc8, 0x2a, 0xa8, 0x64, 0x25, 0x64, 0x2d, 0x2a, 0x3c, 0xba, 0x98,
-	0x3e, 0x20, 0x32, 0xbe, 0x90, 0x31, 0xde, 0x23, 0x7c, 0xaa, 0x08, 0xb4, 0x49, 0x9c, 0x06, 0x24,
-	0xec, 0xd4, 0xc2, 0x65, 0x28, 0xd7, 0xcb, 0x0f, 0x91, 0x20, 0xfc, 0xc2, 0xe6, 0xf1, 0x5b, 0x96,
-	0x1d, 0xca, 0x3c, 0xb2, 0xb5, 0xec, 0x51, 0xa6, 0xd8, 0x3c, 0x09, 0xa9, 0xd7, 0x3c, 0x69, 0x80,
-	0x90, 0xdb, 0xc2, 0x7f, 0x97, 0x81, 0xd5, 0xd7, 0x2d, 0x62, 0x1b, 0x97, 0x95, 0xf2, 0xf8, 0xed,
-	0xdc, 0xe2, 0x8a, 0x26, 0x25, 0x4a, 0x3f, 0xc1, 0xb8, 0xe0, 0x7a, 0x14, 0xa2, 0xe2, 0x93, 0x4a,
-	0x31, 0x87, 0x00, 0x2f, 0x7f, 0x55, 0x9b, 0x13, 0x02, 0xaf, 0x11, 0xfe, 0xbf, 0xe2, 0x50, 0xd6,
-	0xeb, 0xcc, 0x1d, 0x8b, 0x6e, 0x50, 0x63, 0x56, 0x29, 0xaf, 0x1f, 0xe3, 0x36, 0x73, 0x29, 0xe9,
-	0x78, 0x53, 0x6a, 0xd0, 0xf2, 0x3a, 0x10, 0x5e, 0x50, 0x6c, 0xca, 0x21, 0xa0, 0xd7, 0x94, 0x38,
-	0x27, 0x04, 0xbe, 0x21, 0x3c, 0x56, 0x06, 0x76, 0xdf, 0x23, 0x1b, 0xab, 0xae, 0xb7, 0x59, 0x7a,
-	0x0c, 0xcd, 0x80, 0x39, 0x5e, 0xbb, 0x66, 0x6d, 0xf6, 0x94, 0xef, 0x4d, 0x18, 0x15, 0xd5, 0xcf,
-	0xfc, 0xc8, 0x18, 0x6e, 0x5b, 0x3d, 0xa6, 0x34, 0xf1, 0x1e, 0x3e, 0x20, 0x7c, 0xba, 0x0c, 0xac,
-	0x06, 0xbe, 0xeb, 0x34, 0xad, 0xf0, 0xc6, 0x2a, 0x50, 0x6a, 0xad, 0x01, 0x35, 0x96, 0x54, 0x6b,
-	0x49, 0x60, 0xee, 0x5b, 0x18, 0x2a, 0x43, 0x58, 0x7e, 0x45, 0xf8, 0x7c, 0x19, 0xd8, 0x4d, 0xab,
-	0x05, 0xd4, 0xb7, 0x9a, 0x20, 0xd3, 0xbd, 0xa1, 0x5a, 0xea, 0xa8, 0x14, 0xee, 0x5d, 0x39, 0x9e,
-	0x30, 0xf1, 0x06, 0xbe, 0x20, 0x7c, 0xae, 0x0c, 0xac, 0x58, 0x59, 0x91, 0xa9, 0x97, 0x54, 0xab,
-	0xc9, 0x79, 0x2e, 0x7d, 0x7d, 0xd8, 0x18, 0xa1, 0xfb, 0x1c, 0xe1, 0x7f, 0x6a, 0x60, 0xf9, 0xbe,
-	0xbb, 0x55, 0xea, 0x40, 0x9b, 0x51, 0x63, 0x4a, 0x71, 0x99, 0xc4, 0x18, 0xae, 0x35, 0x9d, 0x06,
-	0x4d, 0x8c, 0x84, 0xbc, 0x6d, 0xd7, 0xc1, 0x22, 0xcd, 0xf5, 0x3c, 0x63, 0xc4, 0x69, 0x04, 0x0c,
-	0xa8, 0xe2, 0x48, 0x90, 0x90, 0x7a, 0x23, 0x41, 0x1a, 0x90, 0x58, 0x3d, 0xd1, 0xd6, 0x30, 0xe0,
-	0xb7, 0xa4, 0xb1, 0xaf, 0xfc, 0x49, 0xb1, 0x30, 0x54, 0x46, 0xa2, 0x85, 0xe1, 0x50, 0x49, 0xd7,
-	0x42, 0x09, 0xa9, 0xd7, 0x42, 0x69, 0x80, 0x90, 0x7b, 0x89, 0xf0, 0x7f, 0x7c, 0xee, 0x16, 0xdc,
-	0x80, 0x32, 0x20, 0xc6, 0x8c, 0xd6, 0xb4, 0xee, 0x51, 0x5c, 0x6a, 0x36, 0x1d, 0x2c, 0x84, 0x9e,
-	0x21, 0x3c, 0x12, 0x4e, 0x9d, 0xde, 0x15, 0x6a, 0x5c, 0x53, 0x1e, 0x54, 0x1c, 0xe1, 0x2a, 0x53,
-	0x29, 0x48, 0xe1, 0xf1, 0x16, 0x61, 0x23, 0x76, 0xa9, 0x0a, 0xad, 0x46, 0x68, 0x33, 0xaf, 0x9b,
-	0xd9, 0x03, 0xb9, 0xd3, 0x42, 0x6a, 0x5e, 0x98, 0x7d, 0x46, 0xf8, 0x6c, 0xde, 0xb6, 0x6f, 0x91,
-	0xbb, 0xbe, 0x7d, 0x70, 0x7e, 0x6b, 0x79, 0x4c, 0x7c, 0x76, 0x45, 0xd5, 0x65, 0x25, 0xc5, 0xb9,
-	0x65, 0x69, 0xc8, 0x94, 0xc4, 0xb3, 0x1f, 0x2d, 0x90, 0xa4, 0xe6, 0x82, 0xc6, 0xd2, 0x92, 0x1a,
-	0x2e, 0xa6, 0x0f, 0x10, 0x72, 0x2f, 0x10, 0xfe, 0x37, 0xda, 0x8e, 0xc5, 0x28, 0x98, 0xd6, 0xd8,
-	0xc3, 0xfb, 0xf7, 0xff, 0x99, 0x54, 0x6c, 0xe2, 0x8c, 0x77, 0x3b, 0x20, 0x6b, 0x10, 0xf7, 0x51,
-	0x5b, 0x4d, 0xfd, 0x98, 0xde, 0x19, 0x6f, 0x90, 0x4e, 0x38, 0x55, 0x21, 0x95, 0x53, 0x3f, 0xa6,
-	0xe7, 0x34, 0x48, 0x27, 0xbe, 0x44, 0xd5, 0x60, 0x95, 0x00, 0x5d, 0xe7, 0xa7, 0xac, 0xe8, 0x3c,
-	0xac, 0xfa, 0x48, 0x0c, 0xa2, 0x7a, 0x5f, 0xa2, 0xe4, 0x09, 0x7d, 0x43, 0x89, 0x42, 0xdb, 0x8e,
-	0x0d, 0xf9, 0xc8, 0x50, 0x75, 0x28, 0xc9, 0x60, 0xdd, 0xa1, 0x24, 0xcf, 0x10, 0x96, 0x6f, 0x10,
-	0x3e, 0x51, 0x06, 0x16, 0xfe, 0x7b, 0x25, 0x80, 0x00, 0x22, 0xc1, 0x39, 0xd5, 0x47, 0x38, 0xc9,
-	0x71, 0xb7, 0xf9, 0xb4, 0xb8, 0xd0, 0xfa, 0x88, 0xf0, 0x99, 0x22, 0xb8, 0xc0, 0x60, 0xe0, 0x04,
-	0x6d, 0x14, 0x14, 0x27, 0x8b, 0x94, 0xe6, 0x8a, 0xc5, 0xe1, 0x42, 0xb8, 0xe8, 0x92, 0xbb, 0xbd,
-	0x6b, 0x66, 0x76, 0x76, 0xcd, 0xcc, 0xfe, 0xae, 0x89, 0x9e, 0x76, 0x4d, 0xf4, 0xa9, 0x6b, 0xa2,
-	0xef, 0x5d, 0x13, 0x6d, 0x77, 0x4d, 0xf4, 0xa3, 0x6b, 0xa2, 0x9f, 0x5d, 0x33, 0xb3, 0xdf, 0x35,
-	0xd1, 0xab, 0x3d, 0x33, 0xb3, 0xbd, 0x67, 0x66, 0x76, 0xf6, 0xcc, 0xcc, 0x83, 0xc9, 0x35, 0xef,
-	0xb0, 0xbe, 0xe3, 0x1d, 0xf1, 0x83, 0xc7, 0x4c, 0xfc, 0x75, 0xe3, 0xaf, 0x83, 0x5f, 0x3b, 0x2e,
-	0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x73, 0xcc, 0x7e, 0x83, 0x11, 0x00, 0x00,
+	// 944 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0xcd, 0x6b, 0xe3, 0x46,
+	0x18, 0xc6, 0x3d, 0x97, 0x52, 0x86, 0xf4, 0x4b, 0x2d, 0xfd, 0xc8, 0x41, 0x2d, 0x2d, 0xf4, 0x68,
+	0x93, 0xb4, 0x4d, 0x9b, 0xef, 0x38, 0xb6, 0xe3, 0x40, 0xed, 0xb6, 0x91, 0xfb, 0x01, 0xbd, 0x94,
+	0xb1, 0xf5, 0x26, 0x11, 0x91, 0x2d, 0x75, 0x66, 0xe4, 0x34, 0xa7, 0x96, 0x42, 0xa1, 0x50, 0x28,
+	0x2d, 0x14, 0x0a, 0x85, 0x9e, 0x16, 0x96, 0x5d, 0x58, 0xd8, 0xff, 0x60, 0x61, 0x6f, 0x7b, 0xcc,
+	0x31, 0xc7, 0x8d, 0x73, 0xd9, 0x63, 0xfe, 0x84, 0x45, 0x91, 0x67, 0x22, 0xd9, 0xe3, 0x30, 0x23,
+	0xe5, 0x16, 0x47, 0xf3, 0x3c, 0xef, 0x4f, 0xaf, 0x3c, 0xf3, 0xbc, 0x16, 0x5e, 0xe0, 0xd0, 0x0f,
+	0x03, 0x4a, 0xfc, 0x0a, 0x03, 0x3a, 0x04, 0x5a, 0x21, 0xa1, 0x57, 0x21, 0x6e, 0xdf, 0x1b, 0xc4,
+	0x9f, 0xbd, 0x1e, 0x54, 0x86, 0x0b, 0x95, 0xf1, 0x9f, 0xe5, 0x90, 0x06, 0x3c, 0xb0, 0x3e, 0x10,
+	0x92, 0x72, 0x22, 0x29, 0x93, 0xd0, 0x2b, 0xa7, 0x25, 0xe5, 0xe1, 0xc2, 0xfc, 0x8a, 0x8e, 0x2f,
+	0x85, 0x1f, 0x23, 0x60, 0xfc, 0x07, 0x0a, 0x2c, 0x0c, 0x06, 0x6c, 0x5c, 0x60, 0xf1, 0xd7, 0x0f,
+	0xf1, 0x5c, 0x35, 0x5e, 0xda, 0x49, 0x96, 0x5a, 0xff, 0x21, 0xfc, 0xba, 0x03, 0xdd, 0xc8, 0xf3,
+	0xdd, 0x76, 0xc4, 0x49, 0xd7, 0x87, 0x0e, 0x27, 0x1c, 0xac, 0xcd, 0xb2, 0x06, 0x4a, 0x59, 0xa1,
+	0x74, 0x92, 0xc2, 0xf3, 0x5b, 0xf9, 0x0d, 0x12, 0xe2, 0xf7, 0x4b, 0xd6, 0xff, 0x08, 0xbf, 0x51,
+	0x07, 0xd6, 0xa3, 0x5e, 0x17, 0x32, 0x74, 0x7a, 0xe6, 0x2a, 0xa9, 0xc0, 0xab, 0x16, 0x70, 0x90,
+	0x7c, 0x71, 0xf3, 0xc4, 0x92, 0x5d, 0x8f, 0xf1, 0x80, 0x9e, 0xec, 0x06, 0x8c, 0x6b, 0x36, 0x4f,
+	0xa1, 0x34, 0x6b, 0x9e, 0xd2, 0x40, 0xc2, 0x9d, 0xe0, 0x17, 0x9b, 0xc0, 0x3b, 0x87, 0x84, 0xba,
+	0xd6, 0xc7, 0x5a, 0x7e, 0x62, 0xb9, 0xa0, 0xf8, 0xc4, 0x50, 0x25, 0x4b, 0xff, 0x8c, 0x71, 0xcd,
+	0x0f, 0x18, 0x24, 0xc5, 0x97, 0xb4, 0x6c, 0xae, 0x05, 0xa2, 0xfc, 0xa7, 0xc6, 0x3a, 0x09, 0xf0,
+	0x37, 0xc2, 0xaf, 0xb6, 0x3c, 0xc6, 0xc7, 0x9d, 0xf9, 0x9a, 0xb0, 0x23, 0x66, 0xad, 0x69, 0xf9,
+	0x4d, 0xca, 0x04, 0xcd, 0x7a, 0x4e, 0x75, 0xba, 0x29, 0x0e, 0xf4, 0x83, 0x21, 0xc4, 0x17, 0x34,
+	0x9b, 0x72, 0x2d, 0x30, 0x6b, 0x4a, 0x5a, 0x27, 0x01, 0x1e, 0x23, 0xfc, 0x5e, 0x13, 0xf8, 0x77,
+	0x01, 0x3d, 0xda, 0xf7, 0x83, 0xe3, 0xc6, 0x4f, 0xd0, 0x8b, 0xb8, 0x17, 0x0c, 0x1c, 0x72, 0x3c,
+	0x46, 0xfe, 0x76, 0xd1, 0x6a, 0xe9, 0x3e, 0xf3, 0x1b, 0x6d, 0x04, 0x6d, 0xfb, 0x96, 0xdc, 0xe4,
+	0x3d, 0xdc, 0x41, 0xf8, 0xcd, 0x26, 0x70, 0x07, 0x42, 0xdf, 0xeb, 0x91, 0x78, 0x61, 0x1b, 0x18,
+	0x23, 0x07, 0xc0, 0xac, 0x6d, 0xdd, 0x5a, 0x0a, 0xb1, 0xe0, 0xad, 0x15, 0xf2, 0x90, 0x94, 0x8f,
+	0x10, 0x7e, 0xb7, 0x09, 0xfc, 0x0b, 0xd2, 0x07, 0x16, 0x92, 0x1e, 0xa8, 0x70, 0x3f, 0xd7, 0x2d,
+	0x75, 0x93, 0x8b, 0xe0, 0x6e, 0xdd, 0x8e, 0x99, 0xbc, 0x81, 0x07, 0x08, 0xbf, 0xd3, 0x04, 0x5e,
+	0x6f, 0xed, 0xa9, 0xd0, 0x1b, 0xba, 0xd5, 0xd4, 0x7a, 0x01, 0xbd, 0x53, 0xd4, 0x26, 0x93, 0x13,
+	0xd9, 0x87, 0x12, 0x9f, 0xd4, 0x11, 0xd3, 0xcc, 0x09, 0x95, 0xd4, 0x2c, 0x27, 0xd4, 0x0e, 0x92,
+	0xef, 0x77, 0x84, 0x5f, 0x72, 0x80, 0x84, 0xa1, 0x7f, 0xd2, 0x18, 0xc2, 0x80, 0x33, 0x6b, 0x59,
+	0x73, 0x1b, 0xa7, 0x34, 0x82, 0x68, 0x25, 0x8f, 0x34, 0x13, 0x59, 0x55, 0xd7, 0xed, 0x00, 0xa1,
+	0xbd, 0xc3, 0x2a, 0xe7, 0xd4, 0xeb, 0x46, 0x1c, 0x98, 0x66, 0x64, 0x29, 0x94, 0x66, 0x91, 0xa5,
+	0x34, 0xc8, 0xec, 0xee, 0xe4, 0xe8, 0x9a, 0xe2, 0xdb, 0x36, 0x38, 0xf7, 0x66, 0x21, 0xd6, 0x0a,
+	0x79, 0x64, 0x5a, 0x18, 0x87, 0x5e, 0xbe, 0x16, 0x2a, 0x94, 0x66, 0x2d, 0x54, 0x1a, 0x48, 0xb8,
+	0x3f, 0x11, 0x7e, 0x45, 0xcc, 0x05, 0x35, 0x3f, 0x62, 0x1c, 0xa8, 0xb5, 0x6a, 0x34, 0x4d, 0x8c,
+	0x55, 0x02, 0x6a, 0x2d, 0x9f, 0x58, 0x02, 0xfd, 0x86, 0xf0, 0x5c, 0x9c, 0x8a, 0xe3, 0x2b, 0xcc,
+	0xfa, 0x4c, 0x3b, 0x48, 0x85, 0x44, 0xa0, 0x2c, 0xe7, 0x50, 0x4a, 0x8e, 0x7f, 0x11, 0xb6, 0x52,
+	0x97, 0xda, 0xd0, 0xef, 0xc6, 0x34, 0x1b, 0xa6, 0x9e, 0x63, 0xa1, 0x60, 0xda, 0xcc, 0xad, 0x97,
+	0x64, 0xf7, 0x11, 0x7e, 0xbb, 0xea, 0xba, 0x5f, 0xd2, 0x6f, 0x42, 0xf7, 0x6a, 0xbe, 0xec, 0x07,
+	0x5c, 0x3e, 0xbb, 0xba, 0xee, 0xb6, 0x52, 0xca, 0x05, 0x65, 0xa3, 0xa0, 0x4b, 0xe6, 0xbb, 0x9f,
+	0x6c, 0x90, 0x2c, 0xe6, 0xa6, 0xc1, 0xd6, 0x52, 0x12, 0x6e, 0xe5, 0x37, 0x90, 0x70, 0x7f, 0x20,
+	0xfc, 0x72, 0x12, 0x17, 0x32, 0xaa, 0x56, 0x0c, 0x32, 0x66, 0x32, 0x9f, 0x56, 0x73, 0x69, 0x33,
+	0x33, 0xe8, 0x57, 0x11, 0x3d, 0x80, 0x34, 0x8f, 0xde, 0x6e, 0x9a, 0x94, 0x99, 0xcd, 0xa0, 0xd3,
+	0xea, 0x0c, 0x53, 0x1b, 0x72, 0x31, 0xb5, 0xa1, 0x08, 0x53, 0x1b, 0x66, 0x32, 0xc5, 0xe1, 0xed,
+	0xc0, 0x3e, 0x05, 0x76, 0x28, 0xa6, 0xc0, 0x64, 0x5e, 0xd7, 0xfd, 0x4a, 0x4c, 0x4b, 0xcd, 0xc2,
+	0x5b, 0xed, 0x20, 0xf9, 0x1e, 0x22, 0x3c, 0xef, 0x5c, 0x1d, 0xb8, 0xde, 0x10, 0xa6, 0xe6, 0x54,
+	0x6b, 0x47, 0xb3, 0xc6, 0x2c, 0x03, 0xc1, 0xda, 0x2c, 0xec, 0x33, 0x11, 0xa3, 0x0c, 0x06, 0x6e,
+	0x6a, 0x28, 0x49, 0x7a, 0xaa, 0x1b, 0xa3, 0x2a, 0xb1, 0x69, 0x8c, 0xaa, 0x3d, 0x24, 0xe5, 0x3f,
+	0x08, 0xbf, 0xd6, 0x04, 0x1e, 0xff, 0x7b, 0x2f, 0x82, 0x08, 0x12, 0xc0, 0x75, 0xdd, 0x4d, 0x97,
+	0xd5, 0x09, 0xb6, 0x8d, 0xbc, 0x72, 0x89, 0x75, 0x17, 0xe1, 0xb7, 0x6a, 0x41, 0x34, 0x98, 0xfe,
+	0x49, 0xc2, 0x2c, 0xbd, 0x3b, 0x9f, 0xa1, 0x16, 0x88, 0xf5, 0x62, 0x26, 0x99, 0xa4, 0x77, 0x60,
+	0x40, 0xfa, 0x20, 0x87, 0x7a, 0xcd, 0xa4, 0x9f, 0x50, 0x99, 0x25, 0xfd, 0x94, 0x38, 0xd3, 0xb9,
+	0x3a, 0xf8, 0xc0, 0x15, 0xbb, 0xa4, 0xa6, 0x39, 0x45, 0x28, 0xd5, 0x66, 0x9d, 0x9b, 0x69, 0x22,
+	0x40, 0xb7, 0xfd, 0xd3, 0x73, 0xbb, 0x74, 0x76, 0x6e, 0x97, 0x2e, 0xcf, 0x6d, 0xf4, 0xcb, 0xc8,
+	0x46, 0xf7, 0x46, 0x36, 0x7a, 0x32, 0xb2, 0xd1, 0xe9, 0xc8, 0x46, 0x4f, 0x47, 0x36, 0x7a, 0x36,
+	0xb2, 0x4b, 0x97, 0x23, 0x1b, 0xfd, 0x75, 0x61, 0x97, 0x4e, 0x2f, 0xec, 0xd2, 0xd9, 0x85, 0x5d,
+	0xfa, 0x7e, 0xe9, 0x20, 0xb8, 0xae, 0xef, 0x05, 0x37, 0xbc, 0x7c, 0x5b, 0x4d, 0x7f, 0xee, 0xbe,
+	0x70, 0xf5, 0xe6, 0xed, 0xa3, 0xe7, 0x03, 0x00, 0x74, 0x43, 0xdb, 0x0a, 0x0f, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -145,6 +150,9 @@ type AdminServiceClient interface {
 	GetNamespaceReplicationMessages(ctx context.Context, in *GetNamespaceReplicationMessagesRequest, opts ...grpc.CallOption) (*GetNamespaceReplicationMessagesResponse, error)
 	// GetDLQReplicationMessages return replication messages based on DLQ info.
 	GetDLQReplicationMessages(ctx context.Context, in *GetDLQReplicationMessagesRequest, opts ...grpc.CallOption) (*GetDLQReplicationMessagesResponse, error)
+	// GetReplicationStatus combines the replication ack levels of all history shards into per remote cluster
+	// lag percentiles, together with the replication DLQ sizes.
+	GetReplicationStatus(ctx context.Context, in *GetReplicationStatusRequest, opts ...grpc.CallOption) (*GetReplicationStatusResponse, error)
 	// ReapplyEvents applies stale events to the current workflow and current run.
 	ReapplyEvents(ctx context.Context, in *ReapplyEventsRequest, opts ...grpc.CallOption) (*ReapplyEventsResponse, error)
 	// AddSearchAttributes add custom search attributes and returns comprehensive information about them.
@@ -176,10 +184,17 @@ type AdminServiceClient interface {
 	MergeDLQMessages(ctx context.Context, in *MergeDLQMessagesRequest, opts ...grpc.CallOption) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(ctx context.Context, in *RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*RefreshWorkflowTasksResponse, error)
+	// RearchiveWorkflowExecution schedules a new archival task for a closed workflow execution.
+	RearchiveWorkflowExecution(ctx context.Context, in *RearchiveWorkflowExecutionRequest, opts ...grpc.CallOption) (*RearchiveWorkflowExecutionResponse, error)
 	// ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster.
 	ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error)
 	// GetTaskQueueTasks returns tasks from task queue.
 	GetTaskQueueTasks(ctx context.Context, in *GetTaskQueueTasksRequest, opts ...grpc.CallOption) (*GetTaskQueueTasksResponse, error)
+	// CountWorkflowExecutions counts the executions matching a visibility query. Unlike the workflow service API,
+	// it also returns the counts of each group if the query has a GROUP BY clause.
+	CountWorkflowExecutions(ctx context.Context, in *CountWorkflowExecutionsRequest, opts ...grpc.CallOption) (*CountWorkflowExecutionsResponse, error)
+	// RenameNamespace renames a namespace while keeping its ID, by running the rename namespace system workflow.
+	RenameNamespace(ctx context.Context, in *RenameNamespaceRequest, opts ...grpc.CallOption) (*RenameNamespaceResponse, error)
 	// DeleteWorkflowExecution force deletes a workflow's visibility record, current & concrete execution record and history if possible
 	DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error)
 }
@@ -291,6 +306,15 @@ func (c *adminServiceClient) GetDLQReplicationMessages(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *adminServiceClient) GetReplicationStatus(ctx context.Context, in *GetReplicationStatusRequest, opts ...grpc.CallOption) (*GetReplicationStatusResponse, error) {
+	out := new(GetReplicationStatusResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetReplicationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ReapplyEvents(ctx context.Context, in *ReapplyEventsRequest, opts ...grpc.CallOption) (*ReapplyEventsResponse, error) {
 	out := new(ReapplyEventsResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ReapplyEvents", in, out, opts...)
@@ -408,6 +432,15 @@ func (c *adminServiceClient) RefreshWorkflowTasks(ctx context.Context, in *Refre
 	return out, nil
 }
 
+func (c *adminServiceClient) RearchiveWorkflowExecution(ctx context.Context, in *RearchiveWorkflowExecutionRequest, opts ...grpc.CallOption) (*RearchiveWorkflowExecutionResponse, error) {
+	out := new(RearchiveWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RearchiveWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error) {
 	out := new(ResendReplicationTasksResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ResendReplicationTasks", in, out, opts...)
@@ -426,6 +459,24 @@ func (c *adminServiceClient) GetTaskQueueTasks(ctx context.Context, in *GetTaskQ
 	return out, nil
 }
 
+func (c *adminServiceClient) CountWorkflowExecutions(ctx context.Context, in *CountWorkflowExecutionsRequest, opts ...grpc.CallOption) (*CountWorkflowExecutionsResponse, error) {
+	out := new(CountWorkflowExecutionsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/CountWorkflowExecutions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RenameNamespace(ctx context.Context, in *RenameNamespaceRequest, opts ...grpc.CallOption) (*RenameNamespaceResponse, error) {
+	out := new(RenameNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RenameNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error) {
 	out := new(DeleteWorkflowExecutionResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution", in, out, opts...)
@@ -459,6 +510,9 @@ type AdminServiceServer interface {
 	GetNamespaceReplicationMessages(context.Context, *GetNamespaceReplicationMessagesRequest) (*GetNamespaceReplicationMessagesResponse, error)
 	// GetDLQReplicationMessages return replication messages based on DLQ info.
 	GetDLQReplicationMessages(context.Context, *GetDLQReplicationMessagesRequest) (*GetDLQReplicationMessagesResponse, error)
+	// GetReplicationStatus combines the replication ack levels of all history shards into per remote cluster
+	// lag percentiles, together with the replication DLQ sizes.
+	GetReplicationStatus(context.Context, *GetReplicationStatusRequest) (*GetReplicationStatusResponse, error)
 	// ReapplyEvents applies stale events to the current workflow and current run.
 	ReapplyEvents(context.Context, *ReapplyEventsRequest) (*ReapplyEventsResponse, error)
 	// AddSearchAttributes add custom search attributes and returns comprehensive information about them.
@@ -490,10 +544,17 @@ type AdminServiceServer interface {
 	MergeDLQMessages(context.Context, *MergeDLQMessagesRequest) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
+	// RearchiveWorkflowExecution schedules a new archival task for a closed workflow execution.
+	RearchiveWorkflowExecution(context.Context, *RearchiveWorkflowExecutionRequest) (*RearchiveWorkflowExecutionResponse, error)
 	// ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster.
 	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error)
 	// GetTaskQueueTasks returns tasks from task queue.
 	GetTaskQueueTasks(context.Context, *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error)
+	// CountWorkflowExecutions counts the executions matching a visibility query. Unlike the workflow service API,
+	// it also returns the counts of each group if the query has a GROUP BY clause.
+	CountWorkflowExecutions(context.Context, *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error)
+	// RenameNamespace renames a namespace while keeping its ID, by running the rename namespace system workflow.
+	RenameNamespace(context.Context, *RenameNamespaceRequest) (*RenameNamespaceResponse, error)
 	// DeleteWorkflowExecution force deletes a workflow's visibility record, current & concrete execution record and history if possible
 	DeleteWorkflowExecution(context.Context, *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error)
 }
@@ -535,6 +596,9 @@ func (*UnimplementedAdminServiceServer) GetNamespaceReplicationMessages(ctx cont
 func (*UnimplementedAdminServiceServer) GetDLQReplicationMessages(ctx context.Context, req *GetDLQReplicationMessagesRequest) (*GetDLQReplicationMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDLQReplicationMessages not implemented")
 }
+func (*UnimplementedAdminServiceServer) GetReplicationStatus(ctx context.Context, req *GetReplicationStatusRequest) (*GetReplicationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplicationStatus not implemented")
+}
 func (*UnimplementedAdminServiceServer) ReapplyEvents(ctx context.Context, req *ReapplyEventsRequest) (*ReapplyEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReapplyEvents not implemented")
 }
@@ -574,12 +638,21 @@ func (*UnimplementedAdminServiceServer) MergeDLQMessages(ctx context.Context, re
 func (*UnimplementedAdminServiceServer) RefreshWorkflowTasks(ctx context.Context, req *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshWorkflowTasks not implemented")
 }
+func (*UnimplementedAdminServiceServer) RearchiveWorkflowExecution(ctx context.Context, req *RearchiveWorkflowExecutionRequest) (*RearchiveWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RearchiveWorkflowExecution not implemented")
+}
 func (*UnimplementedAdminServiceServer) ResendReplicationTasks(ctx context.Context, req *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResendReplicationTasks not implemented")
 }
 func (*UnimplementedAdminServiceServer) GetTaskQueueTasks(ctx context.Context, req *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTaskQueueTasks not implemented")
 }
+func (*UnimplementedAdminServiceServer) CountWorkflowExecutions(ctx context.Context, req *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountWorkflowExecutions not implemented")
+}
+func (*UnimplementedAdminServiceServer) RenameNamespace(ctx context.Context, req *RenameNamespaceRequest) (*RenameNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameNamespace not implemented")
+}
 func (*UnimplementedAdminServiceServer) DeleteWorkflowExecution(ctx context.Context, req *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflowExecution not implemented")
 }
@@ -786,6 +859,24 @@ func _AdminService_GetDLQReplicationMessages_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetReplicationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplicationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetReplicationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetReplicationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetReplicationStatus(ctx, req.(*GetReplicationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ReapplyEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReapplyEventsRequest)
 	if err := dec(in); err != nil {
@@ -1020,6 +1111,24 @@ func _AdminService_RefreshWorkflowTasks_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_RearchiveWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RearchiveWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RearchiveWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RearchiveWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RearchiveWorkflowExecution(ctx, req.(*RearchiveWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ResendReplicationTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResendReplicationTasksRequest)
 	if err := dec(in); err != nil {
@@ -1056,6 +1165,42 @@ func _AdminService_GetTaskQueueTasks_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_CountWorkflowExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountWorkflowExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CountWorkflowExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/CountWorkflowExecutions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CountWorkflowExecutions(ctx, req.(*CountWorkflowExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RenameNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RenameNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RenameNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RenameNamespace(ctx, req.(*RenameNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_DeleteWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteWorkflowExecutionRequest)
 	if err := dec(in); err != nil {
@@ -1122,6 +1267,10 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetDLQReplicationMessages",
 			Handler:    _AdminService_GetDLQReplicationMessages_Handler,
 		},
+		{
+			MethodName: "GetReplicationStatus",
+			Handler:    _AdminService_GetReplicationStatus_Handler,
+		},
 		{
 			MethodName: "ReapplyEvents",
 			Handler:    _AdminService_ReapplyEvents_Handler,
@@ -1174,6 +1323,10 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshWorkflowTasks",
 			Handler:    _AdminService_RefreshWorkflowTasks_Handler,
 		},
+		{
+			MethodName: "RearchiveWorkflowExecution",
+			Handler:    _AdminService_RearchiveWorkflowExecution_Handler,
+		},
 		{
 			MethodName: "ResendReplicationTasks",
 			Handler:    _AdminService_ResendReplicationTasks_Handler,
@@ -1182,6 +1335,14 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTaskQueueTasks",
 			Handler:    _AdminService_GetTaskQueueTasks_Handler,
 		},
+		{
+			MethodName: "CountWorkflowExecutions",
+			Handler:    _AdminService_CountWorkflowExecutions_Handler,
+		},
+		{
+			MethodName: "RenameNamespace",
+			Handler:    _AdminService_RenameNamespace_Handler,
+		},
 		{
 			MethodName: "DeleteWorkflowExecution",
 			Handler:    _AdminService_DeleteWorkflowExecution_Handler,
@@ -120,6 +120,26 @@ func (mr *MockAdminServiceClientMockRecorder) CloseShard(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceClient)(nil).CloseShard), varargs...)
 }
 
+// CountWorkflowExecutions mocks base method.
+func (m *MockAdminServiceClient) CountWorkflowExecutions(ctx context.Context, in *adminservice.CountWorkflowExecutionsRequest, opts ...grpc.CallOption) (*adminservice.CountWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CountWorkflowExecutions", varargs...)
+	ret0, _ := ret[0].(*adminservice.CountWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountWorkflowExecutions indicates an expected call of CountWorkflowExecutions.
+func (mr *MockAdminServiceClientMockRecorder) CountWorkflowExecutions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWorkflowExecutions", reflect.TypeOf((*MockAdminServiceClient)(nil).CountWorkflowExecutions), varargs...)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *adminservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -280,6 +300,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetReplicationMessages(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetReplicationMessages), varargs...)
 }
 
+// GetReplicationStatus mocks base method.
+func (m *MockAdminServiceClient) GetReplicationStatus(ctx context.Context, in *adminservice.GetReplicationStatusRequest, opts ...grpc.CallOption) (*adminservice.GetReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReplicationStatus", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus.
+func (mr *MockAdminServiceClientMockRecorder) GetReplicationStatus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockAdminServiceClient)(nil).GetReplicationStatus), varargs...)
+}
+
 // GetSearchAttributes mocks base method.
 func (m *MockAdminServiceClient) GetSearchAttributes(ctx context.Context, in *adminservice.GetSearchAttributesRequest, opts ...grpc.CallOption) (*adminservice.GetSearchAttributesResponse, error) {
 	m.ctrl.T.Helper()
@@ -480,6 +520,26 @@ func (mr *MockAdminServiceClientMockRecorder) ReapplyEvents(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockAdminServiceClient)(nil).ReapplyEvents), varargs...)
 }
 
+// RearchiveWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) RearchiveWorkflowExecution(ctx context.Context, in *adminservice.RearchiveWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.RearchiveWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RearchiveWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.RearchiveWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RearchiveWorkflowExecution indicates an expected call of RearchiveWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) RearchiveWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RearchiveWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).RearchiveWorkflowExecution), varargs...)
+}
+
 // RebuildMutableState mocks base method.
 func (m *MockAdminServiceClient) RebuildMutableState(ctx context.Context, in *adminservice.RebuildMutableStateRequest, opts ...grpc.CallOption) (*adminservice.RebuildMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -580,6 +640,26 @@ func (mr *MockAdminServiceClientMockRecorder) RemoveTask(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockAdminServiceClient)(nil).RemoveTask), varargs...)
 }
 
+// RenameNamespace mocks base method.
+func (m *MockAdminServiceClient) RenameNamespace(ctx context.Context, in *adminservice.RenameNamespaceRequest, opts ...grpc.CallOption) (*adminservice.RenameNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RenameNamespace", varargs...)
+	ret0, _ := ret[0].(*adminservice.RenameNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameNamespace indicates an expected call of RenameNamespace.
+func (mr *MockAdminServiceClientMockRecorder) RenameNamespace(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameNamespace", reflect.TypeOf((*MockAdminServiceClient)(nil).RenameNamespace), varargs...)
+}
+
 // ResendReplicationTasks mocks base method.
 func (m *MockAdminServiceClient) ResendReplicationTasks(ctx context.Context, in *adminservice.ResendReplicationTasksRequest, opts ...grpc.CallOption) (*adminservice.ResendReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -668,6 +748,21 @@ func (mr *MockAdminServiceServerMockRecorder) CloseShard(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceServer)(nil).CloseShard), arg0, arg1)
 }
 
+// CountWorkflowExecutions mocks base method.
+func (m *MockAdminServiceServer) CountWorkflowExecutions(arg0 context.Context, arg1 *adminservice.CountWorkflowExecutionsRequest) (*adminservice.CountWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountWorkflowExecutions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CountWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountWorkflowExecutions indicates an expected call of CountWorkflowExecutions.
+func (mr *MockAdminServiceServerMockRecorder) CountWorkflowExecutions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWorkflowExecutions", reflect.TypeOf((*MockAdminServiceServer)(nil).CountWorkflowExecutions), arg0, arg1)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *adminservice.DeleteWorkflowExecutionRequest) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -788,6 +883,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetReplicationMessages(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetReplicationMessages), arg0, arg1)
 }
 
+// GetReplicationStatus mocks base method.
+func (m *MockAdminServiceServer) GetReplicationStatus(arg0 context.Context, arg1 *adminservice.GetReplicationStatusRequest) (*adminservice.GetReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationStatus", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus.
+func (mr *MockAdminServiceServerMockRecorder) GetReplicationStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockAdminServiceServer)(nil).GetReplicationStatus), arg0, arg1)
+}
+
 // GetSearchAttributes mocks base method.
 func (m *MockAdminServiceServer) GetSearchAttributes(arg0 context.Context, arg1 *adminservice.GetSearchAttributesRequest) (*adminservice.GetSearchAttributesResponse, error) {
 	m.ctrl.T.Helper()
@@ -938,6 +1048,21 @@ func (mr *MockAdminServiceServerMockRecorder) ReapplyEvents(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockAdminServiceServer)(nil).ReapplyEvents), arg0, arg1)
 }
 
+// RearchiveWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) RearchiveWorkflowExecution(arg0 context.Context, arg1 *adminservice.RearchiveWorkflowExecutionRequest) (*adminservice.RearchiveWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RearchiveWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RearchiveWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RearchiveWorkflowExecution indicates an expected call of RearchiveWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) RearchiveWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RearchiveWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).RearchiveWorkflowExecution), arg0, arg1)
+}
+
 // RebuildMutableState mocks base method.
 func (m *MockAdminServiceServer) RebuildMutableState(arg0 context.Context, arg1 *adminservice.RebuildMutableStateRequest) (*adminservice.RebuildMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -1013,6 +1138,21 @@ func (mr *MockAdminServiceServerMockRecorder) RemoveTask(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockAdminServiceServer)(nil).RemoveTask), arg0, arg1)
 }
 
+// RenameNamespace mocks base method.
+func (m *MockAdminServiceServer) RenameNamespace(arg0 context.Context, arg1 *adminservice.RenameNamespaceRequest) (*adminservice.RenameNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameNamespace", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RenameNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameNamespace indicates an expected call of RenameNamespace.
+func (mr *MockAdminServiceServerMockRecorder) RenameNamespace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameNamespace", reflect.TypeOf((*MockAdminServiceServer)(nil).RenameNamespace), arg0, arg1)
+}
+
 // ResendReplicationTasks mocks base method.
 func (m *MockAdminServiceServer) ResendReplicationTasks(arg0 context.Context, arg1 *adminservice.ResendReplicationTasksRequest) (*adminservice.ResendReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,27 +88,57 @@ const (
 	WORKFLOW_BACKOFF_TYPE_UNSPECIFIED WorkflowBackoffType = 0
 	WORKFLOW_BACKOFF_TYPE_RETRY       WorkflowBackoffType = 1
 	WORKFLOW_BACKOFF_TYPE_CRON        WorkflowBackoffType = 2
+	WORKFLOW_BACKOFF_TYPE_DELAY_START WorkflowBackoffType = 3
 )
 
 var WorkflowBackoffType_name = map[int32]string{
 	0: "Unspecified",
 	1: "Retry",
 	2: "Cron",
+	3: "DelayStart",
 }
 
 var WorkflowBackoffType_value = map[string]int32{
 	"Unspecified": 0,
 	"Retry":       1,
 	"Cron":        2,
+	"DelayStart":  3,
 }
 
 func (WorkflowBackoffType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_004b7fefe981a755, []int{1}
 }
 
+type WorkflowIdConflictPolicy int32
+
+const (
+	WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED WorkflowIdConflictPolicy = 0
+	// Fail the start request with WorkflowExecutionAlreadyStarted.
+	WORKFLOW_ID_CONFLICT_POLICY_FAIL WorkflowIdConflictPolicy = 1
+	// Return the run ID of the running workflow and attach the request ID of the start request to it.
+	WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING WorkflowIdConflictPolicy = 2
+)
+
+var WorkflowIdConflictPolicy_name = map[int32]string{
+	0: "Unspecified",
+	1: "Fail",
+	2: "UseExisting",
+}
+
+var WorkflowIdConflictPolicy_value = map[string]int32{
+	"Unspecified": 0,
+	"Fail":        1,
+	"UseExisting": 2,
+}
+
+func (WorkflowIdConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_004b7fefe981a755, []int{2}
+}
+
 func init() {
 	proto.RegisterEnum("temporal.server.api.enums.v1.WorkflowExecutionState", WorkflowExecutionState_name, WorkflowExecutionState_value)
 	proto.RegisterEnum("temporal.server.api.enums.v1.WorkflowBackoffType", WorkflowBackoffType_name, WorkflowBackoffType_value)
+	proto.RegisterEnum("temporal.server.api.enums.v1.WorkflowIdConflictPolicy", WorkflowIdConflictPolicy_name, WorkflowIdConflictPolicy_value)
 }
 
 func init() {
@@ -116,30 +146,35 @@ func init() {
 }
 
 var fileDescriptor_004b7fefe981a755 = []byte{
-	// 366 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2e, 0x49, 0xcd, 0x2d,
-	0xc8, 0x2f, 0x4a, 0xcc, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0x4b, 0x2d, 0xd2, 0x4f, 0x2c, 0xc8, 0xd4,
-	0x4f, 0xcd, 0x2b, 0xcd, 0x2d, 0xd6, 0x2f, 0x33, 0xd4, 0x2f, 0xcf, 0x2f, 0xca, 0x4e, 0xcb, 0xc9,
-	0x2f, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0x29, 0xd6, 0x83, 0x28, 0xd6, 0x4b,
-	0x2c, 0xc8, 0xd4, 0x03, 0x2b, 0xd6, 0x2b, 0x33, 0xd4, 0x5a, 0xc6, 0xc4, 0x25, 0x16, 0x0e, 0xd5,
-	0xe0, 0x5a, 0x91, 0x9a, 0x5c, 0x5a, 0x92, 0x99, 0x9f, 0x17, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xa4,
-	0xc1, 0xa5, 0x12, 0xee, 0x1f, 0xe4, 0xed, 0xe6, 0xe3, 0x1f, 0x1e, 0xef, 0x1a, 0xe1, 0xea, 0x1c,
-	0x1a, 0xe2, 0xe9, 0xef, 0x17, 0x1f, 0x1c, 0xe2, 0x18, 0xe2, 0x1a, 0x1f, 0xea, 0x17, 0x1c, 0xe0,
-	0xea, 0xec, 0xe9, 0xe6, 0xe9, 0xea, 0x22, 0xc0, 0x20, 0xa4, 0xc2, 0xa5, 0x80, 0x53, 0xa5, 0x73,
-	0x90, 0xab, 0x63, 0x88, 0xab, 0x8b, 0x00, 0x23, 0x5e, 0x55, 0x41, 0xa1, 0x7e, 0x7e, 0x9e, 0x7e,
-	0xee, 0x02, 0x4c, 0x42, 0x6a, 0x5c, 0x4a, 0xb8, 0xcd, 0xf2, 0xf7, 0x0d, 0xf0, 0x71, 0x05, 0x99,
-	0xc6, 0x2c, 0xa4, 0xcc, 0x25, 0x8f, 0x53, 0x5d, 0x94, 0xbf, 0xaf, 0x93, 0xa7, 0xab, 0x00, 0x8b,
-	0x90, 0x22, 0x97, 0x2c, 0x4e, 0x45, 0x61, 0xfe, 0x9e, 0x2e, 0x02, 0xac, 0x04, 0xec, 0x0b, 0x0a,
-	0x0a, 0x0d, 0x00, 0xd9, 0xc7, 0xa6, 0x55, 0xcb, 0x25, 0x0c, 0x0b, 0x27, 0xa7, 0xc4, 0xe4, 0xec,
-	0xfc, 0xb4, 0xb4, 0x90, 0xca, 0x82, 0x54, 0x21, 0x55, 0x2e, 0x45, 0xb8, 0x76, 0x27, 0x47, 0x67,
-	0x6f, 0x7f, 0x37, 0xb7, 0xf8, 0x90, 0xc8, 0x00, 0xf4, 0x10, 0x92, 0xe7, 0x92, 0xc6, 0xae, 0x2c,
-	0xc8, 0x35, 0x24, 0x28, 0x52, 0x80, 0x51, 0x48, 0x8e, 0x4b, 0x0a, 0xbb, 0x02, 0xe7, 0x20, 0x7f,
-	0x3f, 0x01, 0x26, 0xa7, 0xb8, 0x0b, 0x0f, 0xe5, 0x18, 0x6e, 0x3c, 0x94, 0x63, 0xf8, 0xf0, 0x50,
-	0x8e, 0xb1, 0xe1, 0x91, 0x1c, 0xe3, 0x8a, 0x47, 0x72, 0x8c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
-	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x8b, 0x47, 0x72, 0x0c, 0x1f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x1a, 0xe9, 0xf9,
-	0x7a, 0xf0, 0xe8, 0xcf, 0xcc, 0xc7, 0x96, 0x5c, 0xac, 0xc1, 0x8c, 0x24, 0x36, 0x70, 0x62, 0x31,
-	0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x0f, 0x05, 0x23, 0x5b, 0x02, 0x00, 0x00,
+	// 440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x7d, 0x29, 0x74, 0xb8, 0xe9, 0x74, 0x48, 0x08, 0xf1, 0xe7, 0x4a, 0xa1, 0x40, 0x94,
+	0x22, 0x5b, 0x15, 0x23, 0x93, 0x73, 0x3e, 0xa3, 0x53, 0x5d, 0x9f, 0x75, 0xbe, 0x90, 0xa6, 0x03,
+	0x96, 0x09, 0x0e, 0xb2, 0x9a, 0xe6, 0x2c, 0xd7, 0x4d, 0xe9, 0xc6, 0x47, 0x60, 0x67, 0x45, 0x88,
+	0x8f, 0xc2, 0x98, 0xb1, 0x23, 0x71, 0x16, 0xc6, 0x7c, 0x04, 0x94, 0x40, 0x32, 0x20, 0x3b, 0xdd,
+	0xde, 0xe1, 0xf7, 0x3e, 0xcf, 0xab, 0xf7, 0x79, 0xe0, 0x7e, 0x91, 0x9c, 0x65, 0x3a, 0x8f, 0x87,
+	0xd6, 0x79, 0x92, 0x8f, 0x93, 0xdc, 0x8a, 0xb3, 0xd4, 0x4a, 0x46, 0x17, 0x67, 0xe7, 0xd6, 0xf8,
+	0xc0, 0xba, 0xd4, 0xf9, 0xe9, 0x60, 0xa8, 0x2f, 0xcd, 0x2c, 0xd7, 0x85, 0xc6, 0x0f, 0x57, 0xb0,
+	0xf9, 0x17, 0x36, 0xe3, 0x2c, 0x35, 0x97, 0xb0, 0x39, 0x3e, 0x68, 0x7d, 0x6f, 0xc0, 0xbb, 0xdd,
+	0x7f, 0x0b, 0xec, 0x53, 0xd2, 0xbf, 0x28, 0x52, 0x3d, 0x0a, 0x8b, 0xb8, 0x48, 0x70, 0x13, 0xee,
+	0x75, 0x85, 0x3c, 0x74, 0x3d, 0xd1, 0x8d, 0xd8, 0x31, 0xa3, 0x1d, 0xc5, 0x85, 0x1f, 0x85, 0xca,
+	0x56, 0x2c, 0xea, 0xf8, 0x61, 0xc0, 0x28, 0x77, 0x39, 0x73, 0x90, 0x81, 0xf7, 0xe0, 0xe3, 0x5a,
+	0x92, 0x4a, 0x66, 0x2b, 0xe6, 0x20, 0xb0, 0x91, 0x92, 0x1d, 0xdf, 0xe7, 0xfe, 0x1b, 0xd4, 0xc0,
+	0xcf, 0xe1, 0x93, 0x7a, 0x2d, 0x71, 0x14, 0x78, 0x6c, 0xa1, 0xb6, 0x85, 0x9f, 0xc2, 0x9d, 0x5a,
+	0xee, 0x44, 0x1c, 0xb5, 0x39, 0x43, 0xb7, 0xf0, 0x2e, 0x7c, 0x54, 0x0b, 0xbd, 0x15, 0xdc, 0x41,
+	0xb7, 0x6f, 0xf0, 0x93, 0xb2, 0x13, 0x2c, 0xfc, 0xb6, 0x5b, 0xdf, 0x00, 0xbc, 0xb3, 0x7a, 0x54,
+	0x3b, 0xee, 0x9f, 0xea, 0xc1, 0x40, 0x5d, 0x65, 0x09, 0x7e, 0x06, 0x77, 0xd7, 0xfb, 0x6d, 0x9b,
+	0x1e, 0x0a, 0xd7, 0x8d, 0x54, 0x2f, 0xf8, 0xff, 0x45, 0x3b, 0xf0, 0x41, 0x35, 0x26, 0x99, 0x92,
+	0x3d, 0x04, 0x30, 0x81, 0xf7, 0xab, 0x01, 0x2a, 0x85, 0x8f, 0x1a, 0xf5, 0x3e, 0x0e, 0xf3, 0xec,
+	0xde, 0xe2, 0x60, 0xa9, 0xd0, 0x56, 0xeb, 0x2b, 0x80, 0xf7, 0x56, 0x67, 0xf2, 0x0f, 0x54, 0x8f,
+	0x06, 0xc3, 0xb4, 0x5f, 0x04, 0x7a, 0x98, 0xf6, 0xaf, 0xf0, 0x3e, 0x7c, 0xb1, 0xd6, 0xe0, 0x4e,
+	0x44, 0x85, 0xef, 0x7a, 0x9c, 0xaa, 0x28, 0x10, 0x1e, 0xa7, 0xbd, 0x0d, 0xa1, 0x56, 0xc0, 0xae,
+	0xcd, 0x3d, 0x04, 0xf0, 0x4b, 0xd8, 0xdc, 0x28, 0x19, 0xb2, 0x88, 0x1d, 0xf3, 0x50, 0x2d, 0xc3,
+	0x6d, 0xbf, 0x9b, 0x4c, 0x89, 0x71, 0x3d, 0x25, 0xc6, 0x7c, 0x4a, 0xc0, 0xe7, 0x92, 0x80, 0x1f,
+	0x25, 0x01, 0x3f, 0x4b, 0x02, 0x26, 0x25, 0x01, 0xbf, 0x4a, 0x02, 0x7e, 0x97, 0xc4, 0x98, 0x97,
+	0x04, 0x7c, 0x99, 0x11, 0x63, 0x32, 0x23, 0xc6, 0xf5, 0x8c, 0x18, 0x27, 0xcd, 0x8f, 0xda, 0x5c,
+	0x97, 0x38, 0xd5, 0x55, 0xa5, 0x7f, 0xbd, 0x1c, 0xde, 0x6f, 0x2f, 0x2b, 0xff, 0xea, 0xcf, 0x00,
+	0xd5, 0x1b, 0x2e, 0xf5, 0x21, 0x03, 0x00, 0x00,
 }
 
 func (x WorkflowExecutionState) String() string {
@@ -156,3 +191,10 @@ func (x WorkflowBackoffType) String() string {
 	}
 	return strconv.Itoa(int(x))
 }
+func (x WorkflowIdConflictPolicy) String() string {
+	s, ok := WorkflowIdConflictPolicy_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
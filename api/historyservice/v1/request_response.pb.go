@@ -48,12 +48,12 @@ import (
 	v111 "go.temporal.io/api/history/v1"
 	v110 "go.temporal.io/api/protocol/v1"
 	v19 "go.temporal.io/api/query/v1"
-	v16 "go.temporal.io/api/taskqueue/v1"
+	v17 "go.temporal.io/api/taskqueue/v1"
 	v112 "go.temporal.io/api/workflow/v1"
 	v1 "go.temporal.io/api/workflowservice/v1"
 	v116 "go.temporal.io/server/api/adminservice/v1"
-	v15 "go.temporal.io/server/api/clock/v1"
-	v17 "go.temporal.io/server/api/enums/v1"
+	v16 "go.temporal.io/server/api/clock/v1"
+	v15 "go.temporal.io/server/api/enums/v1"
 	v18 "go.temporal.io/server/api/history/v1"
 	v114 "go.temporal.io/server/api/namespace/v1"
 	v113 "go.temporal.io/server/api/persistence/v1"
@@ -83,6 +83,7 @@ type StartWorkflowExecutionRequest struct {
 	ContinuedFailure                *v13.Failure                      `protobuf:"bytes,7,opt,name=continued_failure,json=continuedFailure,proto3" json:"continued_failure,omitempty"`
 	LastCompletionResult            *v14.Payloads                     `protobuf:"bytes,8,opt,name=last_completion_result,json=lastCompletionResult,proto3" json:"last_completion_result,omitempty"`
 	FirstWorkflowTaskBackoff        *time.Duration                    `protobuf:"bytes,9,opt,name=first_workflow_task_backoff,json=firstWorkflowTaskBackoff,proto3,stdduration" json:"first_workflow_task_backoff,omitempty"`
+	WorkflowIdConflictPolicy        v15.WorkflowIdConflictPolicy      `protobuf:"varint,10,opt,name=workflow_id_conflict_policy,json=workflowIdConflictPolicy,proto3,enum=temporal.server.api.enums.v1.WorkflowIdConflictPolicy" json:"workflow_id_conflict_policy,omitempty"`
 }
 
 func (m *StartWorkflowExecutionRequest) Reset()      { *m = StartWorkflowExecutionRequest{} }
@@ -180,9 +181,18 @@ func (m *StartWorkflowExecutionRequest) GetFirstWorkflowTaskBackoff() *time.Dura
 	return nil
 }
 
+func (m *StartWorkflowExecutionRequest) GetWorkflowIdConflictPolicy() v15.WorkflowIdConflictPolicy {
+	if m != nil {
+		return m.WorkflowIdConflictPolicy
+	}
+	return v15.WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED
+}
+
 type StartWorkflowExecutionResponse struct {
 	RunId string           `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	Clock *v15.VectorClock `protobuf:"bytes,2,opt,name=clock,proto3" json:"clock,omitempty"`
+	Clock *v16.VectorClock `protobuf:"bytes,2,opt,name=clock,proto3" json:"clock,omitempty"`
+	// False if the request was attached to an already running workflow instead of starting a new one.
+	Started bool `protobuf:"varint,3,opt,name=started,proto3" json:"started,omitempty"`
 }
 
 func (m *StartWorkflowExecutionResponse) Reset()      { *m = StartWorkflowExecutionResponse{} }
@@ -224,13 +234,20 @@ func (m *StartWorkflowExecutionResponse) GetRunId() string {
 	return ""
 }
 
-func (m *StartWorkflowExecutionResponse) GetClock() *v15.VectorClock {
+func (m *StartWorkflowExecutionResponse) GetClock() *v16.VectorClock {
 	if m != nil {
 		return m.Clock
 	}
 	return nil
 }
 
+func (m *StartWorkflowExecutionResponse) GetStarted() bool {
+	if m != nil {
+		return m.Started
+	}
+	return false
+}
+
 type GetMutableStateRequest struct {
 	NamespaceId         string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	Execution           *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
@@ -304,13 +321,13 @@ type GetMutableStateResponse struct {
 	NextEventId            int64                  `protobuf:"varint,3,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
 	PreviousStartedEventId int64                  `protobuf:"varint,4,opt,name=previous_started_event_id,json=previousStartedEventId,proto3" json:"previous_started_event_id,omitempty"`
 	LastFirstEventId       int64                  `protobuf:"varint,5,opt,name=last_first_event_id,json=lastFirstEventId,proto3" json:"last_first_event_id,omitempty"`
-	TaskQueue              *v16.TaskQueue         `protobuf:"bytes,6,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
-	StickyTaskQueue        *v16.TaskQueue         `protobuf:"bytes,7,opt,name=sticky_task_queue,json=stickyTaskQueue,proto3" json:"sticky_task_queue,omitempty"`
+	TaskQueue              *v17.TaskQueue         `protobuf:"bytes,6,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	StickyTaskQueue        *v17.TaskQueue         `protobuf:"bytes,7,opt,name=sticky_task_queue,json=stickyTaskQueue,proto3" json:"sticky_task_queue,omitempty"`
 	// (-- api-linter: core::0140::prepositions=disabled
 	//     aip.dev/not-precedent: "to" is used to indicate interval. --)
 	StickyTaskQueueScheduleToStartTimeout *time.Duration              `protobuf:"bytes,11,opt,name=sticky_task_queue_schedule_to_start_timeout,json=stickyTaskQueueScheduleToStartTimeout,proto3,stdduration" json:"sticky_task_queue_schedule_to_start_timeout,omitempty"`
 	CurrentBranchToken                    []byte                      `protobuf:"bytes,13,opt,name=current_branch_token,json=currentBranchToken,proto3" json:"current_branch_token,omitempty"`
-	WorkflowState                         v17.WorkflowExecutionState  `protobuf:"varint,15,opt,name=workflow_state,json=workflowState,proto3,enum=temporal.server.api.enums.v1.WorkflowExecutionState" json:"workflow_state,omitempty"`
+	WorkflowState                         v15.WorkflowExecutionState  `protobuf:"varint,15,opt,name=workflow_state,json=workflowState,proto3,enum=temporal.server.api.enums.v1.WorkflowExecutionState" json:"workflow_state,omitempty"`
 	WorkflowStatus                        v12.WorkflowExecutionStatus `protobuf:"varint,16,opt,name=workflow_status,json=workflowStatus,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"workflow_status,omitempty"`
 	VersionHistories                      *v18.VersionHistories       `protobuf:"bytes,17,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
 	IsStickyTaskQueueEnabled              bool                        `protobuf:"varint,18,opt,name=is_sticky_task_queue_enabled,json=isStickyTaskQueueEnabled,proto3" json:"is_sticky_task_queue_enabled,omitempty"`
@@ -385,14 +402,14 @@ func (m *GetMutableStateResponse) GetLastFirstEventId() int64 {
 	return 0
 }
 
-func (m *GetMutableStateResponse) GetTaskQueue() *v16.TaskQueue {
+func (m *GetMutableStateResponse) GetTaskQueue() *v17.TaskQueue {
 	if m != nil {
 		return m.TaskQueue
 	}
 	return nil
 }
 
-func (m *GetMutableStateResponse) GetStickyTaskQueue() *v16.TaskQueue {
+func (m *GetMutableStateResponse) GetStickyTaskQueue() *v17.TaskQueue {
 	if m != nil {
 		return m.StickyTaskQueue
 	}
@@ -413,11 +430,11 @@ func (m *GetMutableStateResponse) GetCurrentBranchToken() []byte {
 	return nil
 }
 
-func (m *GetMutableStateResponse) GetWorkflowState() v17.WorkflowExecutionState {
+func (m *GetMutableStateResponse) GetWorkflowState() v15.WorkflowExecutionState {
 	if m != nil {
 		return m.WorkflowState
 	}
-	return v17.WORKFLOW_EXECUTION_STATE_UNSPECIFIED
+	return v15.WORKFLOW_EXECUTION_STATE_UNSPECIFIED
 }
 
 func (m *GetMutableStateResponse) GetWorkflowStatus() v12.WorkflowExecutionStatus {
@@ -528,14 +545,14 @@ type PollMutableStateResponse struct {
 	NextEventId            int64                  `protobuf:"varint,3,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
 	PreviousStartedEventId int64                  `protobuf:"varint,4,opt,name=previous_started_event_id,json=previousStartedEventId,proto3" json:"previous_started_event_id,omitempty"`
 	LastFirstEventId       int64                  `protobuf:"varint,5,opt,name=last_first_event_id,json=lastFirstEventId,proto3" json:"last_first_event_id,omitempty"`
-	TaskQueue              *v16.TaskQueue         `protobuf:"bytes,6,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
-	StickyTaskQueue        *v16.TaskQueue         `protobuf:"bytes,7,opt,name=sticky_task_queue,json=stickyTaskQueue,proto3" json:"sticky_task_queue,omitempty"`
+	TaskQueue              *v17.TaskQueue         `protobuf:"bytes,6,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	StickyTaskQueue        *v17.TaskQueue         `protobuf:"bytes,7,opt,name=sticky_task_queue,json=stickyTaskQueue,proto3" json:"sticky_task_queue,omitempty"`
 	// (-- api-linter: core::0140::prepositions=disabled
 	//     aip.dev/not-precedent: "to" is used to indicate interval. --)
 	StickyTaskQueueScheduleToStartTimeout *time.Duration              `protobuf:"bytes,11,opt,name=sticky_task_queue_schedule_to_start_timeout,json=stickyTaskQueueScheduleToStartTimeout,proto3,stdduration" json:"sticky_task_queue_schedule_to_start_timeout,omitempty"`
 	CurrentBranchToken                    []byte                      `protobuf:"bytes,12,opt,name=current_branch_token,json=currentBranchToken,proto3" json:"current_branch_token,omitempty"`
 	VersionHistories                      *v18.VersionHistories       `protobuf:"bytes,14,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
-	WorkflowState                         v17.WorkflowExecutionState  `protobuf:"varint,15,opt,name=workflow_state,json=workflowState,proto3,enum=temporal.server.api.enums.v1.WorkflowExecutionState" json:"workflow_state,omitempty"`
+	WorkflowState                         v15.WorkflowExecutionState  `protobuf:"varint,15,opt,name=workflow_state,json=workflowState,proto3,enum=temporal.server.api.enums.v1.WorkflowExecutionState" json:"workflow_state,omitempty"`
 	WorkflowStatus                        v12.WorkflowExecutionStatus `protobuf:"varint,16,opt,name=workflow_status,json=workflowStatus,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"workflow_status,omitempty"`
 	LastFirstEventTxnId                   int64                       `protobuf:"varint,17,opt,name=last_first_event_txn_id,json=lastFirstEventTxnId,proto3" json:"last_first_event_txn_id,omitempty"`
 	FirstExecutionRunId                   string                      `protobuf:"bytes,18,opt,name=first_execution_run_id,json=firstExecutionRunId,proto3" json:"first_execution_run_id,omitempty"`
@@ -608,14 +625,14 @@ func (m *PollMutableStateResponse) GetLastFirstEventId() int64 {
 	return 0
 }
 
-func (m *PollMutableStateResponse) GetTaskQueue() *v16.TaskQueue {
+func (m *PollMutableStateResponse) GetTaskQueue() *v17.TaskQueue {
 	if m != nil {
 		return m.TaskQueue
 	}
 	return nil
 }
 
-func (m *PollMutableStateResponse) GetStickyTaskQueue() *v16.TaskQueue {
+func (m *PollMutableStateResponse) GetStickyTaskQueue() *v17.TaskQueue {
 	if m != nil {
 		return m.StickyTaskQueue
 	}
@@ -643,11 +660,11 @@ func (m *PollMutableStateResponse) GetVersionHistories() *v18.VersionHistories {
 	return nil
 }
 
-func (m *PollMutableStateResponse) GetWorkflowState() v17.WorkflowExecutionState {
+func (m *PollMutableStateResponse) GetWorkflowState() v15.WorkflowExecutionState {
 	if m != nil {
 		return m.WorkflowState
 	}
-	return v17.WORKFLOW_EXECUTION_STATE_UNSPECIFIED
+	return v15.WORKFLOW_EXECUTION_STATE_UNSPECIFIED
 }
 
 func (m *PollMutableStateResponse) GetWorkflowStatus() v12.WorkflowExecutionStatus {
@@ -765,7 +782,7 @@ type RecordWorkflowTaskStartedRequest struct {
 	// Unique id of each poll request. Used to ensure at most once delivery of tasks.
 	RequestId   string                           `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	PollRequest *v1.PollWorkflowTaskQueueRequest `protobuf:"bytes,6,opt,name=poll_request,json=pollRequest,proto3" json:"poll_request,omitempty"`
-	Clock       *v15.VectorClock                 `protobuf:"bytes,7,opt,name=clock,proto3" json:"clock,omitempty"`
+	Clock       *v16.VectorClock                 `protobuf:"bytes,7,opt,name=clock,proto3" json:"clock,omitempty"`
 }
 
 func (m *RecordWorkflowTaskStartedRequest) Reset()      { *m = RecordWorkflowTaskStartedRequest{} }
@@ -842,7 +859,7 @@ func (m *RecordWorkflowTaskStartedRequest) GetPollRequest() *v1.PollWorkflowTask
 	return nil
 }
 
-func (m *RecordWorkflowTaskStartedRequest) GetClock() *v15.VectorClock {
+func (m *RecordWorkflowTaskStartedRequest) GetClock() *v16.VectorClock {
 	if m != nil {
 		return m.Clock
 	}
@@ -858,12 +875,12 @@ type RecordWorkflowTaskStartedResponse struct {
 	Attempt                    int32                          `protobuf:"varint,6,opt,name=attempt,proto3" json:"attempt,omitempty"`
 	StickyExecutionEnabled     bool                           `protobuf:"varint,7,opt,name=sticky_execution_enabled,json=stickyExecutionEnabled,proto3" json:"sticky_execution_enabled,omitempty"`
 	TransientWorkflowTask      *v18.TransientWorkflowTaskInfo `protobuf:"bytes,8,opt,name=transient_workflow_task,json=transientWorkflowTask,proto3" json:"transient_workflow_task,omitempty"`
-	WorkflowExecutionTaskQueue *v16.TaskQueue                 `protobuf:"bytes,9,opt,name=workflow_execution_task_queue,json=workflowExecutionTaskQueue,proto3" json:"workflow_execution_task_queue,omitempty"`
+	WorkflowExecutionTaskQueue *v17.TaskQueue                 `protobuf:"bytes,9,opt,name=workflow_execution_task_queue,json=workflowExecutionTaskQueue,proto3" json:"workflow_execution_task_queue,omitempty"`
 	BranchToken                []byte                         `protobuf:"bytes,11,opt,name=branch_token,json=branchToken,proto3" json:"branch_token,omitempty"`
 	ScheduledTime              *time.Time                     `protobuf:"bytes,12,opt,name=scheduled_time,json=scheduledTime,proto3,stdtime" json:"scheduled_time,omitempty"`
 	StartedTime                *time.Time                     `protobuf:"bytes,13,opt,name=started_time,json=startedTime,proto3,stdtime" json:"started_time,omitempty"`
 	Queries                    map[string]*v19.WorkflowQuery  `protobuf:"bytes,14,rep,name=queries,proto3" json:"queries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Clock                      *v15.VectorClock               `protobuf:"bytes,15,opt,name=clock,proto3" json:"clock,omitempty"`
+	Clock                      *v16.VectorClock               `protobuf:"bytes,15,opt,name=clock,proto3" json:"clock,omitempty"`
 	Messages                   []*v110.Message                `protobuf:"bytes,16,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
@@ -955,7 +972,7 @@ func (m *RecordWorkflowTaskStartedResponse) GetTransientWorkflowTask() *v18.Tran
 	return nil
 }
 
-func (m *RecordWorkflowTaskStartedResponse) GetWorkflowExecutionTaskQueue() *v16.TaskQueue {
+func (m *RecordWorkflowTaskStartedResponse) GetWorkflowExecutionTaskQueue() *v17.TaskQueue {
 	if m != nil {
 		return m.WorkflowExecutionTaskQueue
 	}
@@ -990,7 +1007,7 @@ func (m *RecordWorkflowTaskStartedResponse) GetQueries() map[string]*v19.Workflo
 	return nil
 }
 
-func (m *RecordWorkflowTaskStartedResponse) GetClock() *v15.VectorClock {
+func (m *RecordWorkflowTaskStartedResponse) GetClock() *v16.VectorClock {
 	if m != nil {
 		return m.Clock
 	}
@@ -1012,7 +1029,7 @@ type RecordActivityTaskStartedRequest struct {
 	// Unique id of each poll request. Used to ensure at most once delivery of tasks.
 	RequestId   string                           `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	PollRequest *v1.PollActivityTaskQueueRequest `protobuf:"bytes,6,opt,name=poll_request,json=pollRequest,proto3" json:"poll_request,omitempty"`
-	Clock       *v15.VectorClock                 `protobuf:"bytes,7,opt,name=clock,proto3" json:"clock,omitempty"`
+	Clock       *v16.VectorClock                 `protobuf:"bytes,7,opt,name=clock,proto3" json:"clock,omitempty"`
 }
 
 func (m *RecordActivityTaskStartedRequest) Reset()      { *m = RecordActivityTaskStartedRequest{} }
@@ -1089,7 +1106,7 @@ func (m *RecordActivityTaskStartedRequest) GetPollRequest() *v1.PollActivityTask
 	return nil
 }
 
-func (m *RecordActivityTaskStartedRequest) GetClock() *v15.VectorClock {
+func (m *RecordActivityTaskStartedRequest) GetClock() *v16.VectorClock {
 	if m != nil {
 		return m.Clock
 	}
@@ -1104,7 +1121,7 @@ type RecordActivityTaskStartedResponse struct {
 	HeartbeatDetails            *v14.Payloads      `protobuf:"bytes,5,opt,name=heartbeat_details,json=heartbeatDetails,proto3" json:"heartbeat_details,omitempty"`
 	WorkflowType                *v14.WorkflowType  `protobuf:"bytes,6,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
 	WorkflowNamespace           string             `protobuf:"bytes,7,opt,name=workflow_namespace,json=workflowNamespace,proto3" json:"workflow_namespace,omitempty"`
-	Clock                       *v15.VectorClock   `protobuf:"bytes,8,opt,name=clock,proto3" json:"clock,omitempty"`
+	Clock                       *v16.VectorClock   `protobuf:"bytes,8,opt,name=clock,proto3" json:"clock,omitempty"`
 }
 
 func (m *RecordActivityTaskStartedResponse) Reset()      { *m = RecordActivityTaskStartedResponse{} }
@@ -1188,7 +1205,7 @@ func (m *RecordActivityTaskStartedResponse) GetWorkflowNamespace() string {
 	return ""
 }
 
-func (m *RecordActivityTaskStartedResponse) GetClock() *v15.VectorClock {
+func (m *RecordActivityTaskStartedResponse) GetClock() *v16.VectorClock {
 	if m != nil {
 		return m.Clock
 	}
@@ -1842,6 +1859,8 @@ type SignalWithStartWorkflowExecutionRequest struct {
 	// (-- api-linter: core::0140::prepositions=disabled
 	//     aip.dev/not-precedent: "with" is needed here. --)
 	SignalWithStartRequest *v1.SignalWithStartWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=signal_with_start_request,json=signalWithStartRequest,proto3" json:"signal_with_start_request,omitempty"`
+	// Delay of the first workflow task if a new workflow is started, validated by the frontend.
+	WorkflowStartDelay *time.Duration `protobuf:"bytes,3,opt,name=workflow_start_delay,json=workflowStartDelay,proto3,stdduration" json:"workflow_start_delay,omitempty"`
 }
 
 func (m *SignalWithStartWorkflowExecutionRequest) Reset() {
@@ -1892,6 +1911,13 @@ func (m *SignalWithStartWorkflowExecutionRequest) GetSignalWithStartRequest() *v
 	return nil
 }
 
+func (m *SignalWithStartWorkflowExecutionRequest) GetWorkflowStartDelay() *time.Duration {
+	if m != nil {
+		return m.WorkflowStartDelay
+	}
+	return nil
+}
+
 type SignalWithStartWorkflowExecutionResponse struct {
 	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 }
@@ -2445,7 +2471,7 @@ type ScheduleWorkflowTaskRequest struct {
 	NamespaceId         string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowExecution   *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
 	IsFirstWorkflowTask bool                   `protobuf:"varint,3,opt,name=is_first_workflow_task,json=isFirstWorkflowTask,proto3" json:"is_first_workflow_task,omitempty"`
-	Clock               *v15.VectorClock       `protobuf:"bytes,4,opt,name=clock,proto3" json:"clock,omitempty"`
+	Clock               *v16.VectorClock       `protobuf:"bytes,4,opt,name=clock,proto3" json:"clock,omitempty"`
 }
 
 func (m *ScheduleWorkflowTaskRequest) Reset()      { *m = ScheduleWorkflowTaskRequest{} }
@@ -2501,7 +2527,7 @@ func (m *ScheduleWorkflowTaskRequest) GetIsFirstWorkflowTask() bool {
 	return false
 }
 
-func (m *ScheduleWorkflowTaskRequest) GetClock() *v15.VectorClock {
+func (m *ScheduleWorkflowTaskRequest) GetClock() *v16.VectorClock {
 	if m != nil {
 		return m.Clock
 	}
@@ -2546,7 +2572,7 @@ var xxx_messageInfo_ScheduleWorkflowTaskResponse proto.InternalMessageInfo
 type VerifyFirstWorkflowTaskScheduledRequest struct {
 	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowExecution *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	Clock             *v15.VectorClock       `protobuf:"bytes,3,opt,name=clock,proto3" json:"clock,omitempty"`
+	Clock             *v16.VectorClock       `protobuf:"bytes,3,opt,name=clock,proto3" json:"clock,omitempty"`
 }
 
 func (m *VerifyFirstWorkflowTaskScheduledRequest) Reset() {
@@ -2597,7 +2623,7 @@ func (m *VerifyFirstWorkflowTaskScheduledRequest) GetWorkflowExecution() *v14.Wo
 	return nil
 }
 
-func (m *VerifyFirstWorkflowTaskScheduledRequest) GetClock() *v15.VectorClock {
+func (m *VerifyFirstWorkflowTaskScheduledRequest) GetClock() *v16.VectorClock {
 	if m != nil {
 		return m.Clock
 	}
@@ -2653,7 +2679,7 @@ type RecordChildExecutionCompletedRequest struct {
 	ParentInitiatedId      int64                  `protobuf:"varint,3,opt,name=parent_initiated_id,json=parentInitiatedId,proto3" json:"parent_initiated_id,omitempty"`
 	CompletedExecution     *v14.WorkflowExecution `protobuf:"bytes,4,opt,name=completed_execution,json=completedExecution,proto3" json:"completed_execution,omitempty"`
 	CompletionEvent        *v111.HistoryEvent     `protobuf:"bytes,5,opt,name=completion_event,json=completionEvent,proto3" json:"completion_event,omitempty"`
-	Clock                  *v15.VectorClock       `protobuf:"bytes,6,opt,name=clock,proto3" json:"clock,omitempty"`
+	Clock                  *v16.VectorClock       `protobuf:"bytes,6,opt,name=clock,proto3" json:"clock,omitempty"`
 	ParentInitiatedVersion int64                  `protobuf:"varint,7,opt,name=parent_initiated_version,json=parentInitiatedVersion,proto3" json:"parent_initiated_version,omitempty"`
 }
 
@@ -2724,7 +2750,7 @@ func (m *RecordChildExecutionCompletedRequest) GetCompletionEvent() *v111.Histor
 	return nil
 }
 
-func (m *RecordChildExecutionCompletedRequest) GetClock() *v15.VectorClock {
+func (m *RecordChildExecutionCompletedRequest) GetClock() *v16.VectorClock {
 	if m != nil {
 		return m.Clock
 	}
@@ -2779,7 +2805,7 @@ type VerifyChildExecutionCompletionRecordedRequest struct {
 	ChildExecution         *v14.WorkflowExecution `protobuf:"bytes,3,opt,name=child_execution,json=childExecution,proto3" json:"child_execution,omitempty"`
 	ParentInitiatedId      int64                  `protobuf:"varint,4,opt,name=parent_initiated_id,json=parentInitiatedId,proto3" json:"parent_initiated_id,omitempty"`
 	ParentInitiatedVersion int64                  `protobuf:"varint,5,opt,name=parent_initiated_version,json=parentInitiatedVersion,proto3" json:"parent_initiated_version,omitempty"`
-	Clock                  *v15.VectorClock       `protobuf:"bytes,6,opt,name=clock,proto3" json:"clock,omitempty"`
+	Clock                  *v16.VectorClock       `protobuf:"bytes,6,opt,name=clock,proto3" json:"clock,omitempty"`
 }
 
 func (m *VerifyChildExecutionCompletionRecordedRequest) Reset() {
@@ -2851,7 +2877,7 @@ func (m *VerifyChildExecutionCompletionRecordedRequest) GetParentInitiatedVersio
 	return 0
 }
 
-func (m *VerifyChildExecutionCompletionRecordedRequest) GetClock() *v15.VectorClock {
+func (m *VerifyChildExecutionCompletionRecordedRequest) GetClock() *v16.VectorClock {
 	if m != nil {
 		return m.Clock
 	}
@@ -3906,7 +3932,7 @@ func (m *GetShardResponse) GetShardInfo() *v113.ShardInfo {
 
 type RemoveTaskRequest struct {
 	ShardId        int32            `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	Category       v17.TaskCategory `protobuf:"varint,2,opt,name=category,proto3,enum=temporal.server.api.enums.v1.TaskCategory" json:"category,omitempty"`
+	Category       v15.TaskCategory `protobuf:"varint,2,opt,name=category,proto3,enum=temporal.server.api.enums.v1.TaskCategory" json:"category,omitempty"`
 	TaskId         int64            `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	VisibilityTime *time.Time       `protobuf:"bytes,4,opt,name=visibility_time,json=visibilityTime,proto3,stdtime" json:"visibility_time,omitempty"`
 }
@@ -3950,11 +3976,11 @@ func (m *RemoveTaskRequest) GetShardId() int32 {
 	return 0
 }
 
-func (m *RemoveTaskRequest) GetCategory() v17.TaskCategory {
+func (m *RemoveTaskRequest) GetCategory() v15.TaskCategory {
 	if m != nil {
 		return m.Category
 	}
-	return v17.TASK_CATEGORY_UNSPECIFIED
+	return v15.TASK_CATEGORY_UNSPECIFIED
 }
 
 func (m *RemoveTaskRequest) GetTaskId() int64 {
@@ -4367,7 +4393,7 @@ func (m *ReapplyEventsResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_ReapplyEventsResponse proto.InternalMessageInfo
 
 type GetDLQMessagesRequest struct {
-	Type                  v17.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	Type                  v15.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
 	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
 	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
@@ -4407,11 +4433,11 @@ func (m *GetDLQMessagesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetDLQMessagesRequest proto.InternalMessageInfo
 
-func (m *GetDLQMessagesRequest) GetType() v17.DeadLetterQueueType {
+func (m *GetDLQMessagesRequest) GetType() v15.DeadLetterQueueType {
 	if m != nil {
 		return m.Type
 	}
-	return v17.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
+	return v15.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
 }
 
 func (m *GetDLQMessagesRequest) GetShardId() int32 {
@@ -4450,7 +4476,7 @@ func (m *GetDLQMessagesRequest) GetNextPageToken() []byte {
 }
 
 type GetDLQMessagesResponse struct {
-	Type             v17.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	Type             v15.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
 	ReplicationTasks []*v115.ReplicationTask `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
 	NextPageToken    []byte                  `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
@@ -4487,11 +4513,11 @@ func (m *GetDLQMessagesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetDLQMessagesResponse proto.InternalMessageInfo
 
-func (m *GetDLQMessagesResponse) GetType() v17.DeadLetterQueueType {
+func (m *GetDLQMessagesResponse) GetType() v15.DeadLetterQueueType {
 	if m != nil {
 		return m.Type
 	}
-	return v17.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
+	return v15.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
 }
 
 func (m *GetDLQMessagesResponse) GetReplicationTasks() []*v115.ReplicationTask {
@@ -4509,7 +4535,7 @@ func (m *GetDLQMessagesResponse) GetNextPageToken() []byte {
 }
 
 type PurgeDLQMessagesRequest struct {
-	Type                  v17.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	Type                  v15.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
 	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
 	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
@@ -4547,11 +4573,11 @@ func (m *PurgeDLQMessagesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PurgeDLQMessagesRequest proto.InternalMessageInfo
 
-func (m *PurgeDLQMessagesRequest) GetType() v17.DeadLetterQueueType {
+func (m *PurgeDLQMessagesRequest) GetType() v15.DeadLetterQueueType {
 	if m != nil {
 		return m.Type
 	}
-	return v17.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
+	return v15.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
 }
 
 func (m *PurgeDLQMessagesRequest) GetShardId() int32 {
@@ -4611,7 +4637,7 @@ func (m *PurgeDLQMessagesResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_PurgeDLQMessagesResponse proto.InternalMessageInfo
 
 type MergeDLQMessagesRequest struct {
-	Type                  v17.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	Type                  v15.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
 	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
 	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
@@ -4651,11 +4677,11 @@ func (m *MergeDLQMessagesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MergeDLQMessagesRequest proto.InternalMessageInfo
 
-func (m *MergeDLQMessagesRequest) GetType() v17.DeadLetterQueueType {
+func (m *MergeDLQMessagesRequest) GetType() v15.DeadLetterQueueType {
 	if m != nil {
 		return m.Type
 	}
-	return v17.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
+	return v15.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
 }
 
 func (m *MergeDLQMessagesRequest) GetShardId() int32 {
@@ -4822,6 +4848,92 @@ func (m *RefreshWorkflowTasksResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RefreshWorkflowTasksResponse proto.InternalMessageInfo
 
+type RearchiveWorkflowExecutionRequest struct {
+	NamespaceId string                                  `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Request     *v116.RearchiveWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *RearchiveWorkflowExecutionRequest) Reset()      { *m = RearchiveWorkflowExecutionRequest{} }
+func (*RearchiveWorkflowExecutionRequest) ProtoMessage() {}
+func (*RearchiveWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{82}
+}
+func (m *RearchiveWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RearchiveWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RearchiveWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RearchiveWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RearchiveWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *RearchiveWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RearchiveWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RearchiveWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RearchiveWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *RearchiveWorkflowExecutionRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *RearchiveWorkflowExecutionRequest) GetRequest() *v116.RearchiveWorkflowExecutionRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+type RearchiveWorkflowExecutionResponse struct {
+}
+
+func (m *RearchiveWorkflowExecutionResponse) Reset()      { *m = RearchiveWorkflowExecutionResponse{} }
+func (*RearchiveWorkflowExecutionResponse) ProtoMessage() {}
+func (*RearchiveWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{83}
+}
+func (m *RearchiveWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RearchiveWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RearchiveWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RearchiveWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RearchiveWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *RearchiveWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RearchiveWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RearchiveWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RearchiveWorkflowExecutionResponse proto.InternalMessageInfo
+
 type GenerateLastHistoryReplicationTasksRequest struct {
 	NamespaceId string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	Execution   *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
@@ -4832,7 +4944,7 @@ func (m *GenerateLastHistoryReplicationTasksRequest) Reset() {
 }
 func (*GenerateLastHistoryReplicationTasksRequest) ProtoMessage() {}
 func (*GenerateLastHistoryReplicationTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{82}
+	return fileDescriptor_b8c78c1d460a3711, []int{84}
 }
 func (m *GenerateLastHistoryReplicationTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4883,7 +4995,7 @@ func (m *GenerateLastHistoryReplicationTasksResponse) Reset() {
 }
 func (*GenerateLastHistoryReplicationTasksResponse) ProtoMessage() {}
 func (*GenerateLastHistoryReplicationTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{83}
+	return fileDescriptor_b8c78c1d460a3711, []int{85}
 }
 func (m *GenerateLastHistoryReplicationTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4920,7 +5032,7 @@ type GetReplicationStatusRequest struct {
 func (m *GetReplicationStatusRequest) Reset()      { *m = GetReplicationStatusRequest{} }
 func (*GetReplicationStatusRequest) ProtoMessage() {}
 func (*GetReplicationStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{84}
+	return fileDescriptor_b8c78c1d460a3711, []int{86}
 }
 func (m *GetReplicationStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4963,7 +5075,7 @@ type GetReplicationStatusResponse struct {
 func (m *GetReplicationStatusResponse) Reset()      { *m = GetReplicationStatusResponse{} }
 func (*GetReplicationStatusResponse) ProtoMessage() {}
 func (*GetReplicationStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{85}
+	return fileDescriptor_b8c78c1d460a3711, []int{87}
 }
 func (m *GetReplicationStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5013,7 +5125,7 @@ type ShardReplicationStatus struct {
 func (m *ShardReplicationStatus) Reset()      { *m = ShardReplicationStatus{} }
 func (*ShardReplicationStatus) ProtoMessage() {}
 func (*ShardReplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{86}
+	return fileDescriptor_b8c78c1d460a3711, []int{88}
 }
 func (m *ShardReplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5093,7 +5205,7 @@ type HandoverNamespaceInfo struct {
 func (m *HandoverNamespaceInfo) Reset()      { *m = HandoverNamespaceInfo{} }
 func (*HandoverNamespaceInfo) ProtoMessage() {}
 func (*HandoverNamespaceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{87}
+	return fileDescriptor_b8c78c1d460a3711, []int{89}
 }
 func (m *HandoverNamespaceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5139,7 +5251,7 @@ type ShardReplicationStatusPerCluster struct {
 func (m *ShardReplicationStatusPerCluster) Reset()      { *m = ShardReplicationStatusPerCluster{} }
 func (*ShardReplicationStatusPerCluster) ProtoMessage() {}
 func (*ShardReplicationStatusPerCluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{88}
+	return fileDescriptor_b8c78c1d460a3711, []int{90}
 }
 func (m *ShardReplicationStatusPerCluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5190,7 +5302,7 @@ type RebuildMutableStateRequest struct {
 func (m *RebuildMutableStateRequest) Reset()      { *m = RebuildMutableStateRequest{} }
 func (*RebuildMutableStateRequest) ProtoMessage() {}
 func (*RebuildMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{89}
+	return fileDescriptor_b8c78c1d460a3711, []int{91}
 }
 func (m *RebuildMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5239,7 +5351,7 @@ type RebuildMutableStateResponse struct {
 func (m *RebuildMutableStateResponse) Reset()      { *m = RebuildMutableStateResponse{} }
 func (*RebuildMutableStateResponse) ProtoMessage() {}
 func (*RebuildMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{90}
+	return fileDescriptor_b8c78c1d460a3711, []int{92}
 }
 func (m *RebuildMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5278,7 +5390,7 @@ type DeleteWorkflowVisibilityRecordRequest struct {
 func (m *DeleteWorkflowVisibilityRecordRequest) Reset()      { *m = DeleteWorkflowVisibilityRecordRequest{} }
 func (*DeleteWorkflowVisibilityRecordRequest) ProtoMessage() {}
 func (*DeleteWorkflowVisibilityRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{91}
+	return fileDescriptor_b8c78c1d460a3711, []int{93}
 }
 func (m *DeleteWorkflowVisibilityRecordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5343,7 +5455,7 @@ func (m *DeleteWorkflowVisibilityRecordResponse) Reset() {
 }
 func (*DeleteWorkflowVisibilityRecordResponse) ProtoMessage() {}
 func (*DeleteWorkflowVisibilityRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{92}
+	return fileDescriptor_b8c78c1d460a3711, []int{94}
 }
 func (m *DeleteWorkflowVisibilityRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5383,7 +5495,7 @@ type UpdateWorkflowExecutionRequest struct {
 func (m *UpdateWorkflowExecutionRequest) Reset()      { *m = UpdateWorkflowExecutionRequest{} }
 func (*UpdateWorkflowExecutionRequest) ProtoMessage() {}
 func (*UpdateWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{93}
+	return fileDescriptor_b8c78c1d460a3711, []int{95}
 }
 func (m *UpdateWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5433,7 +5545,7 @@ type UpdateWorkflowExecutionResponse struct {
 func (m *UpdateWorkflowExecutionResponse) Reset()      { *m = UpdateWorkflowExecutionResponse{} }
 func (*UpdateWorkflowExecutionResponse) ProtoMessage() {}
 func (*UpdateWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{94}
+	return fileDescriptor_b8c78c1d460a3711, []int{96}
 }
 func (m *UpdateWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5554,6 +5666,8 @@ func init() {
 	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.MergeDLQMessagesResponse")
 	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksRequest")
 	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksResponse")
+	proto.RegisterType((*RearchiveWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.RearchiveWorkflowExecutionRequest")
+	proto.RegisterType((*RearchiveWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.RearchiveWorkflowExecutionResponse")
 	proto.RegisterType((*GenerateLastHistoryReplicationTasksRequest)(nil), "temporal.server.api.historyservice.v1.GenerateLastHistoryReplicationTasksRequest")
 	proto.RegisterType((*GenerateLastHistoryReplicationTasksResponse)(nil), "temporal.server.api.historyservice.v1.GenerateLastHistoryReplicationTasksResponse")
 	proto.RegisterType((*GetReplicationStatusRequest)(nil), "temporal.server.api.historyservice.v1.GetReplicationStatusRequest")
@@ -5576,289 +5690,294 @@ func init() {
 }
 
 var fileDescriptor_b8c78c1d460a3711 = []byte{
-	// 4504 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x6c, 0x1c, 0xc9,
-	0x75, 0x6a, 0xce, 0x0c, 0x39, 0x7c, 0x24, 0xe7, 0xd3, 0xfc, 0x0d, 0x49, 0x69, 0x44, 0xf6, 0x8a,
-	0x12, 0x57, 0xbb, 0x1a, 0xea, 0x63, 0x7b, 0x65, 0xc5, 0xbb, 0x6b, 0x89, 0xfa, 0x51, 0x90, 0x64,
-	0x6e, 0x93, 0x2b, 0x6d, 0xd6, 0x5e, 0xf7, 0x36, 0xbb, 0x8b, 0x64, 0x87, 0x33, 0xdd, 0xa3, 0xae,
-	0x1e, 0x92, 0xa3, 0x1c, 0x9c, 0xc4, 0x48, 0x10, 0x3b, 0x40, 0xb2, 0x40, 0x2e, 0x46, 0xe0, 0xe4,
-	0x10, 0x20, 0x88, 0x2f, 0x41, 0x0e, 0x39, 0x04, 0x3e, 0xe4, 0x92, 0x00, 0x41, 0x90, 0xd3, 0x22,
-	0x97, 0x18, 0x09, 0x90, 0xcd, 0x6a, 0x0f, 0x71, 0x90, 0x1c, 0x7c, 0x0c, 0x82, 0x1c, 0x82, 0xfa,
-	0xf5, 0xf4, 0x6f, 0x7e, 0x1c, 0x29, 0x5a, 0x3b, 0x7b, 0xe3, 0x54, 0xbd, 0xf7, 0xaa, 0xde, 0xbf,
-	0xea, 0xd5, 0x6b, 0xc2, 0xd7, 0x3c, 0x54, 0xab, 0x3b, 0xae, 0x5e, 0x5d, 0xc5, 0xc8, 0x3d, 0x40,
-	0xee, 0xaa, 0x5e, 0xb7, 0x56, 0xf7, 0x2c, 0xec, 0x39, 0x6e, 0x93, 0x8c, 0x58, 0x06, 0x5a, 0x3d,
-	0xb8, 0xb4, 0xea, 0xa2, 0x27, 0x0d, 0x84, 0x3d, 0xcd, 0x45, 0xb8, 0xee, 0xd8, 0x18, 0x55, 0xea,
-	0xae, 0xe3, 0x39, 0xf2, 0xb2, 0xc0, 0xae, 0x30, 0xec, 0x8a, 0x5e, 0xb7, 0x2a, 0x61, 0xec, 0xca,
-	0xc1, 0xa5, 0xf9, 0xf2, 0xae, 0xe3, 0xec, 0x56, 0xd1, 0x2a, 0x45, 0xda, 0x6e, 0xec, 0xac, 0x9a,
-	0x0d, 0x57, 0xf7, 0x2c, 0xc7, 0x66, 0x64, 0xe6, 0x4f, 0x47, 0xe7, 0x3d, 0xab, 0x86, 0xb0, 0xa7,
-	0xd7, 0xea, 0x1c, 0x60, 0xc9, 0x44, 0x75, 0x64, 0x9b, 0xc8, 0x36, 0x2c, 0x84, 0x57, 0x77, 0x9d,
-	0x5d, 0x87, 0x8e, 0xd3, 0xbf, 0x38, 0xc8, 0x19, 0x9f, 0x11, 0xc2, 0x81, 0xe1, 0xd4, 0x6a, 0x8e,
-	0x4d, 0x76, 0x5e, 0x43, 0x18, 0xeb, 0xbb, 0x7c, 0xc3, 0xf3, 0xcb, 0x21, 0x28, 0xbe, 0xd3, 0x38,
-	0xd8, 0xb9, 0x10, 0x98, 0xa7, 0xe3, 0xfd, 0x27, 0x0d, 0xd4, 0x40, 0x71, 0xc0, 0xf0, 0xaa, 0xc8,
-	0x6e, 0xd4, 0x30, 0x01, 0x3a, 0x74, 0xdc, 0xfd, 0x9d, 0xaa, 0x73, 0xc8, 0xa1, 0xce, 0x86, 0xa0,
-	0xc4, 0x64, 0x9c, 0xda, 0x2b, 0x21, 0xb8, 0x27, 0x0d, 0x94, 0xb4, 0xb7, 0x30, 0x31, 0x3a, 0x66,
-	0x38, 0xd5, 0x6e, 0xac, 0xee, 0xe8, 0x56, 0xb5, 0xe1, 0x26, 0x70, 0x70, 0x3e, 0xc9, 0x00, 0x8c,
-	0xaa, 0x63, 0xec, 0xc7, 0x61, 0x5f, 0xef, 0x60, 0x2c, 0x71, 0xe8, 0x57, 0x93, 0xa0, 0x7d, 0x11,
-	0x31, 0x0d, 0x71, 0xd0, 0xd7, 0x3a, 0x82, 0x46, 0xa4, 0x79, 0xae, 0x23, 0x30, 0x51, 0x16, 0x07,
-	0xbc, 0x90, 0x04, 0xd8, 0x5e, 0xfa, 0x95, 0x24, 0x70, 0x5b, 0xaf, 0x21, 0x5c, 0xd7, 0x8d, 0x04,
-	0xc9, 0x5d, 0x4c, 0x82, 0x77, 0x51, 0xbd, 0x6a, 0x19, 0xd4, 0xb8, 0xe3, 0x18, 0x57, 0x92, 0x30,
-	0xea, 0xc8, 0xc5, 0x16, 0xf6, 0x90, 0xcd, 0xd6, 0x40, 0x47, 0xc8, 0x68, 0x10, 0x74, 0xcc, 0x91,
-	0xde, 0xee, 0x01, 0x49, 0x30, 0xa5, 0xd5, 0x1a, 0x9e, 0xbe, 0x5d, 0x45, 0x1a, 0xf6, 0x74, 0x4f,
-	0xac, 0xfa, 0x95, 0x44, 0xeb, 0xeb, 0xea, 0xdc, 0xf3, 0xd7, 0x92, 0x16, 0xd6, 0xcd, 0x9a, 0x65,
-	0x77, 0xc5, 0x55, 0x7e, 0x67, 0x18, 0x4e, 0x6d, 0x7a, 0xba, 0xeb, 0x3d, 0xe6, 0xcb, 0xdd, 0x12,
-	0x6c, 0xa9, 0x0c, 0x41, 0x5e, 0x82, 0x71, 0x5f, 0xb6, 0x9a, 0x65, 0x96, 0xa4, 0x45, 0x69, 0x65,
-	0x54, 0x1d, 0xf3, 0xc7, 0xd6, 0x4d, 0xd9, 0x80, 0x09, 0x4c, 0x68, 0x68, 0x7c, 0x91, 0xd2, 0xd0,
-	0xa2, 0xb4, 0x32, 0x76, 0xf9, 0x2d, 0x5f, 0x51, 0x34, 0xdc, 0x44, 0x18, 0xaa, 0x1c, 0x5c, 0xaa,
-	0x74, 0x5c, 0x59, 0x1d, 0xa7, 0x44, 0xc5, 0x3e, 0xf6, 0x60, 0xba, 0xae, 0xbb, 0xc8, 0xf6, 0x34,
-	0x5f, 0xf2, 0x9a, 0x65, 0xef, 0x38, 0xa5, 0x14, 0x5d, 0xec, 0x4b, 0x95, 0xa4, 0x10, 0xe7, 0x5b,
-	0xe4, 0xc1, 0xa5, 0xca, 0x06, 0xc5, 0xf6, 0x57, 0x59, 0xb7, 0x77, 0x1c, 0x75, 0xb2, 0x1e, 0x1f,
-	0x94, 0x4b, 0x30, 0xa2, 0x7b, 0x84, 0x9a, 0x57, 0x4a, 0x2f, 0x4a, 0x2b, 0x19, 0x55, 0xfc, 0x94,
-	0x6b, 0xa0, 0xf8, 0x1a, 0x6c, 0xed, 0x02, 0x1d, 0xd5, 0x2d, 0x16, 0x26, 0x35, 0x12, 0x0f, 0x4b,
-	0x19, 0xba, 0xa1, 0xf9, 0x0a, 0x0b, 0x96, 0x15, 0x11, 0x2c, 0x2b, 0x5b, 0x22, 0x58, 0xde, 0x48,
-	0x7f, 0xf4, 0xc9, 0x69, 0x49, 0x3d, 0x7d, 0x18, 0xe5, 0xfc, 0x96, 0x4f, 0x89, 0xc0, 0xca, 0x7b,
-	0x30, 0x67, 0x38, 0xb6, 0x67, 0xd9, 0x0d, 0xa4, 0xe9, 0x58, 0xb3, 0xd1, 0xa1, 0x66, 0xd9, 0x96,
-	0x67, 0xe9, 0x9e, 0xe3, 0x96, 0x86, 0x17, 0xa5, 0x95, 0xdc, 0xe5, 0x0b, 0x61, 0x19, 0x53, 0xef,
-	0x22, 0xcc, 0xae, 0x71, 0xbc, 0xeb, 0xf8, 0x21, 0x3a, 0x5c, 0x17, 0x48, 0xea, 0x8c, 0x91, 0x38,
-	0x2e, 0x3f, 0x80, 0xa2, 0x98, 0x31, 0x35, 0x1e, 0x82, 0x4a, 0x23, 0x94, 0x8f, 0xc5, 0xf0, 0x0a,
-	0x7c, 0x92, 0xac, 0x71, 0x9b, 0xfd, 0xa9, 0x16, 0x7c, 0x54, 0x3e, 0x22, 0x3f, 0x82, 0x99, 0xaa,
-	0x8e, 0x3d, 0xcd, 0x70, 0x6a, 0xf5, 0x2a, 0xa2, 0x92, 0x71, 0x11, 0x6e, 0x54, 0xbd, 0x52, 0x36,
-	0x89, 0x26, 0x0f, 0x31, 0x54, 0x47, 0xcd, 0xaa, 0xa3, 0x9b, 0x58, 0x9d, 0x22, 0xf8, 0x6b, 0x3e,
-	0xba, 0x4a, 0xb1, 0xe5, 0x6f, 0xc3, 0xc2, 0x8e, 0xe5, 0x62, 0x4f, 0xf3, 0xb5, 0x40, 0xa2, 0x88,
-	0xb6, 0xad, 0x1b, 0xfb, 0xce, 0xce, 0x4e, 0x69, 0x94, 0x12, 0x9f, 0x8b, 0x09, 0xfe, 0x26, 0xcf,
-	0x62, 0x37, 0xd2, 0x3f, 0x20, 0x72, 0x2f, 0x51, 0x1a, 0xc2, 0xec, 0xb6, 0x74, 0xbc, 0x7f, 0x83,
-	0x11, 0x50, 0x8e, 0xa0, 0xdc, 0xce, 0x24, 0x99, 0xd7, 0xc8, 0xd3, 0x30, 0xec, 0x36, 0xec, 0x96,
-	0x1f, 0x64, 0xdc, 0x86, 0xbd, 0x6e, 0xca, 0x6f, 0x43, 0x86, 0x86, 0x62, 0x6e, 0xf9, 0xaf, 0x26,
-	0x1a, 0x23, 0x85, 0x20, 0x5c, 0x3e, 0x42, 0x86, 0xe7, 0xb8, 0x6b, 0xe4, 0xa7, 0xca, 0xf0, 0x94,
-	0xff, 0x90, 0x60, 0xe6, 0x0e, 0xf2, 0x1e, 0xb0, 0xb0, 0xb0, 0x49, 0xa2, 0x42, 0x1f, 0x0e, 0x78,
-	0x07, 0x46, 0x7d, 0x73, 0x8c, 0x6f, 0x21, 0x2c, 0xe2, 0x38, 0x6f, 0x2d, 0x5c, 0xf9, 0x0a, 0xcc,
-	0xa0, 0xa3, 0x3a, 0x32, 0x3c, 0x64, 0x6a, 0x36, 0x3a, 0xf2, 0x34, 0x74, 0x40, 0x3c, 0xce, 0x32,
-	0xa9, 0x97, 0xa5, 0xd4, 0x49, 0x31, 0xfb, 0x10, 0x1d, 0x79, 0xb7, 0xc8, 0xdc, 0xba, 0x29, 0x5f,
-	0x84, 0x29, 0xa3, 0xe1, 0x52, 0xd7, 0xdc, 0x76, 0x75, 0xdb, 0xd8, 0xd3, 0x3c, 0x67, 0x1f, 0xd9,
-	0xd4, 0x79, 0xc6, 0x55, 0x99, 0xcf, 0xdd, 0xa0, 0x53, 0x5b, 0x64, 0x46, 0xf9, 0x24, 0x0b, 0xb3,
-	0x31, 0x6e, 0xb9, 0x84, 0x43, 0xbc, 0x48, 0x03, 0xf0, 0xb2, 0x0e, 0x13, 0x2d, 0x33, 0x69, 0xd6,
-	0x11, 0x17, 0xcc, 0x99, 0x6e, 0xc4, 0xb6, 0x9a, 0x75, 0xa4, 0x8e, 0x1f, 0x06, 0x7e, 0xc9, 0x0a,
-	0x4c, 0x24, 0x49, 0x63, 0xcc, 0x0e, 0x48, 0xe1, 0xab, 0x30, 0x57, 0x77, 0xd1, 0x81, 0xe5, 0x34,
-	0xb0, 0x46, 0x03, 0x17, 0x32, 0x5b, 0xf0, 0x69, 0x0a, 0x3f, 0x23, 0x00, 0x36, 0xd9, 0xbc, 0x40,
-	0xbd, 0x00, 0x93, 0xd4, 0x5d, 0x98, 0x6d, 0xfb, 0x48, 0x19, 0x8a, 0x54, 0x20, 0x53, 0xb7, 0xc9,
-	0x8c, 0x00, 0x5f, 0x03, 0xa0, 0x66, 0x4f, 0x8f, 0x3a, 0x34, 0x0e, 0xc4, 0xb8, 0xf2, 0x4f, 0x42,
-	0x84, 0x31, 0x62, 0xe1, 0xef, 0x90, 0x1f, 0xea, 0xa8, 0x27, 0xfe, 0x94, 0x37, 0xa0, 0x88, 0x3d,
-	0xcb, 0xd8, 0x6f, 0x6a, 0x01, 0x5a, 0x23, 0x7d, 0xd0, 0xca, 0x33, 0x74, 0x7f, 0x40, 0xfe, 0x55,
-	0x78, 0x2d, 0x46, 0x51, 0xc3, 0xc6, 0x1e, 0x32, 0x1b, 0x55, 0xa4, 0x79, 0x0e, 0x93, 0x0a, 0x0d,
-	0x91, 0x4e, 0xc3, 0x2b, 0x8d, 0xf5, 0xe6, 0xac, 0xcb, 0x91, 0x65, 0x36, 0x39, 0xc1, 0x2d, 0x87,
-	0x0a, 0x71, 0x8b, 0x51, 0x6b, 0x6b, 0x83, 0x13, 0xed, 0x6c, 0x50, 0xfe, 0x26, 0xe4, 0x7c, 0xf3,
-	0xa0, 0x59, 0xb8, 0x94, 0xa7, 0x11, 0x35, 0x39, 0x91, 0xf8, 0x81, 0x35, 0x66, 0x72, 0xcc, 0x7a,
-	0x7d, 0x53, 0xa3, 0x3f, 0xe5, 0xc7, 0x90, 0x0f, 0x11, 0x6f, 0xe0, 0x52, 0x81, 0x52, 0xaf, 0xb4,
-	0x89, 0xd7, 0x89, 0x64, 0x1b, 0x58, 0xcd, 0x05, 0xe9, 0x36, 0xb0, 0xfc, 0x01, 0x14, 0x0f, 0xc8,
-	0x91, 0xc2, 0xb1, 0x35, 0x76, 0x9e, 0xb3, 0x10, 0x2e, 0x15, 0xa9, 0x28, 0x2f, 0x56, 0x3a, 0x1c,
-	0xf2, 0x59, 0xd8, 0xa1, 0x88, 0x77, 0x05, 0x9e, 0x5a, 0x38, 0x88, 0x8c, 0xc8, 0x6f, 0xc1, 0x49,
-	0x8b, 0x98, 0x6f, 0x54, 0x8d, 0xc8, 0x26, 0x8e, 0x6a, 0x96, 0xe4, 0x45, 0x69, 0x25, 0xab, 0x96,
-	0x2c, 0xbc, 0x19, 0xd6, 0xca, 0x2d, 0x36, 0x2f, 0x7f, 0x09, 0x66, 0x63, 0x96, 0xec, 0x1d, 0xd1,
-	0x78, 0x39, 0xc9, 0x02, 0x48, 0xd8, 0x9a, 0xb7, 0x8e, 0x48, 0xf4, 0xbc, 0x02, 0x33, 0x1c, 0xc1,
-	0xcf, 0xa9, 0x3c, 0xc8, 0x4e, 0xd1, 0x58, 0x37, 0x49, 0x67, 0x5b, 0x4e, 0x4e, 0x42, 0xee, 0xbd,
-	0x74, 0x36, 0x5b, 0x18, 0xbd, 0x97, 0xce, 0x8e, 0x16, 0xe0, 0x5e, 0x3a, 0x0b, 0x85, 0xb1, 0x7b,
-	0xe9, 0xec, 0x78, 0x61, 0xe2, 0x5e, 0x3a, 0x9b, 0x2b, 0xe4, 0x95, 0xff, 0x94, 0x60, 0x76, 0xc3,
-	0xa9, 0x56, 0xff, 0x9f, 0x04, 0xd4, 0x3f, 0xc8, 0x42, 0x29, 0xce, 0xee, 0x17, 0x11, 0xf5, 0x8b,
-	0x88, 0xfa, 0xdc, 0x23, 0xea, 0x78, 0xdb, 0x88, 0x9a, 0x18, 0x9b, 0x72, 0xcf, 0x2d, 0x36, 0xfd,
-	0x7c, 0x06, 0xec, 0x0e, 0x11, 0xb1, 0x78, 0x9c, 0x88, 0x28, 0xf7, 0x17, 0x11, 0x27, 0x0a, 0x39,
-	0xe5, 0x7b, 0x12, 0x2c, 0xa8, 0x08, 0x23, 0x2f, 0x12, 0xb4, 0x5f, 0x42, 0x3c, 0x54, 0xca, 0x70,
-	0x32, 0x79, 0x2b, 0x2c, 0x56, 0x29, 0x3f, 0x4a, 0xc1, 0xa2, 0x8a, 0x0c, 0xc7, 0x35, 0x83, 0xe7,
-	0x73, 0xee, 0xdd, 0x7d, 0x6c, 0xf8, 0x3d, 0x90, 0xe3, 0x37, 0xb5, 0xfe, 0x77, 0x5e, 0x8c, 0x5d,
-	0xd1, 0xe4, 0xd7, 0x41, 0x16, 0x2e, 0x68, 0x46, 0xc3, 0x57, 0xc1, 0x9f, 0x11, 0x91, 0x65, 0x16,
-	0x46, 0xa8, 0xef, 0xfa, 0x11, 0x6b, 0x98, 0xfc, 0x5c, 0x37, 0xe5, 0x53, 0x00, 0xe2, 0x4a, 0xce,
-	0x03, 0xd3, 0xa8, 0x3a, 0xca, 0x47, 0xd6, 0x4d, 0xf9, 0x43, 0x18, 0xaf, 0x3b, 0xd5, 0xaa, 0x7f,
-	0xa3, 0x66, 0x31, 0xe9, 0xcd, 0xae, 0x37, 0x6a, 0x92, 0x04, 0x82, 0x92, 0x0b, 0x2a, 0x5a, 0x1d,
-	0x23, 0x24, 0x85, 0x10, 0xfd, 0x2b, 0xcb, 0xc8, 0x31, 0xaf, 0x2c, 0x9f, 0x64, 0x61, 0xa9, 0x83,
-	0xaa, 0x78, 0xf2, 0x89, 0xe5, 0x0c, 0xe9, 0xd8, 0x39, 0xa3, 0x63, 0x3e, 0x18, 0xea, 0x98, 0x0f,
-	0xfa, 0x53, 0xda, 0x0a, 0x14, 0xda, 0xe4, 0x9b, 0x1c, 0x0e, 0xd3, 0x8d, 0xa5, 0xb1, 0x4c, 0x3c,
-	0x8d, 0x05, 0xca, 0x09, 0xc3, 0xe1, 0x72, 0xc2, 0x55, 0x28, 0xf1, 0xf8, 0x1e, 0x28, 0x26, 0xf0,
-	0x93, 0xd6, 0x08, 0x3d, 0x69, 0xcd, 0xb0, 0xf9, 0x56, 0x81, 0x80, 0x9f, 0xb3, 0x9e, 0xc0, 0xac,
-	0xe7, 0xea, 0x36, 0xb6, 0xc8, 0xb2, 0xa1, 0xcb, 0x30, 0xbf, 0x61, 0x7f, 0xb5, 0x5b, 0xc0, 0xdd,
-	0x12, 0xe8, 0x41, 0xe5, 0xd1, 0x9a, 0xc8, 0xb4, 0x97, 0x34, 0x25, 0xef, 0xc2, 0xa9, 0x84, 0xda,
-	0x47, 0x20, 0xd5, 0x8d, 0xf6, 0x91, 0xea, 0xe6, 0x63, 0x7e, 0xd5, 0xca, 0x7a, 0x4b, 0x30, 0x1e,
-	0x4a, 0x38, 0x63, 0x34, 0xe1, 0x8c, 0x6d, 0x07, 0x32, 0xcd, 0x1d, 0xc8, 0xb5, 0xd4, 0x49, 0x6b,
-	0x2e, 0xe3, 0x3d, 0xd6, 0x5c, 0x26, 0x7c, 0x3c, 0x5a, 0x61, 0x59, 0x83, 0x71, 0xa1, 0x69, 0x4a,
-	0x66, 0xa2, 0x47, 0x32, 0x63, 0x1c, 0x8b, 0x12, 0x71, 0x60, 0xe4, 0x49, 0x03, 0xf1, 0x6c, 0x97,
-	0x5a, 0x19, 0xbb, 0xfc, 0x6e, 0xa5, 0xa7, 0x72, 0x7b, 0xa5, 0xab, 0xf7, 0x54, 0xde, 0x61, 0x74,
-	0x6f, 0xd9, 0x9e, 0xdb, 0x54, 0xc5, 0x2a, 0x2d, 0xd7, 0xcd, 0x1f, 0xcf, 0x75, 0xe5, 0x37, 0x21,
-	0xcb, 0x0b, 0x9e, 0x24, 0xcd, 0x91, 0x2d, 0x2f, 0x85, 0xd5, 0x26, 0xaa, 0xd5, 0x04, 0xff, 0x01,
-	0x83, 0x54, 0x7d, 0x94, 0xf9, 0x0f, 0x61, 0x3c, 0xb8, 0x31, 0xb9, 0x00, 0xa9, 0x7d, 0xd4, 0xe4,
-	0x61, 0x98, 0xfc, 0x29, 0x5f, 0x83, 0xcc, 0x81, 0x5e, 0x6d, 0xb4, 0x39, 0x21, 0xd2, 0x82, 0x79,
-	0xd0, 0xd9, 0x09, 0xb5, 0xa6, 0xca, 0x50, 0xae, 0x0d, 0x5d, 0x95, 0x58, 0xfa, 0x0a, 0x24, 0x83,
-	0xeb, 0x86, 0x67, 0x1d, 0x58, 0x5e, 0xf3, 0x8b, 0x64, 0xd0, 0x6f, 0x32, 0x08, 0x4a, 0xee, 0x05,
-	0x26, 0x83, 0xbf, 0x49, 0x8b, 0x64, 0x90, 0xa8, 0x2a, 0x9e, 0x0c, 0x1e, 0x42, 0x3e, 0x22, 0x2e,
-	0x9e, 0x0e, 0x96, 0xc3, 0xbc, 0x04, 0xe2, 0x14, 0x3b, 0xff, 0x35, 0xa9, 0x08, 0xd5, 0x5c, 0x58,
-	0xa4, 0x31, 0xf7, 0x1d, 0x3a, 0x8e, 0xfb, 0x06, 0xe2, 0x73, 0x2a, 0x1c, 0x9f, 0x11, 0x94, 0xc5,
-	0x11, 0x98, 0x0f, 0x69, 0x91, 0xb0, 0x93, 0xee, 0x71, 0xc1, 0x05, 0x4e, 0xe7, 0x3a, 0x23, 0xb3,
-	0x19, 0x0a, 0x42, 0x0f, 0xa0, 0xb8, 0x87, 0x74, 0xd7, 0xdb, 0x46, 0xba, 0xa7, 0x99, 0xc8, 0xd3,
-	0xad, 0x2a, 0xe6, 0x45, 0xe4, 0xee, 0x85, 0xd2, 0x82, 0x8f, 0x7a, 0x93, 0x61, 0xc6, 0x33, 0xee,
-	0xf0, 0xb1, 0x33, 0xee, 0x85, 0x80, 0xe3, 0xf8, 0x0e, 0x45, 0x6d, 0x64, 0xb4, 0xe5, 0x0d, 0x0f,
-	0xc5, 0x44, 0xcb, 0x8a, 0xb2, 0xc7, 0xb4, 0xa2, 0x1f, 0x4b, 0xf0, 0x0a, 0x33, 0x96, 0x50, 0x54,
-	0xe4, 0x75, 0xe0, 0xbe, 0x7c, 0xde, 0x81, 0x02, 0xaf, 0x3e, 0xa3, 0xc8, 0xb3, 0xc4, 0xcd, 0xae,
-	0x7e, 0xd3, 0xc3, 0x16, 0xd4, 0xbc, 0xa0, 0xce, 0x07, 0x94, 0xdf, 0x18, 0x82, 0x33, 0x9d, 0x11,
-	0xb9, 0x13, 0xe0, 0xd6, 0xe9, 0x42, 0x3c, 0xc6, 0x70, 0x2f, 0xb8, 0xfb, 0xbc, 0xf2, 0x06, 0xb9,
-	0x4a, 0x86, 0x3d, 0x0f, 0x41, 0x4e, 0xe7, 0x8e, 0x49, 0x73, 0x36, 0x2e, 0x0d, 0xd1, 0xb8, 0xff,
-	0xd6, 0x71, 0x83, 0x08, 0x5f, 0x68, 0x42, 0x0f, 0x4c, 0x61, 0xe5, 0xcf, 0x25, 0x12, 0xb1, 0x63,
-	0x42, 0xb8, 0xad, 0x5b, 0xd5, 0xbe, 0xb4, 0xb7, 0x07, 0xb9, 0x1d, 0x8a, 0x13, 0xd1, 0xdd, 0xf5,
-	0xe3, 0xe8, 0x2e, 0xb4, 0xba, 0x3a, 0xb1, 0x13, 0xfc, 0xa9, 0xbc, 0x42, 0xe2, 0x56, 0x5b, 0x14,
-	0x7e, 0x2b, 0xf9, 0xb1, 0x04, 0x4a, 0x3c, 0xba, 0xdd, 0x15, 0x9e, 0xd7, 0x07, 0x63, 0xf5, 0xa0,
-	0xaf, 0x87, 0x79, 0x5b, 0xeb, 0x81, 0xb7, 0x6e, 0x5b, 0x08, 0x84, 0x03, 0xc1, 0xe0, 0x06, 0x71,
-	0xa9, 0x0e, 0x78, 0xdc, 0x40, 0x5e, 0x85, 0x82, 0xa1, 0xdb, 0x06, 0xf2, 0xb3, 0x0c, 0x62, 0xfb,
-	0xcf, 0xaa, 0x79, 0x36, 0xae, 0x8a, 0xe1, 0xa0, 0x97, 0x06, 0x69, 0xbe, 0x24, 0x2f, 0xed, 0xb4,
-	0x85, 0xb8, 0x97, 0x9e, 0xf5, 0x9d, 0xb4, 0x0d, 0x1e, 0xd7, 0x78, 0xc0, 0x90, 0x83, 0x80, 0xff,
-	0xf7, 0x86, 0xdc, 0x76, 0xf5, 0xf6, 0x86, 0x9c, 0x84, 0xc2, 0xd9, 0xfa, 0x0b, 0x6a, 0xc8, 0x71,
-	0xfe, 0xa9, 0x86, 0xfb, 0x62, 0xec, 0x57, 0x20, 0x17, 0xb6, 0x97, 0x3e, 0xac, 0xb8, 0xdb, 0xfa,
-	0xea, 0x44, 0xc8, 0xe4, 0x94, 0xe5, 0x64, 0x7b, 0xf3, 0x91, 0x38, 0x73, 0x7f, 0x3b, 0x04, 0xe5,
-	0x4d, 0x6b, 0xd7, 0xd6, 0xab, 0x83, 0x3c, 0x66, 0xef, 0x40, 0x0e, 0x53, 0x22, 0x11, 0xc6, 0xde,
-	0xee, 0xfe, 0x9a, 0xdd, 0x71, 0x6d, 0x75, 0x82, 0x91, 0x15, 0x5b, 0xb1, 0x60, 0x01, 0x1d, 0x79,
-	0xc8, 0x25, 0x2b, 0x25, 0x9c, 0x4e, 0x53, 0xfd, 0x9e, 0x4e, 0xe7, 0x04, 0xb5, 0xd8, 0x94, 0x5c,
-	0x81, 0x49, 0x63, 0xcf, 0xaa, 0x9a, 0xad, 0x75, 0x1c, 0xbb, 0xda, 0xa4, 0x87, 0x97, 0xac, 0x5a,
-	0xa4, 0x53, 0x02, 0xe9, 0x1b, 0x76, 0xb5, 0xa9, 0x2c, 0xc1, 0xe9, 0xb6, 0xbc, 0x70, 0x59, 0xff,
-	0x83, 0x04, 0xe7, 0x38, 0x8c, 0xe5, 0xed, 0x0d, 0xdc, 0x41, 0xf0, 0x5d, 0x09, 0xe6, 0xb8, 0xd4,
-	0x0f, 0x2d, 0x6f, 0x4f, 0x4b, 0x6a, 0x27, 0xb8, 0xdb, 0xab, 0x02, 0xba, 0x6d, 0x48, 0x9d, 0xc1,
-	0x61, 0x40, 0x61, 0x67, 0xd7, 0x61, 0xa5, 0x3b, 0x89, 0x8e, 0x0f, 0xc1, 0xca, 0x5f, 0x49, 0x70,
-	0x5a, 0x45, 0x35, 0xe7, 0x00, 0x31, 0x4a, 0xc7, 0x7c, 0x7f, 0x78, 0x71, 0x37, 0x96, 0xf0, 0x55,
-	0x23, 0x15, 0xb9, 0x6a, 0x28, 0x0a, 0x09, 0x7b, 0xed, 0xb6, 0x2f, 0x74, 0x3f, 0x04, 0x4b, 0x5b,
-	0xc8, 0xad, 0x59, 0xb6, 0xee, 0xa1, 0x41, 0xb4, 0xee, 0x40, 0xd1, 0x13, 0x74, 0x22, 0xca, 0xbe,
-	0xd1, 0x55, 0xd9, 0x5d, 0x77, 0xa0, 0x16, 0x7c, 0xe2, 0x3f, 0x07, 0x3e, 0x77, 0x06, 0x94, 0x4e,
-	0x1c, 0x71, 0xd1, 0xff, 0xb7, 0x04, 0xe5, 0x9b, 0x88, 0xa4, 0xaa, 0x41, 0xe4, 0xfe, 0xe2, 0xac,
-	0xeb, 0x55, 0x28, 0xf8, 0x94, 0x79, 0x01, 0x9f, 0xdf, 0x86, 0xfd, 0xf2, 0x3a, 0xaf, 0xf4, 0xd3,
-	0xf7, 0x85, 0xaa, 0x83, 0x51, 0xb2, 0x84, 0x64, 0x36, 0x17, 0x0d, 0x4b, 0x6d, 0x79, 0xe7, 0xf2,
-	0xf9, 0x53, 0x09, 0x4e, 0xd1, 0xfa, 0xf2, 0x80, 0xed, 0x4c, 0x2e, 0xa1, 0xd1, 0x77, 0x3b, 0x53,
-	0xc7, 0x95, 0xd5, 0x71, 0x4a, 0x54, 0xc4, 0x9a, 0x37, 0xa0, 0xdc, 0x0e, 0xbc, 0x73, 0x84, 0xf9,
-	0xfd, 0x14, 0x2c, 0x73, 0x22, 0x2c, 0x03, 0x0e, 0xc2, 0x6a, 0xad, 0x4d, 0x16, 0xbf, 0xdd, 0x03,
-	0xaf, 0x3d, 0x6c, 0x21, 0x92, 0xc8, 0xe5, 0x37, 0x03, 0xfe, 0xc7, 0x3b, 0x99, 0xe2, 0x75, 0x93,
-	0x92, 0x00, 0x59, 0x17, 0x10, 0xa2, 0x7e, 0xd2, 0xc5, 0x7d, 0xd3, 0x2f, 0xde, 0x7d, 0x33, 0xed,
-	0xdc, 0x77, 0x05, 0xce, 0x76, 0x93, 0x08, 0x37, 0xd1, 0x8f, 0x86, 0x60, 0x41, 0xdc, 0xff, 0x83,
-	0x57, 0x8e, 0xcf, 0x85, 0xff, 0x5e, 0x81, 0x19, 0x0b, 0x6b, 0x09, 0x3d, 0x56, 0x54, 0x37, 0x59,
-	0x75, 0xd2, 0xc2, 0xb7, 0xa3, 0xcd, 0x53, 0xad, 0x6b, 0x7f, 0xfa, 0x98, 0xd7, 0xfe, 0x32, 0x9c,
-	0x4c, 0x96, 0x08, 0x17, 0xd9, 0xbf, 0x49, 0x70, 0xee, 0x11, 0x72, 0xad, 0x9d, 0x66, 0x6c, 0x71,
-	0xbf, 0x92, 0xf2, 0xb9, 0x10, 0x9f, 0x2f, 0x89, 0xd4, 0x31, 0x25, 0x71, 0x1e, 0x56, 0xba, 0x33,
-	0xca, 0xa5, 0xf2, 0x3f, 0x29, 0x72, 0x97, 0x21, 0x37, 0xbb, 0x35, 0x62, 0x8e, 0xfe, 0x2e, 0x8e,
-	0x73, 0x0f, 0x7b, 0x71, 0x22, 0xa9, 0x00, 0xef, 0xb1, 0x0c, 0x38, 0xbc, 0xef, 0xea, 0x45, 0x36,
-	0xe5, 0x3b, 0xfa, 0xba, 0x29, 0xbf, 0x0f, 0x93, 0xe2, 0xce, 0x66, 0x0e, 0xe2, 0xdb, 0xb2, 0x4f,
-	0xa5, 0xb5, 0x97, 0x0d, 0xff, 0xb6, 0x49, 0x5f, 0x5a, 0x68, 0xfd, 0x31, 0xd3, 0x4f, 0xfd, 0x31,
-	0xdf, 0x42, 0x67, 0x05, 0x48, 0x5f, 0xe1, 0xc3, 0xc7, 0xac, 0xc4, 0x5f, 0x85, 0x52, 0x4c, 0x3c,
-	0x22, 0x71, 0x8e, 0xf0, 0x27, 0xad, 0xb0, 0x8c, 0x78, 0xfe, 0x54, 0xce, 0x91, 0x34, 0xd0, 0x51,
-	0xfb, 0x22, 0x27, 0xa6, 0xe0, 0x02, 0x33, 0xaa, 0x44, 0x48, 0x1a, 0x9b, 0x08, 0x9d, 0xbe, 0x0c,
-	0x66, 0x0b, 0x0a, 0xd1, 0x6e, 0xdc, 0xfe, 0xcd, 0x25, 0x1f, 0xe9, 0xbe, 0x95, 0x55, 0xc8, 0xb3,
-	0xa8, 0x3b, 0xc0, 0x99, 0x2c, 0x67, 0x84, 0xb8, 0x6c, 0x67, 0x80, 0xe9, 0x76, 0x06, 0xd8, 0x49,
-	0x23, 0x99, 0x4e, 0x1a, 0x19, 0xd8, 0x18, 0x94, 0x8b, 0x50, 0xe9, 0x55, 0x51, 0x5c, 0xb7, 0x7f,
-	0x2c, 0xc1, 0xe2, 0x4d, 0x84, 0x0d, 0xd7, 0xda, 0x1e, 0xe8, 0x44, 0xf8, 0x4d, 0x18, 0xe9, 0xb7,
-	0x3e, 0xd1, 0x6d, 0x59, 0x55, 0x50, 0x54, 0x7e, 0x2f, 0x0d, 0x4b, 0x1d, 0xa0, 0xf9, 0x71, 0xe7,
-	0x5b, 0x50, 0x68, 0x3d, 0x2b, 0x1a, 0x8e, 0xbd, 0x63, 0xed, 0xf2, 0xb2, 0xe8, 0xa5, 0xe4, 0xbd,
-	0x24, 0xaa, 0x7f, 0x8d, 0x22, 0xaa, 0x79, 0x14, 0x1e, 0x90, 0x77, 0x61, 0x36, 0xe1, 0xf5, 0x92,
-	0xf6, 0x8f, 0x33, 0x86, 0x57, 0xfb, 0x58, 0x84, 0x3d, 0x93, 0x1e, 0x26, 0x0d, 0xcb, 0xdf, 0x02,
-	0xb9, 0x8e, 0x6c, 0xd3, 0xb2, 0x77, 0x35, 0x5e, 0x1a, 0xb5, 0x10, 0x2e, 0xa5, 0x68, 0xb1, 0xf5,
-	0x42, 0xfb, 0x35, 0x36, 0x18, 0x8e, 0xa8, 0x6f, 0xd0, 0x15, 0x8a, 0xf5, 0xd0, 0xa0, 0x85, 0xb0,
-	0xfc, 0x6d, 0x28, 0x08, 0xea, 0xd4, 0xcc, 0x5d, 0xda, 0x15, 0x46, 0x68, 0x5f, 0xe9, 0x4a, 0x3b,
-	0x6c, 0x54, 0x74, 0x85, 0x7c, 0x3d, 0x30, 0xe5, 0x22, 0x5b, 0x46, 0x30, 0x2d, 0xe8, 0x87, 0xd3,
-	0x7f, 0xa6, 0x9b, 0x26, 0xf8, 0x22, 0xb1, 0xd7, 0xe4, 0xc9, 0x7a, 0x7c, 0x42, 0xf9, 0xf5, 0x14,
-	0x94, 0x54, 0xfe, 0x01, 0x06, 0xa2, 0x91, 0x14, 0x3f, 0xba, 0xfc, 0xb9, 0x48, 0x57, 0x3b, 0x30,
-	0x1d, 0xee, 0x61, 0x6a, 0x6a, 0x96, 0x87, 0x6a, 0x42, 0x83, 0x97, 0xfb, 0xea, 0x63, 0x6a, 0xae,
-	0x7b, 0xa8, 0xa6, 0x4e, 0x1e, 0xc4, 0xc6, 0xb0, 0x7c, 0x15, 0x86, 0x69, 0xfe, 0xc1, 0x3c, 0xb3,
-	0xb5, 0x7d, 0xe8, 0xb9, 0xa9, 0x7b, 0xfa, 0x8d, 0xaa, 0xb3, 0xad, 0x72, 0x78, 0xf9, 0x36, 0xe4,
-	0x6c, 0x74, 0x48, 0xdb, 0x81, 0x38, 0x85, 0x4c, 0x8f, 0x14, 0xc6, 0x6d, 0x74, 0xa8, 0x36, 0x58,
-	0xe6, 0xc2, 0xca, 0x02, 0xcc, 0x25, 0xa8, 0x80, 0xc7, 0x95, 0xbf, 0xa7, 0xf7, 0x28, 0x3e, 0xfb,
-	0x38, 0xd8, 0x29, 0x25, 0xb4, 0xa4, 0xc5, 0xba, 0xb1, 0x98, 0xb3, 0x5e, 0x4d, 0x94, 0x50, 0xe0,
-	0x33, 0x98, 0xa0, 0x2a, 0x42, 0xe5, 0x85, 0x48, 0x47, 0xd6, 0x32, 0xe4, 0x5c, 0x54, 0x73, 0x3c,
-	0xa4, 0x19, 0xd5, 0x06, 0xf6, 0x90, 0x4b, 0xf5, 0x3b, 0xaa, 0x4e, 0xb0, 0xd1, 0x35, 0x36, 0x18,
-	0xb3, 0x96, 0x54, 0xcc, 0x5a, 0x94, 0x45, 0x72, 0xd5, 0x4a, 0xe6, 0x85, 0xb3, 0xfb, 0x87, 0x12,
-	0xcc, 0x6c, 0x36, 0x6d, 0x63, 0x73, 0x4f, 0x77, 0x4d, 0xde, 0xc8, 0xc5, 0xf9, 0x5c, 0x86, 0x1c,
-	0x76, 0x1a, 0xae, 0xd1, 0xda, 0x06, 0xb3, 0xc7, 0x09, 0x36, 0x2a, 0xb6, 0x31, 0x07, 0x59, 0x4c,
-	0x90, 0x45, 0x2b, 0x4a, 0x46, 0x1d, 0xa1, 0xbf, 0xd7, 0x4d, 0xf9, 0x3a, 0x8c, 0xb1, 0x8e, 0x32,
-	0xf6, 0x64, 0x98, 0xea, 0xf1, 0xc9, 0x10, 0x18, 0x12, 0x19, 0x56, 0xe6, 0x60, 0x36, 0xb6, 0x3d,
-	0xbe, 0xf5, 0x9f, 0x66, 0x60, 0x92, 0xcc, 0x89, 0xc8, 0xd1, 0x87, 0x17, 0x9d, 0x86, 0x31, 0x5f,
-	0x85, 0x7c, 0xdb, 0xa3, 0x2a, 0x88, 0xa1, 0x75, 0x33, 0x70, 0x03, 0x4d, 0x05, 0x3f, 0x76, 0x28,
-	0xc1, 0x88, 0x48, 0x88, 0x2c, 0x8b, 0x8a, 0x9f, 0x6d, 0x9e, 0xc3, 0x33, 0x6d, 0x9e, 0xc3, 0xe3,
-	0x5d, 0x1c, 0xc3, 0xc7, 0xeb, 0xe2, 0x48, 0xea, 0xd7, 0x19, 0x49, 0xec, 0xd7, 0x89, 0x3e, 0x18,
-	0x67, 0x8f, 0xf3, 0x60, 0xbc, 0xc1, 0x9b, 0x4b, 0x5b, 0x0f, 0x39, 0x94, 0xd6, 0x68, 0x8f, 0xb4,
-	0x8a, 0x04, 0xd9, 0x7f, 0x80, 0xa1, 0x14, 0xaf, 0xc1, 0x88, 0x78, 0xf7, 0x85, 0x1e, 0xdf, 0x7d,
-	0x05, 0x42, 0xf0, 0xf9, 0x7a, 0x2c, 0xfc, 0x7c, 0xbd, 0x06, 0xe3, 0xac, 0xf5, 0x90, 0x7f, 0xcf,
-	0x33, 0xde, 0xe3, 0xf7, 0x3c, 0x63, 0xb4, 0x23, 0x91, 0x7f, 0xca, 0x73, 0x11, 0xe8, 0xa7, 0x38,
-	0x34, 0x1d, 0x20, 0x57, 0xb3, 0x4c, 0x64, 0x7b, 0x96, 0xd7, 0xa4, 0x9d, 0x32, 0xa3, 0xaa, 0x4c,
-	0xe6, 0x1e, 0xd3, 0xa9, 0x75, 0x3e, 0x23, 0x3f, 0x86, 0x7c, 0x24, 0x84, 0xf2, 0x26, 0xd0, 0x4a,
-	0x7f, 0xc1, 0x53, 0xcd, 0x85, 0x03, 0xa7, 0x32, 0x03, 0x53, 0x61, 0x4b, 0xe7, 0x2e, 0xf0, 0x3d,
-	0x09, 0x16, 0xc4, 0xf9, 0xe2, 0x25, 0xf7, 0x7b, 0x2b, 0xff, 0x25, 0xc1, 0xc9, 0xe4, 0xbd, 0xf0,
-	0x63, 0xce, 0x1e, 0x4c, 0x1a, 0xba, 0xb1, 0x87, 0xc2, 0x5f, 0x00, 0x0e, 0x1c, 0x3c, 0x8b, 0x94,
-	0x68, 0x70, 0x48, 0xb6, 0x61, 0xc6, 0xd4, 0x3d, 0x7d, 0x5b, 0xc7, 0xd1, 0xc5, 0x86, 0x06, 0x5c,
-	0x6c, 0x4a, 0xd0, 0x0d, 0x8e, 0x2a, 0xff, 0x28, 0xc1, 0xbc, 0x60, 0x9d, 0xab, 0xec, 0xae, 0x83,
-	0x83, 0x8f, 0xa3, 0x7b, 0x0e, 0xf6, 0x34, 0xdd, 0x34, 0x5d, 0x84, 0xb1, 0xd0, 0x02, 0x19, 0xbb,
-	0xce, 0x86, 0x3a, 0x05, 0xd1, 0xee, 0x61, 0xbe, 0xcd, 0xa1, 0x20, 0x3d, 0xf8, 0xa1, 0x40, 0xf9,
-	0x97, 0x80, 0x81, 0x85, 0x38, 0xe3, 0x3a, 0x7d, 0x05, 0x26, 0xe8, 0x3e, 0xb1, 0x66, 0x37, 0x6a,
-	0xdb, 0x3c, 0x45, 0x64, 0xd4, 0x71, 0x36, 0xf8, 0x90, 0x8e, 0xc9, 0x0b, 0x30, 0x2a, 0x98, 0x63,
-	0x8f, 0xef, 0x19, 0x35, 0xcb, 0xb9, 0xc3, 0xf2, 0x07, 0x90, 0x6f, 0xb1, 0x47, 0x55, 0xd9, 0xf1,
-	0xb3, 0x46, 0x1f, 0x96, 0xb0, 0xe0, 0xf7, 0x5f, 0xac, 0x11, 0x3c, 0x7a, 0xe8, 0xca, 0xd9, 0xa1,
-	0x31, 0x1a, 0x23, 0xb8, 0xd8, 0x59, 0x73, 0x91, 0xf8, 0x79, 0x2f, 0x9d, 0x4d, 0x17, 0x32, 0x4a,
-	0x05, 0x8a, 0x6b, 0x55, 0x07, 0x23, 0x9a, 0x60, 0x84, 0xc2, 0x82, 0xda, 0x90, 0x42, 0xda, 0x50,
-	0xa6, 0x40, 0x0e, 0xc2, 0x73, 0x3f, 0x7c, 0x1d, 0xf2, 0x77, 0x90, 0xd7, 0x2b, 0x8d, 0x0f, 0xa1,
-	0xd0, 0x82, 0xe6, 0x82, 0xbc, 0x0f, 0xc0, 0xc1, 0xc9, 0xc1, 0x9c, 0xf9, 0xc4, 0x85, 0x5e, 0xcc,
-	0x94, 0x92, 0xa1, 0xac, 0x33, 0x21, 0x93, 0x3f, 0x95, 0x7f, 0x92, 0xa0, 0xc8, 0x1e, 0x33, 0x82,
-	0xf5, 0xb5, 0xf6, 0x5b, 0x92, 0x6f, 0x43, 0x96, 0x9c, 0x11, 0x76, 0x49, 0xc8, 0x1a, 0xa2, 0xdd,
-	0xdf, 0xe7, 0x3b, 0xf7, 0x96, 0xb3, 0x67, 0x48, 0x86, 0xa1, 0xfa, 0xb8, 0xc1, 0x3e, 0xaf, 0x54,
-	0xa8, 0xcf, 0x6b, 0x1d, 0xf2, 0x07, 0x16, 0xb6, 0xb6, 0xad, 0x2a, 0xed, 0xc3, 0xe8, 0xa7, 0x83,
-	0x28, 0xd7, 0x42, 0xa4, 0x47, 0x82, 0x29, 0x90, 0x83, 0xbc, 0x89, 0xe2, 0xa2, 0x04, 0xa7, 0xee,
-	0x20, 0x4f, 0x6d, 0x7d, 0xdc, 0xcc, 0xbb, 0xf7, 0xfc, 0xf3, 0xcc, 0x7d, 0x18, 0xa6, 0x6d, 0x95,
-	0xc4, 0x01, 0x53, 0x6d, 0x0d, 0x2c, 0xf0, 0x75, 0x34, 0x2b, 0xf6, 0xfa, 0x3f, 0x69, 0x03, 0xa6,
-	0xca, 0x69, 0x10, 0xb7, 0xe4, 0xc7, 0x22, 0xda, 0x1f, 0xc4, 0xcf, 0x10, 0x63, 0x7c, 0x8c, 0x58,
-	0xa6, 0xf2, 0xc3, 0x21, 0x28, 0xb7, 0xdb, 0x12, 0x57, 0xfb, 0x77, 0x20, 0xc7, 0x54, 0xe2, 0x37,
-	0x25, 0xb2, 0xbd, 0xbd, 0xd7, 0x63, 0x3f, 0x4c, 0x67, 0xf2, 0xcc, 0x38, 0xc4, 0x28, 0x6b, 0xa5,
-	0x64, 0xfe, 0x2a, 0xc6, 0xe6, 0x9b, 0x20, 0xc7, 0x81, 0x82, 0x6d, 0x8d, 0x19, 0xd6, 0xd6, 0xf8,
-	0x20, 0xdc, 0xd6, 0xf8, 0x46, 0x9f, 0xb2, 0xf3, 0x77, 0xd6, 0xea, 0x74, 0x54, 0x9e, 0xc2, 0xe2,
-	0x1d, 0xe4, 0xdd, 0xbc, 0xff, 0x4e, 0x07, 0x9d, 0x3d, 0xe2, 0x9f, 0xa7, 0x10, 0xaf, 0x10, 0xb2,
-	0xe9, 0x77, 0x6d, 0xff, 0x42, 0x46, 0xbf, 0x58, 0x21, 0x7f, 0x61, 0xe5, 0x37, 0x25, 0x58, 0xea,
-	0xb0, 0x38, 0xd7, 0xce, 0x87, 0x50, 0x0c, 0x90, 0xe5, 0xdd, 0x43, 0x52, 0xf4, 0xd2, 0xd9, 0xf3,
-	0x26, 0xd4, 0x82, 0x1b, 0x1e, 0xc0, 0xca, 0xf7, 0x25, 0x98, 0xa2, 0x2d, 0xa0, 0x22, 0x1a, 0xf7,
-	0x91, 0xb9, 0xbf, 0x11, 0xad, 0x5c, 0x7c, 0xb9, 0x6b, 0xe5, 0x22, 0x69, 0xa9, 0x56, 0xb5, 0x62,
-	0x1f, 0xa6, 0x23, 0x00, 0x5c, 0x0e, 0x2a, 0x64, 0x23, 0xfd, 0x5a, 0x5f, 0xe9, 0x77, 0x29, 0xde,
-	0x34, 0xe5, 0xd3, 0x51, 0x7e, 0x57, 0x82, 0x29, 0x15, 0xe9, 0xf5, 0x7a, 0x95, 0x55, 0x18, 0x71,
-	0x1f, 0x9c, 0x6f, 0x46, 0x39, 0x4f, 0xee, 0xf9, 0x0e, 0xfe, 0x23, 0x00, 0xa6, 0x8e, 0xf8, 0x72,
-	0x2d, 0xee, 0x67, 0x61, 0x3a, 0x02, 0xc0, 0x77, 0xfa, 0x67, 0x43, 0x30, 0xcd, 0x6c, 0x25, 0x6a,
-	0x9d, 0xb7, 0x20, 0xed, 0x37, 0xf6, 0xe7, 0x82, 0x25, 0x82, 0xa4, 0x88, 0x79, 0x13, 0xe9, 0xe6,
-	0x7d, 0xe4, 0x79, 0xc8, 0xa5, 0x7d, 0x64, 0xb4, 0xe7, 0x90, 0xa2, 0x77, 0x4a, 0xfe, 0xf1, 0x3b,
-	0x58, 0x2a, 0xe9, 0x0e, 0xf6, 0x06, 0x94, 0x2c, 0x9b, 0x40, 0x58, 0x07, 0x48, 0x43, 0xb6, 0x1f,
-	0x4e, 0x5a, 0xe5, 0xbe, 0x69, 0x7f, 0xfe, 0x96, 0x2d, 0x9c, 0x7d, 0xdd, 0x94, 0xcf, 0x43, 0xb1,
-	0xa6, 0x1f, 0x59, 0xb5, 0x46, 0x4d, 0xab, 0x13, 0x78, 0x6c, 0x3d, 0x65, 0x5f, 0xf1, 0x67, 0xd4,
-	0x3c, 0x9f, 0xd8, 0xd0, 0x77, 0xd1, 0xa6, 0xf5, 0x14, 0xc9, 0x67, 0x21, 0x4f, 0x3b, 0xfe, 0x29,
-	0x20, 0x6b, 0x50, 0x1f, 0xa6, 0x0d, 0xea, 0xf4, 0x43, 0x00, 0x02, 0xc6, 0xbe, 0xc8, 0xfb, 0x77,
-	0xf6, 0x41, 0x77, 0x48, 0x5e, 0xdc, 0x90, 0x9e, 0x93, 0xc0, 0x12, 0xfd, 0x72, 0xe8, 0x39, 0xfa,
-	0x65, 0x12, 0xaf, 0xa9, 0x24, 0x5e, 0xff, 0x59, 0x82, 0xd9, 0x8d, 0x86, 0xbb, 0x8b, 0x7e, 0x11,
-	0xad, 0x43, 0x99, 0x87, 0x52, 0x9c, 0x39, 0xd1, 0x26, 0x36, 0x04, 0xb3, 0x0f, 0xd0, 0x2f, 0x28,
-	0xe7, 0x2f, 0xc4, 0x2f, 0x6e, 0x40, 0x29, 0x2e, 0x30, 0xee, 0x18, 0x09, 0x34, 0xa4, 0x24, 0x1a,
-	0x3f, 0xa4, 0x1f, 0xb4, 0xed, 0xb8, 0x08, 0xef, 0x05, 0xcb, 0x8a, 0xfd, 0x04, 0xcf, 0xf7, 0xa3,
-	0xc1, 0xf3, 0xeb, 0x3d, 0x06, 0xcf, 0xb6, 0xab, 0xb6, 0x62, 0x28, 0xfd, 0xc6, 0x2d, 0x09, 0x8e,
-	0x1b, 0xcd, 0x0f, 0x24, 0x38, 0x7f, 0x07, 0xd9, 0xc8, 0xd5, 0x3d, 0x74, 0x5f, 0xc7, 0x9e, 0xb8,
-	0xef, 0x46, 0xdc, 0xef, 0x65, 0x5c, 0x5f, 0x2f, 0xc0, 0x6b, 0x3d, 0xed, 0x8c, 0x73, 0x72, 0x1b,
-	0x16, 0xc2, 0x67, 0xaf, 0x70, 0xed, 0xec, 0x1c, 0xe4, 0xc3, 0x25, 0x3c, 0x76, 0x6e, 0x18, 0x55,
-	0x73, 0xa1, 0x1a, 0x1e, 0x56, 0x1a, 0x70, 0x32, 0x99, 0x0e, 0x37, 0x8c, 0x77, 0x61, 0x98, 0xdd,
-	0xa5, 0xf8, 0xb9, 0xe3, 0xcd, 0x1e, 0x0f, 0x86, 0xfc, 0x76, 0x11, 0x25, 0xcb, 0x89, 0x29, 0x7f,
-	0x3d, 0x0c, 0x33, 0xc9, 0x20, 0x9d, 0x6e, 0x09, 0x5f, 0x86, 0xd9, 0x9a, 0x7e, 0xa4, 0x45, 0x63,
-	0x6f, 0xeb, 0x23, 0xb4, 0xa9, 0x9a, 0x7e, 0x14, 0x3d, 0x79, 0x99, 0xf2, 0x7d, 0x28, 0x30, 0x8a,
-	0x55, 0xc7, 0xd0, 0xab, 0xbd, 0xd6, 0x02, 0x87, 0xc9, 0xe1, 0xbf, 0x24, 0xa9, 0xec, 0x80, 0x7c,
-	0x9f, 0xa0, 0xd2, 0x8a, 0xd1, 0xd3, 0xb8, 0x68, 0xd9, 0x3b, 0xc0, 0x3b, 0x03, 0x89, 0xa6, 0xa2,
-	0x86, 0x14, 0xc3, 0x0e, 0xcb, 0x11, 0x6d, 0xc9, 0xbf, 0x25, 0xc1, 0xe4, 0x9e, 0x6e, 0x9b, 0xce,
-	0x01, 0x3f, 0xf6, 0x53, 0x33, 0x24, 0x57, 0xcb, 0x7e, 0x3e, 0x7e, 0x6a, 0xb3, 0x81, 0xbb, 0x9c,
-	0xb0, 0x7f, 0xab, 0xe5, 0x9b, 0x90, 0xf7, 0x62, 0x13, 0x72, 0x1d, 0xce, 0x24, 0x6a, 0x22, 0x7a,
-	0xc7, 0xea, 0xb5, 0xac, 0xb8, 0x18, 0x57, 0xdc, 0xa3, 0xd0, 0xad, 0x6b, 0xfe, 0xfb, 0x12, 0x4c,
-	0x26, 0x88, 0x28, 0xe1, 0x0b, 0xa8, 0x0f, 0xc2, 0x57, 0x85, 0x3b, 0x03, 0x49, 0x65, 0x03, 0xb9,
-	0x7c, 0xbd, 0xc0, 0xd5, 0x61, 0xfe, 0xbb, 0x12, 0xcc, 0xb6, 0x11, 0x57, 0xc2, 0x86, 0xd4, 0xf0,
-	0x86, 0xbe, 0xd6, 0xe3, 0x86, 0x62, 0x0b, 0xd0, 0x4b, 0x44, 0xe0, 0x02, 0xf3, 0x1e, 0x4c, 0x27,
-	0xc2, 0xc8, 0x6f, 0xc3, 0x49, 0xdf, 0x4a, 0x92, 0x9c, 0x45, 0xa2, 0xce, 0x32, 0x27, 0x60, 0x62,
-	0x1e, 0xa3, 0xfc, 0x89, 0x04, 0x8b, 0xdd, 0xe4, 0x21, 0x2b, 0x30, 0xa1, 0x1b, 0xfb, 0xc8, 0x8c,
-	0x90, 0x1d, 0xa3, 0x83, 0xdc, 0xf5, 0x3e, 0x80, 0xf9, 0x00, 0x4c, 0xd4, 0x3a, 0x7a, 0xfd, 0x68,
-	0x68, 0xd6, 0x27, 0x19, 0x36, 0x0a, 0xe5, 0xb7, 0x25, 0x98, 0x57, 0xd1, 0x76, 0xc3, 0xaa, 0x9a,
-	0x2f, 0xbb, 0xfc, 0x78, 0x8a, 0x24, 0xc6, 0x84, 0x9d, 0xf0, 0x78, 0xfd, 0x97, 0x43, 0xb0, 0x1c,
-	0x6e, 0xa1, 0x6b, 0xb1, 0xc2, 0xde, 0x96, 0x5f, 0xc6, 0xff, 0xc8, 0xd8, 0x80, 0xc9, 0xe0, 0x53,
-	0x12, 0xff, 0x8f, 0x06, 0x3d, 0x3f, 0x94, 0x14, 0x03, 0xef, 0x46, 0xec, 0xdf, 0x17, 0x84, 0x28,
-	0xd2, 0x46, 0xc2, 0xfe, 0x6a, 0x2d, 0x3e, 0x45, 0x5a, 0xe4, 0xa2, 0x3a, 0x5e, 0x81, 0xb3, 0xdd,
-	0x04, 0xc7, 0x65, 0xfc, 0x47, 0x12, 0x94, 0xdf, 0xad, 0x9b, 0x03, 0xb6, 0xc6, 0xfe, 0x72, 0xf4,
-	0x7c, 0xd2, 0xbd, 0xfd, 0xbc, 0xf3, 0xa2, 0xad, 0xe3, 0xc9, 0x77, 0xe0, 0x74, 0x5b, 0x50, 0xff,
-	0x2d, 0x3e, 0x7a, 0xd5, 0xfd, 0xfa, 0xf1, 0x97, 0x8f, 0x5e, 0x7a, 0x6f, 0xd4, 0x3f, 0xfe, 0xb4,
-	0x7c, 0xe2, 0x27, 0x9f, 0x96, 0x4f, 0xfc, 0xec, 0xd3, 0xb2, 0xf4, 0x6b, 0xcf, 0xca, 0xd2, 0x8f,
-	0x9e, 0x95, 0xa5, 0xbf, 0x7b, 0x56, 0x96, 0x3e, 0x7e, 0x56, 0x96, 0xfe, 0xf5, 0x59, 0x59, 0xfa,
-	0xe9, 0xb3, 0xf2, 0x89, 0x9f, 0x3d, 0x2b, 0x4b, 0x1f, 0x7d, 0x56, 0x3e, 0xf1, 0xf1, 0x67, 0xe5,
-	0x13, 0x3f, 0xf9, 0xac, 0x7c, 0xe2, 0xfd, 0x6b, 0xbb, 0x4e, 0x6b, 0x0f, 0x96, 0xd3, 0xf1, 0xdf,
-	0x60, 0xfe, 0x52, 0x78, 0x64, 0x7b, 0x98, 0xaa, 0xfa, 0xca, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff,
-	0xbc, 0x66, 0x76, 0x03, 0x45, 0x53, 0x00, 0x00,
+	// 4587 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4d, 0x6c, 0x1c, 0xd7,
+	0x79, 0x1a, 0xee, 0x2e, 0xb9, 0xfc, 0x48, 0xee, 0x2e, 0x87, 0x7f, 0x2b, 0x52, 0x5a, 0x51, 0x23,
+	0x51, 0xa2, 0x65, 0x6b, 0x65, 0x49, 0x8e, 0xad, 0xa8, 0x71, 0x1c, 0x89, 0xd4, 0x0f, 0x05, 0x49,
+	0xa1, 0x86, 0xb4, 0xec, 0x3a, 0x71, 0x46, 0xc3, 0x99, 0x47, 0x72, 0xca, 0xd9, 0x99, 0xf5, 0xcc,
+	0x2c, 0xc9, 0x75, 0x0f, 0x69, 0x13, 0xb4, 0x68, 0x73, 0x68, 0x8d, 0xf6, 0x12, 0x14, 0x69, 0x0f,
+	0x05, 0x82, 0xe6, 0x52, 0xf4, 0xd0, 0x43, 0x91, 0x43, 0x2f, 0x2d, 0x50, 0x14, 0x3d, 0x19, 0xbd,
+	0x34, 0x68, 0x81, 0xa6, 0x96, 0x0f, 0x4d, 0xd1, 0x1e, 0x72, 0x2c, 0x8a, 0x1e, 0x8a, 0xf7, 0x37,
+	0xff, 0x3b, 0xbb, 0xcb, 0x95, 0x2a, 0xc7, 0xf1, 0x8d, 0xfb, 0xde, 0xf7, 0x7d, 0xef, 0xfb, 0x7f,
+	0xef, 0x7d, 0xef, 0x1b, 0xc2, 0x57, 0x3c, 0xd4, 0x68, 0xda, 0x8e, 0x6a, 0x5e, 0x72, 0x91, 0xb3,
+	0x8f, 0x9c, 0x4b, 0x6a, 0xd3, 0xb8, 0xb4, 0x6b, 0xb8, 0x9e, 0xed, 0xb4, 0xf1, 0x88, 0xa1, 0xa1,
+	0x4b, 0xfb, 0x97, 0x2f, 0x39, 0xe8, 0x83, 0x16, 0x72, 0x3d, 0xc5, 0x41, 0x6e, 0xd3, 0xb6, 0x5c,
+	0x54, 0x6f, 0x3a, 0xb6, 0x67, 0x8b, 0x4b, 0x1c, 0xbb, 0x4e, 0xb1, 0xeb, 0x6a, 0xd3, 0xa8, 0x47,
+	0xb1, 0xeb, 0xfb, 0x97, 0xe7, 0x6b, 0x3b, 0xb6, 0xbd, 0x63, 0xa2, 0x4b, 0x04, 0x69, 0xab, 0xb5,
+	0x7d, 0x49, 0x6f, 0x39, 0xaa, 0x67, 0xd8, 0x16, 0x25, 0x33, 0x7f, 0x2a, 0x3e, 0xef, 0x19, 0x0d,
+	0xe4, 0x7a, 0x6a, 0xa3, 0xc9, 0x00, 0x4e, 0xeb, 0xa8, 0x89, 0x2c, 0x1d, 0x59, 0x9a, 0x81, 0xdc,
+	0x4b, 0x3b, 0xf6, 0x8e, 0x4d, 0xc6, 0xc9, 0x5f, 0x0c, 0xe4, 0xac, 0x2f, 0x08, 0x96, 0x40, 0xb3,
+	0x1b, 0x0d, 0xdb, 0xc2, 0x9c, 0x37, 0x90, 0xeb, 0xaa, 0x3b, 0x8c, 0xe1, 0xf9, 0xa5, 0x08, 0x14,
+	0xe3, 0x34, 0x09, 0x76, 0x3e, 0x02, 0xe6, 0xa9, 0xee, 0xde, 0x07, 0x2d, 0xd4, 0x42, 0x49, 0xc0,
+	0xe8, 0xaa, 0xc8, 0x6a, 0x35, 0x5c, 0x0c, 0x74, 0x60, 0x3b, 0x7b, 0xdb, 0xa6, 0x7d, 0xc0, 0xa0,
+	0xce, 0x45, 0xa0, 0xf8, 0x64, 0x92, 0xda, 0x99, 0x08, 0xdc, 0x07, 0x2d, 0x94, 0xc6, 0x5b, 0x94,
+	0x18, 0x19, 0xd3, 0x6c, 0xb3, 0x9b, 0xa8, 0xdb, 0xaa, 0x61, 0xb6, 0x9c, 0x14, 0x09, 0x2e, 0xa4,
+	0x39, 0x80, 0x66, 0xda, 0xda, 0x5e, 0x12, 0xf6, 0x95, 0x0c, 0x67, 0x49, 0x42, 0xbf, 0x94, 0x06,
+	0xed, 0xab, 0x88, 0x5a, 0x88, 0x81, 0xbe, 0x9c, 0x09, 0x1a, 0xd3, 0xe6, 0xf9, 0x4c, 0x60, 0x6c,
+	0x2c, 0x06, 0x78, 0x31, 0x0d, 0xb0, 0xb3, 0xf6, 0xeb, 0x69, 0xe0, 0x96, 0xda, 0x40, 0x6e, 0x53,
+	0xd5, 0x52, 0x34, 0xf7, 0x6a, 0x1a, 0xbc, 0x83, 0x9a, 0xa6, 0xa1, 0x11, 0xe7, 0x4e, 0x62, 0x5c,
+	0x4d, 0xc3, 0x68, 0x22, 0xc7, 0x35, 0x5c, 0x0f, 0x59, 0x74, 0x0d, 0x74, 0x88, 0xb4, 0x16, 0x46,
+	0x77, 0x19, 0xd2, 0x5b, 0x3d, 0x20, 0x71, 0xa1, 0x94, 0x46, 0xcb, 0x53, 0xb7, 0x4c, 0xa4, 0xb8,
+	0x9e, 0xea, 0xf1, 0x55, 0x5f, 0x4f, 0xf5, 0xbe, 0xae, 0xc1, 0x3d, 0x7f, 0x3d, 0x6d, 0x61, 0x55,
+	0x6f, 0x18, 0x56, 0x57, 0x5c, 0xe9, 0x3b, 0x23, 0x70, 0x72, 0xc3, 0x53, 0x1d, 0xef, 0x1d, 0xb6,
+	0xdc, 0x2d, 0x2e, 0x96, 0x4c, 0x11, 0xc4, 0xd3, 0x30, 0xee, 0xeb, 0x56, 0x31, 0xf4, 0xaa, 0xb0,
+	0x28, 0x2c, 0x8f, 0xca, 0x63, 0xfe, 0xd8, 0x9a, 0x2e, 0x6a, 0x30, 0xe1, 0x62, 0x1a, 0x0a, 0x5b,
+	0xa4, 0x3a, 0xb4, 0x28, 0x2c, 0x8f, 0x5d, 0xf9, 0xaa, 0x6f, 0x28, 0x92, 0x6e, 0x62, 0x02, 0xd5,
+	0xf7, 0x2f, 0xd7, 0x33, 0x57, 0x96, 0xc7, 0x09, 0x51, 0xce, 0xc7, 0x2e, 0xcc, 0x34, 0x55, 0x07,
+	0x59, 0x9e, 0xe2, 0x6b, 0x5e, 0x31, 0xac, 0x6d, 0xbb, 0x9a, 0x23, 0x8b, 0xbd, 0x56, 0x4f, 0x4b,
+	0x71, 0xbe, 0x47, 0xee, 0x5f, 0xae, 0xaf, 0x13, 0x6c, 0x7f, 0x95, 0x35, 0x6b, 0xdb, 0x96, 0xa7,
+	0x9a, 0xc9, 0x41, 0xb1, 0x0a, 0x23, 0xaa, 0x87, 0xa9, 0x79, 0xd5, 0xfc, 0xa2, 0xb0, 0x5c, 0x90,
+	0xf9, 0x4f, 0xb1, 0x01, 0x92, 0x6f, 0xc1, 0x80, 0x0b, 0x74, 0xd8, 0x34, 0x68, 0x9a, 0x54, 0x70,
+	0x3e, 0xac, 0x16, 0x08, 0x43, 0xf3, 0x75, 0x9a, 0x2c, 0xeb, 0x3c, 0x59, 0xd6, 0x37, 0x79, 0xb2,
+	0xbc, 0x99, 0xff, 0xe8, 0xa7, 0xa7, 0x04, 0xf9, 0xd4, 0x41, 0x5c, 0xf2, 0x5b, 0x3e, 0x25, 0x0c,
+	0x2b, 0xee, 0xc2, 0x71, 0xcd, 0xb6, 0x3c, 0xc3, 0x6a, 0x21, 0x45, 0x75, 0x15, 0x0b, 0x1d, 0x28,
+	0x86, 0x65, 0x78, 0x86, 0xea, 0xd9, 0x4e, 0x75, 0x78, 0x51, 0x58, 0x2e, 0x5d, 0xb9, 0x18, 0xd5,
+	0x31, 0x89, 0x2e, 0x2c, 0xec, 0x0a, 0xc3, 0xbb, 0xe1, 0x3e, 0x44, 0x07, 0x6b, 0x1c, 0x49, 0x9e,
+	0xd5, 0x52, 0xc7, 0xc5, 0x07, 0x30, 0xc9, 0x67, 0x74, 0x85, 0xa5, 0xa0, 0xea, 0x08, 0x91, 0x63,
+	0x31, 0xba, 0x02, 0x9b, 0xc4, 0x6b, 0xdc, 0xa6, 0x7f, 0xca, 0x15, 0x1f, 0x95, 0x8d, 0x88, 0x8f,
+	0x61, 0xd6, 0x54, 0x5d, 0x4f, 0xd1, 0xec, 0x46, 0xd3, 0x44, 0x44, 0x33, 0x0e, 0x72, 0x5b, 0xa6,
+	0x57, 0x2d, 0xa6, 0xd1, 0x64, 0x29, 0x86, 0xd8, 0xa8, 0x6d, 0xda, 0xaa, 0xee, 0xca, 0xd3, 0x18,
+	0x7f, 0xc5, 0x47, 0x97, 0x09, 0xb6, 0xf8, 0x2d, 0x58, 0xd8, 0x36, 0x1c, 0xd7, 0x53, 0x7c, 0x2b,
+	0xe0, 0x2c, 0xa2, 0x6c, 0xa9, 0xda, 0x9e, 0xbd, 0xbd, 0x5d, 0x1d, 0x25, 0xc4, 0x8f, 0x27, 0x14,
+	0xbf, 0xca, 0x76, 0xb1, 0x9b, 0xf9, 0xef, 0x63, 0xbd, 0x57, 0x09, 0x0d, 0xee, 0x76, 0x9b, 0xaa,
+	0xbb, 0x77, 0x93, 0x12, 0x10, 0x5b, 0xb0, 0xe0, 0x53, 0x36, 0x74, 0x45, 0xb3, 0xad, 0x6d, 0xd3,
+	0xd0, 0x3c, 0xa5, 0x69, 0x9b, 0x86, 0xd6, 0xae, 0x02, 0x51, 0xf9, 0xeb, 0xa9, 0x9e, 0xe6, 0x6b,
+	0x9e, 0xd3, 0x5d, 0xd3, 0x57, 0x18, 0xfa, 0x3a, 0xc1, 0x96, 0xab, 0x07, 0x1d, 0x66, 0xa4, 0x3f,
+	0x10, 0xa0, 0xd6, 0x29, 0x14, 0x68, 0xb4, 0x8a, 0x33, 0x30, 0xec, 0xb4, 0xac, 0x20, 0xfe, 0x0a,
+	0x4e, 0xcb, 0x5a, 0xd3, 0xc5, 0xb7, 0xa0, 0x40, 0xb6, 0x00, 0x16, 0x71, 0x2f, 0xa5, 0xb2, 0x46,
+	0x20, 0x30, 0x6b, 0x8f, 0x91, 0xe6, 0xd9, 0xce, 0x0a, 0xfe, 0x29, 0x53, 0x3c, 0xec, 0xeb, 0x24,
+	0xca, 0x90, 0x4e, 0xe2, 0xa8, 0x28, 0xf3, 0x9f, 0xd2, 0x7f, 0x0a, 0x30, 0x7b, 0x07, 0x79, 0x0f,
+	0x68, 0xa2, 0xda, 0xf0, 0x54, 0x0f, 0xf5, 0x91, 0x12, 0xee, 0xc0, 0xa8, 0x1f, 0x20, 0x49, 0xe6,
+	0xa2, 0x46, 0x4f, 0x4a, 0x1d, 0xe0, 0x8a, 0x57, 0x61, 0x16, 0x1d, 0x36, 0x91, 0xe6, 0x21, 0x5d,
+	0xb1, 0xd0, 0xa1, 0xa7, 0xa0, 0x7d, 0x9c, 0x03, 0x0c, 0xca, 0x6f, 0x4e, 0x9e, 0xe2, 0xb3, 0x0f,
+	0xd1, 0xa1, 0x77, 0x0b, 0xcf, 0xad, 0xe9, 0xe2, 0xab, 0x30, 0xad, 0xb5, 0x1c, 0x92, 0x2c, 0xb6,
+	0x1c, 0xd5, 0xd2, 0x76, 0x15, 0xcf, 0xde, 0x43, 0x16, 0x09, 0xe7, 0x71, 0x59, 0x64, 0x73, 0x37,
+	0xc9, 0xd4, 0x26, 0x9e, 0x91, 0x7e, 0x5a, 0x84, 0xb9, 0x84, 0xb4, 0x4c, 0xf7, 0x11, 0x59, 0x84,
+	0x01, 0x64, 0x59, 0x83, 0x89, 0xc0, 0x71, 0xdb, 0x4d, 0xc4, 0x14, 0x73, 0xb6, 0x1b, 0xb1, 0xcd,
+	0x76, 0x13, 0xc9, 0xe3, 0x07, 0xa1, 0x5f, 0xa2, 0x04, 0x13, 0x69, 0xda, 0x18, 0xb3, 0x42, 0x5a,
+	0xf8, 0x32, 0x1c, 0x6f, 0x3a, 0x68, 0xdf, 0xb0, 0x5b, 0xae, 0xc2, 0xac, 0x1a, 0xc0, 0xe7, 0x09,
+	0xfc, 0x2c, 0x07, 0xd8, 0xa0, 0xf3, 0x1c, 0xf5, 0x22, 0x4c, 0x91, 0x00, 0xa6, 0xd1, 0xe6, 0x23,
+	0x15, 0x08, 0x52, 0x05, 0x4f, 0xdd, 0xc6, 0x33, 0x1c, 0x7c, 0x05, 0x80, 0x04, 0x22, 0x39, 0x7c,
+	0x55, 0x87, 0xd3, 0xa4, 0xf2, 0xcf, 0x66, 0x58, 0x30, 0x1c, 0x73, 0x8f, 0xf0, 0x0f, 0x79, 0xd4,
+	0xe3, 0x7f, 0x8a, 0xeb, 0x30, 0xe9, 0x7a, 0x86, 0xb6, 0xd7, 0x56, 0x42, 0xb4, 0x46, 0xfa, 0xa0,
+	0x55, 0xa6, 0xe8, 0xfe, 0x80, 0xf8, 0xeb, 0xf0, 0x72, 0x82, 0xa2, 0xe2, 0x6a, 0xbb, 0x48, 0x6f,
+	0x99, 0x48, 0xf1, 0x6c, 0xaa, 0x15, 0x92, 0xb4, 0xed, 0x96, 0x57, 0x1d, 0xeb, 0x2d, 0x7d, 0x2c,
+	0xc5, 0x96, 0xd9, 0x60, 0x04, 0x37, 0x6d, 0xa2, 0xc4, 0x4d, 0x4a, 0xad, 0xa3, 0x0f, 0x4e, 0x74,
+	0xf2, 0x41, 0xf1, 0x1b, 0x50, 0xf2, 0xdd, 0x83, 0x9c, 0x0b, 0xaa, 0x65, 0x92, 0x70, 0x5e, 0xeb,
+	0x2d, 0xe1, 0xf8, 0x2e, 0x47, 0xbd, 0xd7, 0x77, 0x35, 0xf2, 0x53, 0x7c, 0x07, 0xca, 0x11, 0xe2,
+	0x2d, 0xb7, 0x5a, 0x21, 0xd4, 0xeb, 0x1d, 0x76, 0x90, 0x54, 0xb2, 0x2d, 0x57, 0x2e, 0x85, 0xe9,
+	0xb6, 0x5c, 0xf1, 0x7d, 0x98, 0xdc, 0xc7, 0x87, 0x1c, 0xdb, 0x52, 0xe8, 0x09, 0xd3, 0x40, 0x6e,
+	0x75, 0x92, 0xa8, 0xf2, 0xd5, 0x7a, 0xc6, 0xb5, 0x83, 0x26, 0x24, 0x82, 0x78, 0x97, 0xe3, 0xc9,
+	0x95, 0xfd, 0xd8, 0x88, 0xf8, 0x55, 0x38, 0x61, 0xb8, 0x0a, 0x55, 0x79, 0xd8, 0x8c, 0xc8, 0xc2,
+	0x81, 0xaa, 0x57, 0x45, 0x92, 0xb5, 0xaa, 0x86, 0xbb, 0x11, 0xb5, 0xca, 0x2d, 0x3a, 0x2f, 0xbe,
+	0x06, 0x73, 0x09, 0x4f, 0xf6, 0x0e, 0x49, 0x26, 0x9d, 0xa2, 0x09, 0x24, 0xea, 0xcd, 0x9b, 0x87,
+	0x38, 0xaf, 0x5e, 0x85, 0x59, 0x86, 0xe0, 0xef, 0xf2, 0x2c, 0xfd, 0x4e, 0x93, 0x5c, 0x37, 0x45,
+	0x66, 0x83, 0x20, 0xc7, 0xc9, 0xf8, 0x5e, 0xbe, 0x58, 0xac, 0x8c, 0xde, 0xcb, 0x17, 0x47, 0x2b,
+	0x70, 0x2f, 0x5f, 0x84, 0xca, 0xd8, 0xbd, 0x7c, 0x71, 0xbc, 0x32, 0x71, 0x2f, 0x5f, 0x2c, 0x55,
+	0xca, 0xd2, 0x7f, 0x09, 0x30, 0xb7, 0x6e, 0x9b, 0xe6, 0x2f, 0x49, 0x42, 0xfd, 0xa3, 0x22, 0x54,
+	0x93, 0xe2, 0x7e, 0x91, 0x51, 0xbf, 0xc8, 0xa8, 0xcf, 0x3c, 0xa3, 0x8e, 0x77, 0xcc, 0xa8, 0xa9,
+	0xb9, 0xa9, 0xf4, 0xcc, 0x72, 0xd3, 0x2f, 0x66, 0xc2, 0xce, 0xc8, 0x88, 0x93, 0x47, 0xc9, 0x88,
+	0x62, 0x7f, 0x19, 0x71, 0xa2, 0x52, 0x92, 0x7e, 0x57, 0x80, 0x05, 0x19, 0xb9, 0xc8, 0x8b, 0x25,
+	0xed, 0x17, 0x90, 0x0f, 0xa5, 0x1a, 0x9c, 0x48, 0x67, 0x85, 0xe6, 0x2a, 0xe9, 0x47, 0x39, 0x58,
+	0x94, 0x91, 0x66, 0x3b, 0x7a, 0xf8, 0xc6, 0xc0, 0xa2, 0xbb, 0x0f, 0x86, 0xdf, 0x05, 0x31, 0x79,
+	0x77, 0xec, 0x9f, 0xf3, 0xc9, 0xc4, 0xa5, 0x51, 0x7c, 0x05, 0x44, 0x1e, 0x82, 0x7a, 0x3c, 0x7d,
+	0x55, 0xfc, 0x19, 0x9e, 0x59, 0xe6, 0x60, 0x84, 0xc4, 0xae, 0x9f, 0xb1, 0x86, 0xf1, 0xcf, 0x35,
+	0x5d, 0x3c, 0x09, 0xc0, 0x8b, 0x04, 0x2c, 0x31, 0x8d, 0xca, 0xa3, 0x6c, 0x64, 0x4d, 0x17, 0x9f,
+	0xc0, 0x78, 0xd3, 0x36, 0x4d, 0xff, 0x8e, 0x4f, 0x73, 0xd2, 0x9b, 0x5d, 0xef, 0xf8, 0x78, 0x13,
+	0x08, 0x6b, 0x2e, 0x6c, 0x68, 0x79, 0x0c, 0x93, 0xe4, 0x4a, 0xf4, 0x2f, 0x33, 0x23, 0x47, 0xbb,
+	0xcc, 0xe0, 0x43, 0xfc, 0xe9, 0x0c, 0x53, 0xb1, 0xcd, 0x27, 0xb1, 0x67, 0x08, 0x47, 0xde, 0x33,
+	0x32, 0xf7, 0x83, 0xa1, 0xcc, 0xfd, 0xa0, 0x3f, 0xa3, 0x2d, 0x43, 0xa5, 0xc3, 0x7e, 0x53, 0x72,
+	0xa3, 0x74, 0x13, 0xdb, 0x58, 0x21, 0xb9, 0x8d, 0x85, 0x0a, 0x1c, 0xc3, 0xd1, 0x02, 0xc7, 0x35,
+	0xa8, 0xb2, 0xfc, 0x1e, 0x84, 0x39, 0x3f, 0x69, 0x8d, 0x90, 0x93, 0xd6, 0x2c, 0x9d, 0x0f, 0x4a,
+	0x16, 0x74, 0x56, 0xfc, 0x00, 0xe6, 0x3c, 0x47, 0xb5, 0x5c, 0x03, 0x2f, 0x1b, 0xb9, 0x9e, 0xb3,
+	0x3b, 0xff, 0x97, 0xbb, 0x25, 0xdc, 0x4d, 0x8e, 0x1e, 0x36, 0x1e, 0xa9, 0xd2, 0xcc, 0x78, 0x69,
+	0x53, 0xe2, 0x0e, 0x9c, 0x4c, 0xa9, 0xc6, 0x84, 0xb6, 0xba, 0xd1, 0x3e, 0xb6, 0xba, 0xf9, 0x44,
+	0x5c, 0xf9, 0x73, 0x38, 0xba, 0x23, 0x1b, 0xce, 0x18, 0xd9, 0x70, 0xc6, 0xb6, 0x42, 0x3b, 0xcd,
+	0x1d, 0x28, 0x05, 0xe6, 0x24, 0x55, 0xa0, 0xf1, 0x1e, 0xab, 0x40, 0x13, 0x3e, 0x1e, 0x9e, 0x11,
+	0x57, 0x60, 0x9c, 0x5b, 0x9a, 0x90, 0x99, 0xe8, 0x91, 0xcc, 0x18, 0xc3, 0x22, 0x44, 0x6c, 0x18,
+	0xc1, 0x45, 0x69, 0xba, 0xdb, 0xe5, 0x96, 0xc7, 0xae, 0xbc, 0x5d, 0xef, 0xe9, 0x01, 0xa0, 0xde,
+	0x35, 0x7a, 0xea, 0x8f, 0x28, 0xdd, 0x5b, 0x96, 0xe7, 0xb4, 0x65, 0xbe, 0x4a, 0x10, 0xba, 0xe5,
+	0x23, 0xd6, 0x21, 0xde, 0x84, 0x22, 0x2b, 0xc1, 0xe2, 0x6d, 0x0e, 0xb3, 0x7c, 0x3a, 0x6a, 0x36,
+	0x5e, 0x3f, 0xc7, 0xf8, 0x0f, 0x28, 0xa4, 0xec, 0xa3, 0xcc, 0x3f, 0x81, 0xf1, 0x30, 0x63, 0x62,
+	0x05, 0x72, 0x7b, 0xa8, 0xcd, 0xd2, 0x30, 0xfe, 0x53, 0xbc, 0x0e, 0x85, 0x7d, 0xd5, 0x6c, 0x75,
+	0x38, 0x21, 0x92, 0x12, 0x7e, 0x38, 0xd8, 0x31, 0xb5, 0xb6, 0x4c, 0x51, 0xae, 0x0f, 0x5d, 0x13,
+	0xe8, 0xf6, 0x15, 0xda, 0x0c, 0x6e, 0x68, 0x9e, 0xb1, 0x6f, 0x78, 0xed, 0x2f, 0x36, 0x83, 0x7e,
+	0x37, 0x83, 0xb0, 0xe6, 0x9e, 0xe3, 0x66, 0xf0, 0xb7, 0x79, 0xbe, 0x19, 0xa4, 0x9a, 0x8a, 0x6d,
+	0x06, 0x0f, 0xa1, 0x1c, 0x53, 0x17, 0xdb, 0x0e, 0x96, 0xa2, 0xb2, 0x84, 0xf2, 0x14, 0x3d, 0xff,
+	0xb5, 0x89, 0x0a, 0xe5, 0x52, 0x54, 0xa5, 0x89, 0xf0, 0x1d, 0x3a, 0x4a, 0xf8, 0x86, 0xf2, 0x73,
+	0x2e, 0x9a, 0x9f, 0x11, 0xd4, 0xf8, 0x11, 0x98, 0x0d, 0x29, 0xb1, 0xb4, 0x93, 0xef, 0x71, 0xc1,
+	0x05, 0x46, 0xe7, 0x06, 0x25, 0xb3, 0x11, 0x49, 0x42, 0x0f, 0x60, 0x72, 0x17, 0xa9, 0x8e, 0xb7,
+	0x85, 0x54, 0x4f, 0xd1, 0x91, 0xa7, 0x1a, 0xa6, 0x5b, 0x2d, 0xf4, 0x58, 0xba, 0xad, 0xf8, 0xa8,
+	0xab, 0x14, 0x33, 0xb9, 0xe3, 0x0e, 0x1f, 0x79, 0xc7, 0xbd, 0x18, 0x0a, 0x1c, 0x3f, 0xa0, 0x88,
+	0x8f, 0x8c, 0x06, 0xd1, 0xf0, 0x90, 0x4f, 0x04, 0x5e, 0x54, 0x3c, 0xa2, 0x17, 0xfd, 0x58, 0x80,
+	0x33, 0xd4, 0x59, 0x22, 0x59, 0x91, 0x55, 0xa6, 0xfb, 0x8a, 0x79, 0x1b, 0x2a, 0xac, 0x1e, 0x8e,
+	0x62, 0x0f, 0x25, 0xab, 0x5d, 0xe3, 0xa6, 0x07, 0x16, 0xe4, 0x32, 0xa7, 0xce, 0x06, 0xa4, 0xef,
+	0x0c, 0xc1, 0xd9, 0x6c, 0x44, 0x16, 0x04, 0x6e, 0x70, 0xba, 0xe0, 0xcf, 0x43, 0x2c, 0x0a, 0xee,
+	0x3e, 0xab, 0x7d, 0x03, 0x5f, 0x25, 0xa3, 0x91, 0x87, 0xa0, 0xa4, 0xb2, 0xc0, 0x24, 0x7b, 0xb6,
+	0x5b, 0x1d, 0x5a, 0xcc, 0xf5, 0xf4, 0x6a, 0xd4, 0x21, 0x89, 0xb0, 0x85, 0x26, 0xd4, 0xd0, 0x94,
+	0x2b, 0xfd, 0x85, 0x00, 0x8b, 0x74, 0x2e, 0xc2, 0x1e, 0x7e, 0xa9, 0xe8, 0xcb, 0x7a, 0xbb, 0x50,
+	0xda, 0x26, 0x38, 0x31, 0xdb, 0xdd, 0x38, 0x8a, 0xed, 0x22, 0xab, 0xcb, 0x13, 0xdb, 0xe1, 0x9f,
+	0xd2, 0x19, 0x38, 0x9d, 0x81, 0xc2, 0x6e, 0x25, 0x3f, 0x16, 0x40, 0x4a, 0x66, 0xb7, 0xbb, 0x3c,
+	0xf2, 0xfa, 0x10, 0xac, 0x19, 0x8e, 0xf5, 0xa8, 0x6c, 0x2b, 0x3d, 0xc8, 0xd6, 0x8d, 0x85, 0x50,
+	0x3a, 0xe0, 0x02, 0xae, 0xc3, 0x99, 0x4c, 0x3c, 0xe6, 0x20, 0x2f, 0x41, 0x45, 0x53, 0x2d, 0x0d,
+	0xf9, 0xbb, 0x0c, 0xa2, 0xfc, 0x17, 0xe5, 0x32, 0x1d, 0x97, 0xf9, 0x70, 0x38, 0x4a, 0xc3, 0x34,
+	0x5f, 0x50, 0x94, 0x66, 0xb1, 0x90, 0x8c, 0xd2, 0x73, 0x70, 0x36, 0x1b, 0x8f, 0x59, 0x3c, 0xe4,
+	0xc8, 0x61, 0xc0, 0xff, 0x7f, 0x47, 0xee, 0xb8, 0x7a, 0x67, 0x47, 0x4e, 0x43, 0x61, 0x62, 0xfd,
+	0x25, 0x71, 0xe4, 0xa4, 0xfc, 0xc4, 0xc2, 0x7d, 0x09, 0xf6, 0x6b, 0x50, 0x8a, 0xfa, 0x4b, 0x1f,
+	0x5e, 0xdc, 0x6d, 0x7d, 0x79, 0x22, 0xe2, 0x72, 0xd2, 0x52, 0xba, 0xbf, 0xf9, 0x48, 0x4c, 0xb8,
+	0xbf, 0x1b, 0x82, 0xda, 0x86, 0xb1, 0x63, 0xa9, 0xe6, 0x20, 0xcf, 0xeb, 0xdb, 0x50, 0x72, 0x09,
+	0x91, 0x98, 0x60, 0x6f, 0x75, 0x7f, 0x5f, 0xcf, 0x5c, 0x5b, 0x9e, 0xa0, 0x64, 0x39, 0x2b, 0x06,
+	0x2c, 0xa0, 0x43, 0x0f, 0x39, 0x78, 0xa5, 0x94, 0xd3, 0x69, 0xae, 0xdf, 0xd3, 0xe9, 0x71, 0x4e,
+	0x2d, 0x31, 0x25, 0xd6, 0x61, 0x4a, 0xdb, 0x35, 0x4c, 0x3d, 0x58, 0xc7, 0xb6, 0xcc, 0x36, 0x39,
+	0xbc, 0x14, 0xe5, 0x49, 0x32, 0xc5, 0x91, 0xbe, 0x6e, 0x99, 0x6d, 0xe9, 0x34, 0x9c, 0xea, 0x28,
+	0x0b, 0xd3, 0xf5, 0x0f, 0x87, 0xe0, 0x3c, 0x83, 0x31, 0xbc, 0xdd, 0x81, 0x7b, 0x1a, 0xbe, 0x2b,
+	0xc0, 0x71, 0xa6, 0xf5, 0x03, 0xc3, 0xdb, 0x55, 0xd2, 0x1a, 0x1c, 0xee, 0xf6, 0x6a, 0x80, 0x6e,
+	0x0c, 0xc9, 0xb3, 0x6e, 0x14, 0x90, 0x33, 0xfa, 0x08, 0xa6, 0xc3, 0x45, 0x40, 0x07, 0x9f, 0xc6,
+	0x4c, 0xb5, 0x5d, 0xcd, 0xf5, 0x56, 0x58, 0x15, 0x43, 0xa5, 0x3f, 0xc7, 0x5b, 0xc5, 0xa8, 0xd2,
+	0x0d, 0x58, 0xee, 0xce, 0x55, 0xe6, 0xab, 0xb3, 0xf4, 0xd7, 0x02, 0x9c, 0x92, 0x51, 0xc3, 0xde,
+	0x47, 0x94, 0xd2, 0x11, 0x9f, 0x34, 0x9e, 0xdf, 0x25, 0x28, 0x7a, 0x7b, 0xc9, 0xc5, 0x6e, 0x2f,
+	0x92, 0x04, 0x8b, 0x9d, 0xd9, 0x67, 0xee, 0xf4, 0x8f, 0x43, 0x70, 0x7a, 0x13, 0x39, 0x0d, 0xc3,
+	0x52, 0x3d, 0x34, 0x88, 0x23, 0xd9, 0x30, 0xe9, 0x71, 0x3a, 0x31, 0xff, 0xb9, 0xd9, 0xd5, 0x7f,
+	0xba, 0x72, 0x20, 0x57, 0x7c, 0xe2, 0xbf, 0x00, 0x61, 0x7c, 0x16, 0xa4, 0x2c, 0x89, 0x98, 0xea,
+	0xff, 0x47, 0x80, 0xda, 0x2a, 0x32, 0xd1, 0x60, 0x7a, 0x7f, 0x7e, 0xde, 0xf5, 0x12, 0x54, 0x7c,
+	0xca, 0xec, 0x4d, 0x80, 0x5d, 0xb0, 0xfd, 0x8a, 0x3d, 0x7b, 0x3c, 0x20, 0x4f, 0x16, 0xa6, 0xed,
+	0xa2, 0x74, 0x0d, 0x89, 0x74, 0x2e, 0x9e, 0xe9, 0x3a, 0xca, 0xce, 0xf4, 0xf3, 0x67, 0x02, 0x9c,
+	0x24, 0x25, 0xeb, 0x01, 0x7b, 0xb6, 0x1c, 0x4c, 0xa3, 0xef, 0x9e, 0xad, 0xcc, 0x95, 0xe5, 0x71,
+	0x42, 0x94, 0x6f, 0x93, 0x6f, 0x40, 0xad, 0x13, 0x78, 0x76, 0x86, 0xf9, 0xc3, 0x1c, 0x2c, 0x31,
+	0x22, 0x74, 0x53, 0x1d, 0x44, 0xd4, 0x46, 0x87, 0x83, 0xc1, 0xed, 0x1e, 0x64, 0xed, 0x81, 0x85,
+	0xd8, 0xd9, 0x40, 0x7c, 0x33, 0x14, 0x7f, 0xac, 0x5d, 0x2b, 0x59, 0x8a, 0xa9, 0x72, 0x90, 0x35,
+	0x0e, 0xc1, 0x4b, 0x32, 0x5d, 0xc2, 0x37, 0xff, 0xfc, 0xc3, 0xb7, 0xd0, 0x29, 0x7c, 0x97, 0xe1,
+	0x5c, 0x37, 0x8d, 0x30, 0x17, 0xfd, 0x68, 0x08, 0x16, 0x78, 0x49, 0x21, 0x7c, 0x8b, 0xf9, 0x4c,
+	0xc4, 0xef, 0x55, 0x98, 0x35, 0x5c, 0x25, 0xa5, 0x91, 0x8c, 0xb5, 0x40, 0x4d, 0x19, 0xee, 0xed,
+	0x78, 0x87, 0x58, 0x50, 0x49, 0xc8, 0x1f, 0xb1, 0x92, 0x50, 0x83, 0x13, 0xe9, 0x1a, 0x61, 0x2a,
+	0xfb, 0x77, 0x01, 0xce, 0x3f, 0x46, 0x8e, 0xb1, 0xdd, 0x4e, 0x2c, 0xce, 0xf1, 0x3e, 0x1b, 0x15,
+	0x46, 0x5f, 0x13, 0xb9, 0x23, 0x6a, 0xe2, 0x02, 0x2c, 0x77, 0x17, 0x94, 0x69, 0xe5, 0x7f, 0x73,
+	0x70, 0x96, 0x5e, 0x16, 0x57, 0xb0, 0x3b, 0xfa, 0x5c, 0x1c, 0xe5, 0x6a, 0xf7, 0xfc, 0x54, 0x52,
+	0x07, 0xd6, 0x48, 0x1a, 0x0a, 0x78, 0x3f, 0xd4, 0x27, 0xe9, 0x94, 0x1f, 0xe8, 0x6b, 0xba, 0xf8,
+	0x1e, 0x4c, 0xf1, 0x6b, 0xa0, 0x3e, 0x48, 0x6c, 0x8b, 0x3e, 0x95, 0x80, 0x97, 0x75, 0xff, 0x02,
+	0x4b, 0x1e, 0x6f, 0x48, 0x49, 0xb3, 0xd0, 0x4f, 0x49, 0xb3, 0x1c, 0xa0, 0x93, 0x81, 0xc0, 0xe0,
+	0xc3, 0x47, 0x2c, 0xee, 0x5f, 0x83, 0x6a, 0x42, 0x3d, 0x7c, 0xe3, 0x1c, 0x61, 0xaf, 0x64, 0x51,
+	0x1d, 0xb1, 0xfd, 0x53, 0x3a, 0x0f, 0x4b, 0x5d, 0xac, 0xcf, 0xf7, 0xc4, 0x1c, 0x5c, 0xa4, 0x4e,
+	0x95, 0x0a, 0x49, 0x72, 0x13, 0xa6, 0xd3, 0x97, 0xc3, 0x6c, 0x42, 0x25, 0xde, 0x72, 0xdc, 0xbf,
+	0xbb, 0x94, 0x63, 0x2d, 0xc6, 0xa2, 0x0c, 0x65, 0x9a, 0x75, 0x07, 0x38, 0x93, 0x95, 0xb4, 0x88,
+	0x94, 0x9d, 0x1c, 0x30, 0xdf, 0xc9, 0x01, 0xb3, 0x2c, 0x52, 0xc8, 0xb2, 0xc8, 0xc0, 0xce, 0x20,
+	0xbd, 0x0a, 0xf5, 0x5e, 0x0d, 0xc5, 0x6c, 0xfb, 0xa7, 0x02, 0x2c, 0xae, 0x22, 0x57, 0x73, 0x8c,
+	0xad, 0x81, 0x4e, 0x84, 0xdf, 0x80, 0x91, 0x7e, 0x4b, 0x1e, 0xdd, 0x96, 0x95, 0x39, 0x45, 0xe9,
+	0xf7, 0xf3, 0x70, 0x3a, 0x03, 0x9a, 0x1d, 0x77, 0xbe, 0x09, 0x95, 0xe0, 0xa5, 0x12, 0xb7, 0x17,
+	0x1b, 0x3b, 0xac, 0xd2, 0x7a, 0x39, 0x9d, 0x97, 0x54, 0xf3, 0xaf, 0x10, 0x44, 0xb9, 0x8c, 0xa2,
+	0x03, 0xe2, 0x0e, 0xcc, 0xa5, 0x3c, 0x88, 0x92, 0x26, 0x79, 0x2a, 0xf0, 0xa5, 0x3e, 0x16, 0xa1,
+	0x2f, 0xaf, 0x07, 0x69, 0xc3, 0xe2, 0x37, 0x41, 0x6c, 0x22, 0x4b, 0x37, 0xac, 0x1d, 0x85, 0x55,
+	0x5b, 0xf1, 0x53, 0x63, 0x8e, 0xd4, 0x6f, 0x2f, 0x76, 0x5e, 0x63, 0x9d, 0xe2, 0xf0, 0x92, 0x09,
+	0x59, 0x61, 0xb2, 0x19, 0x19, 0xc4, 0x8f, 0x89, 0xdf, 0x82, 0x0a, 0xa7, 0x4e, 0xdc, 0xdc, 0x21,
+	0x8d, 0x66, 0x98, 0xf6, 0xd5, 0xae, 0xb4, 0xa3, 0x4e, 0x45, 0x56, 0x28, 0x37, 0x43, 0x53, 0x0e,
+	0xb2, 0x44, 0x04, 0x33, 0x9c, 0x7e, 0x74, 0xfb, 0x2f, 0x74, 0xb3, 0x04, 0x5b, 0x24, 0xf1, 0x40,
+	0x3d, 0xd5, 0x4c, 0x4e, 0x48, 0xbf, 0x99, 0x83, 0xaa, 0xcc, 0xbe, 0x32, 0x41, 0x24, 0x93, 0xba,
+	0x8f, 0xaf, 0x7c, 0x26, 0xb6, 0xab, 0x6d, 0x98, 0x89, 0xb6, 0x45, 0xb5, 0x15, 0xc3, 0x43, 0x0d,
+	0x6e, 0xc1, 0x2b, 0x7d, 0xb5, 0x46, 0xb5, 0xd7, 0x3c, 0xd4, 0x90, 0xa7, 0xf6, 0x13, 0x63, 0xae,
+	0x78, 0x0d, 0x86, 0xc9, 0xfe, 0xe3, 0x56, 0xf3, 0xd9, 0x6f, 0x47, 0xab, 0xaa, 0xa7, 0xde, 0x34,
+	0xed, 0x2d, 0x99, 0xc1, 0x8b, 0xb7, 0xa1, 0x84, 0xbf, 0x76, 0xc0, 0x57, 0x03, 0x46, 0xa1, 0xd0,
+	0x23, 0x85, 0x71, 0x0b, 0x1d, 0xc8, 0x2d, 0xba, 0x73, 0xb9, 0xd2, 0x02, 0x1c, 0x4f, 0x31, 0x01,
+	0xcb, 0x2b, 0xff, 0x40, 0xee, 0x51, 0x6c, 0xf6, 0x9d, 0x70, 0xf3, 0x15, 0xb7, 0x92, 0x92, 0x68,
+	0xf0, 0xa2, 0xc1, 0x7a, 0x2d, 0x55, 0x43, 0xa1, 0x6f, 0x7d, 0xc2, 0xa6, 0x88, 0x94, 0x17, 0x62,
+	0x4d, 0x5e, 0x4b, 0x50, 0x72, 0x50, 0xc3, 0xf6, 0x90, 0xa2, 0x99, 0x2d, 0xd7, 0x43, 0x0e, 0xb1,
+	0xef, 0xa8, 0x3c, 0x41, 0x47, 0x57, 0xe8, 0x60, 0xc2, 0x5b, 0x72, 0x09, 0x6f, 0x91, 0x16, 0xa1,
+	0xd6, 0x49, 0x16, 0x26, 0xee, 0x1f, 0x0b, 0x30, 0xbb, 0xd1, 0xb6, 0xb4, 0x8d, 0x5d, 0xd5, 0xd1,
+	0x59, 0x6f, 0x18, 0x93, 0x73, 0x09, 0x4a, 0xae, 0xdd, 0x72, 0xb4, 0x80, 0x0d, 0xea, 0x8f, 0x13,
+	0x74, 0x94, 0xb3, 0x71, 0x1c, 0x8a, 0x2e, 0x46, 0xe6, 0xdd, 0x2d, 0x05, 0x79, 0x84, 0xfc, 0x5e,
+	0xd3, 0xc5, 0x1b, 0x30, 0x46, 0x9b, 0xd4, 0xe8, 0x2b, 0x64, 0xae, 0xc7, 0x57, 0x48, 0xa0, 0x48,
+	0x78, 0x58, 0x3a, 0x0e, 0x73, 0x09, 0xf6, 0x18, 0xeb, 0x3f, 0x2b, 0xc0, 0x14, 0x9e, 0xe3, 0x99,
+	0xa3, 0x8f, 0x28, 0x3a, 0x05, 0x63, 0xa1, 0x4f, 0x3a, 0x98, 0x7a, 0x21, 0xf8, 0x14, 0x23, 0x74,
+	0x03, 0xcd, 0x85, 0xbf, 0xac, 0xa8, 0xc2, 0x08, 0xdf, 0x10, 0xe9, 0x2e, 0xca, 0x7f, 0x76, 0x78,
+	0x61, 0x2f, 0x74, 0x78, 0x61, 0x4f, 0x36, 0x86, 0x0c, 0x1f, 0xad, 0x31, 0x24, 0xad, 0x05, 0x68,
+	0x24, 0xb5, 0x05, 0x28, 0xfe, 0x06, 0x5d, 0x3c, 0xca, 0x1b, 0xf4, 0x3a, 0xeb, 0x57, 0x0d, 0xde,
+	0x86, 0x08, 0xad, 0xd1, 0x1e, 0x69, 0x4d, 0x62, 0x64, 0xff, 0x4d, 0x87, 0x50, 0xbc, 0x0e, 0x23,
+	0xfc, 0x29, 0x19, 0x7a, 0x7c, 0x4a, 0xe6, 0x08, 0xe1, 0x17, 0xf1, 0xb1, 0xe8, 0x8b, 0xf8, 0x0a,
+	0x8c, 0x13, 0x3e, 0xf9, 0x47, 0x4b, 0xe3, 0x3d, 0x7e, 0xb4, 0x34, 0x46, 0x9a, 0x1c, 0xe9, 0x0f,
+	0x5c, 0xa6, 0x21, 0x44, 0xb0, 0x5b, 0x20, 0x47, 0x31, 0x74, 0x64, 0x79, 0x86, 0xd7, 0x26, 0xcd,
+	0x37, 0xa3, 0xb2, 0x88, 0xe7, 0xde, 0x21, 0x53, 0x6b, 0x6c, 0x06, 0x77, 0x67, 0xc6, 0x52, 0x28,
+	0xeb, 0x2b, 0xad, 0xf7, 0x97, 0x3c, 0xe5, 0x52, 0x34, 0x71, 0x4a, 0xb3, 0x30, 0x1d, 0xf5, 0x74,
+	0x16, 0x02, 0xb8, 0x65, 0x92, 0x9f, 0x2f, 0x5e, 0x70, 0x0b, 0xb9, 0xf4, 0xdf, 0x02, 0x9c, 0x48,
+	0xe7, 0x85, 0x1d, 0x73, 0x76, 0x61, 0x4a, 0x53, 0xb5, 0x5d, 0x14, 0xfd, 0xcc, 0x71, 0xe0, 0xe4,
+	0x39, 0x49, 0x88, 0x86, 0x87, 0x44, 0x0b, 0x66, 0x75, 0xd5, 0x53, 0xb7, 0x54, 0x37, 0xbe, 0xd8,
+	0xd0, 0x80, 0x8b, 0x4d, 0x73, 0xba, 0xe1, 0x51, 0xe9, 0x9f, 0x04, 0x98, 0xe7, 0xa2, 0x33, 0x93,
+	0xdd, 0xb5, 0xdd, 0xf0, 0x7b, 0xeb, 0xae, 0xed, 0x7a, 0x8a, 0xaa, 0xeb, 0x0e, 0x72, 0x5d, 0x6e,
+	0x05, 0x3c, 0x76, 0x83, 0x0e, 0x65, 0x25, 0xd1, 0xee, 0x69, 0xbe, 0xc3, 0xa1, 0x20, 0x3f, 0xf8,
+	0xa1, 0x40, 0xfa, 0xd7, 0x90, 0x83, 0x45, 0x24, 0x63, 0x36, 0x3d, 0x03, 0x13, 0x84, 0x4f, 0x57,
+	0xb1, 0x5a, 0x8d, 0x2d, 0xb6, 0x45, 0x14, 0xe4, 0x71, 0x3a, 0xf8, 0x90, 0x8c, 0x89, 0x0b, 0x30,
+	0xca, 0x85, 0xa3, 0xef, 0xf9, 0x05, 0xb9, 0xc8, 0xa4, 0xc3, 0x5f, 0x8a, 0x94, 0x03, 0xf1, 0x88,
+	0x29, 0x33, 0xbf, 0xdd, 0xf4, 0x61, 0xb1, 0x08, 0x7e, 0x4b, 0xc7, 0x0a, 0xc6, 0x23, 0x87, 0xae,
+	0x92, 0x15, 0x19, 0x23, 0x39, 0x82, 0xa9, 0x9d, 0xf6, 0x2b, 0xf1, 0x9f, 0xf7, 0xf2, 0xc5, 0x7c,
+	0xa5, 0x20, 0xd5, 0x61, 0x72, 0xc5, 0xb4, 0x5d, 0x44, 0x36, 0x18, 0x6e, 0xb0, 0xb0, 0x35, 0x84,
+	0x88, 0x35, 0xa4, 0x69, 0x10, 0xc3, 0xf0, 0x2c, 0x0e, 0x5f, 0x81, 0xf2, 0x1d, 0xe4, 0xf5, 0x4a,
+	0xe3, 0x09, 0x54, 0x02, 0x68, 0xa6, 0xc8, 0xfb, 0x00, 0x0c, 0x1c, 0x1f, 0xcc, 0x69, 0x4c, 0x5c,
+	0xec, 0xc5, 0x4d, 0x09, 0x19, 0x22, 0xfa, 0xa8, 0xcb, 0xff, 0x94, 0xfe, 0x59, 0x80, 0x49, 0xfa,
+	0x98, 0x11, 0xae, 0xaf, 0x75, 0x66, 0x49, 0xbc, 0x0d, 0x45, 0x4d, 0xf5, 0xd0, 0x0e, 0x4e, 0x59,
+	0x43, 0xa4, 0xa1, 0xfc, 0x42, 0x76, 0xbb, 0x3a, 0x7d, 0xd9, 0xa4, 0x18, 0xb2, 0x8f, 0x1b, 0x6e,
+	0x1d, 0xcb, 0x45, 0x5a, 0xc7, 0xd6, 0xa0, 0xbc, 0x6f, 0xb8, 0xc6, 0x96, 0x61, 0x92, 0xd6, 0x8e,
+	0x7e, 0x9a, 0x92, 0x4a, 0x01, 0x22, 0x39, 0x12, 0x4c, 0x83, 0x18, 0x96, 0x8d, 0x17, 0x17, 0x05,
+	0x38, 0x79, 0x07, 0x79, 0x72, 0xf0, 0x05, 0x37, 0x6b, 0x08, 0xf4, 0xcf, 0x33, 0xf7, 0x61, 0x98,
+	0x74, 0x6a, 0xe2, 0x00, 0xcc, 0x75, 0x74, 0xb0, 0xd0, 0x27, 0xe0, 0xb4, 0xd8, 0xeb, 0xff, 0x24,
+	0x3d, 0x9d, 0x32, 0xa3, 0x81, 0xc3, 0x92, 0x1d, 0x8b, 0x48, 0xcb, 0x11, 0x3b, 0x43, 0x8c, 0xb1,
+	0x31, 0xec, 0x99, 0xd2, 0x0f, 0x86, 0xa0, 0xd6, 0x89, 0x25, 0x66, 0xf6, 0x6f, 0x43, 0x89, 0x9a,
+	0xc4, 0xef, 0x73, 0xa4, 0xbc, 0xbd, 0xdb, 0x63, 0x8b, 0x4d, 0x36, 0x79, 0xea, 0x1c, 0x7c, 0x94,
+	0x76, 0x67, 0x4e, 0xb8, 0xe1, 0xb1, 0xf9, 0x36, 0x88, 0x49, 0xa0, 0x70, 0xa7, 0x64, 0x81, 0x76,
+	0x4a, 0x3e, 0x88, 0x76, 0x4a, 0xbe, 0xd1, 0xa7, 0xee, 0x7c, 0xce, 0x82, 0xe6, 0x49, 0xe9, 0x43,
+	0x58, 0xbc, 0x83, 0xbc, 0xd5, 0xfb, 0x8f, 0x32, 0x6c, 0xf6, 0x98, 0x7d, 0xf1, 0x82, 0xa3, 0x82,
+	0xeb, 0xa6, 0xdf, 0xb5, 0xfd, 0x0b, 0xd9, 0xa8, 0xc7, 0xfe, 0x72, 0xa5, 0xdf, 0x12, 0xe0, 0x74,
+	0xc6, 0xe2, 0xcc, 0x3a, 0x4f, 0x60, 0x32, 0x44, 0x96, 0x35, 0x24, 0x09, 0xf1, 0x4b, 0x67, 0xcf,
+	0x4c, 0xc8, 0x15, 0x27, 0x3a, 0xe0, 0x4a, 0xdf, 0x13, 0x60, 0x9a, 0x74, 0x95, 0xf2, 0x6c, 0xdc,
+	0xc7, 0xce, 0xfd, 0xf5, 0x78, 0xe5, 0xe2, 0x4b, 0x5d, 0x2b, 0x17, 0x69, 0x4b, 0x05, 0xd5, 0x8a,
+	0x3d, 0x98, 0x89, 0x01, 0x30, 0x3d, 0xc8, 0x50, 0x8c, 0xb5, 0x80, 0xbd, 0xde, 0xef, 0x52, 0x14,
+	0x5b, 0xf6, 0xe9, 0x48, 0xbf, 0x27, 0xc0, 0xb4, 0x8c, 0xd4, 0x66, 0xd3, 0xa4, 0x15, 0x46, 0xb7,
+	0x0f, 0xc9, 0x37, 0xe2, 0x92, 0xa7, 0xb7, 0x91, 0x87, 0xff, 0xdb, 0x01, 0x35, 0x47, 0x72, 0xb9,
+	0x40, 0xfa, 0x39, 0x98, 0x89, 0x01, 0x30, 0x4e, 0xff, 0x7c, 0x08, 0x66, 0xa8, 0xaf, 0xc4, 0xbd,
+	0xf3, 0x16, 0xe4, 0xfd, 0x6f, 0x05, 0x4a, 0xe1, 0x12, 0x41, 0x5a, 0xc6, 0x5c, 0x45, 0xaa, 0x7e,
+	0x1f, 0x79, 0x1e, 0x72, 0x48, 0x6b, 0x1a, 0x69, 0x63, 0x24, 0xe8, 0x59, 0x9b, 0x7f, 0xf2, 0x0e,
+	0x96, 0x4b, 0xbb, 0x83, 0xbd, 0x01, 0x55, 0xc3, 0xc2, 0x10, 0xc6, 0x3e, 0x52, 0x90, 0xe5, 0xa7,
+	0x93, 0xa0, 0xdc, 0x37, 0xe3, 0xcf, 0xdf, 0xb2, 0x78, 0xb0, 0xaf, 0xe9, 0xe2, 0x05, 0x98, 0x6c,
+	0xa8, 0x87, 0x46, 0xa3, 0xd5, 0x50, 0x9a, 0x18, 0xde, 0x35, 0x3e, 0xa4, 0xff, 0xaa, 0xa0, 0x20,
+	0x97, 0xd9, 0xc4, 0xba, 0xba, 0x83, 0x36, 0x8c, 0x0f, 0x91, 0x78, 0x0e, 0xca, 0xe4, 0x23, 0x02,
+	0x02, 0x48, 0x7b, 0xde, 0x87, 0x49, 0xcf, 0x3b, 0xf9, 0xb6, 0x00, 0x83, 0xd1, 0x8f, 0xfc, 0xfe,
+	0x83, 0x7e, 0x23, 0x1e, 0xd1, 0x17, 0x73, 0xa4, 0x67, 0xa4, 0xb0, 0xd4, 0xb8, 0x1c, 0x7a, 0x86,
+	0x71, 0x99, 0x26, 0x6b, 0x2e, 0x4d, 0xd6, 0x7f, 0xc1, 0xdf, 0x6f, 0xb6, 0x9c, 0x1d, 0xf4, 0x79,
+	0xf4, 0x0e, 0x69, 0x1e, 0xaa, 0x49, 0xe1, 0x78, 0xe7, 0xd9, 0x10, 0xcc, 0x3d, 0x40, 0x9f, 0x53,
+	0xc9, 0x9f, 0x4b, 0x5c, 0xdc, 0x84, 0xea, 0x03, 0x94, 0xae, 0xcd, 0x34, 0x1a, 0x42, 0x1a, 0x8d,
+	0x1f, 0x90, 0x6f, 0xe4, 0xb6, 0x1d, 0xe4, 0xee, 0x86, 0xcb, 0x8a, 0xfd, 0x24, 0xcf, 0xf7, 0xe2,
+	0xc9, 0xf3, 0x6b, 0x3d, 0x26, 0xcf, 0x8e, 0xab, 0x06, 0x39, 0x94, 0x7c, 0x36, 0x97, 0x06, 0xc7,
+	0x3f, 0x9b, 0x13, 0x70, 0xf7, 0x9f, 0xea, 0x68, 0xbb, 0xc6, 0xfe, 0x40, 0x55, 0xfb, 0x27, 0x30,
+	0xd2, 0xf1, 0xd9, 0x3e, 0x7b, 0x07, 0xc8, 0x5e, 0x3b, 0x10, 0xe5, 0x2c, 0x48, 0x59, 0xd0, 0x4c,
+	0xa0, 0xef, 0x0b, 0x70, 0xe1, 0x0e, 0xb2, 0x90, 0xa3, 0x7a, 0xe8, 0x3e, 0x2e, 0x6e, 0xb0, 0x0b,
+	0x7c, 0x2c, 0x9f, 0xbc, 0x88, 0xfb, 0xf8, 0x45, 0x78, 0xb9, 0x27, 0xce, 0x98, 0x24, 0xb7, 0x61,
+	0x21, 0x7a, 0x98, 0x8c, 0x16, 0x03, 0xcf, 0x43, 0x39, 0x5a, 0x93, 0xa4, 0x07, 0xa1, 0x51, 0xb9,
+	0x14, 0x29, 0x4a, 0xba, 0x52, 0x0b, 0x4e, 0xa4, 0xd3, 0x61, 0x9e, 0xfe, 0x36, 0x0c, 0xd3, 0xcb,
+	0x21, 0x3b, 0x48, 0xbd, 0xd9, 0xe3, 0x49, 0x97, 0x5d, 0x97, 0xe2, 0x64, 0x19, 0x31, 0xe9, 0x6f,
+	0x86, 0x61, 0x36, 0x1d, 0x24, 0xeb, 0xda, 0xf3, 0x25, 0x98, 0x6b, 0xa8, 0x87, 0x4a, 0x7c, 0x33,
+	0x09, 0x3e, 0xd4, 0x9b, 0x6e, 0xa8, 0x87, 0xf1, 0xa3, 0xa4, 0x2e, 0xde, 0x87, 0x0a, 0xa5, 0x68,
+	0xda, 0x9a, 0x6a, 0xf6, 0x5a, 0xdc, 0x1c, 0xc6, 0xb7, 0x99, 0xaa, 0x20, 0xd3, 0x13, 0xff, 0x7d,
+	0x8c, 0x8a, 0x27, 0xc5, 0x0f, 0x93, 0xaa, 0xa5, 0x0f, 0x1b, 0x8f, 0x06, 0x52, 0x4d, 0x5d, 0x8e,
+	0x18, 0x86, 0x9e, 0xfe, 0x63, 0xd6, 0x12, 0x7f, 0x5b, 0x80, 0xa9, 0x5d, 0xd5, 0xd2, 0xed, 0x7d,
+	0x76, 0x8f, 0x21, 0x6e, 0x88, 0xef, 0xca, 0xfd, 0x7c, 0x20, 0xd6, 0x81, 0x81, 0xbb, 0x8c, 0xb0,
+	0x7f, 0x4d, 0x67, 0x4c, 0x88, 0xbb, 0x89, 0x09, 0xb1, 0x09, 0x67, 0x53, 0x2d, 0x11, 0xbf, 0x34,
+	0xf6, 0x5a, 0x27, 0x5d, 0x4c, 0x1a, 0xee, 0x71, 0xe4, 0x1a, 0x39, 0xff, 0x3d, 0x01, 0xa6, 0x52,
+	0x54, 0x94, 0xf2, 0x95, 0xd8, 0xfb, 0xd1, 0xbb, 0xcf, 0x9d, 0x81, 0xb4, 0xb2, 0x8e, 0x1c, 0xb6,
+	0x5e, 0xe8, 0x2e, 0x34, 0xff, 0x5d, 0x01, 0xe6, 0x3a, 0xa8, 0x2b, 0x85, 0x21, 0x39, 0xca, 0xd0,
+	0x57, 0x7a, 0x64, 0x28, 0xb1, 0x00, 0xb9, 0x15, 0x85, 0x6e, 0x64, 0xef, 0xc2, 0x4c, 0x2a, 0x8c,
+	0xf8, 0x16, 0x9c, 0xf0, 0xbd, 0x24, 0x2d, 0x58, 0x04, 0x12, 0x2c, 0xc7, 0x39, 0x4c, 0x22, 0x62,
+	0xa4, 0x1f, 0x0a, 0xb0, 0xd8, 0x4d, 0x1f, 0xf8, 0x2b, 0x55, 0x55, 0xdb, 0x43, 0x7a, 0x8c, 0xec,
+	0x18, 0x19, 0x64, 0xa1, 0xf7, 0x3e, 0xcc, 0x87, 0x60, 0xe2, 0xde, 0xd1, 0xeb, 0x87, 0x55, 0x73,
+	0x3e, 0xc9, 0xa8, 0x53, 0x48, 0xbf, 0x23, 0xc0, 0xbc, 0x8c, 0xb6, 0x5a, 0x86, 0xa9, 0xbf, 0xe8,
+	0x7a, 0xea, 0x49, 0x58, 0x48, 0xe5, 0x84, 0xe5, 0xeb, 0xbf, 0x1a, 0x82, 0xa5, 0x68, 0x4f, 0x60,
+	0x20, 0x0a, 0x7d, 0x2c, 0x7f, 0x01, 0x4c, 0xe3, 0x07, 0x82, 0x58, 0x6b, 0x72, 0x5f, 0x2f, 0x3f,
+	0x93, 0x91, 0xd6, 0x64, 0xfe, 0xe4, 0xe0, 0x53, 0x24, 0x9d, 0x91, 0xfd, 0x15, 0x8f, 0x7c, 0x8a,
+	0xa4, 0x6a, 0x47, 0x6c, 0xbc, 0x0c, 0xe7, 0xba, 0x29, 0x8e, 0xe9, 0xf8, 0x4f, 0x04, 0xa8, 0xbd,
+	0xdd, 0xd4, 0x07, 0xec, 0xf5, 0xfd, 0x55, 0x18, 0xe9, 0xb7, 0x45, 0x3f, 0x7b, 0xd1, 0xe0, 0x90,
+	0xf2, 0x6d, 0x38, 0xd5, 0x11, 0xd4, 0x6f, 0x2e, 0x88, 0xdf, 0xdd, 0xbf, 0x76, 0xf4, 0xe5, 0xe3,
+	0xb7, 0xf8, 0x9b, 0xcd, 0x8f, 0x3f, 0xa9, 0x1d, 0xfb, 0xc9, 0x27, 0xb5, 0x63, 0x3f, 0xff, 0xa4,
+	0x26, 0xfc, 0xc6, 0xd3, 0x9a, 0xf0, 0xa3, 0xa7, 0x35, 0xe1, 0xef, 0x9f, 0xd6, 0x84, 0x8f, 0x9f,
+	0xd6, 0x84, 0x7f, 0x7b, 0x5a, 0x13, 0x7e, 0xf6, 0xb4, 0x76, 0xec, 0xe7, 0x4f, 0x6b, 0xc2, 0x47,
+	0x9f, 0xd6, 0x8e, 0x7d, 0xfc, 0x69, 0xed, 0xd8, 0x4f, 0x3e, 0xad, 0x1d, 0x7b, 0xef, 0xfa, 0x8e,
+	0x1d, 0xf0, 0x60, 0xd8, 0x99, 0xff, 0xbc, 0xf4, 0x57, 0xa2, 0x23, 0x5b, 0xc3, 0xc4, 0xd4, 0x57,
+	0xff, 0x6f, 0x00, 0xca, 0xb2, 0x0d, 0x6c, 0xfb, 0x54, 0x00, 0x00,
 }
 
 func (this *StartWorkflowExecutionRequest) Equal(that interface{}) bool {
@@ -5917,6 +6036,9 @@ func (this *StartWorkflowExecutionRequest) Equal(that interface{}) bool {
 	} else if that1.FirstWorkflowTaskBackoff != nil {
 		return false
 	}
+	if this.WorkflowIdConflictPolicy != that1.WorkflowIdConflictPolicy {
+		return false
+	}
 	return true
 }
 func (this *StartWorkflowExecutionResponse) Equal(that interface{}) bool {
@@ -5944,6 +6066,9 @@ func (this *StartWorkflowExecutionResponse) Equal(that interface{}) bool {
 	if !this.Clock.Equal(that1.Clock) {
 		return false
 	}
+	if this.Started != that1.Started {
+		return false
+	}
 	return true
 }
 func (this *GetMutableStateRequest) Equal(that interface{}) bool {
@@ -6803,6 +6928,15 @@ func (this *SignalWithStartWorkflowExecutionRequest) Equal(that interface{}) boo
 	if !this.SignalWithStartRequest.Equal(that1.SignalWithStartRequest) {
 		return false
 	}
+	if this.WorkflowStartDelay != nil && that1.WorkflowStartDelay != nil {
+		if *this.WorkflowStartDelay != *that1.WorkflowStartDelay {
+			return false
+		}
+	} else if this.WorkflowStartDelay != nil {
+		return false
+	} else if that1.WorkflowStartDelay != nil {
+		return false
+	}
 	return true
 }
 func (this *SignalWithStartWorkflowExecutionResponse) Equal(that interface{}) bool {
@@ -8394,6 +8528,54 @@ func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RearchiveWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RearchiveWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(RearchiveWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.Request.Equal(that1.Request) {
+		return false
+	}
+	return true
+}
+func (this *RearchiveWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RearchiveWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(RearchiveWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 func (this *GenerateLastHistoryReplicationTasksRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -8777,7 +8959,7 @@ func (this *StartWorkflowExecutionRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 13)
+	s := make([]string, 0, 14)
 	s = append(s, "&historyservice.StartWorkflowExecutionRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.StartRequest != nil {
@@ -8796,6 +8978,7 @@ func (this *StartWorkflowExecutionRequest) GoString() string {
 		s = append(s, "LastCompletionResult: "+fmt.Sprintf("%#v", this.LastCompletionResult)+",\n")
 	}
 	s = append(s, "FirstWorkflowTaskBackoff: "+fmt.Sprintf("%#v", this.FirstWorkflowTaskBackoff)+",\n")
+	s = append(s, "WorkflowIdConflictPolicy: "+fmt.Sprintf("%#v", this.WorkflowIdConflictPolicy)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -8803,12 +8986,13 @@ func (this *StartWorkflowExecutionResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&historyservice.StartWorkflowExecutionResponse{")
 	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
 	if this.Clock != nil {
 		s = append(s, "Clock: "+fmt.Sprintf("%#v", this.Clock)+",\n")
 	}
+	s = append(s, "Started: "+fmt.Sprintf("%#v", this.Started)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -9215,12 +9399,13 @@ func (this *SignalWithStartWorkflowExecutionRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&historyservice.SignalWithStartWorkflowExecutionRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.SignalWithStartRequest != nil {
 		s = append(s, "SignalWithStartRequest: "+fmt.Sprintf("%#v", this.SignalWithStartRequest)+",\n")
 	}
+	s = append(s, "WorkflowStartDelay: "+fmt.Sprintf("%#v", this.WorkflowStartDelay)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -9946,6 +10131,28 @@ func (this *RefreshWorkflowTasksResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *RearchiveWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.RearchiveWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RearchiveWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.RearchiveWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *GenerateLastHistoryReplicationTasksRequest) GoString() string {
 	if this == nil {
 		return "nil"
@@ -10149,6 +10356,11 @@ func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.WorkflowIdConflictPolicy != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.WorkflowIdConflictPolicy))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.FirstWorkflowTaskBackoff != nil {
 		n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.FirstWorkflowTaskBackoff, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.FirstWorkflowTaskBackoff):])
 		if err1 != nil {
@@ -10257,6 +10469,16 @@ func (m *StartWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.Started {
+		i--
+		if m.Started {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Clock != nil {
 		{
 			size, err := m.Clock.MarshalToSizedBuffer(dAtA[:i])
@@ -11729,6 +11951,16 @@ func (m *SignalWithStartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []by
 	_ = i
 	var l int
 	_ = l
+	if m.WorkflowStartDelay != nil {
+		n51, err51 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowStartDelay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowStartDelay):])
+		if err51 != nil {
+			return 0, err51
+		}
+		i -= n51
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n51))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.SignalWithStartRequest != nil {
 		{
 			size, err := m.SignalWithStartRequest.MarshalToSizedBuffer(dAtA[:i])
@@ -12883,12 +13115,12 @@ func (m *SyncShardStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if m.StatusTime != nil {
-		n78, err78 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
-		if err78 != nil {
-			return 0, err78
+		n79, err79 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
+		if err79 != nil {
+			return 0, err79
 		}
-		i -= n78
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n78))
+		i -= n79
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n79))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -12999,22 +13231,22 @@ func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.LastHeartbeatTime != nil {
-		n82, err82 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
-		if err82 != nil {
-			return 0, err82
+		n83, err83 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
+		if err83 != nil {
+			return 0, err83
 		}
-		i -= n82
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n82))
+		i -= n83
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n83))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.StartedTime != nil {
-		n83, err83 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err83 != nil {
-			return 0, err83
+		n84, err84 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err84 != nil {
+			return 0, err84
 		}
-		i -= n83
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n83))
+		i -= n84
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n84))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -13024,12 +13256,12 @@ func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x38
 	}
 	if m.ScheduledTime != nil {
-		n84, err84 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err84 != nil {
-			return 0, err84
+		n85, err85 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err85 != nil {
+			return 0, err85
 		}
-		i -= n84
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n84))
+		i -= n85
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n85))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -13273,21 +13505,21 @@ func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		dAtA[i] = 0x1a
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA91 := make([]byte, len(m.ShardIds)*10)
-		var j90 int
+		dAtA92 := make([]byte, len(m.ShardIds)*10)
+		var j91 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA91[j90] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA92[j91] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j90++
+				j91++
 			}
-			dAtA91[j90] = uint8(num)
-			j90++
+			dAtA92[j91] = uint8(num)
+			j91++
 		}
-		i -= j90
-		copy(dAtA[i:], dAtA91[:j90])
-		i = encodeVarintRequestResponse(dAtA, i, uint64(j90))
+		i -= j91
+		copy(dAtA[i:], dAtA92[:j91])
+		i = encodeVarintRequestResponse(dAtA, i, uint64(j91))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -13434,12 +13666,12 @@ func (m *RemoveTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VisibilityTime != nil {
-		n93, err93 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err93 != nil {
-			return 0, err93
+		n94, err94 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err94 != nil {
+			return 0, err94
 		}
-		i -= n93
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n93))
+		i -= n94
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n94))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -14117,6 +14349,71 @@ func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *RearchiveWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RearchiveWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RearchiveWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RearchiveWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RearchiveWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RearchiveWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *GenerateLastHistoryReplicationTasksRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14272,12 +14569,12 @@ func (m *ShardReplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if m.MaxReplicationTaskVisibilityTime != nil {
-		n100, err100 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.MaxReplicationTaskVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.MaxReplicationTaskVisibilityTime):])
-		if err100 != nil {
-			return 0, err100
+		n102, err102 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.MaxReplicationTaskVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.MaxReplicationTaskVisibilityTime):])
+		if err102 != nil {
+			return 0, err102
 		}
-		i -= n100
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n100))
+		i -= n102
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n102))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -14334,12 +14631,12 @@ func (m *ShardReplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		}
 	}
 	if m.ShardLocalTime != nil {
-		n103, err103 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ShardLocalTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ShardLocalTime):])
-		if err103 != nil {
-			return 0, err103
+		n105, err105 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ShardLocalTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ShardLocalTime):])
+		if err105 != nil {
+			return 0, err105
 		}
-		i -= n103
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n103))
+		i -= n105
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n105))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -14405,12 +14702,12 @@ func (m *ShardReplicationStatusPerCluster) MarshalToSizedBuffer(dAtA []byte) (in
 	var l int
 	_ = l
 	if m.AckedTaskVisibilityTime != nil {
-		n104, err104 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AckedTaskVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AckedTaskVisibilityTime):])
-		if err104 != nil {
-			return 0, err104
+		n106, err106 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AckedTaskVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AckedTaskVisibilityTime):])
+		if err106 != nil {
+			return 0, err106
 		}
-		i -= n104
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n104))
+		i -= n106
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n106))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -14508,22 +14805,22 @@ func (m *DeleteWorkflowVisibilityRecordRequest) MarshalToSizedBuffer(dAtA []byte
 	var l int
 	_ = l
 	if m.WorkflowCloseTime != nil {
-		n106, err106 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowCloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowCloseTime):])
-		if err106 != nil {
-			return 0, err106
+		n108, err108 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowCloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowCloseTime):])
+		if err108 != nil {
+			return 0, err108
 		}
-		i -= n106
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n106))
+		i -= n108
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n108))
 		i--
 		dAtA[i] = 0x22
 	}
 	if m.WorkflowStartTime != nil {
-		n107, err107 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowStartTime):])
-		if err107 != nil {
-			return 0, err107
+		n109, err109 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowStartTime):])
+		if err109 != nil {
+			return 0, err109
 		}
-		i -= n107
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n107))
+		i -= n109
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n109))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -14700,6 +14997,9 @@ func (m *StartWorkflowExecutionRequest) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.FirstWorkflowTaskBackoff)
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.WorkflowIdConflictPolicy != 0 {
+		n += 1 + sovRequestResponse(uint64(m.WorkflowIdConflictPolicy))
+	}
 	return n
 }
 
@@ -14717,6 +15017,9 @@ func (m *StartWorkflowExecutionResponse) Size() (n int) {
 		l = m.Clock.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.Started {
+		n += 2
+	}
 	return n
 }
 
@@ -15315,6 +15618,10 @@ func (m *SignalWithStartWorkflowExecutionRequest) Size() (n int) {
 		l = m.SignalWithStartRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.WorkflowStartDelay != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowStartDelay)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -16297,6 +16604,32 @@ func (m *RefreshWorkflowTasksResponse) Size() (n int) {
 	return n
 }
 
+func (m *RearchiveWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RearchiveWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *GenerateLastHistoryReplicationTasksRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16540,6 +16873,7 @@ func (this *StartWorkflowExecutionRequest) String() string {
 		`ContinuedFailure:` + strings.Replace(fmt.Sprintf("%v", this.ContinuedFailure), "Failure", "v13.Failure", 1) + `,`,
 		`LastCompletionResult:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionResult), "Payloads", "v14.Payloads", 1) + `,`,
 		`FirstWorkflowTaskBackoff:` + strings.Replace(fmt.Sprintf("%v", this.FirstWorkflowTaskBackoff), "Duration", "types.Duration", 1) + `,`,
+		`WorkflowIdConflictPolicy:` + fmt.Sprintf("%v", this.WorkflowIdConflictPolicy) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -16550,7 +16884,8 @@ func (this *StartWorkflowExecutionResponse) String() string {
 	}
 	s := strings.Join([]string{`&StartWorkflowExecutionResponse{`,
 		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v15.VectorClock", 1) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v16.VectorClock", 1) + `,`,
+		`Started:` + fmt.Sprintf("%v", this.Started) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -16578,8 +16913,8 @@ func (this *GetMutableStateResponse) String() string {
 		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
 		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
 		`LastFirstEventId:` + fmt.Sprintf("%v", this.LastFirstEventId) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v16.TaskQueue", 1) + `,`,
-		`StickyTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueue), "TaskQueue", "v16.TaskQueue", 1) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v17.TaskQueue", 1) + `,`,
+		`StickyTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueue), "TaskQueue", "v17.TaskQueue", 1) + `,`,
 		`StickyTaskQueueScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueueScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
 		`CurrentBranchToken:` + fmt.Sprintf("%v", this.CurrentBranchToken) + `,`,
 		`WorkflowState:` + fmt.Sprintf("%v", this.WorkflowState) + `,`,
@@ -16615,8 +16950,8 @@ func (this *PollMutableStateResponse) String() string {
 		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
 		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
 		`LastFirstEventId:` + fmt.Sprintf("%v", this.LastFirstEventId) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v16.TaskQueue", 1) + `,`,
-		`StickyTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueue), "TaskQueue", "v16.TaskQueue", 1) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v17.TaskQueue", 1) + `,`,
+		`StickyTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueue), "TaskQueue", "v17.TaskQueue", 1) + `,`,
 		`StickyTaskQueueScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueueScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
 		`CurrentBranchToken:` + fmt.Sprintf("%v", this.CurrentBranchToken) + `,`,
 		`VersionHistories:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistories), "VersionHistories", "v18.VersionHistories", 1) + `,`,
@@ -16659,7 +16994,7 @@ func (this *RecordWorkflowTaskStartedRequest) String() string {
 		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
 		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
 		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollWorkflowTaskQueueRequest", "v1.PollWorkflowTaskQueueRequest", 1) + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v15.VectorClock", 1) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v16.VectorClock", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -16692,12 +17027,12 @@ func (this *RecordWorkflowTaskStartedResponse) String() string {
 		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
 		`StickyExecutionEnabled:` + fmt.Sprintf("%v", this.StickyExecutionEnabled) + `,`,
 		`TransientWorkflowTask:` + strings.Replace(fmt.Sprintf("%v", this.TransientWorkflowTask), "TransientWorkflowTaskInfo", "v18.TransientWorkflowTaskInfo", 1) + `,`,
-		`WorkflowExecutionTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionTaskQueue), "TaskQueue", "v16.TaskQueue", 1) + `,`,
+		`WorkflowExecutionTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionTaskQueue), "TaskQueue", "v17.TaskQueue", 1) + `,`,
 		`BranchToken:` + fmt.Sprintf("%v", this.BranchToken) + `,`,
 		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`Queries:` + mapStringForQueries + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v15.VectorClock", 1) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v16.VectorClock", 1) + `,`,
 		`Messages:` + repeatedStringForMessages + `,`,
 		`}`,
 	}, "")
@@ -16714,7 +17049,7 @@ func (this *RecordActivityTaskStartedRequest) String() string {
 		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
 		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
 		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollActivityTaskQueueRequest", "v1.PollActivityTaskQueueRequest", 1) + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v15.VectorClock", 1) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v16.VectorClock", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -16731,7 +17066,7 @@ func (this *RecordActivityTaskStartedResponse) String() string {
 		`HeartbeatDetails:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatDetails), "Payloads", "v14.Payloads", 1) + `,`,
 		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v14.WorkflowType", 1) + `,`,
 		`WorkflowNamespace:` + fmt.Sprintf("%v", this.WorkflowNamespace) + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v15.VectorClock", 1) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v16.VectorClock", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -16893,6 +17228,7 @@ func (this *SignalWithStartWorkflowExecutionRequest) String() string {
 	s := strings.Join([]string{`&SignalWithStartWorkflowExecutionRequest{`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`SignalWithStartRequest:` + strings.Replace(fmt.Sprintf("%v", this.SignalWithStartRequest), "SignalWithStartWorkflowExecutionRequest", "v1.SignalWithStartWorkflowExecutionRequest", 1) + `,`,
+		`WorkflowStartDelay:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowStartDelay), "Duration", "types.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17024,7 +17360,7 @@ func (this *ScheduleWorkflowTaskRequest) String() string {
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
 		`IsFirstWorkflowTask:` + fmt.Sprintf("%v", this.IsFirstWorkflowTask) + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v15.VectorClock", 1) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v16.VectorClock", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17045,7 +17381,7 @@ func (this *VerifyFirstWorkflowTaskScheduledRequest) String() string {
 	s := strings.Join([]string{`&VerifyFirstWorkflowTaskScheduledRequest{`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v15.VectorClock", 1) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v16.VectorClock", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17069,7 +17405,7 @@ func (this *RecordChildExecutionCompletedRequest) String() string {
 		`ParentInitiatedId:` + fmt.Sprintf("%v", this.ParentInitiatedId) + `,`,
 		`CompletedExecution:` + strings.Replace(fmt.Sprintf("%v", this.CompletedExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
 		`CompletionEvent:` + strings.Replace(fmt.Sprintf("%v", this.CompletionEvent), "HistoryEvent", "v111.HistoryEvent", 1) + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v15.VectorClock", 1) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v16.VectorClock", 1) + `,`,
 		`ParentInitiatedVersion:` + fmt.Sprintf("%v", this.ParentInitiatedVersion) + `,`,
 		`}`,
 	}, "")
@@ -17094,7 +17430,7 @@ func (this *VerifyChildExecutionCompletionRecordedRequest) String() string {
 		`ChildExecution:` + strings.Replace(fmt.Sprintf("%v", this.ChildExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
 		`ParentInitiatedId:` + fmt.Sprintf("%v", this.ParentInitiatedId) + `,`,
 		`ParentInitiatedVersion:` + fmt.Sprintf("%v", this.ParentInitiatedVersion) + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v15.VectorClock", 1) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v16.VectorClock", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17560,6 +17896,26 @@ func (this *RefreshWorkflowTasksResponse) String() string {
 	}, "")
 	return s
 }
+func (this *RearchiveWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RearchiveWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "RearchiveWorkflowExecutionRequest", "v116.RearchiveWorkflowExecutionRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RearchiveWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RearchiveWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GenerateLastHistoryReplicationTasksRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -18047,6 +18403,25 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowIdConflictPolicy", wireType)
+			}
+			m.WorkflowIdConflictPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowIdConflictPolicy |= v15.WorkflowIdConflictPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -18162,12 +18537,32 @@ func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Clock == nil {
-				m.Clock = &v15.VectorClock{}
+				m.Clock = &v16.VectorClock{}
 			}
 			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Started = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -18554,7 +18949,7 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.TaskQueue == nil {
-				m.TaskQueue = &v16.TaskQueue{}
+				m.TaskQueue = &v17.TaskQueue{}
 			}
 			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -18590,7 +18985,7 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.StickyTaskQueue == nil {
-				m.StickyTaskQueue = &v16.TaskQueue{}
+				m.StickyTaskQueue = &v17.TaskQueue{}
 			}
 			if err := m.StickyTaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -18680,7 +19075,7 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkflowState |= v17.WorkflowExecutionState(b&0x7F) << shift
+				m.WorkflowState |= v15.WorkflowExecutionState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -19197,7 +19592,7 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.TaskQueue == nil {
-				m.TaskQueue = &v16.TaskQueue{}
+				m.TaskQueue = &v17.TaskQueue{}
 			}
 			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -19233,7 +19628,7 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.StickyTaskQueue == nil {
-				m.StickyTaskQueue = &v16.TaskQueue{}
+				m.StickyTaskQueue = &v17.TaskQueue{}
 			}
 			if err := m.StickyTaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -19359,7 +19754,7 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkflowState |= v17.WorkflowExecutionState(b&0x7F) << shift
+				m.WorkflowState |= v15.WorkflowExecutionState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -19865,7 +20260,7 @@ func (m *RecordWorkflowTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Clock == nil {
-				m.Clock = &v15.VectorClock{}
+				m.Clock = &v16.VectorClock{}
 			}
 			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -20141,7 +20536,7 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.WorkflowExecutionTaskQueue == nil {
-				m.WorkflowExecutionTaskQueue = &v16.TaskQueue{}
+				m.WorkflowExecutionTaskQueue = &v17.TaskQueue{}
 			}
 			if err := m.WorkflowExecutionTaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -20412,7 +20807,7 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Clock == nil {
-				m.Clock = &v15.VectorClock{}
+				m.Clock = &v16.VectorClock{}
 			}
 			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -20709,7 +21104,7 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Clock == nil {
-				m.Clock = &v15.VectorClock{}
+				m.Clock = &v16.VectorClock{}
 			}
 			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -21029,7 +21424,7 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Clock == nil {
-				m.Clock = &v15.VectorClock{}
+				m.Clock = &v16.VectorClock{}
 			}
 			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -22520,6 +22915,42 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowStartDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowStartDelay == nil {
+				m.WorkflowStartDelay = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.WorkflowStartDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -23880,7 +24311,7 @@ func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Clock == nil {
-				m.Clock = &v15.VectorClock{}
+				m.Clock = &v16.VectorClock{}
 			}
 			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -24090,7 +24521,7 @@ func (m *VerifyFirstWorkflowTaskScheduledRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Clock == nil {
-				m.Clock = &v15.VectorClock{}
+				m.Clock = &v16.VectorClock{}
 			}
 			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -24391,7 +24822,7 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Clock == nil {
-				m.Clock = &v15.VectorClock{}
+				m.Clock = &v16.VectorClock{}
 			}
 			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -24694,7 +25125,7 @@ func (m *VerifyChildExecutionCompletionRecordedRequest) Unmarshal(dAtA []byte) e
 				return io.ErrUnexpectedEOF
 			}
 			if m.Clock == nil {
-				m.Clock = &v15.VectorClock{}
+				m.Clock = &v16.VectorClock{}
 			}
 			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -27314,7 +27745,7 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Category |= v17.TaskCategory(b&0x7F) << shift
+				m.Category |= v15.TaskCategory(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -28339,7 +28770,7 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v17.DeadLetterQueueType(b&0x7F) << shift
+				m.Type |= v15.DeadLetterQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -28534,7 +28965,7 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v17.DeadLetterQueueType(b&0x7F) << shift
+				m.Type |= v15.DeadLetterQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -28674,7 +29105,7 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v17.DeadLetterQueueType(b&0x7F) << shift
+				m.Type |= v15.DeadLetterQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -28869,7 +29300,7 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v17.DeadLetterQueueType(b&0x7F) << shift
+				m.Type |= v15.DeadLetterQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -29282,6 +29713,180 @@ func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RearchiveWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RearchiveWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RearchiveWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v116.RearchiveWorkflowExecutionRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RearchiveWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RearchiveWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RearchiveWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenerateLastHistoryReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -54,86 +54,87 @@ func init() {
 }
 
 var fileDescriptor_655983da427ae822 = []byte{
-	// 1255 bytes of a gzipped FileDescriptorProto
+	// 1272 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcd, 0x6b, 0x24, 0x45,
 	0x18, 0xc6, 0xa7, 0x2e, 0x22, 0x85, 0xae, 0xda, 0x8a, 0x1f, 0x51, 0x1b, 0x51, 0xf4, 0x38, 0x61,
-	0x77, 0x41, 0xf7, 0x23, 0x71, 0x4d, 0x26, 0xc9, 0x24, 0xbb, 0x89, 0x9a, 0x99, 0x6c, 0x04, 0x2f,
-	0x52, 0x33, 0xf3, 0x26, 0x53, 0xa4, 0x33, 0xdd, 0x56, 0xd5, 0x8c, 0xce, 0x41, 0x10, 0x3c, 0x2d,
-	0x08, 0x8a, 0x20, 0x78, 0x12, 0x3c, 0x29, 0x82, 0x20, 0x08, 0x82, 0x20, 0x78, 0x12, 0x3c, 0x49,
-	0x6e, 0xee, 0xd1, 0x4c, 0x2e, 0x1e, 0xf7, 0x4f, 0x90, 0x99, 0xee, 0xaa, 0x4c, 0x75, 0x57, 0x4f,
-	0xaa, 0xba, 0xe7, 0xb6, 0x3b, 0x53, 0xcf, 0xaf, 0x9f, 0xaa, 0x7a, 0xe7, 0xad, 0x27, 0xd5, 0xf8,
-	0xaa, 0x80, 0xe3, 0x28, 0x64, 0x24, 0x58, 0xe4, 0xc0, 0x06, 0xc0, 0x16, 0x49, 0x44, 0x17, 0xbb,
-	0x94, 0x8b, 0x90, 0x0d, 0xc7, 0x9f, 0xd0, 0x36, 0x2c, 0x0e, 0x2e, 0x2f, 0x26, 0xff, 0xac, 0x46,
-	0x2c, 0x14, 0xa1, 0xf7, 0xaa, 0x14, 0x55, 0x63, 0x51, 0x95, 0x44, 0xb4, 0xaa, 0x8b, 0xaa, 0x83,
-	0xcb, 0x0b, 0x4b, 0x76, 0x6c, 0x06, 0x1f, 0xf6, 0x81, 0x8b, 0x0f, 0x18, 0xf0, 0x28, 0xec, 0xf1,
-	0xe4, 0x21, 0x57, 0xee, 0x2d, 0xe3, 0x4b, 0x9b, 0xf1, 0xe0, 0x66, 0x3c, 0xd8, 0xfb, 0x1e, 0xe1,
-	0xa7, 0x9b, 0x82, 0x30, 0xf1, 0x5e, 0xc8, 0x8e, 0x0e, 0x82, 0xf0, 0xa3, 0xf5, 0x8f, 0xa1, 0xdd,
-	0x17, 0x34, 0xec, 0x79, 0x6b, 0x55, 0x2b, 0x4f, 0x55, 0xb3, 0xbc, 0x11, 0x5b, 0x58, 0x58, 0x2f,
-	0x49, 0x89, 0x27, 0xf0, 0x72, 0xc5, 0xfb, 0x0a, 0xe1, 0xc7, 0xea, 0x20, 0x76, 0xfa, 0x82, 0xb4,
-	0x02, 0x68, 0x0a, 0x22, 0xc0, 0x5b, 0xb6, 0x84, 0xa7, 0x74, 0xd2, 0xdb, 0x9b, 0x45, 0xe5, 0xca,
-	0xd4, 0xd7, 0x08, 0x3f, 0xfe, 0x6e, 0x18, 0x04, 0x9a, 0x2b, 0x5b, 0x6c, 0x5a, 0x28, 0x6d, 0xdd,
-	0x2a, 0xac, 0x57, 0xbe, 0xbe, 0x43, 0xf8, 0xa9, 0x06, 0x70, 0x10, 0x4d, 0x41, 0xdb, 0x47, 0xc3,
-	0x3d, 0xc2, 0x8f, 0x76, 0xfb, 0xd0, 0x07, 0x6f, 0xd5, 0x92, 0x6d, 0x12, 0x4b, 0x7f, 0xb5, 0x52,
-	0x0c, 0xe5, 0xf1, 0x67, 0x84, 0x9f, 0x6b, 0x40, 0x3b, 0x64, 0x1d, 0xb9, 0xed, 0xe3, 0x51, 0x93,
-	0x3a, 0x80, 0x8e, 0x57, 0xb7, 0x7e, 0x48, 0x0e, 0x41, 0xba, 0xdd, 0x2c, 0x0f, 0x32, 0x58, 0x5e,
-	0x69, 0x0b, 0x3a, 0xa0, 0x62, 0x58, 0xdc, 0xb2, 0x81, 0x50, 0xcc, 0xb2, 0x11, 0xa4, 0x2c, 0xff,
-	0x86, 0xf0, 0x0b, 0xf1, 0x7f, 0xb5, 0xb9, 0xd5, 0xc2, 0xe3, 0x28, 0x80, 0xb1, 0xeb, 0xdb, 0xf6,
-	0xbb, 0x99, 0x0b, 0x91, 0xc6, 0xef, 0xcc, 0x85, 0x95, 0x5a, 0xee, 0xcc, 0xd0, 0x0d, 0x42, 0x03,
-	0xa7, 0xe5, 0xce, 0x21, 0xb8, 0x2f, 0x77, 0x2e, 0x48, 0x59, 0xfe, 0x15, 0xe1, 0xe7, 0xb3, 0xdb,
-	0xb2, 0x09, 0x84, 0x89, 0x16, 0x10, 0xe1, 0x6d, 0x15, 0xde, 0x5a, 0xc5, 0x90, 0xb6, 0x6f, 0xcf,
-	0x03, 0x65, 0xaa, 0x93, 0xe9, 0xa1, 0x85, 0xeb, 0xc4, 0x08, 0x29, 0x58, 0x27, 0x39, 0x2c, 0x53,
-	0x9d, 0x4c, 0x0f, 0x2d, 0x56, 0x27, 0x59, 0x42, 0xc1, 0x3a, 0x31, 0x81, 0x52, 0x75, 0x92, 0x9d,
-	0x1d, 0xe9, 0xb5, 0x61, 0x6c, 0x7a, 0xab, 0xc4, 0x0a, 0x25, 0x0c, 0xf7, 0x3a, 0x99, 0x81, 0x52,
-	0xc6, 0x7f, 0x44, 0xf8, 0x99, 0x26, 0x3d, 0xec, 0x91, 0x20, 0x9b, 0x18, 0xac, 0xcf, 0x7a, 0xb3,
-	0x5e, 0x1a, 0xde, 0x28, 0x8b, 0x51, 0x66, 0xff, 0x44, 0xf8, 0xa5, 0x64, 0x14, 0x15, 0xdd, 0x9c,
-	0x9c, 0xf3, 0xb6, 0xdb, 0xe3, 0x72, 0x41, 0xd2, 0xfe, 0x3b, 0x73, 0xe3, 0xa9, 0x79, 0xfc, 0x84,
-	0xf0, 0xb3, 0x0d, 0x38, 0x0e, 0x07, 0x10, 0x8b, 0xb4, 0xb8, 0xb1, 0x61, 0xbd, 0xbf, 0x66, 0x80,
-	0xf4, 0x5d, 0x2f, 0xcd, 0x51, 0x7e, 0x7f, 0x41, 0x78, 0x61, 0x0f, 0xd8, 0x31, 0xed, 0x11, 0x01,
-	0xd9, 0x15, 0xb7, 0xfd, 0x21, 0xe5, 0x23, 0xa4, 0xe7, 0xad, 0x39, 0x90, 0xb4, 0xd2, 0x5e, 0x83,
-	0x71, 0x73, 0x29, 0x5e, 0xda, 0x39, 0x7a, 0xd7, 0xd2, 0xce, 0xc5, 0x28, 0xb3, 0xe3, 0xe0, 0x3e,
-	0x09, 0x58, 0xc5, 0x83, 0xbb, 0x59, 0xee, 0x1a, 0xdc, 0xf3, 0x28, 0xca, 0xe9, 0x1f, 0x08, 0xfb,
-	0x09, 0x34, 0xee, 0x27, 0x59, 0xc7, 0xdb, 0xd6, 0xcf, 0x9a, 0x85, 0x91, 0xce, 0x77, 0xe6, 0x44,
-	0xd3, 0xd2, 0x74, 0xb3, 0xdd, 0x85, 0x4e, 0x3f, 0x80, 0xe9, 0xd3, 0xdf, 0x3a, 0x4d, 0x9b, 0xc4,
-	0xae, 0x69, 0xda, 0xcc, 0xd0, 0x5a, 0xdd, 0x3e, 0x30, 0x7a, 0x30, 0xdc, 0xa0, 0x8c, 0x0b, 0x2d,
-	0xc7, 0x26, 0xca, 0x8e, 0x75, 0xab, 0xbb, 0x08, 0xe4, 0xda, 0xea, 0x2e, 0xe6, 0xa9, 0x79, 0xfc,
-	0x8e, 0xf0, 0x8b, 0x71, 0x62, 0xa9, 0x75, 0x69, 0xd0, 0x51, 0xdb, 0x71, 0x1e, 0x44, 0xee, 0x38,
-	0xe5, 0x9e, 0x1c, 0x8a, 0x9c, 0xc1, 0xf6, 0x7c, 0x60, 0xca, 0xfe, 0x3f, 0x08, 0xbf, 0x16, 0xcf,
-	0xd6, 0x38, 0x76, 0x52, 0x57, 0x63, 0x12, 0x74, 0xbc, 0x3d, 0xa7, 0xc5, 0xbb, 0x08, 0x27, 0x27,
-	0x74, 0x77, 0xce, 0x54, 0x2d, 0x64, 0xad, 0x01, 0x6f, 0x33, 0xda, 0x32, 0xf4, 0xc7, 0xba, 0x75,
-	0x63, 0xcb, 0x21, 0xb8, 0x86, 0xac, 0x19, 0x20, 0x65, 0xf9, 0x1b, 0x84, 0x9f, 0x68, 0x40, 0x14,
-	0xd0, 0x36, 0x11, 0xb0, 0x3e, 0x80, 0x9e, 0xe0, 0xfb, 0x57, 0xbc, 0x5b, 0xd6, 0x5b, 0x9e, 0x52,
-	0x4a, 0x8b, 0x6f, 0x15, 0x07, 0xa4, 0xda, 0x77, 0xf2, 0xbd, 0x9c, 0x43, 0x7c, 0x9e, 0xaf, 0xb9,
-	0xe2, 0x35, 0xb9, 0x7b, 0xfb, 0x36, 0x53, 0xb4, 0x7b, 0x97, 0xe6, 0xb0, 0xd7, 0x6e, 0x76, 0x09,
-	0xeb, 0x8c, 0xbf, 0xec, 0x73, 0xeb, 0x7b, 0x97, 0x94, 0xce, 0xf5, 0xde, 0x25, 0x23, 0x57, 0xa6,
-	0xee, 0x21, 0xfc, 0xc8, 0xf8, 0x5b, 0x19, 0x56, 0xbd, 0x1b, 0x0e, 0x48, 0x29, 0x92, 0x76, 0x6e,
-	0x16, 0xd2, 0x6a, 0xa7, 0x83, 0xac, 0x46, 0x2d, 0x98, 0xad, 0x3a, 0x96, 0xb2, 0x29, 0x94, 0xd5,
-	0x4a, 0x31, 0x94, 0xc7, 0x6f, 0x11, 0x7e, 0x52, 0x0e, 0x49, 0x6e, 0x00, 0x37, 0x43, 0x2e, 0xbc,
-	0x15, 0x47, 0xfc, 0x94, 0x56, 0x3a, 0x5c, 0x2d, 0x83, 0x50, 0x06, 0x3f, 0x43, 0x18, 0xd7, 0x82,
-	0x90, 0xc3, 0x64, 0xbf, 0xbd, 0x6b, 0x96, 0xd0, 0x73, 0x89, 0xb4, 0x73, 0xbd, 0x80, 0x52, 0xb9,
-	0xf8, 0x04, 0x3f, 0x5c, 0x07, 0x11, 0x5b, 0x78, 0xdd, 0xfe, 0x72, 0x50, 0x33, 0xf0, 0x86, 0xb3,
-	0x4e, 0x5b, 0x84, 0x38, 0x5d, 0x4f, 0xd2, 0xc5, 0x35, 0xa7, 0x40, 0x3e, 0x9d, 0x29, 0xae, 0x17,
-	0x50, 0x6a, 0xad, 0xa9, 0x0e, 0x42, 0x36, 0x06, 0x1a, 0xf6, 0x76, 0x80, 0x73, 0x72, 0x08, 0xdc,
-	0xba, 0x35, 0x99, 0xe5, 0xae, 0xad, 0x29, 0x8f, 0xa2, 0x1d, 0x49, 0x75, 0x10, 0x6b, 0xdb, 0xbb,
-	0x26, 0xb3, 0x75, 0xfb, 0xc7, 0x98, 0x09, 0xae, 0x47, 0xd2, 0x0c, 0x90, 0xb2, 0xfc, 0x39, 0xc2,
-	0x8f, 0xee, 0xf6, 0x81, 0x0d, 0x65, 0xbb, 0xf5, 0x6c, 0xbb, 0x8f, 0xa6, 0x92, 0xd6, 0x96, 0x8a,
-	0x89, 0x35, 0x3b, 0x0d, 0x20, 0x51, 0x14, 0x0c, 0xe3, 0x43, 0xca, 0xda, 0x8e, 0xa6, 0x72, 0xb5,
-	0x93, 0x12, 0x2b, 0x3b, 0x5f, 0x20, 0x7c, 0x29, 0x5e, 0x45, 0xb5, 0x8b, 0x4b, 0x4e, 0x8b, 0x9f,
-	0xde, 0xba, 0xe5, 0x82, 0x6a, 0xfd, 0x82, 0xbf, 0xcf, 0x0e, 0x61, 0xda, 0x93, 0xf5, 0x05, 0x7f,
-	0x4a, 0xe8, 0x7c, 0xc1, 0x9f, 0xd1, 0x6b, 0xbe, 0x76, 0xa0, 0xa0, 0xaf, 0xb4, 0xd0, 0xd5, 0x57,
-	0x56, 0x9f, 0x7a, 0xf1, 0x70, 0xc0, 0x80, 0x77, 0xa7, 0x93, 0x3e, 0x77, 0x78, 0xf1, 0x90, 0x15,
-	0xbb, 0xbf, 0x78, 0x30, 0x31, 0x94, 0xc7, 0xbf, 0x11, 0x7e, 0xa5, 0x0e, 0x3d, 0x60, 0x44, 0xc0,
-	0x36, 0xe1, 0x22, 0x39, 0x91, 0xa6, 0x7e, 0xb8, 0xb1, 0xe5, 0x5d, 0xeb, 0xe2, 0xb9, 0x90, 0x25,
-	0x67, 0xd0, 0x98, 0x27, 0x52, 0x5b, 0x74, 0xbd, 0x59, 0x26, 0x39, 0x6d, 0xb5, 0x50, 0xa7, 0xd5,
-	0xc3, 0x5a, 0xad, 0x14, 0x43, 0x4b, 0x20, 0x0d, 0x68, 0xf5, 0x69, 0xd0, 0xd1, 0x42, 0xd2, 0x8a,
-	0xf5, 0x9e, 0x66, 0xb4, 0xae, 0x09, 0xc4, 0x88, 0xd0, 0xae, 0x29, 0xf4, 0x6b, 0x97, 0x7d, 0xca,
-	0x69, 0x8b, 0x06, 0x93, 0xb4, 0x37, 0xfe, 0x73, 0xc8, 0xfa, 0x9a, 0x62, 0x36, 0xc6, 0xf5, 0x9a,
-	0xe2, 0x22, 0x9a, 0x76, 0x7f, 0x75, 0x37, 0xea, 0x90, 0x32, 0xf7, 0x57, 0x39, 0x7a, 0xd7, 0xfb,
-	0xab, 0x5c, 0x8c, 0x34, 0xbb, 0x1a, 0x9d, 0x9c, 0xfa, 0x95, 0xfb, 0xa7, 0x7e, 0xe5, 0xc1, 0xa9,
-	0x8f, 0x3e, 0x1d, 0xf9, 0xe8, 0x87, 0x91, 0x8f, 0xfe, 0x1a, 0xf9, 0xe8, 0x64, 0xe4, 0xa3, 0x7f,
-	0x47, 0x3e, 0xfa, 0x6f, 0xe4, 0x57, 0x1e, 0x8c, 0x7c, 0xf4, 0xe5, 0x99, 0x5f, 0x39, 0x39, 0xf3,
-	0x2b, 0xf7, 0xcf, 0xfc, 0xca, 0xfb, 0x37, 0x0e, 0xc3, 0x73, 0x07, 0x34, 0x9c, 0xf9, 0x16, 0xfc,
-	0xa6, 0xfe, 0x49, 0xeb, 0xa1, 0xc9, 0x4b, 0xf0, 0xab, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x2d,
-	0x24, 0xcb, 0xbd, 0xa0, 0x1f, 0x00, 0x00,
+	0x77, 0x41, 0xf7, 0x23, 0xbb, 0x6b, 0x32, 0x49, 0x26, 0xd9, 0xcd, 0xa8, 0x99, 0xc9, 0x46, 0xf0,
+	0x22, 0x35, 0x3d, 0x6f, 0x32, 0x45, 0x3a, 0xd3, 0x6d, 0x55, 0xcd, 0xe8, 0x1c, 0x04, 0xc1, 0x93,
+	0x20, 0x28, 0x82, 0xe0, 0x49, 0xf0, 0xa4, 0x08, 0x82, 0x20, 0x08, 0x82, 0xb0, 0x27, 0xc1, 0x93,
+	0xe4, 0xe6, 0x1e, 0xcd, 0xe4, 0xe2, 0x71, 0xff, 0x84, 0x65, 0xa6, 0xa7, 0x2a, 0x53, 0xd3, 0xd5,
+	0x33, 0x55, 0xdd, 0x73, 0x4b, 0x66, 0xea, 0xf9, 0xf5, 0x53, 0x55, 0x6f, 0xaa, 0x9e, 0xbc, 0x8d,
+	0x2f, 0x0b, 0x38, 0x8e, 0x23, 0x46, 0xc2, 0x65, 0x0e, 0xac, 0x07, 0x6c, 0x99, 0xc4, 0x74, 0xb9,
+	0x4d, 0xb9, 0x88, 0x58, 0x7f, 0xf8, 0x09, 0x0d, 0x60, 0xb9, 0x77, 0x71, 0x79, 0xfc, 0x63, 0x39,
+	0x66, 0x91, 0x88, 0xbc, 0xd7, 0xa5, 0xa8, 0x9c, 0x88, 0xca, 0x24, 0xa6, 0x65, 0x5d, 0x54, 0xee,
+	0x5d, 0x5c, 0x5a, 0xb1, 0x63, 0x33, 0xf8, 0xa8, 0x0b, 0x5c, 0x7c, 0xc8, 0x80, 0xc7, 0x51, 0x87,
+	0x8f, 0x1f, 0x72, 0xe9, 0xde, 0x4d, 0x7c, 0x61, 0x2b, 0x19, 0xdc, 0x48, 0x06, 0x7b, 0x3f, 0x22,
+	0xfc, 0x6c, 0x43, 0x10, 0x26, 0xde, 0x8f, 0xd8, 0xd1, 0x41, 0x18, 0x7d, 0xbc, 0xf1, 0x09, 0x04,
+	0x5d, 0x41, 0xa3, 0x8e, 0xb7, 0x5e, 0xb6, 0xf2, 0x54, 0x36, 0xcb, 0xeb, 0x89, 0x85, 0xa5, 0x8d,
+	0x82, 0x94, 0x64, 0x02, 0xaf, 0x96, 0xbc, 0x6f, 0x10, 0x7e, 0xa2, 0x0a, 0xa2, 0xd6, 0x15, 0xa4,
+	0x19, 0x42, 0x43, 0x10, 0x01, 0xde, 0x0d, 0x4b, 0xf8, 0x94, 0x4e, 0x7a, 0xbb, 0x99, 0x57, 0xae,
+	0x4c, 0x7d, 0x8b, 0xf0, 0x93, 0xef, 0x45, 0x61, 0xa8, 0xb9, 0xb2, 0xc5, 0x4e, 0x0b, 0xa5, 0xad,
+	0x5b, 0xb9, 0xf5, 0xca, 0xd7, 0x0f, 0x08, 0x3f, 0x53, 0x07, 0x0e, 0xa2, 0x21, 0x68, 0x70, 0xd4,
+	0xdf, 0x23, 0xfc, 0x68, 0xb7, 0x0b, 0x5d, 0xf0, 0xd6, 0x2c, 0xd9, 0x26, 0xb1, 0xf4, 0x57, 0x29,
+	0xc4, 0x50, 0x1e, 0x7f, 0x45, 0xf8, 0x85, 0x3a, 0x04, 0x11, 0x6b, 0xc9, 0x6d, 0x1f, 0x8e, 0x1a,
+	0xd5, 0x01, 0xb4, 0xbc, 0xaa, 0xf5, 0x43, 0x32, 0x08, 0xd2, 0xed, 0x56, 0x71, 0x90, 0xc1, 0xf2,
+	0x6a, 0x20, 0x68, 0x8f, 0x8a, 0x7e, 0x7e, 0xcb, 0x06, 0x42, 0x3e, 0xcb, 0x46, 0x90, 0xb2, 0xfc,
+	0x07, 0xc2, 0x2f, 0x25, 0xbf, 0x6a, 0x73, 0xab, 0x44, 0xc7, 0x71, 0x08, 0x43, 0xd7, 0xb7, 0xed,
+	0x77, 0x33, 0x13, 0x22, 0x8d, 0xdf, 0x59, 0x08, 0x6b, 0x6a, 0xb9, 0x53, 0x43, 0x37, 0x09, 0x0d,
+	0x9d, 0x96, 0x3b, 0x83, 0xe0, 0xbe, 0xdc, 0x99, 0x20, 0x65, 0xf9, 0x77, 0x84, 0x5f, 0x4c, 0x6f,
+	0xcb, 0x16, 0x10, 0x26, 0x9a, 0x40, 0x84, 0xb7, 0x9d, 0x7b, 0x6b, 0x15, 0x43, 0xda, 0xbe, 0xbd,
+	0x08, 0x94, 0xa9, 0x4e, 0x26, 0x87, 0xe6, 0xae, 0x13, 0x23, 0x24, 0x67, 0x9d, 0x64, 0xb0, 0x4c,
+	0x75, 0x32, 0x39, 0x34, 0x5f, 0x9d, 0xa4, 0x09, 0x39, 0xeb, 0xc4, 0x04, 0x9a, 0xaa, 0x93, 0xf4,
+	0xec, 0x48, 0x27, 0x80, 0xa1, 0xe9, 0xed, 0x02, 0x2b, 0x34, 0x66, 0xb8, 0xd7, 0xc9, 0x0c, 0x94,
+	0x32, 0xfe, 0x33, 0xc2, 0xcf, 0x35, 0xe8, 0x61, 0x87, 0x84, 0xe9, 0xc4, 0x60, 0x7d, 0xd7, 0x9b,
+	0xf5, 0xd2, 0xf0, 0x66, 0x51, 0x8c, 0x32, 0xfb, 0x17, 0xc2, 0xaf, 0x8c, 0x47, 0x51, 0xd1, 0xce,
+	0xc8, 0x39, 0xef, 0xb8, 0x3d, 0x2e, 0x13, 0x24, 0xed, 0xbf, 0xbb, 0x30, 0x9e, 0x9a, 0xc7, 0x2f,
+	0x08, 0x3f, 0x5f, 0x87, 0xe3, 0xa8, 0x07, 0x89, 0x48, 0x8b, 0x1b, 0x9b, 0xd6, 0xfb, 0x6b, 0x06,
+	0x48, 0xdf, 0xd5, 0xc2, 0x1c, 0xe5, 0xf7, 0x37, 0x84, 0x97, 0xf6, 0x80, 0x1d, 0xd3, 0x0e, 0x11,
+	0x90, 0x5e, 0x71, 0xdb, 0x3f, 0xa4, 0x6c, 0x84, 0xf4, 0xbc, 0xbd, 0x00, 0x92, 0x56, 0xda, 0xeb,
+	0x10, 0x82, 0x80, 0xfc, 0xa5, 0x9d, 0xa1, 0x77, 0x2d, 0xed, 0x4c, 0x8c, 0x32, 0x3b, 0x0c, 0xee,
+	0xa3, 0x80, 0x95, 0x3f, 0xb8, 0x9b, 0xe5, 0xae, 0xc1, 0x3d, 0x8b, 0xa2, 0x9c, 0xde, 0x43, 0xd8,
+	0x1f, 0x43, 0x93, 0xf3, 0x24, 0xed, 0x78, 0xc7, 0xfa, 0x59, 0xb3, 0x30, 0xd2, 0x79, 0x6d, 0x41,
+	0x34, 0x2d, 0x4d, 0x37, 0x82, 0x36, 0xb4, 0xba, 0x21, 0x4c, 0xde, 0xfe, 0xd6, 0x69, 0xda, 0x24,
+	0x76, 0x4d, 0xd3, 0x66, 0x86, 0x76, 0xd4, 0xed, 0x03, 0xa3, 0x07, 0xfd, 0x4d, 0xca, 0xb8, 0xd0,
+	0x72, 0xec, 0x58, 0xd9, 0xb2, 0x3e, 0xea, 0xe6, 0x81, 0x5c, 0x8f, 0xba, 0xf9, 0x3c, 0x35, 0x8f,
+	0x3f, 0x11, 0x7e, 0x39, 0x49, 0x2c, 0x95, 0x36, 0x0d, 0x5b, 0x6a, 0x3b, 0xce, 0x83, 0xc8, 0x1d,
+	0xa7, 0xdc, 0x93, 0x41, 0x91, 0x33, 0xd8, 0x59, 0x0c, 0x4c, 0xd9, 0xff, 0x17, 0xe1, 0x37, 0x92,
+	0xd9, 0x1a, 0xc7, 0x8e, 0xea, 0x6a, 0x48, 0x82, 0x96, 0xb7, 0xe7, 0xb4, 0x78, 0xf3, 0x70, 0x72,
+	0x42, 0x77, 0x17, 0x4c, 0xd5, 0x42, 0xd6, 0x3a, 0xf0, 0x80, 0xd1, 0xa6, 0xe1, 0x7c, 0xac, 0x5a,
+	0x1f, 0x6c, 0x19, 0x04, 0xd7, 0x90, 0x35, 0x03, 0xa4, 0x2c, 0x7f, 0x87, 0xf0, 0x53, 0x75, 0x88,
+	0x43, 0x1a, 0x10, 0x01, 0x1b, 0x3d, 0xe8, 0x08, 0xbe, 0x7f, 0xc9, 0xbb, 0x65, 0xbd, 0xe5, 0x53,
+	0x4a, 0x69, 0xf1, 0xed, 0xfc, 0x80, 0xa9, 0xe3, 0x7b, 0xfc, 0xbd, 0x9c, 0x43, 0x72, 0x9f, 0xaf,
+	0xbb, 0xe2, 0x35, 0xb9, 0xfb, 0xf1, 0x6d, 0xa6, 0x68, 0x7d, 0x97, 0x46, 0xbf, 0x13, 0x34, 0xda,
+	0x84, 0xb5, 0x86, 0x5f, 0x76, 0xb9, 0x75, 0xdf, 0x65, 0x4a, 0xe7, 0xda, 0x77, 0x49, 0xc9, 0x95,
+	0xa9, 0x2f, 0x10, 0x7e, 0x6c, 0xf8, 0xad, 0x0c, 0xab, 0xde, 0x35, 0x07, 0xa4, 0x14, 0x49, 0x3b,
+	0xd7, 0x73, 0x69, 0xb5, 0xdb, 0x41, 0x56, 0xa3, 0x16, 0xcc, 0xd6, 0x1c, 0x4b, 0xd9, 0x14, 0xca,
+	0x2a, 0x85, 0x18, 0xca, 0xe3, 0xf7, 0x08, 0x3f, 0x2d, 0x87, 0x8c, 0x3b, 0x80, 0x5b, 0x11, 0x17,
+	0xde, 0xaa, 0x23, 0x7e, 0x42, 0x2b, 0x1d, 0xae, 0x15, 0x41, 0x28, 0x83, 0x9f, 0x23, 0x8c, 0x2b,
+	0x61, 0xc4, 0x61, 0xb4, 0xdf, 0xde, 0x15, 0x4b, 0xe8, 0xb9, 0x44, 0xda, 0xb9, 0x9a, 0x43, 0xa9,
+	0x5c, 0x7c, 0x8a, 0x1f, 0xad, 0x82, 0x48, 0x2c, 0xbc, 0x69, 0xdf, 0x1c, 0xd4, 0x0c, 0xbc, 0xe5,
+	0xac, 0xd3, 0x16, 0x21, 0x49, 0xd7, 0xa3, 0x74, 0x71, 0xc5, 0x29, 0x90, 0x4f, 0x66, 0x8a, 0xab,
+	0x39, 0x94, 0xda, 0xd1, 0x54, 0x05, 0x21, 0x0f, 0x06, 0x1a, 0x75, 0x6a, 0xc0, 0x39, 0x39, 0x04,
+	0x6e, 0x7d, 0x34, 0x99, 0xe5, 0xae, 0x47, 0x53, 0x16, 0x45, 0xbb, 0x92, 0xaa, 0x20, 0xd6, 0x77,
+	0x76, 0x4d, 0x66, 0xab, 0xf6, 0x8f, 0x31, 0x13, 0x5c, 0xaf, 0xa4, 0x19, 0x20, 0x65, 0xf9, 0x4b,
+	0x84, 0x1f, 0xdf, 0xed, 0x02, 0xeb, 0xcb, 0xe3, 0xd6, 0xb3, 0x3d, 0x7d, 0x34, 0x95, 0xb4, 0xb6,
+	0x92, 0x4f, 0xac, 0xd9, 0xa9, 0x03, 0x89, 0xe3, 0xb0, 0x9f, 0x5c, 0x52, 0xd6, 0x76, 0x34, 0x95,
+	0xab, 0x9d, 0x29, 0xb1, 0xb2, 0xf3, 0x15, 0xc2, 0x17, 0x92, 0x55, 0x54, 0xbb, 0xb8, 0xe2, 0xb4,
+	0xf8, 0xd3, 0x5b, 0x77, 0x23, 0xa7, 0x5a, 0x6f, 0xf0, 0x77, 0xd9, 0x21, 0x4c, 0x7a, 0xb2, 0x6e,
+	0xf0, 0x4f, 0x09, 0x9d, 0x1b, 0xfc, 0x29, 0xbd, 0xe6, 0xab, 0x06, 0x39, 0x7d, 0xd5, 0xa0, 0x98,
+	0xaf, 0x1a, 0x64, 0xfa, 0x4a, 0x5e, 0x3c, 0x1c, 0x30, 0xe0, 0xed, 0xc9, 0xa4, 0xcf, 0x1d, 0x5e,
+	0x3c, 0xa4, 0xc5, 0xee, 0x2f, 0x1e, 0x4c, 0x0c, 0xad, 0x3b, 0x51, 0x07, 0xc2, 0x82, 0x36, 0xed,
+	0x15, 0xe8, 0x4e, 0x64, 0x23, 0x5c, 0xbb, 0x13, 0xb3, 0x48, 0xca, 0xf5, 0x3f, 0x08, 0xbf, 0x56,
+	0x85, 0x0e, 0x30, 0x22, 0x60, 0x87, 0x70, 0x31, 0xbe, 0x47, 0x27, 0x8e, 0x9b, 0x64, 0xa1, 0x77,
+	0xad, 0x4b, 0x7e, 0x2e, 0x4b, 0xce, 0xa3, 0xbe, 0x48, 0xa4, 0x56, 0x2a, 0xfa, 0x11, 0x3f, 0x4e,
+	0x97, 0x6b, 0xb9, 0xee, 0x07, 0x3d, 0x62, 0x56, 0x0a, 0x31, 0xb4, 0xdc, 0x54, 0x87, 0x66, 0x97,
+	0x86, 0x2d, 0x2d, 0xda, 0xad, 0x5a, 0xef, 0x6c, 0x4a, 0xeb, 0x9a, 0x9b, 0x8c, 0x08, 0xad, 0xb9,
+	0xa2, 0x37, 0x8b, 0xf6, 0x29, 0xa7, 0x4d, 0x1a, 0x8e, 0x32, 0xea, 0xf0, 0x9f, 0x38, 0xeb, 0xe6,
+	0xca, 0x6c, 0x8c, 0x6b, 0x73, 0x65, 0x1e, 0x4d, 0xeb, 0xba, 0xdd, 0x8d, 0x5b, 0xa4, 0x48, 0xd7,
+	0x2d, 0x43, 0xef, 0xda, 0x75, 0xcb, 0xc4, 0x48, 0xb3, 0x6b, 0xf1, 0xc9, 0xa9, 0x5f, 0xba, 0x7f,
+	0xea, 0x97, 0x1e, 0x9c, 0xfa, 0xe8, 0xb3, 0x81, 0x8f, 0x7e, 0x1a, 0xf8, 0xe8, 0xef, 0x81, 0x8f,
+	0x4e, 0x06, 0x3e, 0xfa, 0x6f, 0xe0, 0xa3, 0xff, 0x07, 0x7e, 0xe9, 0xc1, 0xc0, 0x47, 0x5f, 0x9f,
+	0xf9, 0xa5, 0x93, 0x33, 0xbf, 0x74, 0xff, 0xcc, 0x2f, 0x7d, 0x70, 0xed, 0x30, 0x3a, 0x77, 0x40,
+	0xa3, 0x99, 0xef, 0xee, 0xaf, 0xeb, 0x9f, 0x34, 0x1f, 0x19, 0xbd, 0xba, 0xbf, 0xfc, 0x70, 0x00,
+	0x89, 0x99, 0x89, 0x0b, 0x56, 0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -299,6 +300,8 @@ type HistoryServiceClient interface {
 	MergeDLQMessages(ctx context.Context, in *MergeDLQMessagesRequest, opts ...grpc.CallOption) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(ctx context.Context, in *RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*RefreshWorkflowTasksResponse, error)
+	// RearchiveWorkflowExecution schedules a new archival task for a closed workflow execution.
+	RearchiveWorkflowExecution(ctx context.Context, in *RearchiveWorkflowExecutionRequest, opts ...grpc.CallOption) (*RearchiveWorkflowExecutionResponse, error)
 	// GenerateLastHistoryReplicationTasks generate a replication task for last history event for requested workflow execution
 	GenerateLastHistoryReplicationTasks(ctx context.Context, in *GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*GenerateLastHistoryReplicationTasksResponse, error)
 	GetReplicationStatus(ctx context.Context, in *GetReplicationStatusRequest, opts ...grpc.CallOption) (*GetReplicationStatusResponse, error)
@@ -690,6 +693,15 @@ func (c *historyServiceClient) RefreshWorkflowTasks(ctx context.Context, in *Ref
 	return out, nil
 }
 
+func (c *historyServiceClient) RearchiveWorkflowExecution(ctx context.Context, in *RearchiveWorkflowExecutionRequest, opts ...grpc.CallOption) (*RearchiveWorkflowExecutionResponse, error) {
+	out := new(RearchiveWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/RearchiveWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) GenerateLastHistoryReplicationTasks(ctx context.Context, in *GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*GenerateLastHistoryReplicationTasksResponse, error) {
 	out := new(GenerateLastHistoryReplicationTasksResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/GenerateLastHistoryReplicationTasks", in, out, opts...)
@@ -888,6 +900,8 @@ type HistoryServiceServer interface {
 	MergeDLQMessages(context.Context, *MergeDLQMessagesRequest) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
+	// RearchiveWorkflowExecution schedules a new archival task for a closed workflow execution.
+	RearchiveWorkflowExecution(context.Context, *RearchiveWorkflowExecutionRequest) (*RearchiveWorkflowExecutionResponse, error)
 	// GenerateLastHistoryReplicationTasks generate a replication task for last history event for requested workflow execution
 	GenerateLastHistoryReplicationTasks(context.Context, *GenerateLastHistoryReplicationTasksRequest) (*GenerateLastHistoryReplicationTasksResponse, error)
 	GetReplicationStatus(context.Context, *GetReplicationStatusRequest) (*GetReplicationStatusResponse, error)
@@ -1029,6 +1043,9 @@ func (*UnimplementedHistoryServiceServer) MergeDLQMessages(ctx context.Context,
 func (*UnimplementedHistoryServiceServer) RefreshWorkflowTasks(ctx context.Context, req *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshWorkflowTasks not implemented")
 }
+func (*UnimplementedHistoryServiceServer) RearchiveWorkflowExecution(ctx context.Context, req *RearchiveWorkflowExecutionRequest) (*RearchiveWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RearchiveWorkflowExecution not implemented")
+}
 func (*UnimplementedHistoryServiceServer) GenerateLastHistoryReplicationTasks(ctx context.Context, req *GenerateLastHistoryReplicationTasksRequest) (*GenerateLastHistoryReplicationTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateLastHistoryReplicationTasks not implemented")
 }
@@ -1787,6 +1804,24 @@ func _HistoryService_RefreshWorkflowTasks_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_RearchiveWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RearchiveWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).RearchiveWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/RearchiveWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).RearchiveWorkflowExecution(ctx, req.(*RearchiveWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_GenerateLastHistoryReplicationTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GenerateLastHistoryReplicationTasksRequest)
 	if err := dec(in); err != nil {
@@ -2045,6 +2080,10 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshWorkflowTasks",
 			Handler:    _HistoryService_RefreshWorkflowTasks_Handler,
 		},
+		{
+			MethodName: "RearchiveWorkflowExecution",
+			Handler:    _HistoryService_RearchiveWorkflowExecution_Handler,
+		},
 		{
 			MethodName: "GenerateLastHistoryReplicationTasks",
 			Handler:    _HistoryService_GenerateLastHistoryReplicationTasks_Handler,
@@ -420,6 +420,26 @@ func (mr *MockHistoryServiceClientMockRecorder) ReapplyEvents(ctx, in interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockHistoryServiceClient)(nil).ReapplyEvents), varargs...)
 }
 
+// RearchiveWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) RearchiveWorkflowExecution(ctx context.Context, in *historyservice.RearchiveWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.RearchiveWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RearchiveWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.RearchiveWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RearchiveWorkflowExecution indicates an expected call of RearchiveWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) RearchiveWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RearchiveWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).RearchiveWorkflowExecution), varargs...)
+}
+
 // RebuildMutableState mocks base method.
 func (m *MockHistoryServiceClient) RebuildMutableState(ctx context.Context, in *historyservice.RebuildMutableStateRequest, opts ...grpc.CallOption) (*historyservice.RebuildMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -1273,6 +1293,21 @@ func (mr *MockHistoryServiceServerMockRecorder) ReapplyEvents(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockHistoryServiceServer)(nil).ReapplyEvents), arg0, arg1)
 }
 
+// RearchiveWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) RearchiveWorkflowExecution(arg0 context.Context, arg1 *historyservice.RearchiveWorkflowExecutionRequest) (*historyservice.RearchiveWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RearchiveWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.RearchiveWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RearchiveWorkflowExecution indicates an expected call of RearchiveWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) RearchiveWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RearchiveWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).RearchiveWorkflowExecution), arg0, arg1)
+}
+
 // RebuildMutableState mocks base method.
 func (m *MockHistoryServiceServer) RebuildMutableState(arg0 context.Context, arg1 *historyservice.RebuildMutableStateRequest) (*historyservice.RebuildMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,262 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: temporal/server/api/historystreamservice/v1/service.proto
+
+package historystreamservice
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+	v1 "go.temporal.io/api/workflowservice/v1"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("temporal/server/api/historystreamservice/v1/service.proto", fileDescriptor_d6a10ddd7540f187)
+}
+
+var fileDescriptor_d6a10ddd7540f187 = []byte{
+	// 299 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xb2, 0x2c, 0x49, 0xcd, 0x2d,
+	0xc8, 0x2f, 0x4a, 0xcc, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0x4b, 0x2d, 0xd2, 0x4f, 0x2c, 0xc8, 0xd4,
+	0xcf, 0xc8, 0x2c, 0x2e, 0xc9, 0x2f, 0xaa, 0x2c, 0x2e, 0x29, 0x4a, 0x4d, 0xcc, 0x05, 0x89, 0x67,
+	0x26, 0xa7, 0xea, 0x97, 0x19, 0xea, 0x43, 0x99, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xda,
+	0x30, 0xad, 0x7a, 0x10, 0xad, 0x7a, 0x89, 0x05, 0x99, 0x7a, 0xd8, 0xb4, 0xea, 0x95, 0x19, 0x4a,
+	0x99, 0xc1, 0xed, 0x01, 0x59, 0x50, 0x9e, 0x5f, 0x94, 0x9d, 0x96, 0x93, 0x5f, 0x8e, 0x64, 0x76,
+	0x51, 0x6a, 0x61, 0x69, 0x6a, 0x71, 0x49, 0x7c, 0x51, 0x6a, 0x71, 0x41, 0x7e, 0x5e, 0x31, 0xd4,
+	0x12, 0xa3, 0x87, 0x4c, 0x5c, 0x22, 0x1e, 0x10, 0x33, 0x83, 0xc1, 0x66, 0x06, 0x43, 0xb4, 0x08,
+	0x2d, 0x63, 0xe4, 0x12, 0x85, 0x88, 0x84, 0x43, 0x0d, 0x83, 0x2a, 0x13, 0x72, 0xd6, 0x83, 0x3b,
+	0x0c, 0xe4, 0x22, 0x34, 0xbb, 0xf4, 0xca, 0x0c, 0xf5, 0xdc, 0x53, 0x4b, 0x60, 0x9a, 0x5c, 0x2b,
+	0x52, 0x93, 0x4b, 0x4b, 0x32, 0xf3, 0xf3, 0xa0, 0xba, 0x83, 0x20, 0xce, 0x90, 0x72, 0xa1, 0xcc,
+	0x10, 0x88, 0x1f, 0x94, 0x18, 0x0c, 0x18, 0x85, 0xe6, 0x33, 0x72, 0x49, 0xa0, 0x3a, 0x14, 0xae,
+	0xbc, 0x58, 0xc8, 0x8e, 0xa0, 0x35, 0x3e, 0x99, 0xc5, 0x98, 0xf6, 0x14, 0xc3, 0x9c, 0x69, 0x4f,
+	0xb6, 0x7e, 0x84, 0x0b, 0x9d, 0x6a, 0x2e, 0x3c, 0x94, 0x63, 0xb8, 0xf1, 0x50, 0x8e, 0xe1, 0xc3,
+	0x43, 0x39, 0xc6, 0x86, 0x47, 0x72, 0x8c, 0x2b, 0x1e, 0xc9, 0x31, 0x9e, 0x78, 0x24, 0xc7, 0x78,
+	0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x2f, 0x1e, 0xc9, 0x31, 0x7c, 0x78, 0x24, 0xc7,
+	0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xb9, 0xa5,
+	0xe7, 0x23, 0x2c, 0xcf, 0xcc, 0x27, 0x22, 0xfd, 0x58, 0x63, 0x13, 0x4f, 0x62, 0x03, 0x47, 0xb4,
+	0x31, 0x60, 0x00, 0xd5, 0xe2, 0x72, 0xd5, 0x8a, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// HistoryStreamServiceClient is the client API for HistoryStreamService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type HistoryStreamServiceClient interface {
+	// StreamWorkflowHistory pushes the history events of a single workflow as they are
+	// written, until the workflow closes. Execution.RunId is optional and follows the
+	// current run. HistoryEventFilterType CLOSE_EVENT only pushes the close event.
+	// Each response carries a NextPageToken that resumes the stream after that response.
+	StreamWorkflowHistory(ctx context.Context, in *v1.GetWorkflowExecutionHistoryRequest, opts ...grpc.CallOption) (HistoryStreamService_StreamWorkflowHistoryClient, error)
+	// StreamWorkflowExecutions pushes the executions matching the visibility Query each
+	// time one starts or closes. Each response carries a NextPageToken that resumes the
+	// stream after that response.
+	StreamWorkflowExecutions(ctx context.Context, in *v1.ListWorkflowExecutionsRequest, opts ...grpc.CallOption) (HistoryStreamService_StreamWorkflowExecutionsClient, error)
+}
+
+type historyStreamServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHistoryStreamServiceClient(cc grpc.ClientConnInterface) HistoryStreamServiceClient {
+	return &historyStreamServiceClient{cc}
+}
+
+func (c *historyStreamServiceClient) StreamWorkflowHistory(ctx context.Context, in *v1.GetWorkflowExecutionHistoryRequest, opts ...grpc.CallOption) (HistoryStreamService_StreamWorkflowHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_HistoryStreamService_serviceDesc.Streams[0], "/temporal.server.api.historystreamservice.v1.HistoryStreamService/StreamWorkflowHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &historyStreamServiceStreamWorkflowHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type HistoryStreamService_StreamWorkflowHistoryClient interface {
+	Recv() (*v1.GetWorkflowExecutionHistoryResponse, error)
+	grpc.ClientStream
+}
+
+type historyStreamServiceStreamWorkflowHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *historyStreamServiceStreamWorkflowHistoryClient) Recv() (*v1.GetWorkflowExecutionHistoryResponse, error) {
+	m := new(v1.GetWorkflowExecutionHistoryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *historyStreamServiceClient) StreamWorkflowExecutions(ctx context.Context, in *v1.ListWorkflowExecutionsRequest, opts ...grpc.CallOption) (HistoryStreamService_StreamWorkflowExecutionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_HistoryStreamService_serviceDesc.Streams[1], "/temporal.server.api.historystreamservice.v1.HistoryStreamService/StreamWorkflowExecutions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &historyStreamServiceStreamWorkflowExecutionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type HistoryStreamService_StreamWorkflowExecutionsClient interface {
+	Recv() (*v1.ListWorkflowExecutionsResponse, error)
+	grpc.ClientStream
+}
+
+type historyStreamServiceStreamWorkflowExecutionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *historyStreamServiceStreamWorkflowExecutionsClient) Recv() (*v1.ListWorkflowExecutionsResponse, error) {
+	m := new(v1.ListWorkflowExecutionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// HistoryStreamServiceServer is the server API for HistoryStreamService service.
+type HistoryStreamServiceServer interface {
+	// StreamWorkflowHistory pushes the history events of a single workflow as they are
+	// written, until the workflow closes. Execution.RunId is optional and follows the
+	// current run. HistoryEventFilterType CLOSE_EVENT only pushes the close event.
+	// Each response carries a NextPageToken that resumes the stream after that response.
+	StreamWorkflowHistory(*v1.GetWorkflowExecutionHistoryRequest, HistoryStreamService_StreamWorkflowHistoryServer) error
+	// StreamWorkflowExecutions pushes the executions matching the visibility Query each
+	// time one starts or closes. Each response carries a NextPageToken that resumes the
+	// stream after that response.
+	StreamWorkflowExecutions(*v1.ListWorkflowExecutionsRequest, HistoryStreamService_StreamWorkflowExecutionsServer) error
+}
+
+// UnimplementedHistoryStreamServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedHistoryStreamServiceServer struct {
+}
+
+func (*UnimplementedHistoryStreamServiceServer) StreamWorkflowHistory(req *v1.GetWorkflowExecutionHistoryRequest, srv HistoryStreamService_StreamWorkflowHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamWorkflowHistory not implemented")
+}
+func (*UnimplementedHistoryStreamServiceServer) StreamWorkflowExecutions(req *v1.ListWorkflowExecutionsRequest, srv HistoryStreamService_StreamWorkflowExecutionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamWorkflowExecutions not implemented")
+}
+
+func RegisterHistoryStreamServiceServer(s *grpc.Server, srv HistoryStreamServiceServer) {
+	s.RegisterService(&_HistoryStreamService_serviceDesc, srv)
+}
+
+func _HistoryStreamService_StreamWorkflowHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(v1.GetWorkflowExecutionHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HistoryStreamServiceServer).StreamWorkflowHistory(m, &historyStreamServiceStreamWorkflowHistoryServer{stream})
+}
+
+type HistoryStreamService_StreamWorkflowHistoryServer interface {
+	Send(*v1.GetWorkflowExecutionHistoryResponse) error
+	grpc.ServerStream
+}
+
+type historyStreamServiceStreamWorkflowHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *historyStreamServiceStreamWorkflowHistoryServer) Send(m *v1.GetWorkflowExecutionHistoryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _HistoryStreamService_StreamWorkflowExecutions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(v1.ListWorkflowExecutionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HistoryStreamServiceServer).StreamWorkflowExecutions(m, &historyStreamServiceStreamWorkflowExecutionsServer{stream})
+}
+
+type HistoryStreamService_StreamWorkflowExecutionsServer interface {
+	Send(*v1.ListWorkflowExecutionsResponse) error
+	grpc.ServerStream
+}
+
+type historyStreamServiceStreamWorkflowExecutionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *historyStreamServiceStreamWorkflowExecutionsServer) Send(m *v1.ListWorkflowExecutionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _HistoryStreamService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "temporal.server.api.historystreamservice.v1.HistoryStreamService",
+	HandlerType: (*HistoryStreamServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamWorkflowHistory",
+			Handler:       _HistoryStreamService_StreamWorkflowHistory_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamWorkflowExecutions",
+			Handler:       _HistoryStreamService_StreamWorkflowExecutions_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "temporal/server/api/historystreamservice/v1/service.proto",
+}
@@ -0,0 +1,638 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: historystreamservice/v1/service.pb.go
+
+// Package historystreamservicemock is a generated GoMock package.
+package historystreamservicemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "go.temporal.io/api/workflowservice/v1"
+	v10 "go.temporal.io/server/api/historystreamservice/v1"
+	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
+)
+
+// MockHistoryStreamServiceClient is a mock of HistoryStreamServiceClient interface.
+type MockHistoryStreamServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryStreamServiceClientMockRecorder
+}
+
+// MockHistoryStreamServiceClientMockRecorder is the mock recorder for MockHistoryStreamServiceClient.
+type MockHistoryStreamServiceClientMockRecorder struct {
+	mock *MockHistoryStreamServiceClient
+}
+
+// NewMockHistoryStreamServiceClient creates a new mock instance.
+func NewMockHistoryStreamServiceClient(ctrl *gomock.Controller) *MockHistoryStreamServiceClient {
+	mock := &MockHistoryStreamServiceClient{ctrl: ctrl}
+	mock.recorder = &MockHistoryStreamServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryStreamServiceClient) EXPECT() *MockHistoryStreamServiceClientMockRecorder {
+	return m.recorder
+}
+
+// StreamWorkflowExecutions mocks base method.
+func (m *MockHistoryStreamServiceClient) StreamWorkflowExecutions(ctx context.Context, in *v1.ListWorkflowExecutionsRequest, opts ...grpc.CallOption) (v10.HistoryStreamService_StreamWorkflowExecutionsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamWorkflowExecutions", varargs...)
+	ret0, _ := ret[0].(v10.HistoryStreamService_StreamWorkflowExecutionsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamWorkflowExecutions indicates an expected call of StreamWorkflowExecutions.
+func (mr *MockHistoryStreamServiceClientMockRecorder) StreamWorkflowExecutions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowExecutions", reflect.TypeOf((*MockHistoryStreamServiceClient)(nil).StreamWorkflowExecutions), varargs...)
+}
+
+// StreamWorkflowHistory mocks base method.
+func (m *MockHistoryStreamServiceClient) StreamWorkflowHistory(ctx context.Context, in *v1.GetWorkflowExecutionHistoryRequest, opts ...grpc.CallOption) (v10.HistoryStreamService_StreamWorkflowHistoryClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamWorkflowHistory", varargs...)
+	ret0, _ := ret[0].(v10.HistoryStreamService_StreamWorkflowHistoryClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamWorkflowHistory indicates an expected call of StreamWorkflowHistory.
+func (mr *MockHistoryStreamServiceClientMockRecorder) StreamWorkflowHistory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowHistory", reflect.TypeOf((*MockHistoryStreamServiceClient)(nil).StreamWorkflowHistory), varargs...)
+}
+
+// MockHistoryStreamService_StreamWorkflowHistoryClient is a mock of HistoryStreamService_StreamWorkflowHistoryClient interface.
+type MockHistoryStreamService_StreamWorkflowHistoryClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryStreamService_StreamWorkflowHistoryClientMockRecorder
+}
+
+// MockHistoryStreamService_StreamWorkflowHistoryClientMockRecorder is the mock recorder for MockHistoryStreamService_StreamWorkflowHistoryClient.
+type MockHistoryStreamService_StreamWorkflowHistoryClientMockRecorder struct {
+	mock *MockHistoryStreamService_StreamWorkflowHistoryClient
+}
+
+// NewMockHistoryStreamService_StreamWorkflowHistoryClient creates a new mock instance.
+func NewMockHistoryStreamService_StreamWorkflowHistoryClient(ctrl *gomock.Controller) *MockHistoryStreamService_StreamWorkflowHistoryClient {
+	mock := &MockHistoryStreamService_StreamWorkflowHistoryClient{ctrl: ctrl}
+	mock.recorder = &MockHistoryStreamService_StreamWorkflowHistoryClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryStreamService_StreamWorkflowHistoryClient) EXPECT() *MockHistoryStreamService_StreamWorkflowHistoryClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockHistoryStreamService_StreamWorkflowHistoryClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockHistoryStreamService_StreamWorkflowHistoryClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowHistoryClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockHistoryStreamService_StreamWorkflowHistoryClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockHistoryStreamService_StreamWorkflowHistoryClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowHistoryClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockHistoryStreamService_StreamWorkflowHistoryClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockHistoryStreamService_StreamWorkflowHistoryClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowHistoryClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockHistoryStreamService_StreamWorkflowHistoryClient) Recv() (*v1.GetWorkflowExecutionHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*v1.GetWorkflowExecutionHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockHistoryStreamService_StreamWorkflowHistoryClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowHistoryClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockHistoryStreamService_StreamWorkflowHistoryClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockHistoryStreamService_StreamWorkflowHistoryClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowHistoryClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockHistoryStreamService_StreamWorkflowHistoryClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockHistoryStreamService_StreamWorkflowHistoryClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowHistoryClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockHistoryStreamService_StreamWorkflowHistoryClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockHistoryStreamService_StreamWorkflowHistoryClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowHistoryClient)(nil).Trailer))
+}
+
+// MockHistoryStreamService_StreamWorkflowExecutionsClient is a mock of HistoryStreamService_StreamWorkflowExecutionsClient interface.
+type MockHistoryStreamService_StreamWorkflowExecutionsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryStreamService_StreamWorkflowExecutionsClientMockRecorder
+}
+
+// MockHistoryStreamService_StreamWorkflowExecutionsClientMockRecorder is the mock recorder for MockHistoryStreamService_StreamWorkflowExecutionsClient.
+type MockHistoryStreamService_StreamWorkflowExecutionsClientMockRecorder struct {
+	mock *MockHistoryStreamService_StreamWorkflowExecutionsClient
+}
+
+// NewMockHistoryStreamService_StreamWorkflowExecutionsClient creates a new mock instance.
+func NewMockHistoryStreamService_StreamWorkflowExecutionsClient(ctrl *gomock.Controller) *MockHistoryStreamService_StreamWorkflowExecutionsClient {
+	mock := &MockHistoryStreamService_StreamWorkflowExecutionsClient{ctrl: ctrl}
+	mock.recorder = &MockHistoryStreamService_StreamWorkflowExecutionsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryStreamService_StreamWorkflowExecutionsClient) EXPECT() *MockHistoryStreamService_StreamWorkflowExecutionsClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockHistoryStreamService_StreamWorkflowExecutionsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockHistoryStreamService_StreamWorkflowExecutionsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowExecutionsClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockHistoryStreamService_StreamWorkflowExecutionsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockHistoryStreamService_StreamWorkflowExecutionsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowExecutionsClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockHistoryStreamService_StreamWorkflowExecutionsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockHistoryStreamService_StreamWorkflowExecutionsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowExecutionsClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockHistoryStreamService_StreamWorkflowExecutionsClient) Recv() (*v1.ListWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*v1.ListWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockHistoryStreamService_StreamWorkflowExecutionsClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowExecutionsClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockHistoryStreamService_StreamWorkflowExecutionsClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockHistoryStreamService_StreamWorkflowExecutionsClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowExecutionsClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockHistoryStreamService_StreamWorkflowExecutionsClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockHistoryStreamService_StreamWorkflowExecutionsClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowExecutionsClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockHistoryStreamService_StreamWorkflowExecutionsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockHistoryStreamService_StreamWorkflowExecutionsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowExecutionsClient)(nil).Trailer))
+}
+
+// MockHistoryStreamServiceServer is a mock of HistoryStreamServiceServer interface.
+type MockHistoryStreamServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryStreamServiceServerMockRecorder
+}
+
+// MockHistoryStreamServiceServerMockRecorder is the mock recorder for MockHistoryStreamServiceServer.
+type MockHistoryStreamServiceServerMockRecorder struct {
+	mock *MockHistoryStreamServiceServer
+}
+
+// NewMockHistoryStreamServiceServer creates a new mock instance.
+func NewMockHistoryStreamServiceServer(ctrl *gomock.Controller) *MockHistoryStreamServiceServer {
+	mock := &MockHistoryStreamServiceServer{ctrl: ctrl}
+	mock.recorder = &MockHistoryStreamServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryStreamServiceServer) EXPECT() *MockHistoryStreamServiceServerMockRecorder {
+	return m.recorder
+}
+
+// StreamWorkflowExecutions mocks base method.
+func (m *MockHistoryStreamServiceServer) StreamWorkflowExecutions(arg0 *v1.ListWorkflowExecutionsRequest, arg1 v10.HistoryStreamService_StreamWorkflowExecutionsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamWorkflowExecutions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamWorkflowExecutions indicates an expected call of StreamWorkflowExecutions.
+func (mr *MockHistoryStreamServiceServerMockRecorder) StreamWorkflowExecutions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowExecutions", reflect.TypeOf((*MockHistoryStreamServiceServer)(nil).StreamWorkflowExecutions), arg0, arg1)
+}
+
+// StreamWorkflowHistory mocks base method.
+func (m *MockHistoryStreamServiceServer) StreamWorkflowHistory(arg0 *v1.GetWorkflowExecutionHistoryRequest, arg1 v10.HistoryStreamService_StreamWorkflowHistoryServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamWorkflowHistory", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamWorkflowHistory indicates an expected call of StreamWorkflowHistory.
+func (mr *MockHistoryStreamServiceServerMockRecorder) StreamWorkflowHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowHistory", reflect.TypeOf((*MockHistoryStreamServiceServer)(nil).StreamWorkflowHistory), arg0, arg1)
+}
+
+// MockHistoryStreamService_StreamWorkflowHistoryServer is a mock of HistoryStreamService_StreamWorkflowHistoryServer interface.
+type MockHistoryStreamService_StreamWorkflowHistoryServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryStreamService_StreamWorkflowHistoryServerMockRecorder
+}
+
+// MockHistoryStreamService_StreamWorkflowHistoryServerMockRecorder is the mock recorder for MockHistoryStreamService_StreamWorkflowHistoryServer.
+type MockHistoryStreamService_StreamWorkflowHistoryServerMockRecorder struct {
+	mock *MockHistoryStreamService_StreamWorkflowHistoryServer
+}
+
+// NewMockHistoryStreamService_StreamWorkflowHistoryServer creates a new mock instance.
+func NewMockHistoryStreamService_StreamWorkflowHistoryServer(ctrl *gomock.Controller) *MockHistoryStreamService_StreamWorkflowHistoryServer {
+	mock := &MockHistoryStreamService_StreamWorkflowHistoryServer{ctrl: ctrl}
+	mock.recorder = &MockHistoryStreamService_StreamWorkflowHistoryServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryStreamService_StreamWorkflowHistoryServer) EXPECT() *MockHistoryStreamService_StreamWorkflowHistoryServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockHistoryStreamService_StreamWorkflowHistoryServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockHistoryStreamService_StreamWorkflowHistoryServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowHistoryServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockHistoryStreamService_StreamWorkflowHistoryServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockHistoryStreamService_StreamWorkflowHistoryServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowHistoryServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockHistoryStreamService_StreamWorkflowHistoryServer) Send(arg0 *v1.GetWorkflowExecutionHistoryResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockHistoryStreamService_StreamWorkflowHistoryServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowHistoryServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockHistoryStreamService_StreamWorkflowHistoryServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockHistoryStreamService_StreamWorkflowHistoryServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowHistoryServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockHistoryStreamService_StreamWorkflowHistoryServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockHistoryStreamService_StreamWorkflowHistoryServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowHistoryServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockHistoryStreamService_StreamWorkflowHistoryServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockHistoryStreamService_StreamWorkflowHistoryServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowHistoryServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockHistoryStreamService_StreamWorkflowHistoryServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockHistoryStreamService_StreamWorkflowHistoryServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowHistoryServer)(nil).SetTrailer), arg0)
+}
+
+// MockHistoryStreamService_StreamWorkflowExecutionsServer is a mock of HistoryStreamService_StreamWorkflowExecutionsServer interface.
+type MockHistoryStreamService_StreamWorkflowExecutionsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryStreamService_StreamWorkflowExecutionsServerMockRecorder
+}
+
+// MockHistoryStreamService_StreamWorkflowExecutionsServerMockRecorder is the mock recorder for MockHistoryStreamService_StreamWorkflowExecutionsServer.
+type MockHistoryStreamService_StreamWorkflowExecutionsServerMockRecorder struct {
+	mock *MockHistoryStreamService_StreamWorkflowExecutionsServer
+}
+
+// NewMockHistoryStreamService_StreamWorkflowExecutionsServer creates a new mock instance.
+func NewMockHistoryStreamService_StreamWorkflowExecutionsServer(ctrl *gomock.Controller) *MockHistoryStreamService_StreamWorkflowExecutionsServer {
+	mock := &MockHistoryStreamService_StreamWorkflowExecutionsServer{ctrl: ctrl}
+	mock.recorder = &MockHistoryStreamService_StreamWorkflowExecutionsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryStreamService_StreamWorkflowExecutionsServer) EXPECT() *MockHistoryStreamService_StreamWorkflowExecutionsServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockHistoryStreamService_StreamWorkflowExecutionsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockHistoryStreamService_StreamWorkflowExecutionsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowExecutionsServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockHistoryStreamService_StreamWorkflowExecutionsServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockHistoryStreamService_StreamWorkflowExecutionsServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowExecutionsServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockHistoryStreamService_StreamWorkflowExecutionsServer) Send(arg0 *v1.ListWorkflowExecutionsResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockHistoryStreamService_StreamWorkflowExecutionsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowExecutionsServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockHistoryStreamService_StreamWorkflowExecutionsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockHistoryStreamService_StreamWorkflowExecutionsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowExecutionsServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockHistoryStreamService_StreamWorkflowExecutionsServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockHistoryStreamService_StreamWorkflowExecutionsServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowExecutionsServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockHistoryStreamService_StreamWorkflowExecutionsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockHistoryStreamService_StreamWorkflowExecutionsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowExecutionsServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockHistoryStreamService_StreamWorkflowExecutionsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockHistoryStreamService_StreamWorkflowExecutionsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockHistoryStreamService_StreamWorkflowExecutionsServer)(nil).SetTrailer), arg0)
+}
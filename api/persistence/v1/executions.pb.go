@@ -243,6 +243,8 @@ type WorkflowExecutionInfo struct {
 	CloseVisibilityTaskId        int64      `protobuf:"varint,65,opt,name=close_visibility_task_id,json=closeVisibilityTaskId,proto3" json:"close_visibility_task_id,omitempty"`
 	CloseTime                    *time.Time `protobuf:"bytes,66,opt,name=close_time,json=closeTime,proto3,stdtime" json:"close_time,omitempty"`
 	CloseVisibilityTaskCompleted bool       `protobuf:"varint,67,opt,name=close_visibility_task_completed,json=closeVisibilityTaskCompleted,proto3" json:"close_visibility_task_completed,omitempty"`
+	// Request IDs of start requests attached to this run with WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING.
+	AttachedRequestIds []string `protobuf:"bytes,69,rep,name=attached_request_ids,json=attachedRequestIds,proto3" json:"attached_request_ids,omitempty"`
 }
 
 func (m *WorkflowExecutionInfo) Reset()      { *m = WorkflowExecutionInfo{} }
@@ -683,6 +685,13 @@ func (m *WorkflowExecutionInfo) GetCloseVisibilityTaskCompleted() bool {
 	return false
 }
 
+func (m *WorkflowExecutionInfo) GetAttachedRequestIds() []string {
+	if m != nil {
+		return m.AttachedRequestIds
+	}
+	return nil
+}
+
 type ExecutionStats struct {
 	HistorySize int64 `protobuf:"varint,1,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
 }
@@ -2224,225 +2233,226 @@ func init() {
 }
 
 var fileDescriptor_67a714d0e7ba9f37 = []byte{
-	// 3479 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x4d, 0x73, 0x1b, 0xc7,
-	0x95, 0x82, 0x38, 0x24, 0x06, 0x0f, 0x20, 0x38, 0x1c, 0x7e, 0x0d, 0x69, 0x0a, 0xa4, 0x60, 0xc9,
-	0xa6, 0x6c, 0x19, 0x34, 0x29, 0x79, 0xe5, 0x8f, 0x5d, 0x6b, 0x49, 0x4a, 0xb2, 0x81, 0x95, 0x65,
-	0x79, 0x48, 0x5b, 0x2e, 0xaf, 0x5d, 0xa8, 0xe1, 0x4c, 0x93, 0x9c, 0x25, 0x30, 0x03, 0xcd, 0x0c,
-	0x48, 0xc1, 0xb5, 0x07, 0x1f, 0xb6, 0xf6, 0xec, 0xdc, 0x72, 0xce, 0x29, 0xc7, 0x5c, 0x72, 0xcf,
-	0x21, 0x95, 0xca, 0x29, 0xe5, 0x5b, 0x7c, 0x4b, 0x2c, 0x5f, 0x72, 0x49, 0xd9, 0x95, 0x5f, 0x90,
-	0xea, 0xd7, 0xdd, 0xf3, 0x85, 0x21, 0x39, 0x54, 0xac, 0x83, 0x6f, 0x98, 0x7e, 0x9f, 0xfd, 0xfa,
-	0xf5, 0xfb, 0x6a, 0xc0, 0x8d, 0x80, 0x74, 0x7b, 0xae, 0x67, 0x74, 0x56, 0x7d, 0xe2, 0x1d, 0x11,
-	0x6f, 0xd5, 0xe8, 0xd9, 0xab, 0x3d, 0xe2, 0xf9, 0xb6, 0x1f, 0x10, 0xc7, 0x24, 0xab, 0x47, 0x6b,
-	0xab, 0xe4, 0x09, 0x31, 0xfb, 0x81, 0xed, 0x3a, 0x7e, 0xa3, 0xe7, 0xb9, 0x81, 0xab, 0xd6, 0x05,
-	0x51, 0x83, 0x11, 0x35, 0x8c, 0x9e, 0xdd, 0x88, 0x11, 0x35, 0x8e, 0xd6, 0x16, 0x6a, 0xfb, 0xae,
-	0xbb, 0xdf, 0x21, 0xab, 0x48, 0xb1, 0xdb, 0xdf, 0x5b, 0xb5, 0xfa, 0x9e, 0x41, 0x99, 0x30, 0x1e,
-	0x0b, 0x4b, 0x69, 0x78, 0x60, 0x77, 0x89, 0x1f, 0x18, 0xdd, 0x1e, 0x47, 0xb8, 0x6c, 0x91, 0x1e,
-	0x71, 0x2c, 0xe2, 0x98, 0x36, 0xf1, 0x57, 0xf7, 0xdd, 0x7d, 0x17, 0xd7, 0xf1, 0x17, 0x47, 0xb9,
-	0x12, 0x2a, 0x4f, 0xb5, 0x36, 0xdd, 0x6e, 0xd7, 0x75, 0xa8, 0xc2, 0x5d, 0xe2, 0xfb, 0xc6, 0x3e,
-	0xc9, 0xc4, 0x22, 0x4e, 0xbf, 0xeb, 0x53, 0xa4, 0x63, 0xd7, 0x3b, 0xdc, 0xeb, 0xb8, 0xc7, 0x1c,
-	0xeb, 0x6a, 0x02, 0x6b, 0xcf, 0xb0, 0x3b, 0x7d, 0x8f, 0x0c, 0x33, 0x7b, 0x29, 0x81, 0x26, 0x78,
-	0x0c, 0xe3, 0xbd, 0x92, 0x65, 0x57, 0xb3, 0xe3, 0x9a, 0x87, 0xc3, 0xb8, 0xd7, 0xb2, 0x70, 0x43,
-	0x3d, 0xd9, 0xb6, 0x38, 0xea, 0xab, 0xa7, 0xa2, 0xa6, 0xb6, 0xf4, 0xf2, 0xa9, 0xc8, 0x81, 0xe1,
-	0x1f, 0x72, 0xc4, 0x37, 0x72, 0x71, 0x6d, 0x53, 0x8a, 0x76, 0x30, 0xe8, 0x09, 0xbd, 0xaf, 0x67,
-	0x91, 0x1d, 0xd8, 0x7e, 0xe0, 0x7a, 0x83, 0xe1, 0x5d, 0xae, 0xe6, 0xf0, 0xb4, 0xc7, 0x7d, 0xd2,
-	0x27, 0xdc, 0xcb, 0xea, 0x7f, 0x28, 0x42, 0x69, 0xfb, 0xc0, 0xf0, 0xac, 0xa6, 0xb3, 0xe7, 0xaa,
-	0xf3, 0x20, 0xfb, 0xf4, 0xa3, 0x6d, 0x5b, 0x5a, 0x61, 0xb9, 0xb0, 0x32, 0xaa, 0x17, 0xf1, 0xbb,
-	0x69, 0x51, 0x90, 0x67, 0x38, 0xfb, 0x84, 0x82, 0x2e, 0x2e, 0x17, 0x56, 0x46, 0xf4, 0x22, 0x7e,
-	0x37, 0x2d, 0x75, 0x1a, 0x46, 0xdd, 0x63, 0x87, 0x78, 0xda, 0xc8, 0x72, 0x61, 0xa5, 0xa4, 0xb3,
-	0x0f, 0xf5, 0x3a, 0xa8, 0x7e, 0xe0, 0x76, 0x88, 0xd3, 0xf6, 0x6d, 0xc7, 0x24, 0x6d, 0x8f, 0x38,
-	0xe4, 0x58, 0x1b, 0x43, 0xae, 0x0a, 0x83, 0x6c, 0x53, 0x80, 0x4e, 0xd7, 0xd5, 0x0d, 0x28, 0xf7,
-	0x7b, 0x96, 0x11, 0x90, 0x36, 0x75, 0x51, 0xad, 0xb8, 0x5c, 0x58, 0x29, 0xaf, 0x2f, 0x34, 0x98,
-	0xff, 0x36, 0x84, 0xff, 0x36, 0x76, 0x84, 0xff, 0x6e, 0x4a, 0x5f, 0xff, 0x65, 0xa9, 0xa0, 0x03,
-	0x23, 0xa2, 0xcb, 0xea, 0x1d, 0xa8, 0x39, 0x46, 0x97, 0xf8, 0x3d, 0xc3, 0x24, 0x6d, 0xc7, 0x0d,
-	0xec, 0x3d, 0xdb, 0xc4, 0xcb, 0xd0, 0x3e, 0xa2, 0x06, 0x70, 0x1d, 0xad, 0x84, 0x7a, 0x2f, 0x86,
-	0x58, 0x0f, 0x62, 0x48, 0x9f, 0x30, 0x1c, 0xf5, 0xff, 0x0a, 0x30, 0xef, 0x91, 0x5e, 0x47, 0xd0,
-	0x5a, 0x9d, 0xc7, 0x6d, 0xc3, 0x3c, 0x6c, 0x77, 0xc8, 0x11, 0xe9, 0x68, 0xe3, 0xcb, 0x23, 0x2b,
-	0xe5, 0xf5, 0x66, 0xe3, 0xec, 0xbb, 0xd9, 0x08, 0xad, 0xda, 0xd0, 0x23, 0x76, 0x77, 0x3a, 0x8f,
-	0x37, 0xcc, 0xc3, 0xfb, 0x94, 0xd7, 0x5d, 0x27, 0xf0, 0x06, 0xfa, 0xac, 0x97, 0x09, 0x54, 0x0f,
-	0x41, 0xc1, 0x73, 0x8a, 0x64, 0xfb, 0x9a, 0x82, 0xc2, 0x37, 0xce, 0x27, 0xfc, 0x23, 0xca, 0x45,
-	0xb0, 0xf5, 0x99, 0xd0, 0xea, 0xe3, 0xc4, 0xa2, 0x6a, 0x40, 0x85, 0x09, 0xf3, 0x03, 0x23, 0x20,
-	0xbe, 0x36, 0x89, 0x82, 0xde, 0x7d, 0x06, 0x41, 0xdb, 0xc8, 0x80, 0x49, 0x29, 0x3f, 0x8e, 0x56,
-	0x16, 0x9a, 0xf0, 0xc2, 0x29, 0x66, 0x50, 0x15, 0x18, 0x39, 0x24, 0x03, 0xf4, 0xb9, 0x92, 0x4e,
-	0x7f, 0x52, 0xa7, 0x3a, 0x32, 0x3a, 0x7d, 0xc2, 0x9d, 0x8d, 0x7d, 0xbc, 0x7d, 0xf1, 0xcd, 0xc2,
-	0x42, 0x00, 0x53, 0x19, 0x9b, 0x8a, 0xb3, 0x18, 0x65, 0x2c, 0xde, 0x8b, 0xb3, 0x28, 0xaf, 0xaf,
-	0xe5, 0xd9, 0x4f, 0x82, 0x73, 0x5c, 0xaa, 0x03, 0x4a, 0x7a, 0x87, 0x19, 0x22, 0xef, 0x24, 0x45,
-	0x36, 0x72, 0x8b, 0x44, 0xb6, 0x31, 0x79, 0x2d, 0x49, 0x96, 0x94, 0xd1, 0x96, 0x24, 0x8f, 0x2a,
-	0x63, 0x2d, 0x49, 0x96, 0x95, 0x52, 0x4b, 0x92, 0x41, 0x29, 0xb7, 0x24, 0xb9, 0xac, 0x54, 0x5a,
-	0x92, 0x5c, 0x51, 0xc6, 0x5b, 0x92, 0x5c, 0x55, 0x26, 0x5a, 0x92, 0x3c, 0xa1, 0x28, 0xf5, 0x5f,
-	0x2d, 0xc3, 0xcc, 0x23, 0x1e, 0x44, 0xee, 0x8a, 0x5c, 0x82, 0x97, 0xfa, 0x32, 0x54, 0xa2, 0x7b,
-	0xc1, 0x2f, 0x76, 0x49, 0x2f, 0x87, 0x6b, 0x4d, 0x4b, 0x5d, 0x82, 0x72, 0x18, 0x80, 0xf8, 0xfd,
-	0x2e, 0xe9, 0x20, 0x96, 0x9a, 0x96, 0xda, 0x80, 0xa9, 0x9e, 0xe1, 0x11, 0x27, 0x68, 0x27, 0x58,
-	0xb1, 0x0b, 0x3f, 0xc9, 0x40, 0x0f, 0x62, 0x0c, 0xaf, 0x83, 0xca, 0xf1, 0xe3, 0x7c, 0x25, 0x44,
-	0x57, 0x18, 0xe4, 0x51, 0xc4, 0xbd, 0x0e, 0xe3, 0x1c, 0xdb, 0xeb, 0x3b, 0x14, 0x71, 0x94, 0xa9,
-	0xc8, 0x16, 0xf5, 0xbe, 0x93, 0xd0, 0xc0, 0x76, 0xec, 0xc0, 0x36, 0x02, 0x82, 0x51, 0x6a, 0x0c,
-	0xbd, 0x83, 0x6b, 0xd0, 0x14, 0x90, 0xa6, 0xa5, 0xbe, 0x05, 0xf3, 0xa6, 0xdb, 0xed, 0x75, 0x08,
-	0xde, 0x62, 0x72, 0x44, 0x29, 0x77, 0x8d, 0xc0, 0x3c, 0xa0, 0x54, 0x45, 0xa4, 0x9a, 0x8d, 0x10,
-	0xee, 0x52, 0xf8, 0x26, 0x05, 0x37, 0x2d, 0xf5, 0x12, 0x00, 0x46, 0x61, 0xf4, 0x5f, 0x0c, 0x1a,
-	0x25, 0xbd, 0x44, 0x57, 0xf0, 0xa4, 0xe8, 0xde, 0xa2, 0x68, 0x3d, 0xe8, 0x11, 0x34, 0x89, 0x06,
-	0x6c, 0x6f, 0x02, 0xb2, 0x33, 0xe8, 0x11, 0x6a, 0x10, 0xf5, 0x0b, 0x58, 0x08, 0xb1, 0xc3, 0x1c,
-	0x8f, 0x41, 0xce, 0xed, 0x07, 0x5a, 0x19, 0xdd, 0x64, 0x7e, 0x28, 0xce, 0xdd, 0xe1, 0x79, 0x7c,
-	0x53, 0xfa, 0x25, 0x0d, 0x73, 0xda, 0x71, 0xfa, 0x64, 0x77, 0x18, 0x03, 0xf5, 0x23, 0x98, 0x0e,
-	0xd9, 0x53, 0xe3, 0x09, 0xc6, 0x95, 0x7c, 0x8c, 0xc3, 0x9d, 0xe8, 0xfd, 0x90, 0xe5, 0x2e, 0x5c,
-	0xb2, 0xc8, 0x9e, 0xd1, 0xef, 0xc4, 0x0e, 0x8f, 0x65, 0x25, 0xce, 0x7b, 0x3c, 0x1f, 0xef, 0x05,
-	0xce, 0x45, 0x1c, 0xf4, 0x8e, 0xe1, 0x1f, 0x0a, 0x19, 0xaf, 0x82, 0xda, 0x31, 0xfc, 0x80, 0x9f,
+	// 3493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x4d, 0x73, 0xdb, 0xd6,
+	0x76, 0xa6, 0x05, 0x89, 0xe0, 0x21, 0x45, 0x41, 0xd0, 0x17, 0xa4, 0xc8, 0x94, 0xcc, 0xd8, 0x89,
+	0x9c, 0x38, 0x94, 0x25, 0x3b, 0x75, 0x3e, 0xda, 0xb8, 0x92, 0x2c, 0x27, 0x64, 0x1d, 0xc7, 0x81,
+	0x94, 0x38, 0x93, 0x26, 0xc3, 0x81, 0x80, 0x2b, 0x09, 0x15, 0x09, 0xd0, 0x00, 0x28, 0x99, 0x99,
+	0x2e, 0xb2, 0xe8, 0x74, 0x9d, 0xee, 0xfa, 0x13, 0xba, 0xec, 0xa6, 0xfb, 0x2e, 0x3a, 0x9d, 0xae,
+	0x3a, 0x59, 0x74, 0xa6, 0xd9, 0xbd, 0x17, 0x67, 0xf3, 0x36, 0x6f, 0x92, 0x79, 0xbf, 0xe0, 0xcd,
+	0x3d, 0xf7, 0x5e, 0x7c, 0x11, 0x92, 0x20, 0xbf, 0x78, 0x91, 0x1d, 0x71, 0xcf, 0xe7, 0x3d, 0xf7,
+	0xdc, 0xf3, 0x75, 0x09, 0xb7, 0x03, 0xd2, 0xed, 0xb9, 0x9e, 0xd1, 0x59, 0xf5, 0x89, 0x77, 0x4c,
+	0xbc, 0x55, 0xa3, 0x67, 0xaf, 0xf6, 0x88, 0xe7, 0xdb, 0x7e, 0x40, 0x1c, 0x93, 0xac, 0x1e, 0xaf,
+	0xad, 0x92, 0x67, 0xc4, 0xec, 0x07, 0xb6, 0xeb, 0xf8, 0x8d, 0x9e, 0xe7, 0x06, 0xae, 0x5a, 0x17,
+	0x44, 0x0d, 0x46, 0xd4, 0x30, 0x7a, 0x76, 0x23, 0x46, 0xd4, 0x38, 0x5e, 0x5b, 0xa8, 0x1d, 0xb8,
+	0xee, 0x41, 0x87, 0xac, 0x22, 0xc5, 0x5e, 0x7f, 0x7f, 0xd5, 0xea, 0x7b, 0x06, 0x65, 0xc2, 0x78,
+	0x2c, 0x2c, 0xa5, 0xe1, 0x81, 0xdd, 0x25, 0x7e, 0x60, 0x74, 0x7b, 0x1c, 0xe1, 0xaa, 0x45, 0x7a,
+	0xc4, 0xb1, 0x88, 0x63, 0xda, 0xc4, 0x5f, 0x3d, 0x70, 0x0f, 0x5c, 0x5c, 0xc7, 0x5f, 0x1c, 0xe5,
+	0x5a, 0xa8, 0x3c, 0xd5, 0xda, 0x74, 0xbb, 0x5d, 0xd7, 0xa1, 0x0a, 0x77, 0x89, 0xef, 0x1b, 0x07,
+	0x24, 0x13, 0x8b, 0x38, 0xfd, 0xae, 0x4f, 0x91, 0x4e, 0x5c, 0xef, 0x68, 0xbf, 0xe3, 0x9e, 0x70,
+	0xac, 0xeb, 0x09, 0xac, 0x7d, 0xc3, 0xee, 0xf4, 0x3d, 0x32, 0xcc, 0xec, 0xb5, 0x04, 0x9a, 0xe0,
+	0x31, 0x8c, 0xf7, 0x46, 0x96, 0x5d, 0xcd, 0x8e, 0x6b, 0x1e, 0x0d, 0xe3, 0xde, 0xc8, 0xc2, 0x0d,
+	0xf5, 0x64, 0xdb, 0xe2, 0xa8, 0x6f, 0x9e, 0x89, 0x9a, 0xda, 0xd2, 0xeb, 0x67, 0x22, 0x07, 0x86,
+	0x7f, 0xc4, 0x11, 0xdf, 0xce, 0xc5, 0xb5, 0x4d, 0x29, 0xda, 0xc1, 0xa0, 0x27, 0xf4, 0xbe, 0x99,
+	0x45, 0x76, 0x68, 0xfb, 0x81, 0xeb, 0x0d, 0x86, 0x77, 0xb9, 0x9a, 0xc3, 0xd3, 0x9e, 0xf6, 0x49,
+	0x9f, 0x70, 0x2f, 0xab, 0xff, 0x77, 0x11, 0x4a, 0x3b, 0x87, 0x86, 0x67, 0x35, 0x9d, 0x7d, 0x57,
+	0x9d, 0x07, 0xd9, 0xa7, 0x1f, 0x6d, 0xdb, 0xd2, 0x0a, 0xcb, 0x85, 0x95, 0x51, 0xbd, 0x88, 0xdf,
+	0x4d, 0x8b, 0x82, 0x3c, 0xc3, 0x39, 0x20, 0x14, 0x74, 0x79, 0xb9, 0xb0, 0x32, 0xa2, 0x17, 0xf1,
+	0xbb, 0x69, 0xa9, 0xd3, 0x30, 0xea, 0x9e, 0x38, 0xc4, 0xd3, 0x46, 0x96, 0x0b, 0x2b, 0x25, 0x9d,
+	0x7d, 0xa8, 0x37, 0x41, 0xf5, 0x03, 0xb7, 0x43, 0x9c, 0xb6, 0x6f, 0x3b, 0x26, 0x69, 0x7b, 0xc4,
+	0x21, 0x27, 0xda, 0x18, 0x72, 0x55, 0x18, 0x64, 0x87, 0x02, 0x74, 0xba, 0xae, 0x6e, 0x40, 0xb9,
+	0xdf, 0xb3, 0x8c, 0x80, 0xb4, 0xa9, 0x8b, 0x6a, 0xc5, 0xe5, 0xc2, 0x4a, 0x79, 0x7d, 0xa1, 0xc1,
+	0xfc, 0xb7, 0x21, 0xfc, 0xb7, 0xb1, 0x2b, 0xfc, 0x77, 0x53, 0xfa, 0xee, 0x77, 0x4b, 0x05, 0x1d,
+	0x18, 0x11, 0x5d, 0x56, 0xef, 0x43, 0xcd, 0x31, 0xba, 0xc4, 0xef, 0x19, 0x26, 0x69, 0x3b, 0x6e,
+	0x60, 0xef, 0xdb, 0x26, 0x5e, 0x86, 0xf6, 0x31, 0x35, 0x80, 0xeb, 0x68, 0x25, 0xd4, 0x7b, 0x31,
+	0xc4, 0x7a, 0x14, 0x43, 0xfa, 0x9c, 0xe1, 0xa8, 0xff, 0x54, 0x80, 0x79, 0x8f, 0xf4, 0x3a, 0x82,
+	0xd6, 0xea, 0x3c, 0x6d, 0x1b, 0xe6, 0x51, 0xbb, 0x43, 0x8e, 0x49, 0x47, 0x1b, 0x5f, 0x1e, 0x59,
+	0x29, 0xaf, 0x37, 0x1b, 0xe7, 0xdf, 0xcd, 0x46, 0x68, 0xd5, 0x86, 0x1e, 0xb1, 0xbb, 0xdf, 0x79,
+	0xba, 0x61, 0x1e, 0x3d, 0xa4, 0xbc, 0xb6, 0x9d, 0xc0, 0x1b, 0xe8, 0xb3, 0x5e, 0x26, 0x50, 0x3d,
+	0x02, 0x05, 0xcf, 0x29, 0x92, 0xed, 0x6b, 0x0a, 0x0a, 0xdf, 0xb8, 0x98, 0xf0, 0x4f, 0x29, 0x17,
+	0xc1, 0xd6, 0x67, 0x42, 0xab, 0x4f, 0x13, 0x8b, 0xaa, 0x01, 0x15, 0x26, 0xcc, 0x0f, 0x8c, 0x80,
+	0xf8, 0xda, 0x24, 0x0a, 0xfa, 0xe0, 0x05, 0x04, 0xed, 0x20, 0x03, 0x26, 0xa5, 0xfc, 0x34, 0x5a,
+	0x59, 0x68, 0xc2, 0x2b, 0x67, 0x98, 0x41, 0x55, 0x60, 0xe4, 0x88, 0x0c, 0xd0, 0xe7, 0x4a, 0x3a,
+	0xfd, 0x49, 0x9d, 0xea, 0xd8, 0xe8, 0xf4, 0x09, 0x77, 0x36, 0xf6, 0xf1, 0xde, 0xe5, 0x77, 0x0a,
+	0x0b, 0x01, 0x4c, 0x65, 0x6c, 0x2a, 0xce, 0x62, 0x94, 0xb1, 0xf8, 0x30, 0xce, 0xa2, 0xbc, 0xbe,
+	0x96, 0x67, 0x3f, 0x09, 0xce, 0x71, 0xa9, 0x0e, 0x28, 0xe9, 0x1d, 0x66, 0x88, 0xbc, 0x9f, 0x14,
+	0xd9, 0xc8, 0x2d, 0x12, 0xd9, 0xc6, 0xe4, 0xb5, 0x24, 0x59, 0x52, 0x46, 0x5b, 0x92, 0x3c, 0xaa,
+	0x8c, 0xb5, 0x24, 0x59, 0x56, 0x4a, 0x2d, 0x49, 0x06, 0xa5, 0xdc, 0x92, 0xe4, 0xb2, 0x52, 0x69,
+	0x49, 0x72, 0x45, 0x19, 0x6f, 0x49, 0x72, 0x55, 0x99, 0x68, 0x49, 0xf2, 0x84, 0xa2, 0xd4, 0xff,
+	0x6f, 0x19, 0x66, 0x9e, 0xf0, 0x20, 0xb2, 0x2d, 0x72, 0x09, 0x5e, 0xea, 0xab, 0x50, 0x89, 0xee,
+	0x05, 0xbf, 0xd8, 0x25, 0xbd, 0x1c, 0xae, 0x35, 0x2d, 0x75, 0x09, 0xca, 0x61, 0x00, 0xe2, 0xf7,
+	0xbb, 0xa4, 0x83, 0x58, 0x6a, 0x5a, 0x6a, 0x03, 0xa6, 0x7a, 0x86, 0x47, 0x9c, 0xa0, 0x9d, 0x60,
+	0xc5, 0x2e, 0xfc, 0x24, 0x03, 0x3d, 0x8a, 0x31, 0xbc, 0x09, 0x2a, 0xc7, 0x8f, 0xf3, 0x95, 0x10,
+	0x5d, 0x61, 0x90, 0x27, 0x11, 0xf7, 0x3a, 0x8c, 0x73, 0x6c, 0xaf, 0xef, 0x50, 0xc4, 0x51, 0xa6,
+	0x22, 0x5b, 0xd4, 0xfb, 0x4e, 0x42, 0x03, 0xdb, 0xb1, 0x03, 0xdb, 0x08, 0x08, 0x46, 0xa9, 0x31,
+	0xf4, 0x0e, 0xae, 0x41, 0x53, 0x40, 0x9a, 0x96, 0xfa, 0x2e, 0xcc, 0x9b, 0x6e, 0xb7, 0xd7, 0x21,
+	0x78, 0x8b, 0xc9, 0x31, 0xa5, 0xdc, 0x33, 0x02, 0xf3, 0x90, 0x52, 0x15, 0x91, 0x6a, 0x36, 0x42,
+	0xd8, 0xa6, 0xf0, 0x4d, 0x0a, 0x6e, 0x5a, 0xea, 0x15, 0x00, 0x8c, 0xc2, 0xe8, 0xbf, 0x18, 0x34,
+	0x4a, 0x7a, 0x89, 0xae, 0xe0, 0x49, 0xd1, 0xbd, 0x45, 0xd1, 0x7a, 0xd0, 0x23, 0x68, 0x12, 0x0d,
+	0xd8, 0xde, 0x04, 0x64, 0x77, 0xd0, 0x23, 0xd4, 0x20, 0xea, 0xd7, 0xb0, 0x10, 0x62, 0x87, 0x39,
+	0x1e, 0x83, 0x9c, 0xdb, 0x0f, 0xb4, 0x32, 0xba, 0xc9, 0xfc, 0x50, 0x9c, 0xbb, 0xcf, 0xf3, 0xf8,
+	0xa6, 0xf4, 0xaf, 0x34, 0xcc, 0x69, 0x27, 0xe9, 0x93, 0xdd, 0x65, 0x0c, 0xd4, 0x4f, 0x61, 0x3a,
+	0x64, 0xef, 0xf5, 0x23, 0xc6, 0x95, 0x7c, 0x8c, 0xc3, 0x9d, 0xe8, 0xfd, 0x90, 0xe5, 0x1e, 0x5c,
+	0xb1, 0xc8, 0xbe, 0xd1, 0xef, 0xc4, 0x0e, 0x8f, 0x65, 0x25, 0xce, 0x7b, 0x3c, 0x1f, 0xef, 0x05,
+	0xce, 0x45, 0x1c, 0xf4, 0xae, 0xe1, 0x1f, 0x09, 0x19, 0x6f, 0x82, 0xda, 0x31, 0xfc, 0x80, 0x9f,
 	0x0b, 0x72, 0xb7, 0x2d, 0x6d, 0x12, 0x8f, 0x65, 0x82, 0x42, 0xf0, 0x40, 0x28, 0x45, 0xd3, 0x52,
-	0x5f, 0x83, 0x29, 0x44, 0xde, 0xb3, 0xbd, 0x90, 0xc4, 0xb6, 0x34, 0x15, 0xb1, 0x15, 0x0a, 0xba,
-	0x47, 0x21, 0x48, 0xd2, 0xb4, 0xd4, 0xff, 0x82, 0x17, 0x11, 0x3d, 0xa9, 0xbc, 0x1f, 0x18, 0x1e,
-	0xf5, 0x99, 0x90, 0x7c, 0x0a, 0xc9, 0x6b, 0x14, 0x35, 0xae, 0xe1, 0x36, 0xc3, 0x13, 0xcc, 0x6e,
-	0x03, 0x20, 0x25, 0x4b, 0x4b, 0xd3, 0x39, 0xd3, 0x52, 0x09, 0x69, 0x30, 0x2b, 0xb5, 0x00, 0x35,
-	0x6c, 0xc7, 0xb3, 0xdb, 0x4c, 0x4e, 0x36, 0x55, 0x4a, 0xf9, 0x71, 0x94, 0xe1, 0xd6, 0x61, 0x26,
-	0xb9, 0x29, 0x91, 0xd8, 0x66, 0x71, 0x2f, 0x53, 0xc7, 0xb1, 0x7d, 0x88, 0x7c, 0x76, 0x0f, 0x96,
-	0x53, 0x86, 0x30, 0x0f, 0x88, 0xd5, 0xef, 0xc4, 0x4d, 0x31, 0xc7, 0xf2, 0x62, 0x9c, 0x7c, 0x5b,
-	0x60, 0x09, 0x43, 0x6c, 0x42, 0xed, 0x0c, 0x83, 0x6a, 0xc8, 0x65, 0xe1, 0xf8, 0x64, 0x63, 0x6e,
-	0xa7, 0xf5, 0x17, 0x1e, 0x35, 0x9f, 0xcf, 0xa3, 0x12, 0x1b, 0x14, 0xae, 0x34, 0x64, 0x14, 0x23,
-	0xa0, 0x41, 0x37, 0xd0, 0x16, 0x30, 0x2c, 0x27, 0x68, 0x36, 0x18, 0x28, 0x71, 0x29, 0x13, 0x9b,
-	0xc1, 0xe3, 0x79, 0x21, 0xe7, 0xf1, 0xcc, 0x65, 0x6c, 0x15, 0xcf, 0xc9, 0x80, 0xc5, 0x93, 0x6c,
-	0x8e, 0x02, 0x16, 0x73, 0x0a, 0x98, 0xcf, 0x3c, 0x11, 0x14, 0xe1, 0xc1, 0xd5, 0xa4, 0x08, 0xd7,
-	0xb3, 0xf7, 0x6d, 0xc7, 0xe8, 0xa4, 0x65, 0xd5, 0x72, 0xca, 0xba, 0x1c, 0x97, 0xf5, 0x21, 0x67,
-	0x96, 0x94, 0x79, 0x0b, 0xb4, 0xa4, 0x4c, 0x8f, 0x3c, 0xee, 0x13, 0x1f, 0x0f, 0x7f, 0x09, 0xc3,
-	0xdf, 0x4c, 0x9c, 0x89, 0xce, 0xa0, 0x4d, 0x4b, 0xfd, 0x3c, 0x1e, 0x31, 0x45, 0x7d, 0xab, 0xdd,
-	0x59, 0x2e, 0xac, 0x54, 0x4f, 0x48, 0x91, 0x58, 0x17, 0xd3, 0xe4, 0x98, 0x08, 0x1e, 0x83, 0x1e,
-	0x89, 0x45, 0x58, 0xbe, 0xa2, 0x5e, 0x03, 0xc5, 0x34, 0x1c, 0x93, 0x74, 0x84, 0x3e, 0xc4, 0xd2,
-	0x2e, 0x2d, 0x17, 0x56, 0x64, 0x7d, 0x82, 0xad, 0xeb, 0x62, 0x59, 0x7d, 0x05, 0x26, 0x93, 0xa8,
-	0x54, 0xf5, 0x65, 0x54, 0x3d, 0x89, 0xdb, 0x44, 0x5c, 0x3f, 0xb0, 0xcd, 0xc3, 0x41, 0x3b, 0x96,
-	0x0c, 0x2e, 0x33, 0x5c, 0x06, 0xd8, 0x09, 0x53, 0xc2, 0x3e, 0x2c, 0x73, 0x5c, 0x61, 0xfd, 0x76,
-	0xe0, 0xb6, 0xa3, 0xc0, 0x41, 0x7d, 0xbc, 0x9e, 0xcf, 0xc7, 0x17, 0x19, 0x23, 0x61, 0xf9, 0x1d,
-	0x77, 0x5b, 0x84, 0x12, 0xea, 0xec, 0x1a, 0x14, 0x85, 0x7b, 0xbf, 0xc8, 0xea, 0x73, 0xfe, 0xa9,
-	0x7e, 0x0c, 0xb3, 0x1e, 0x09, 0xbc, 0x01, 0x4f, 0x8f, 0x9d, 0xb6, 0xed, 0x04, 0xc4, 0x3b, 0x32,
-	0x3a, 0xda, 0x95, 0x7c, 0x82, 0xa7, 0x91, 0x9c, 0xa5, 0xd0, 0x4e, 0x93, 0x13, 0x47, 0x6c, 0xbb,
-	0xc6, 0x13, 0xbb, 0xdb, 0xef, 0x46, 0x6c, 0xaf, 0x9e, 0x87, 0xed, 0x07, 0x8c, 0x3a, 0x64, 0x7b,
-	0x33, 0xcd, 0x96, 0x6f, 0xc3, 0xd7, 0x5e, 0xc2, 0x6d, 0x25, 0xa8, 0xf8, 0xad, 0xf5, 0xd5, 0xb7,
-	0x69, 0x69, 0x4e, 0xa9, 0x76, 0x0d, 0xf3, 0xd0, 0xdd, 0xdb, 0x6b, 0x9b, 0x2e, 0xd9, 0xdb, 0xb3,
-	0x4d, 0x9b, 0x38, 0x81, 0xf6, 0xf2, 0x72, 0x61, 0xa5, 0xa0, 0xcf, 0x21, 0xc2, 0x26, 0x83, 0x6f,
-	0x45, 0x60, 0xb5, 0x0b, 0xf5, 0x8c, 0x3c, 0x4c, 0x9e, 0xf4, 0x6c, 0xa6, 0x2e, 0xbb, 0x2d, 0x2b,
-	0x39, 0x6f, 0xcb, 0xd2, 0x50, 0x42, 0xbe, 0x1b, 0x72, 0xe2, 0xcd, 0xc8, 0x12, 0x53, 0xd5, 0x71,
-	0x9d, 0x36, 0xfe, 0x32, 0x76, 0x3b, 0xa4, 0x4d, 0x3c, 0xcf, 0xf5, 0xd0, 0xfd, 0x7d, 0xed, 0xda,
-	0xf2, 0xc8, 0x4a, 0x49, 0x7f, 0x01, 0x81, 0x0f, 0x5c, 0x47, 0x17, 0x48, 0x77, 0x29, 0x0e, 0xf5,
-	0x6c, 0x5f, 0x5d, 0x01, 0xe5, 0xc0, 0xf0, 0x19, 0x7d, 0xbb, 0xe7, 0x76, 0x6c, 0x73, 0xa0, 0xbd,
-	0x82, 0xae, 0x5d, 0x3d, 0x30, 0x7c, 0xa4, 0x78, 0x88, 0xab, 0xea, 0x8b, 0x30, 0x6e, 0x7a, 0xae,
-	0x13, 0xfa, 0x9f, 0xf6, 0x2a, 0x7a, 0x6a, 0x85, 0x2e, 0x0a, 0x5f, 0xa2, 0x95, 0xa0, 0x6f, 0xef,
-	0xd3, 0x20, 0x61, 0xba, 0x7d, 0x27, 0xd0, 0x1a, 0x18, 0xb1, 0xcb, 0x6c, 0x6d, 0x8b, 0x2e, 0xa9,
-	0x1f, 0xc1, 0xa4, 0xd1, 0x0f, 0xdc, 0xb6, 0x47, 0x7c, 0x12, 0xb4, 0x7b, 0xae, 0xed, 0x04, 0xbe,
-	0x76, 0x03, 0xad, 0x72, 0x35, 0xba, 0xa9, 0xf4, 0x8a, 0x86, 0x7d, 0xf0, 0xd1, 0x5a, 0x43, 0xa7,
-	0xd8, 0x0f, 0x11, 0x59, 0x9f, 0xa0, 0xf4, 0xb1, 0x05, 0xf5, 0x7f, 0x61, 0xd2, 0x27, 0x86, 0x67,
-	0x1e, 0xd0, 0x43, 0xf6, 0xec, 0xdd, 0x3e, 0x6d, 0x31, 0x6e, 0x62, 0x8b, 0xf1, 0x61, 0x9e, 0xfa,
-	0x38, 0xb3, 0xaa, 0x6d, 0x6c, 0x23, 0xcb, 0x8d, 0x90, 0x23, 0xeb, 0x39, 0x14, 0x3f, 0xb5, 0xac,
-	0x3e, 0x02, 0xa9, 0x4b, 0xba, 0xae, 0xf6, 0x06, 0x0a, 0xdc, 0x7a, 0x76, 0x81, 0x1f, 0x90, 0xae,
-	0xcb, 0x84, 0x20, 0x43, 0xf5, 0x0b, 0x98, 0xe4, 0xe9, 0xb7, 0xcd, 0xda, 0x71, 0x9b, 0xf8, 0xda,
-	0xbf, 0xa1, 0xa5, 0x5e, 0xcf, 0x94, 0xc2, 0x9b, 0x76, 0x2a, 0x81, 0x27, 0xe7, 0xf7, 0x05, 0x9d,
-	0xae, 0x1c, 0xa5, 0x56, 0xd4, 0x1b, 0x30, 0xcb, 0xeb, 0x9d, 0xd0, 0x59, 0x79, 0x71, 0x7c, 0x0b,
-	0x4f, 0x76, 0x0a, 0xa1, 0xa1, 0x8a, 0xac, 0x48, 0xfe, 0x6f, 0x98, 0x88, 0xd0, 0x69, 0x33, 0xe7,
-	0x6b, 0x6f, 0xa2, 0x46, 0xeb, 0x79, 0xf6, 0x1d, 0x32, 0xa3, 0xcd, 0x88, 0xaf, 0x57, 0x49, 0xe2,
-	0x3b, 0x91, 0xd5, 0xa8, 0x2a, 0xe9, 0xbb, 0xf3, 0xd6, 0x79, 0xb3, 0x9a, 0xde, 0x4f, 0xdf, 0x9a,
-	0x9b, 0x30, 0x37, 0x54, 0xe9, 0x05, 0x4f, 0x70, 0xd7, 0x6f, 0xb3, 0x12, 0x27, 0x59, 0xed, 0xed,
-	0x3c, 0xa1, 0xbb, 0xbe, 0x09, 0xb3, 0xd8, 0xb8, 0xb6, 0x03, 0xcf, 0x70, 0x7c, 0x1b, 0x35, 0x62,
-	0x0e, 0xfe, 0x0e, 0x12, 0x4d, 0x23, 0x74, 0x27, 0x04, 0x32, 0x4f, 0x7f, 0x0f, 0xaa, 0xc9, 0x7a,
-	0x5c, 0xfb, 0xf7, 0x9c, 0x1b, 0x18, 0x27, 0xf1, 0x2a, 0x5c, 0x5d, 0x85, 0x69, 0x87, 0x1c, 0x0f,
-	0x9f, 0xd3, 0x7f, 0xb0, 0xe6, 0xc8, 0x21, 0xc7, 0xa9, 0x53, 0xba, 0x0f, 0x15, 0xde, 0xca, 0xe0,
-	0xac, 0x4a, 0x7b, 0x17, 0xe5, 0x5e, 0xcb, 0x3c, 0x22, 0xc4, 0x60, 0x2e, 0x63, 0x06, 0xae, 0xb7,
-	0x45, 0x3f, 0x45, 0x63, 0x84, 0x1f, 0xea, 0x9b, 0xa0, 0x0d, 0x35, 0x46, 0xa2, 0x2e, 0xbc, 0xcd,
-	0xfa, 0x9c, 0x54, 0x77, 0x24, 0x4a, 0xc3, 0x1b, 0x30, 0x6b, 0x76, 0x5c, 0x9f, 0xdb, 0x6d, 0x8f,
-	0x78, 0x61, 0x21, 0xfe, 0x9f, 0xcc, 0xd8, 0x08, 0xdd, 0xe1, 0x40, 0x5e, 0x8c, 0xdf, 0x02, 0x8d,
-	0x11, 0x1d, 0xd9, 0xbe, 0xbd, 0x6b, 0x77, 0xec, 0x60, 0x10, 0x92, 0x6d, 0x20, 0xd9, 0x0c, 0xc2,
-	0x3f, 0x09, 0xc1, 0x9c, 0xf0, 0x36, 0x00, 0x97, 0x46, 0x6d, 0xbd, 0x99, 0xb7, 0x92, 0x66, 0x3a,
-	0x50, 0x3b, 0xdf, 0x85, 0xa5, 0x6c, 0xc9, 0xbc, 0x8d, 0x23, 0x96, 0xb6, 0x85, 0xb1, 0x71, 0x31,
-	0x43, 0x81, 0x2d, 0x81, 0xb3, 0x60, 0xc1, 0x4c, 0x66, 0xec, 0xc8, 0x98, 0x41, 0xbc, 0x91, 0xec,
-	0xe6, 0x97, 0x92, 0x01, 0x90, 0xcf, 0x0c, 0x8f, 0xd6, 0x1a, 0x0f, 0x8d, 0x41, 0xc7, 0x35, 0xac,
-	0xf8, 0xb8, 0xe0, 0x53, 0x28, 0x85, 0x01, 0xe3, 0x27, 0xe5, 0x1c, 0x0e, 0x03, 0xc2, 0xd6, 0xbf,
-	0x25, 0xc9, 0x8a, 0x32, 0xd9, 0x92, 0xe4, 0xeb, 0xca, 0x6b, 0x2d, 0x49, 0x7e, 0x4d, 0x69, 0xb4,
-	0x24, 0x79, 0x55, 0x79, 0xbd, 0x25, 0xc9, 0xaf, 0x2b, 0x6b, 0x2d, 0x49, 0x5e, 0x53, 0xd6, 0x5b,
-	0x92, 0xbc, 0xae, 0xdc, 0xa8, 0xdf, 0x80, 0x6a, 0xf2, 0x92, 0xd3, 0x94, 0xc0, 0xe3, 0x52, 0xdb,
-	0xb7, 0xbf, 0x24, 0xa8, 0xe3, 0x88, 0x5e, 0xe6, 0x6b, 0xdb, 0xf6, 0x97, 0xa4, 0xfe, 0x43, 0x01,
-	0x66, 0x87, 0x42, 0x22, 0xce, 0x2b, 0xb0, 0x9e, 0xf2, 0x08, 0xbd, 0x7a, 0xb1, 0x7a, 0xaa, 0xc0,
-	0xeb, 0x29, 0x04, 0x44, 0xf5, 0xd4, 0x0c, 0x8c, 0xf1, 0x8b, 0xc1, 0xc6, 0x0b, 0xa3, 0x1e, 0x5e,
-	0x86, 0x16, 0x8c, 0xe2, 0xf5, 0xc4, 0x59, 0x42, 0x75, 0xfd, 0x66, 0xbe, 0x72, 0x30, 0xa9, 0x87,
-	0xce, 0x58, 0xa8, 0xf7, 0x60, 0x8c, 0xfe, 0xe8, 0xfb, 0x38, 0x69, 0x48, 0xd4, 0x96, 0x67, 0x73,
-	0xe9, 0xfb, 0x3a, 0xa7, 0xae, 0xff, 0x63, 0x0c, 0x94, 0x84, 0xdb, 0xff, 0x54, 0x63, 0x94, 0xc8,
-	0x06, 0x23, 0x71, 0x1b, 0x6c, 0x41, 0x29, 0x2a, 0x8b, 0x99, 0xea, 0x2f, 0x9d, 0x6e, 0x87, 0xb0,
-	0x1c, 0x96, 0x03, 0x51, 0x06, 0x37, 0x60, 0x2a, 0x30, 0xbc, 0x7d, 0x92, 0x1a, 0xd1, 0xb0, 0x51,
-	0xca, 0x24, 0x03, 0xa5, 0x46, 0x34, 0x1c, 0x3f, 0xae, 0xf3, 0x18, 0x1b, 0x63, 0x30, 0x48, 0x72,
-	0x44, 0xc3, 0xb1, 0xf9, 0x06, 0x8a, 0x6c, 0xfb, 0x6c, 0x91, 0xc5, 0xb5, 0xe4, 0xdc, 0x44, 0x4e,
-	0xcf, 0x4d, 0xde, 0x81, 0x05, 0xce, 0xc2, 0x3c, 0xb0, 0x3b, 0x56, 0x24, 0xd6, 0x75, 0x3a, 0x03,
-	0x1c, 0xb3, 0xc8, 0xfa, 0x1c, 0xc3, 0xd8, 0xa2, 0x08, 0x42, 0xfa, 0x87, 0x4e, 0x67, 0x80, 0xd3,
-	0xe4, 0xe1, 0xc6, 0x15, 0xd8, 0x08, 0xc0, 0x4f, 0x37, 0xab, 0x1a, 0x14, 0x45, 0x08, 0x2c, 0xb3,
-	0x59, 0x35, 0xff, 0x54, 0xe7, 0xa0, 0x28, 0xa2, 0x55, 0x05, 0x21, 0x63, 0x01, 0x0b, 0x4f, 0x4d,
-	0x98, 0x88, 0xc7, 0x15, 0x1a, 0xa3, 0xc6, 0xf3, 0xb6, 0xe9, 0x11, 0x21, 0x06, 0xaa, 0xeb, 0xa0,
-	0x5a, 0x84, 0x06, 0x9b, 0xb6, 0xb1, 0x17, 0x10, 0xaf, 0x8d, 0xe1, 0x48, 0x9b, 0xc0, 0x0d, 0x2a,
-	0x0c, 0xb2, 0x41, 0x01, 0x5b, 0x74, 0x5d, 0xfd, 0x45, 0x01, 0x58, 0xc0, 0x8a, 0x8f, 0x87, 0xa8,
-	0x8a, 0x16, 0x09, 0x0c, 0x1b, 0xc7, 0xbe, 0x54, 0x8d, 0x07, 0x79, 0x32, 0x78, 0xda, 0x69, 0x1b,
-	0x28, 0x22, 0x1a, 0x1a, 0x19, 0xfe, 0xe1, 0x1d, 0xc6, 0xf5, 0xfd, 0x0b, 0xfa, 0xbc, 0x79, 0x12,
-	0x70, 0xe1, 0x73, 0x98, 0x3f, 0x91, 0x52, 0xbd, 0x0d, 0x8b, 0xa6, 0xe1, 0xb4, 0xfd, 0x43, 0xbb,
-	0x17, 0x0f, 0xc5, 0x34, 0xa4, 0xda, 0xb4, 0x31, 0x28, 0xe0, 0x46, 0xe7, 0x4d, 0xc3, 0xd9, 0x3e,
-	0xb4, 0x7b, 0x51, 0x18, 0xde, 0xe0, 0x08, 0x9b, 0x55, 0xa8, 0xc4, 0x37, 0xc8, 0x62, 0x59, 0xfd,
-	0xb7, 0x12, 0x4c, 0xc5, 0x46, 0xc4, 0x3f, 0x9b, 0x7b, 0x17, 0xf3, 0xb5, 0xd1, 0xa4, 0xaf, 0x5d,
-	0x81, 0x6a, 0x6a, 0x64, 0xc5, 0xa6, 0x95, 0x95, 0xbd, 0xf8, 0xb8, 0xaa, 0x0e, 0xe3, 0x0e, 0x79,
-	0x12, 0x43, 0x62, 0xc3, 0xc9, 0x32, 0x5d, 0x14, 0x38, 0xd9, 0xde, 0x2f, 0x9f, 0xe0, 0xfd, 0x97,
-	0xa1, 0xb2, 0xeb, 0x19, 0x8e, 0x79, 0xd0, 0x0e, 0xdc, 0x43, 0xc2, 0xae, 0x40, 0x45, 0x2f, 0xb3,
-	0xb5, 0x1d, 0xba, 0x24, 0x6a, 0x16, 0x6a, 0x94, 0x04, 0xea, 0x38, 0xa2, 0xd2, 0x9a, 0x45, 0xef,
-	0x3b, 0x9b, 0x31, 0x82, 0xd8, 0xbd, 0x99, 0x38, 0xeb, 0xde, 0x28, 0xcf, 0x78, 0x6f, 0x16, 0x01,
-	0x84, 0x52, 0x7c, 0x18, 0x58, 0xd2, 0x65, 0xa6, 0x4a, 0xd3, 0x6a, 0x49, 0x72, 0x49, 0x81, 0x70,
-	0x08, 0x1e, 0x8e, 0xbf, 0xeb, 0x7f, 0x1f, 0x01, 0x35, 0x55, 0x6c, 0xfc, 0xbc, 0xdd, 0x26, 0x66,
-	0xea, 0xb1, 0xb3, 0x4c, 0x5d, 0x7c, 0x46, 0x53, 0x27, 0x8b, 0x31, 0xf9, 0xfc, 0xc5, 0x58, 0x72,
-	0x2e, 0x5a, 0x3a, 0xff, 0x5c, 0xf4, 0xb4, 0x3a, 0x12, 0x4e, 0xa9, 0x23, 0xeb, 0x3f, 0x48, 0x30,
-	0x4e, 0x39, 0xfc, 0x7c, 0x32, 0xf3, 0x5d, 0xa8, 0xf0, 0x21, 0x10, 0xe3, 0x33, 0x8a, 0x7c, 0xea,
-	0x27, 0x14, 0x27, 0x7c, 0xd4, 0x83, 0x3c, 0xca, 0x41, 0xf4, 0xa1, 0x92, 0xd8, 0xa0, 0x53, 0x0c,
-	0x40, 0x90, 0xdf, 0x18, 0xf2, 0x5b, 0xcb, 0x57, 0x39, 0xf1, 0xd1, 0x08, 0xb2, 0x0f, 0x67, 0xa3,
-	0xb1, 0xc5, 0xb8, 0x63, 0x16, 0x93, 0x8e, 0x79, 0x0d, 0xc2, 0x58, 0x13, 0x0e, 0x59, 0x65, 0x1c,
-	0xd7, 0x4c, 0x88, 0x75, 0x31, 0x60, 0x9d, 0x07, 0x39, 0x0c, 0x53, 0xec, 0xd5, 0xb5, 0x48, 0x78,
-	0x74, 0x8a, 0xb9, 0x37, 0x9c, 0xe5, 0xde, 0xe5, 0x67, 0x74, 0xef, 0x74, 0x04, 0xac, 0x0c, 0x47,
-	0xc0, 0x6b, 0xa0, 0x18, 0x1d, 0x8f, 0x18, 0x96, 0xc8, 0x5c, 0xc4, 0xc2, 0xe8, 0x27, 0xeb, 0x13,
-	0x7c, 0x7d, 0x83, 0x2f, 0xd7, 0x7f, 0x73, 0x11, 0x14, 0x91, 0xbc, 0x42, 0xa7, 0x8b, 0x6d, 0xa3,
-	0x90, 0xd8, 0x46, 0xda, 0x1b, 0x2f, 0x9e, 0xe9, 0x8d, 0x23, 0xa7, 0x78, 0xa3, 0x74, 0xa2, 0x37,
-	0x8e, 0xfe, 0xeb, 0x81, 0x67, 0x2c, 0x79, 0xbe, 0x3f, 0x5d, 0x7c, 0xa9, 0xff, 0x7f, 0x15, 0x2a,
-	0x1b, 0x66, 0x60, 0x1f, 0xd9, 0xc1, 0x00, 0xcd, 0x15, 0x93, 0x5a, 0x48, 0x4a, 0xbd, 0x05, 0x5a,
-	0x3a, 0xb7, 0x85, 0xef, 0x74, 0xec, 0xed, 0x77, 0x26, 0x99, 0xe1, 0xc4, 0x33, 0xdd, 0x7b, 0x50,
-	0x4d, 0xcd, 0xba, 0xa5, 0xbc, 0x0d, 0xbc, 0x9f, 0x98, 0x6b, 0xaf, 0x80, 0x32, 0xf4, 0x98, 0xc1,
-	0x62, 0x72, 0xd5, 0x4f, 0x3e, 0x60, 0x6c, 0x41, 0x25, 0xf1, 0x52, 0x90, 0xd7, 0x3c, 0x65, 0x3f,
-	0xf6, 0x3a, 0xb0, 0x04, 0x65, 0x83, 0x9b, 0x46, 0x64, 0xf1, 0x92, 0x0e, 0x62, 0x89, 0xd5, 0xd1,
-	0xb1, 0x76, 0x8a, 0xbf, 0x3f, 0x7a, 0x61, 0x23, 0xf5, 0x19, 0xcc, 0x9f, 0x3c, 0x65, 0x86, 0x7c,
-	0x53, 0xd9, 0x59, 0x3f, 0x7b, 0xbe, 0x9c, 0xe2, 0x1d, 0xe5, 0x88, 0x73, 0x3c, 0x56, 0xc6, 0x78,
-	0x6f, 0x89, 0x7c, 0x41, 0x79, 0xef, 0xe0, 0x98, 0x86, 0xea, 0x9a, 0x66, 0x9c, 0xf3, 0xb1, 0x72,
-	0x8a, 0x65, 0x8f, 0x24, 0xd7, 0xfb, 0x30, 0x79, 0x40, 0x0c, 0x2f, 0xd8, 0x25, 0x46, 0x70, 0xde,
-	0x17, 0x4a, 0x25, 0xa4, 0x14, 0xdc, 0xb2, 0xde, 0x12, 0xaa, 0xe7, 0x78, 0x4b, 0x60, 0xb5, 0x51,
-	0xd6, 0x5b, 0x02, 0x55, 0xcd, 0x0b, 0x1f, 0x9b, 0x68, 0x8f, 0xaa, 0xb0, 0xd0, 0x19, 0x88, 0x5c,
-	0xc6, 0x9a, 0xd0, 0xf8, 0x88, 0x7f, 0x32, 0x39, 0xe2, 0x4f, 0xf6, 0x57, 0x6a, 0xba, 0xbf, 0xba,
-	0x16, 0xb9, 0xb1, 0x6d, 0x11, 0x27, 0xb0, 0x83, 0x01, 0x3e, 0x72, 0xe2, 0x7b, 0x05, 0xae, 0x37,
-	0xf9, 0x72, 0xe6, 0x5c, 0x79, 0x3a, 0x73, 0xae, 0x7c, 0xf2, 0xb3, 0xc2, 0xcc, 0xf3, 0x79, 0x56,
-	0x98, 0x7d, 0x3e, 0xcf, 0x0a, 0x73, 0xa7, 0x3c, 0x2b, 0xec, 0xc0, 0x0c, 0xa3, 0x4a, 0x4f, 0x34,
-	0xb5, 0x9c, 0xd7, 0x7b, 0x0a, 0xc9, 0x53, 0xb3, 0xcc, 0x53, 0x1f, 0x2b, 0xe6, 0x4f, 0x7f, 0xac,
-	0xc8, 0xf1, 0x7a, 0xb0, 0x70, 0xf6, 0xeb, 0xc1, 0x03, 0x50, 0x19, 0x17, 0x36, 0x53, 0x65, 0xff,
-	0xb6, 0xe3, 0xaf, 0x9b, 0xcb, 0xc9, 0xea, 0x83, 0x03, 0x69, 0xca, 0xb8, 0xc7, 0x7e, 0xea, 0x0a,
-	0xd2, 0xde, 0x37, 0xfc, 0x80, 0xaf, 0xd0, 0x06, 0x3e, 0xc6, 0x8f, 0xa6, 0x2b, 0xe2, 0x45, 0xae,
-	0xb6, 0x88, 0xae, 0x36, 0x17, 0x52, 0x3d, 0x42, 0x78, 0xe8, 0x72, 0xd9, 0x2d, 0x4c, 0xed, 0x84,
-	0x16, 0xe6, 0x13, 0x98, 0x45, 0x21, 0xd1, 0xd5, 0x16, 0xdd, 0xf0, 0x52, 0x96, 0xfa, 0x43, 0x03,
-	0x33, 0x5f, 0x9f, 0xa6, 0xf4, 0xef, 0x0b, 0x72, 0xd1, 0xbb, 0x7e, 0x01, 0x0b, 0x29, 0xbe, 0xf1,
-	0x77, 0xf9, 0xe5, 0xbc, 0x0f, 0xbf, 0x09, 0xde, 0xd1, 0x03, 0x7d, 0x4b, 0x92, 0x47, 0x14, 0xa9,
-	0x25, 0xc9, 0x63, 0x4a, 0xb1, 0x25, 0xc9, 0x97, 0x94, 0x5a, 0xfd, 0x4f, 0x05, 0x28, 0x61, 0xb5,
-	0x7a, 0x46, 0x16, 0xcc, 0xca, 0x41, 0x17, 0x33, 0x73, 0xd0, 0x06, 0x94, 0xd1, 0x4f, 0x79, 0x86,
-	0x1e, 0xc9, 0xfb, 0x4f, 0x39, 0x46, 0x24, 0x32, 0x50, 0x3c, 0x10, 0x49, 0x28, 0x07, 0x63, 0x0b,
-	0x8f, 0x41, 0xf3, 0x20, 0xb3, 0x78, 0x15, 0x0e, 0x90, 0x8a, 0xf8, 0xdd, 0xb4, 0xea, 0x7f, 0x96,
-	0x40, 0xc5, 0xf1, 0x4c, 0xf2, 0x4f, 0x46, 0xa7, 0xe6, 0xf7, 0x68, 0x30, 0x9d, 0x9d, 0xdf, 0x43,
+	0xdf, 0x82, 0x29, 0x44, 0xde, 0xb7, 0xbd, 0x90, 0xc4, 0xb6, 0x34, 0x15, 0xb1, 0x15, 0x0a, 0x7a,
+	0x60, 0x7b, 0x9c, 0xa4, 0x69, 0xa9, 0x7f, 0x07, 0xaf, 0x22, 0x7a, 0x52, 0x79, 0x3f, 0x30, 0x3c,
+	0xea, 0x33, 0x21, 0xf9, 0x14, 0x92, 0xd7, 0x28, 0x6a, 0x5c, 0xc3, 0x1d, 0x86, 0x27, 0x98, 0xdd,
+	0x03, 0x40, 0x4a, 0x96, 0x96, 0xa6, 0x73, 0xa6, 0xa5, 0x12, 0xd2, 0xd0, 0x55, 0xb5, 0x05, 0xa8,
+	0x61, 0x3b, 0x9e, 0xdd, 0x66, 0x72, 0xb2, 0xa9, 0x52, 0xca, 0xcf, 0xa2, 0x0c, 0xb7, 0x0e, 0x33,
+	0xc9, 0x4d, 0x89, 0xc4, 0x36, 0x8b, 0x7b, 0x99, 0x3a, 0x89, 0xed, 0x43, 0xe4, 0xb3, 0x07, 0xb0,
+	0x9c, 0x32, 0x84, 0x79, 0x48, 0xac, 0x7e, 0x27, 0x6e, 0x8a, 0x39, 0x96, 0x17, 0xe3, 0xe4, 0x3b,
+	0x02, 0x4b, 0x18, 0x62, 0x13, 0x6a, 0xe7, 0x18, 0x54, 0x43, 0x2e, 0x0b, 0x27, 0xa7, 0x1b, 0x73,
+	0x27, 0xad, 0xbf, 0xf0, 0xa8, 0xf9, 0x7c, 0x1e, 0x95, 0xd8, 0xa0, 0x70, 0xa5, 0x21, 0xa3, 0x18,
+	0x01, 0x0d, 0xba, 0x81, 0xb6, 0x80, 0x61, 0x39, 0x41, 0xb3, 0xc1, 0x40, 0x89, 0x4b, 0x99, 0xd8,
+	0x0c, 0x1e, 0xcf, 0x2b, 0x39, 0x8f, 0x67, 0x2e, 0x63, 0xab, 0x78, 0x4e, 0x06, 0x2c, 0x9e, 0x66,
+	0x73, 0x14, 0xb0, 0x98, 0x53, 0xc0, 0x7c, 0xe6, 0x89, 0xa0, 0x08, 0x0f, 0xae, 0x27, 0x45, 0xb8,
+	0x9e, 0x7d, 0x60, 0x3b, 0x46, 0x27, 0x2d, 0xab, 0x96, 0x53, 0xd6, 0xd5, 0xb8, 0xac, 0x4f, 0x38,
+	0xb3, 0xa4, 0xcc, 0xbb, 0xa0, 0x25, 0x65, 0x7a, 0xe4, 0x69, 0x9f, 0xf8, 0x78, 0xf8, 0x4b, 0x18,
+	0xfe, 0x66, 0xe2, 0x4c, 0x74, 0x06, 0x6d, 0x5a, 0xea, 0x57, 0xa0, 0x26, 0x09, 0x69, 0xd8, 0xd4,
+	0xee, 0x2f, 0x17, 0x56, 0xaa, 0xa7, 0xa4, 0x48, 0xac, 0x8b, 0x69, 0x72, 0x4c, 0x04, 0x8f, 0x41,
+	0x8f, 0xc4, 0x22, 0x2c, 0x5f, 0x51, 0x6f, 0x80, 0x62, 0x1a, 0x8e, 0x49, 0x3a, 0x42, 0x1f, 0x62,
+	0x69, 0x57, 0x96, 0x0b, 0x2b, 0xb2, 0x3e, 0xc1, 0xd6, 0x75, 0xb1, 0xac, 0xbe, 0x01, 0x93, 0x49,
+	0x54, 0xaa, 0xfa, 0x32, 0xaa, 0x9e, 0xc4, 0x6d, 0x22, 0xae, 0x1f, 0xd8, 0xe6, 0xd1, 0xa0, 0x1d,
+	0x4b, 0x06, 0x57, 0x19, 0x2e, 0x03, 0xec, 0x86, 0x29, 0xe1, 0x00, 0x96, 0x39, 0xae, 0xb0, 0x7e,
+	0x3b, 0x70, 0xdb, 0x51, 0xe0, 0xa0, 0x3e, 0x5e, 0xcf, 0xe7, 0xe3, 0x8b, 0x8c, 0x91, 0xb0, 0xfc,
+	0xae, 0xbb, 0x23, 0x42, 0x09, 0x75, 0x76, 0x0d, 0x8a, 0xc2, 0xbd, 0x5f, 0x65, 0xf5, 0x39, 0xff,
+	0x54, 0x3f, 0x83, 0x59, 0x8f, 0x04, 0xde, 0x80, 0xa7, 0xc7, 0x4e, 0xdb, 0x76, 0x02, 0xe2, 0x1d,
+	0x1b, 0x1d, 0xed, 0x5a, 0x3e, 0xc1, 0xd3, 0x48, 0xce, 0x52, 0x68, 0xa7, 0xc9, 0x89, 0x23, 0xb6,
+	0x5d, 0xe3, 0x99, 0xdd, 0xed, 0x77, 0x23, 0xb6, 0xd7, 0x2f, 0xc2, 0xf6, 0x63, 0x46, 0x1d, 0xb2,
+	0xbd, 0x93, 0x66, 0xcb, 0xb7, 0xe1, 0x6b, 0xaf, 0xe1, 0xb6, 0x12, 0x54, 0xfc, 0xd6, 0xfa, 0xea,
+	0x7b, 0x30, 0xcf, 0xa8, 0xf6, 0x0c, 0xf3, 0xc8, 0xdd, 0xdf, 0x6f, 0x9b, 0x2e, 0xd9, 0xdf, 0xb7,
+	0x4d, 0x9b, 0x38, 0x81, 0xf6, 0xfa, 0x72, 0x61, 0xa5, 0xa0, 0xcf, 0x21, 0xc2, 0x26, 0x83, 0x6f,
+	0x45, 0x60, 0xb5, 0x0b, 0xf5, 0x8c, 0x3c, 0x4c, 0x9e, 0xf5, 0x6c, 0xa6, 0x2e, 0xbb, 0x2d, 0x2b,
+	0x39, 0x6f, 0xcb, 0xd2, 0x50, 0x42, 0xde, 0x0e, 0x39, 0xf1, 0x66, 0x64, 0x89, 0xa9, 0xea, 0xb8,
+	0x4e, 0x1b, 0x7f, 0x19, 0x7b, 0x1d, 0xd2, 0x26, 0x9e, 0xe7, 0x7a, 0xe8, 0xfe, 0xbe, 0x76, 0x63,
+	0x79, 0x64, 0xa5, 0xa4, 0xbf, 0x82, 0xc0, 0x47, 0xae, 0xa3, 0x0b, 0xa4, 0x6d, 0x8a, 0x43, 0x3d,
+	0xdb, 0x57, 0x57, 0x40, 0x39, 0x34, 0x7c, 0x46, 0xdf, 0xee, 0xb9, 0x1d, 0xdb, 0x1c, 0x68, 0x6f,
+	0xa0, 0x6b, 0x57, 0x0f, 0x0d, 0x1f, 0x29, 0x1e, 0xe3, 0xaa, 0xfa, 0x2a, 0x8c, 0x9b, 0x9e, 0xeb,
+	0x84, 0xfe, 0xa7, 0xbd, 0x89, 0x9e, 0x5a, 0xa1, 0x8b, 0xc2, 0x97, 0x68, 0x25, 0xe8, 0xdb, 0x07,
+	0x34, 0x48, 0x98, 0x6e, 0xdf, 0x09, 0xb4, 0x06, 0x46, 0xec, 0x32, 0x5b, 0xdb, 0xa2, 0x4b, 0xea,
+	0xa7, 0x30, 0x69, 0xf4, 0x03, 0xb7, 0xed, 0x11, 0x9f, 0x04, 0xed, 0x9e, 0x6b, 0x3b, 0x81, 0xaf,
+	0xdd, 0x46, 0xab, 0x5c, 0x8f, 0x6e, 0x2a, 0xbd, 0xa2, 0x61, 0x1f, 0x7c, 0xbc, 0xd6, 0xd0, 0x29,
+	0xf6, 0x63, 0x44, 0xd6, 0x27, 0x28, 0x7d, 0x6c, 0x41, 0xfd, 0x47, 0x98, 0xf4, 0x89, 0xe1, 0x99,
+	0x87, 0xf4, 0x90, 0x3d, 0x7b, 0xaf, 0x4f, 0x5b, 0x8c, 0x3b, 0xd8, 0x62, 0x7c, 0x92, 0xa7, 0x3e,
+	0xce, 0xac, 0x6a, 0x1b, 0x3b, 0xc8, 0x72, 0x23, 0xe4, 0xc8, 0x7a, 0x0e, 0xc5, 0x4f, 0x2d, 0xab,
+	0x4f, 0x40, 0xea, 0x92, 0xae, 0xab, 0xbd, 0x8d, 0x02, 0xb7, 0x5e, 0x5c, 0xe0, 0xc7, 0xa4, 0xeb,
+	0x32, 0x21, 0xc8, 0x50, 0xfd, 0x1a, 0x26, 0x79, 0xfa, 0x6d, 0xb3, 0x76, 0xdc, 0x26, 0xbe, 0xf6,
+	0x57, 0x68, 0xa9, 0x5b, 0x99, 0x52, 0x78, 0xd3, 0x4e, 0x25, 0xf0, 0xe4, 0xfc, 0x91, 0xa0, 0xd3,
+	0x95, 0xe3, 0xd4, 0x8a, 0x7a, 0x1b, 0x66, 0x79, 0xbd, 0x13, 0x3a, 0x2b, 0x2f, 0x8e, 0xef, 0xe2,
+	0xc9, 0x4e, 0x21, 0x34, 0x54, 0x91, 0x15, 0xc9, 0x7f, 0x0f, 0x13, 0x11, 0xba, 0x1f, 0x18, 0x81,
+	0xaf, 0xbd, 0x83, 0x1a, 0xad, 0xe7, 0xd9, 0x77, 0xc8, 0x8c, 0x36, 0x23, 0xbe, 0x5e, 0x25, 0x89,
+	0xef, 0x44, 0x56, 0xf3, 0xfa, 0xc3, 0x77, 0xe7, 0xdd, 0x8b, 0x66, 0x35, 0xbd, 0x9f, 0xbe, 0x35,
+	0x77, 0x60, 0x6e, 0xa8, 0xd2, 0x0b, 0x9e, 0xe1, 0xae, 0xdf, 0x63, 0x25, 0x4e, 0xb2, 0xda, 0xdb,
+	0x7d, 0x46, 0x77, 0x7d, 0x07, 0x66, 0xe9, 0x5e, 0x49, 0x3b, 0xf0, 0x0c, 0xc7, 0xb7, 0x51, 0x23,
+	0xe6, 0xe0, 0xef, 0x23, 0xd1, 0x34, 0x42, 0x77, 0x43, 0x20, 0xf3, 0xf4, 0x0f, 0xa1, 0x9a, 0xac,
+	0xc7, 0xb5, 0xbf, 0xce, 0xb9, 0x81, 0x71, 0x12, 0xaf, 0xc2, 0xd5, 0x55, 0x98, 0x76, 0xc8, 0xc9,
+	0xf0, 0x39, 0xfd, 0x0d, 0x6b, 0x8e, 0x1c, 0x72, 0x92, 0x3a, 0xa5, 0x87, 0x50, 0xe1, 0xad, 0x0c,
+	0xce, 0xaa, 0xb4, 0x0f, 0x50, 0xee, 0x8d, 0xcc, 0x23, 0x42, 0x0c, 0xe6, 0x32, 0x66, 0xe0, 0x7a,
+	0x5b, 0xf4, 0x53, 0x34, 0x46, 0xf8, 0xa1, 0xbe, 0x03, 0xda, 0x50, 0x63, 0x24, 0xea, 0xc2, 0x7b,
+	0xac, 0xcf, 0x49, 0x75, 0x47, 0xa2, 0x34, 0xbc, 0x0d, 0xb3, 0x66, 0xc7, 0xf5, 0xb9, 0xdd, 0xf6,
+	0x89, 0x17, 0x16, 0xe2, 0x7f, 0xcb, 0x8c, 0x8d, 0xd0, 0x5d, 0x0e, 0xe4, 0xc5, 0xf8, 0x5d, 0xd0,
+	0x18, 0xd1, 0xb1, 0xed, 0xdb, 0x7b, 0x76, 0xc7, 0x0e, 0x06, 0x21, 0xd9, 0x06, 0x92, 0xcd, 0x20,
+	0xfc, 0xf3, 0x10, 0xcc, 0x09, 0xef, 0x01, 0x70, 0x69, 0xd4, 0xd6, 0x9b, 0x79, 0x2b, 0x69, 0xa6,
+	0x03, 0xb5, 0xf3, 0x36, 0x2c, 0x65, 0x4b, 0xe6, 0x6d, 0x1c, 0xb1, 0xb4, 0x2d, 0x8c, 0x8d, 0x8b,
+	0x19, 0x0a, 0x6c, 0x09, 0x1c, 0xf5, 0x16, 0x4c, 0x1b, 0x41, 0x60, 0xd0, 0x98, 0x18, 0xab, 0x02,
+	0x7c, 0x6d, 0x1b, 0xc3, 0xb1, 0x2a, 0x60, 0x61, 0x21, 0xe0, 0x2f, 0x58, 0x30, 0x93, 0x19, 0x6d,
+	0x32, 0xa6, 0x16, 0x6f, 0x27, 0xfb, 0xff, 0xa5, 0x64, 0xc8, 0xe4, 0x53, 0xc6, 0xe3, 0xb5, 0xc6,
+	0x63, 0x63, 0xd0, 0x71, 0x0d, 0x2b, 0x3e, 0x60, 0xf8, 0x02, 0x4a, 0x61, 0x88, 0xf9, 0x55, 0x39,
+	0x87, 0xe3, 0x83, 0x70, 0x58, 0xd0, 0x92, 0x64, 0x45, 0x99, 0x6c, 0x49, 0xf2, 0x4d, 0xe5, 0xad,
+	0x96, 0x24, 0xbf, 0xa5, 0x34, 0x5a, 0x92, 0xbc, 0xaa, 0xdc, 0x6a, 0x49, 0xf2, 0x2d, 0x65, 0xad,
+	0x25, 0xc9, 0x6b, 0xca, 0x7a, 0x4b, 0x92, 0xd7, 0x95, 0xdb, 0xf5, 0xdb, 0x50, 0x4d, 0x86, 0x05,
+	0x9a, 0x44, 0x78, 0x24, 0x6b, 0xfb, 0xf6, 0x37, 0x04, 0x75, 0x1c, 0xd1, 0xcb, 0x7c, 0x6d, 0xc7,
+	0xfe, 0x86, 0xd4, 0x7f, 0x2e, 0xc0, 0xec, 0x50, 0x10, 0xa5, 0xd4, 0x04, 0x2b, 0x30, 0x8f, 0xd0,
+	0xcb, 0x1a, 0xab, 0xc0, 0x0a, 0xbc, 0x02, 0x43, 0x40, 0x54, 0x81, 0xcd, 0xc0, 0x18, 0xbf, 0x4a,
+	0x6c, 0x20, 0x31, 0xea, 0xe1, 0xf5, 0x69, 0xc1, 0x28, 0x5e, 0x68, 0x9c, 0x3e, 0x54, 0xd7, 0xef,
+	0xe4, 0x2b, 0x20, 0x93, 0x7a, 0xe8, 0x8c, 0x85, 0xfa, 0x00, 0xc6, 0xe8, 0x8f, 0xbe, 0xaf, 0x49,
+	0xe9, 0x6a, 0xf4, 0x7c, 0x2e, 0x7d, 0x5f, 0xe7, 0xd4, 0xf5, 0x3f, 0x8d, 0x81, 0x92, 0xb8, 0x28,
+	0xbf, 0xd6, 0xe0, 0x25, 0xb2, 0xc1, 0x48, 0xdc, 0x06, 0x5b, 0x50, 0x8a, 0x0a, 0x69, 0xa6, 0xfa,
+	0x6b, 0x67, 0xdb, 0x21, 0x2c, 0xa0, 0xe5, 0x80, 0xff, 0xa2, 0x23, 0x95, 0xc0, 0xf0, 0x0e, 0x48,
+	0x6a, 0xa8, 0xc3, 0x86, 0x2f, 0x93, 0x0c, 0x94, 0x1a, 0xea, 0x70, 0xfc, 0xb8, 0xce, 0x63, 0x88,
+	0xae, 0x30, 0x48, 0x72, 0xa8, 0xc3, 0xb1, 0xf9, 0x06, 0x8a, 0x6c, 0xfb, 0x6c, 0x91, 0x45, 0xc2,
+	0xe4, 0xa4, 0x45, 0x4e, 0x4f, 0x5a, 0xde, 0x87, 0x05, 0xce, 0xc2, 0x3c, 0xb4, 0x3b, 0x56, 0x24,
+	0xd6, 0x75, 0x3a, 0x03, 0x1c, 0xcc, 0xc8, 0xfa, 0x1c, 0xc3, 0xd8, 0xa2, 0x08, 0x42, 0xfa, 0x27,
+	0x4e, 0x67, 0x40, 0xb5, 0xcd, 0x68, 0x75, 0x81, 0x0d, 0x0d, 0xfc, 0x74, 0x7b, 0xab, 0x41, 0x51,
+	0x04, 0xcd, 0x32, 0x9b, 0x6e, 0xf3, 0x4f, 0x75, 0x0e, 0x8a, 0x22, 0xbe, 0x55, 0x10, 0x32, 0x16,
+	0xb0, 0x80, 0xd6, 0x84, 0x89, 0x78, 0x24, 0xa2, 0x51, 0x6d, 0x3c, 0x6f, 0x63, 0x1f, 0x11, 0x52,
+	0x10, 0xd5, 0xd5, 0x22, 0x34, 0x3c, 0xb5, 0x8d, 0xfd, 0x80, 0x78, 0x6d, 0x0c, 0x60, 0xda, 0x04,
+	0x6e, 0x50, 0x61, 0x90, 0x0d, 0x0a, 0xd8, 0xa2, 0xeb, 0xea, 0xbf, 0x14, 0x80, 0x85, 0xb8, 0xf8,
+	0x40, 0x89, 0xaa, 0x68, 0x91, 0xc0, 0xb0, 0x71, 0x50, 0x4c, 0xd5, 0x78, 0x94, 0x27, 0xe7, 0xa7,
+	0x9d, 0xb6, 0x81, 0x22, 0xa2, 0x31, 0x93, 0xe1, 0x1f, 0xdd, 0x67, 0x5c, 0x3f, 0xba, 0xa4, 0xcf,
+	0x9b, 0xa7, 0x01, 0x17, 0xbe, 0x82, 0xf9, 0x53, 0x29, 0xd5, 0x7b, 0xb0, 0x68, 0x1a, 0x4e, 0xdb,
+	0x3f, 0xb2, 0x7b, 0xf1, 0xe0, 0x4d, 0x43, 0xaa, 0x4d, 0x5b, 0x89, 0x02, 0x6e, 0x74, 0xde, 0x34,
+	0x9c, 0x9d, 0x23, 0xbb, 0x17, 0x05, 0xee, 0x0d, 0x8e, 0xb0, 0x59, 0x85, 0x4a, 0x7c, 0x83, 0x2c,
+	0x96, 0xd5, 0xff, 0x43, 0x82, 0xa9, 0xd8, 0x50, 0xf9, 0x37, 0x73, 0xef, 0x62, 0xbe, 0x36, 0x9a,
+	0xf4, 0xb5, 0x6b, 0x50, 0x4d, 0x0d, 0xb9, 0xd8, 0x7c, 0xb3, 0xb2, 0x1f, 0x1f, 0x70, 0xd5, 0x61,
+	0xdc, 0x21, 0xcf, 0x62, 0x48, 0x6c, 0x9c, 0x59, 0xa6, 0x8b, 0x02, 0x27, 0xdb, 0xfb, 0xe5, 0x53,
+	0xbc, 0xff, 0x2a, 0x54, 0xf6, 0x3c, 0xc3, 0x31, 0x0f, 0xdb, 0x81, 0x7b, 0x44, 0xd8, 0x15, 0xa8,
+	0xe8, 0x65, 0xb6, 0xb6, 0x4b, 0x97, 0x44, 0x95, 0x43, 0x8d, 0x92, 0x40, 0x1d, 0x47, 0x54, 0x5a,
+	0xe5, 0xe8, 0x7d, 0x67, 0x33, 0x46, 0x10, 0xbb, 0x37, 0x13, 0xe7, 0xdd, 0x1b, 0xe5, 0x05, 0xef,
+	0xcd, 0x22, 0x80, 0x50, 0x8a, 0x8f, 0x0f, 0x4b, 0xba, 0xcc, 0x54, 0x69, 0x5a, 0x2d, 0x49, 0x2e,
+	0x29, 0x10, 0x8e, 0xcd, 0xc3, 0x81, 0x79, 0xfd, 0x8f, 0x23, 0xa0, 0xa6, 0xca, 0x93, 0xdf, 0xb6,
+	0xdb, 0xc4, 0x4c, 0x3d, 0x76, 0x9e, 0xa9, 0x8b, 0x2f, 0x68, 0xea, 0x64, 0xf9, 0x26, 0x5f, 0xbc,
+	0x7c, 0x4b, 0x4e, 0x52, 0x4b, 0x17, 0x9f, 0xa4, 0x9e, 0x55, 0x79, 0xc2, 0x19, 0x95, 0x67, 0xfd,
+	0x67, 0x09, 0xc6, 0x29, 0x87, 0xdf, 0x4e, 0x66, 0xde, 0x86, 0x0a, 0x1f, 0x1b, 0x31, 0x3e, 0xa3,
+	0xc8, 0xa7, 0x7e, 0x4a, 0x71, 0xc2, 0x87, 0x43, 0xc8, 0xa3, 0x1c, 0x44, 0x1f, 0x2a, 0x89, 0x8d,
+	0x46, 0xc5, 0xc8, 0x04, 0xf9, 0x8d, 0x21, 0xbf, 0xb5, 0x7c, 0x95, 0x13, 0x1f, 0xa6, 0x20, 0xfb,
+	0xa9, 0x93, 0xe1, 0xc5, 0xb8, 0x63, 0x16, 0x93, 0x8e, 0x79, 0x03, 0xc2, 0x58, 0x13, 0x8e, 0x65,
+	0x65, 0x1c, 0xf0, 0x4c, 0x88, 0x75, 0x31, 0x92, 0x9d, 0x07, 0x39, 0x0c, 0x53, 0xec, 0x9d, 0xb6,
+	0x48, 0x78, 0x74, 0x8a, 0xb9, 0x37, 0x9c, 0xe7, 0xde, 0xe5, 0x17, 0x74, 0xef, 0x74, 0x04, 0xac,
+	0x0c, 0x47, 0xc0, 0x1b, 0xa0, 0x18, 0x1d, 0x8f, 0x18, 0x96, 0xc8, 0x5c, 0xc4, 0xc2, 0xe8, 0x27,
+	0xeb, 0x13, 0x7c, 0x7d, 0x83, 0x2f, 0xd7, 0xff, 0xfd, 0x32, 0x28, 0x22, 0x79, 0x85, 0x4e, 0x17,
+	0xdb, 0x46, 0x21, 0xb1, 0x8d, 0xb4, 0x37, 0x5e, 0x3e, 0xd7, 0x1b, 0x47, 0xce, 0xf0, 0x46, 0xe9,
+	0x54, 0x6f, 0x1c, 0xfd, 0xcb, 0x03, 0xcf, 0x58, 0xf2, 0x7c, 0x7f, 0xbd, 0xf8, 0x52, 0xff, 0xe7,
+	0x2a, 0x54, 0x36, 0xcc, 0xc0, 0x3e, 0xb6, 0x83, 0x01, 0x9a, 0x2b, 0x26, 0xb5, 0x90, 0x94, 0x7a,
+	0x17, 0xb4, 0x74, 0x6e, 0x0b, 0x5f, 0xf6, 0xd8, 0x6b, 0xf1, 0x4c, 0x32, 0xc3, 0x89, 0x87, 0xbd,
+	0x0f, 0xa1, 0x9a, 0x9a, 0x8e, 0x4b, 0x79, 0x5b, 0x7e, 0x3f, 0x31, 0x09, 0x5f, 0x01, 0x65, 0xe8,
+	0xf9, 0x83, 0xc5, 0xe4, 0xaa, 0x9f, 0x7c, 0xf2, 0xd8, 0x82, 0x4a, 0xe2, 0x6d, 0x21, 0xaf, 0x79,
+	0xca, 0x7e, 0xec, 0x3d, 0x61, 0x09, 0xca, 0x06, 0x37, 0x8d, 0xc8, 0xe2, 0x25, 0x1d, 0xc4, 0x12,
+	0xab, 0xa3, 0x63, 0xed, 0x14, 0x7f, 0xb1, 0xf4, 0xc2, 0x46, 0xea, 0x4b, 0x98, 0x3f, 0x7d, 0x2e,
+	0x0d, 0xf9, 0xe6, 0xb8, 0xb3, 0x7e, 0xf6, 0x44, 0x3a, 0xc5, 0x3b, 0xca, 0x11, 0x17, 0x78, 0xde,
+	0x8c, 0xf1, 0xde, 0x12, 0xf9, 0x82, 0xf2, 0xde, 0x85, 0x59, 0xae, 0x6b, 0x9a, 0x71, 0xce, 0xe7,
+	0xcd, 0x29, 0x96, 0x3d, 0x92, 0x5c, 0x1f, 0xc2, 0xe4, 0x21, 0x31, 0xbc, 0x60, 0x8f, 0x18, 0xc1,
+	0x45, 0xdf, 0x34, 0x95, 0x90, 0x52, 0x70, 0xcb, 0x7a, 0x7d, 0xa8, 0x5e, 0xe0, 0xf5, 0x81, 0xd5,
+	0x46, 0x59, 0xaf, 0x0f, 0x54, 0x35, 0x2f, 0x7c, 0x9e, 0xa2, 0x3d, 0xaa, 0xc2, 0x42, 0x67, 0x20,
+	0x72, 0x19, 0x6b, 0x42, 0xe3, 0x8f, 0x02, 0x93, 0xc9, 0x47, 0x81, 0x64, 0x7f, 0xa5, 0xa6, 0xfb,
+	0xab, 0x1b, 0x91, 0x1b, 0xdb, 0x16, 0x71, 0x02, 0x3b, 0x18, 0x68, 0x53, 0xe2, 0x85, 0x03, 0xd7,
+	0x9b, 0x7c, 0x39, 0x73, 0x12, 0x3d, 0x9d, 0x39, 0x89, 0x3e, 0xfd, 0x21, 0x62, 0xe6, 0xe5, 0x3c,
+	0x44, 0xcc, 0xbe, 0x9c, 0x87, 0x88, 0xb9, 0x33, 0x1e, 0x22, 0x76, 0x61, 0x86, 0x51, 0xa5, 0x67,
+	0xa0, 0x5a, 0xce, 0xeb, 0x3d, 0x85, 0xe4, 0xa9, 0xe9, 0xe7, 0x99, 0xcf, 0x1b, 0xf3, 0x67, 0x3f,
+	0x6f, 0xe4, 0x78, 0x6f, 0x58, 0x38, 0xff, 0xbd, 0xe1, 0x11, 0xa8, 0x8c, 0x0b, 0x9b, 0xc2, 0xb2,
+	0xff, 0xe7, 0xf1, 0xf7, 0xd0, 0xe5, 0x64, 0xf5, 0xc1, 0x81, 0x34, 0x65, 0x3c, 0x60, 0x3f, 0x75,
+	0x05, 0x69, 0x1f, 0xd2, 0x09, 0x2d, 0x5b, 0xa1, 0x0d, 0x7c, 0x8c, 0x1f, 0x4d, 0x57, 0xc4, 0x8b,
+	0x5c, 0x6d, 0x11, 0x5d, 0x6d, 0x2e, 0xa4, 0x7a, 0x82, 0xf0, 0xd0, 0xe5, 0xb2, 0x5b, 0x98, 0xda,
+	0x29, 0x2d, 0xcc, 0xe7, 0x30, 0x8b, 0x42, 0xa2, 0xab, 0x2d, 0xba, 0xe1, 0xa5, 0x2c, 0xf5, 0x87,
+	0x06, 0x66, 0xbe, 0x3e, 0x4d, 0xe9, 0x3f, 0x12, 0xe4, 0xa2, 0x77, 0xfd, 0x1a, 0x16, 0x52, 0x7c,
+	0xe3, 0x2f, 0xf9, 0xcb, 0x79, 0x9f, 0x8a, 0x13, 0xbc, 0xa3, 0x27, 0xfd, 0x96, 0x24, 0x8f, 0x28,
+	0x52, 0x4b, 0x92, 0xc7, 0x94, 0x62, 0x4b, 0x92, 0xaf, 0x28, 0xb5, 0xfa, 0xff, 0x16, 0xa0, 0x44,
+	0x41, 0xde, 0x39, 0x59, 0x30, 0x2b, 0x07, 0x5d, 0xce, 0xcc, 0x41, 0x1b, 0x50, 0x46, 0x3f, 0xe5,
+	0x19, 0x7a, 0x24, 0xa7, 0xce, 0xc0, 0x88, 0x44, 0x06, 0x8a, 0x07, 0x22, 0x09, 0xe5, 0x40, 0x10,
+	0xc5, 0xa0, 0x79, 0x90, 0x59, 0xbc, 0x0a, 0x07, 0x48, 0x45, 0xfc, 0x6e, 0x5a, 0xf5, 0xff, 0x97,
+	0x40, 0xc5, 0xf1, 0x4c, 0xf2, 0x6f, 0x49, 0x67, 0xe6, 0xf7, 0x68, 0x94, 0x9d, 0x9d, 0xdf, 0x43,
 	0x78, 0x22, 0xbf, 0x67, 0x99, 0x64, 0x24, 0xd3, 0x24, 0x0d, 0x98, 0x12, 0x98, 0xf1, 0xba, 0x8a,
-	0x8f, 0xbe, 0x38, 0x28, 0x36, 0xcc, 0xba, 0x02, 0x82, 0x83, 0x68, 0x36, 0xd9, 0xd8, 0x4b, 0x24,
+	0x8f, 0xbe, 0x38, 0x28, 0x36, 0xcc, 0xba, 0x06, 0x82, 0x83, 0x68, 0x36, 0xd9, 0xd8, 0x4b, 0x24,
 	0x77, 0x36, 0xce, 0xca, 0x1c, 0x6e, 0xca, 0xd9, 0xc3, 0xcd, 0x45, 0x28, 0x85, 0x05, 0x9e, 0xc8,
-	0xd8, 0xe1, 0xc2, 0x39, 0xff, 0x31, 0xf4, 0x69, 0xf8, 0x4f, 0x27, 0x96, 0x25, 0x79, 0x7c, 0x2e,
-	0x63, 0xbd, 0xb7, 0x72, 0x42, 0xd7, 0xf0, 0x50, 0xbc, 0x08, 0xf8, 0x84, 0x45, 0x6e, 0xf1, 0x9f,
-	0xa8, 0xd8, 0x12, 0xd5, 0x23, 0x7d, 0x14, 0xe1, 0x1c, 0x4c, 0x49, 0x1e, 0x02, 0x0e, 0xec, 0x47,
-	0xd9, 0xfb, 0xc4, 0xf8, 0x79, 0xdf, 0x27, 0x18, 0xdd, 0x50, 0x25, 0x5c, 0x1d, 0xaa, 0x84, 0xc3,
-	0x7f, 0xb9, 0x15, 0x15, 0xb9, 0xfe, 0xbb, 0x02, 0x4c, 0x72, 0x8b, 0x6e, 0x61, 0xfe, 0x7c, 0x5e,
-	0x8e, 0x95, 0x99, 0xb9, 0x47, 0xb2, 0xff, 0x05, 0x90, 0x6d, 0x32, 0x29, 0xdb, 0x64, 0xf5, 0xdf,
-	0x17, 0x00, 0xb6, 0xf1, 0x35, 0xf5, 0x79, 0xe9, 0x9e, 0xac, 0x0d, 0x47, 0xd2, 0xb5, 0x61, 0xb6,
-	0xba, 0xc5, 0x6c, 0x75, 0x53, 0xff, 0x31, 0x64, 0x41, 0x4b, 0x56, 0x4a, 0xf5, 0xaf, 0x0a, 0x20,
-	0x6f, 0x1d, 0x10, 0xf3, 0xd0, 0xef, 0x77, 0xd3, 0x9b, 0x18, 0x8d, 0x36, 0x71, 0x07, 0xc6, 0xf6,
-	0x3a, 0xc6, 0x91, 0xeb, 0xa1, 0xca, 0xd5, 0xf5, 0xeb, 0xa7, 0xf7, 0x22, 0x82, 0xe3, 0x3d, 0xa4,
-	0xd1, 0x39, 0x6d, 0xf4, 0x47, 0xcf, 0x11, 0x6c, 0xd2, 0xd8, 0xc7, 0xe6, 0xff, 0x7c, 0xf3, 0x5d,
-	0xed, 0xc2, 0xb7, 0xdf, 0xd5, 0x2e, 0xfc, 0xf8, 0x5d, 0xad, 0xf0, 0xd5, 0xd3, 0x5a, 0xe1, 0xd7,
-	0x4f, 0x6b, 0x85, 0x3f, 0x3e, 0xad, 0x15, 0xbe, 0x79, 0x5a, 0x2b, 0xfc, 0xf5, 0x69, 0xad, 0xf0,
-	0xb7, 0xa7, 0xb5, 0x0b, 0x3f, 0x3e, 0xad, 0x15, 0xbe, 0xfe, 0xbe, 0x76, 0xe1, 0x9b, 0xef, 0x6b,
-	0x17, 0xbe, 0xfd, 0xbe, 0x76, 0xe1, 0xb3, 0x9b, 0xfb, 0x6e, 0xa4, 0x83, 0xed, 0x9e, 0xfc, 0x27,
-	0xe8, 0x77, 0x62, 0x9f, 0xbb, 0x63, 0x18, 0x34, 0x6f, 0xfc, 0x33, 0x00, 0x00, 0xff, 0xff, 0x26,
-	0x9b, 0xf5, 0x0b, 0xa7, 0x2f, 0x00, 0x00,
+	0xd8, 0xe1, 0xc2, 0x05, 0xff, 0x63, 0xf4, 0x45, 0xf8, 0xdf, 0x28, 0x96, 0x25, 0x79, 0x7c, 0x2e,
+	0x63, 0xbd, 0xb7, 0x72, 0x4a, 0xd7, 0xf0, 0x58, 0xbc, 0x21, 0xf8, 0x84, 0x45, 0x6e, 0xf1, 0x2f,
+	0xaa, 0xd8, 0x12, 0xd5, 0x23, 0x7d, 0x14, 0xe1, 0x1c, 0x4c, 0x49, 0x1e, 0x02, 0x8e, 0xf8, 0x47,
+	0xd9, 0x8b, 0xc6, 0xf8, 0x45, 0x5f, 0x34, 0x18, 0xdd, 0x50, 0x25, 0x5c, 0x1d, 0xaa, 0x84, 0xc3,
+	0xff, 0xc5, 0x15, 0x15, 0xb9, 0xfe, 0x9f, 0x05, 0x98, 0xe4, 0x16, 0xdd, 0xc2, 0xfc, 0xf9, 0xb2,
+	0x1c, 0x2b, 0x33, 0x73, 0x8f, 0x64, 0xff, 0x6f, 0x20, 0xdb, 0x64, 0x52, 0xb6, 0xc9, 0xea, 0xff,
+	0x55, 0x00, 0xd8, 0xc1, 0xf7, 0xd7, 0x97, 0xa5, 0x7b, 0xb2, 0x36, 0x1c, 0x49, 0xd7, 0x86, 0xd9,
+	0xea, 0x16, 0xb3, 0xd5, 0x4d, 0xfd, 0x2b, 0x91, 0x05, 0x2d, 0x59, 0x29, 0xd5, 0xbf, 0x2d, 0x80,
+	0xbc, 0x75, 0x48, 0xcc, 0x23, 0xbf, 0xdf, 0x4d, 0x6f, 0x62, 0x34, 0xda, 0xc4, 0x7d, 0x18, 0xdb,
+	0xef, 0x18, 0xc7, 0xae, 0x87, 0x2a, 0x57, 0xd7, 0x6f, 0x9e, 0xdd, 0x8b, 0x08, 0x8e, 0x0f, 0x90,
+	0x46, 0xe7, 0xb4, 0xd1, 0x5f, 0x43, 0x47, 0xb0, 0x49, 0x63, 0x1f, 0x9b, 0xff, 0xf0, 0xfd, 0x8f,
+	0xb5, 0x4b, 0x3f, 0xfc, 0x58, 0xbb, 0xf4, 0xcb, 0x8f, 0xb5, 0xc2, 0xb7, 0xcf, 0x6b, 0x85, 0x7f,
+	0x7b, 0x5e, 0x2b, 0xfc, 0xcf, 0xf3, 0x5a, 0xe1, 0xfb, 0xe7, 0xb5, 0xc2, 0xef, 0x9f, 0xd7, 0x0a,
+	0x7f, 0x78, 0x5e, 0xbb, 0xf4, 0xcb, 0xf3, 0x5a, 0xe1, 0xbb, 0x9f, 0x6a, 0x97, 0xbe, 0xff, 0xa9,
+	0x76, 0xe9, 0x87, 0x9f, 0x6a, 0x97, 0xbe, 0xbc, 0x73, 0xe0, 0x46, 0x3a, 0xd8, 0xee, 0xe9, 0x7f,
+	0x9b, 0x7e, 0x3f, 0xf6, 0xb9, 0x37, 0x86, 0x41, 0xf3, 0xf6, 0x9f, 0x07, 0x00, 0x73, 0x11, 0xfb,
+	0x0b, 0xd9, 0x2f, 0x00, 0x00,
 }
 
 func (this *ShardInfo) Equal(that interface{}) bool {
@@ -2798,6 +2808,14 @@ func (this *WorkflowExecutionInfo) Equal(that interface{}) bool {
 	if this.CloseVisibilityTaskCompleted != that1.CloseVisibilityTaskCompleted {
 		return false
 	}
+	if len(this.AttachedRequestIds) != len(that1.AttachedRequestIds) {
+		return false
+	}
+	for i := range this.AttachedRequestIds {
+		if this.AttachedRequestIds[i] != that1.AttachedRequestIds[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *ExecutionStats) Equal(that interface{}) bool {
@@ -3634,7 +3652,7 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 62)
+	s := make([]string, 0, 63)
 	s = append(s, "&persistence.WorkflowExecutionInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -3726,6 +3744,7 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	s = append(s, "CloseVisibilityTaskId: "+fmt.Sprintf("%#v", this.CloseVisibilityTaskId)+",\n")
 	s = append(s, "CloseTime: "+fmt.Sprintf("%#v", this.CloseTime)+",\n")
 	s = append(s, "CloseVisibilityTaskCompleted: "+fmt.Sprintf("%#v", this.CloseVisibilityTaskCompleted)+",\n")
+	s = append(s, "AttachedRequestIds: "+fmt.Sprintf("%#v", this.AttachedRequestIds)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -4149,6 +4168,17 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AttachedRequestIds) > 0 {
+		for iNdEx := len(m.AttachedRequestIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AttachedRequestIds[iNdEx])
+			copy(dAtA[i:], m.AttachedRequestIds[iNdEx])
+			i = encodeVarintExecutions(dAtA, i, uint64(len(m.AttachedRequestIds[iNdEx])))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
 	if m.WorkflowTaskType != 0 {
 		i = encodeVarintExecutions(dAtA, i, uint64(m.WorkflowTaskType))
 		i--
@@ -6256,6 +6286,12 @@ func (m *WorkflowExecutionInfo) Size() (n int) {
 	if m.WorkflowTaskType != 0 {
 		n += 2 + sovExecutions(uint64(m.WorkflowTaskType))
 	}
+	if len(m.AttachedRequestIds) > 0 {
+		for _, s := range m.AttachedRequestIds {
+			l = len(s)
+			n += 2 + l + sovExecutions(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -6961,6 +6997,7 @@ func (this *WorkflowExecutionInfo) String() string {
 		`CloseTime:` + strings.Replace(fmt.Sprintf("%v", this.CloseTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`CloseVisibilityTaskCompleted:` + fmt.Sprintf("%v", this.CloseVisibilityTaskCompleted) + `,`,
 		`WorkflowTaskType:` + fmt.Sprintf("%v", this.WorkflowTaskType) + `,`,
+		`AttachedRequestIds:` + fmt.Sprintf("%v", this.AttachedRequestIds) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -9638,6 +9675,38 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 69:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttachedRequestIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttachedRequestIds = append(m.AttachedRequestIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
@@ -63,6 +63,16 @@ func (c *clientImpl) CloseShard(
 	return c.client.CloseShard(ctx, request, opts...)
 }
 
+func (c *clientImpl) CountWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.CountWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CountWorkflowExecutionsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CountWorkflowExecutions(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -143,6 +153,16 @@ func (c *clientImpl) GetReplicationMessages(
 	return c.client.GetReplicationMessages(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetReplicationStatus(
+	ctx context.Context,
+	request *adminservice.GetReplicationStatusRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetReplicationStatusResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetReplicationStatus(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetSearchAttributes(
 	ctx context.Context,
 	request *adminservice.GetSearchAttributesRequest,
@@ -243,6 +263,16 @@ func (c *clientImpl) ReapplyEvents(
 	return c.client.ReapplyEvents(ctx, request, opts...)
 }
 
+func (c *clientImpl) RearchiveWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RearchiveWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RearchiveWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RearchiveWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) RebuildMutableState(
 	ctx context.Context,
 	request *adminservice.RebuildMutableStateRequest,
@@ -293,6 +323,16 @@ func (c *clientImpl) RemoveTask(
 	return c.client.RemoveTask(ctx, request, opts...)
 }
 
+func (c *clientImpl) RenameNamespace(
+	ctx context.Context,
+	request *adminservice.RenameNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RenameNamespaceResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RenameNamespace(ctx, request, opts...)
+}
+
 func (c *clientImpl) ResendReplicationTasks(
 	ctx context.Context,
 	request *adminservice.ResendReplicationTasksRequest,
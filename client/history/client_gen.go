@@ -398,6 +398,30 @@ func (c *clientImpl) ReapplyEvents(
 	return response, nil
 }
 
+func (c *clientImpl) RearchiveWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.RearchiveWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.RearchiveWorkflowExecutionResponse, error) {
+	client, err := c.getClientForWorkflowID(request.NamespaceId, request.GetRequest().GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.RearchiveWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.RearchiveWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) RebuildMutableState(
 	ctx context.Context,
 	request *historyservice.RebuildMutableStateRequest,
@@ -287,6 +287,20 @@ func (c *metricClient) ReapplyEvents(
 	return c.client.ReapplyEvents(ctx, request, opts...)
 }
 
+func (c *metricClient) RearchiveWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.RearchiveWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.RearchiveWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.HistoryClientRearchiveWorkflowExecutionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RearchiveWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) RebuildMutableState(
 	ctx context.Context,
 	request *historyservice.RebuildMutableStateRequest,
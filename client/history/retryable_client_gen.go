@@ -305,6 +305,21 @@ func (c *retryableClient) ReapplyEvents(
 	return resp, err
 }
 
+func (c *retryableClient) RearchiveWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.RearchiveWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.RearchiveWorkflowExecutionResponse, error) {
+	var resp *historyservice.RearchiveWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RearchiveWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RebuildMutableState(
 	ctx context.Context,
 	request *historyservice.RebuildMutableStateRequest,
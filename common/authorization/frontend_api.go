@@ -44,12 +44,15 @@ var readOnlyNamespaceAPI = map[string]struct{}{
 	"ListScheduleMatchingTimes":          {},
 	"DescribeBatchOperation":             {},
 	"ListBatchOperations":                {},
+	"StreamWorkflowHistory":              {},
+	"StreamWorkflowExecutions":           {},
 }
 
 var readOnlyGlobalAPI = map[string]struct{}{
-	"ListNamespaces":      {},
-	"GetSearchAttributes": {},
-	"GetClusterInfo":      {},
+	"ListNamespaces":       {},
+	"GetSearchAttributes":  {},
+	"GetClusterInfo":       {},
+	"GetReplicationStatus": {},
 }
 
 func IsReadOnlyNamespaceAPI(api string) bool {
@@ -0,0 +1,90 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cache
+
+import (
+	"sync/atomic"
+)
+
+type (
+	// SizeGetter is implemented by cache values that can report their
+	// approximate size in bytes. Values that do not implement it are
+	// accounted as zero bytes and are bounded by entry count only.
+	SizeGetter interface {
+		CacheSize() int
+	}
+
+	// Budget is a byte budget shared by one or more caches, e.g. by all
+	// shard-level workflow caches on a host. Each cache sheds its own
+	// unpinned entries whenever the shared usage exceeds the limit.
+	Budget struct {
+		limit  func() int
+		used   atomic.Int64
+		pinned atomic.Int64
+	}
+)
+
+// NewBudget creates a new Budget. A non-positive limit means unlimited.
+func NewBudget(limit func() int) *Budget {
+	return &Budget{
+		limit: limit,
+	}
+}
+
+// Limit returns the current limit of the budget in bytes
+func (b *Budget) Limit() int {
+	return b.limit()
+}
+
+// Used returns the approximate number of bytes used by all entries
+func (b *Budget) Used() int {
+	return int(b.used.Load())
+}
+
+// Pinned returns the approximate number of bytes used by pinned entries
+func (b *Budget) Pinned() int {
+	return int(b.pinned.Load())
+}
+
+// exceeded returns true if adding the given number of bytes on top of the
+// current usage would go over the limit
+func (b *Budget) exceeded(extra int) bool {
+	limit := b.limit()
+	return limit > 0 && b.used.Load()+int64(extra) > int64(limit)
+}
+
+// pinnedExceeded returns true if adding the given number of bytes on top of
+// the pinned usage would go over the limit, i.e. nothing can be evicted to make room
+func (b *Budget) pinnedExceeded(extra int) bool {
+	limit := b.limit()
+	return limit > 0 && b.pinned.Load()+int64(extra) > int64(limit)
+}
+
+func sizeOf(value interface{}) int {
+	if sizeGetter, ok := value.(SizeGetter); ok {
+		return sizeGetter.CacheSize()
+	}
+	return 0
+}
@@ -26,6 +26,8 @@ package cache
 
 import (
 	"time"
+
+	"go.temporal.io/server/common/metrics"
 )
 
 // A Cache is a generalized interface to a cache.  See cache.LRU for a specific
@@ -53,6 +55,9 @@ type Cache interface {
 
 	// Size returns the number of entries currently stored in the Cache
 	Size() int
+
+	// Purge deletes all elements from the cache, including pinned ones
+	Purge()
 }
 
 // Options control the behavior of the cache
@@ -66,6 +71,13 @@ type Options struct {
 
 	// Pin prevents in-use objects from getting evicted.
 	Pin bool
+
+	// Budget, if set, bounds the approximate size in bytes of all entries of
+	// every cache sharing it. Values are sized via SizeGetter.
+	Budget *Budget
+
+	// MetricsHandler, if set, is used to emit eviction and usage metrics.
+	MetricsHandler metrics.Handler
 }
 
 // SimpleOptions provides options that can be used to configure SimpleCache
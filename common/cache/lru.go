@@ -29,6 +29,8 @@ import (
 	"errors"
 	"sync"
 	"time"
+
+	"go.temporal.io/server/common/metrics"
 )
 
 var (
@@ -45,6 +47,9 @@ type (
 		maxSize  int
 		ttl      time.Duration
 		pin      bool
+		budget   *Budget
+
+		metricsHandler metrics.Handler
 	}
 
 	iteratorImpl struct {
@@ -58,6 +63,7 @@ type (
 		createTime time.Time
 		value      interface{}
 		refCount   int
+		size       int
 	}
 )
 
@@ -94,7 +100,7 @@ func (it *iteratorImpl) prepareNext() {
 		entry := it.nextItem.Value.(*entryImpl)
 		if it.lru.isEntryExpired(entry, it.createTime) {
 			nextItem := it.nextItem.Next()
-			it.lru.deleteInternal(it.nextItem)
+			it.lru.evictInternal(it.nextItem, metrics.CacheEvictionReasonTTLTagValue)
 			it.nextItem = nextItem
 		} else {
 			return
@@ -140,6 +146,9 @@ func New(maxSize int, opts *Options) Cache {
 		ttl:      opts.TTL,
 		maxSize:  maxSize,
 		pin:      opts.Pin,
+		budget:   opts.Budget,
+
+		metricsHandler: opts.MetricsHandler,
 	}
 }
 
@@ -174,12 +183,12 @@ func (c *lru) Get(key interface{}) interface{} {
 
 	if c.isEntryExpired(entry, time.Now().UTC()) {
 		// Entry has expired
-		c.deleteInternal(element)
+		c.evictInternal(element, metrics.CacheEvictionReasonTTLTagValue)
 		return nil
 	}
 
 	if c.pin {
-		entry.refCount++
+		c.pinInternal(entry)
 	}
 	c.byAccess.MoveToFront(element)
 	return entry.value
@@ -237,6 +246,29 @@ func (c *lru) Release(key interface{}) {
 	}
 	entry := elt.Value.(*entryImpl)
 	entry.refCount--
+	if entry.refCount != 0 || c.budget == nil {
+		return
+	}
+
+	// the value may have grown or shrunk while it was pinned,
+	// e.g. mutable state got loaded, so re-evaluate its size
+	c.budget.pinned.Add(-int64(entry.size))
+	newSize := sizeOf(entry.value)
+	c.budget.used.Add(int64(newSize - entry.size))
+	entry.size = newSize
+	c.evictOverBudgetInternal(0)
+	c.emitUsageInternal()
+}
+
+// Purge deletes all entries, including pinned ones, and releases their share of the budget.
+// Releasing a purged entry later on is a noop.
+func (c *lru) Purge() {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	for element := c.byAccess.Front(); element != nil; element = c.byAccess.Front() {
+		c.deleteInternal(element)
+	}
 }
 
 // Size returns the number of entries currently in the lru, useful if cache is not full
@@ -261,7 +293,7 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 		entry := elt.Value.(*entryImpl)
 		if c.isEntryExpired(entry, time.Now().UTC()) {
 			// Entry has expired
-			c.deleteInternal(elt)
+			c.evictInternal(elt, metrics.CacheEvictionReasonTTLTagValue)
 		} else {
 			existing := entry.value
 			if allowUpdate {
@@ -269,11 +301,12 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 				if c.ttl != 0 {
 					entry.createTime = time.Now().UTC()
 				}
+				c.resizeInternal(entry)
 			}
 
 			c.byAccess.MoveToFront(elt)
 			if c.pin {
-				entry.refCount++
+				c.pinInternal(entry)
 			}
 			return existing, nil
 		}
@@ -283,9 +316,8 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 		key:   key,
 		value: value,
 	}
-
-	if c.pin {
-		entry.refCount++
+	if c.budget != nil {
+		entry.size = sizeOf(value)
 	}
 
 	if c.ttl != 0 {
@@ -293,35 +325,106 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 	}
 
 	if len(c.byKey) >= c.maxSize {
-		c.evictOnceInternal()
+		c.evictOnceInternal(metrics.CacheEvictionReasonCapacityTagValue)
 	}
 	if len(c.byKey) >= c.maxSize {
 		return nil, ErrCacheFull
 	}
+	if c.budget != nil {
+		c.evictOverBudgetInternal(entry.size)
+		// only reject the entry if the whole budget is held by pinned entries,
+		// otherwise other caches sharing the budget will shed their unpinned entries
+		if c.budget.pinnedExceeded(entry.size) {
+			return nil, ErrCacheFull
+		}
+		c.budget.used.Add(int64(entry.size))
+	}
+
+	if c.pin {
+		c.pinInternal(entry)
+	}
 
 	element := c.byAccess.PushFront(entry)
 	c.byKey[key] = element
+	c.emitUsageInternal()
 	return nil, nil
 }
 
 func (c *lru) deleteInternal(element *list.Element) {
 	entry := c.byAccess.Remove(element).(*entryImpl)
 	delete(c.byKey, entry.key)
+	if c.budget != nil {
+		c.budget.used.Add(-int64(entry.size))
+		if entry.refCount > 0 {
+			c.budget.pinned.Add(-int64(entry.size))
+		}
+		c.emitUsageInternal()
+	}
+}
+
+func (c *lru) evictInternal(element *list.Element, reason string) {
+	c.deleteInternal(element)
+	if c.metricsHandler != nil {
+		c.metricsHandler.Counter(metrics.CacheEvictions.GetMetricName()).Record(
+			1,
+			metrics.CacheEvictionReasonTag(reason),
+		)
+	}
 }
 
-func (c *lru) evictOnceInternal() {
+func (c *lru) evictOnceInternal(reason string) bool {
 	element := c.byAccess.Back()
 	for element != nil {
 		entry := element.Value.(*entryImpl)
 		if entry.refCount == 0 {
-			c.deleteInternal(element)
-			return
+			c.evictInternal(element, reason)
+			return true
 		}
 
 		// entry.refCount > 0
 		// skip, entry still being referenced
 		element = element.Prev()
 	}
+	return false
+}
+
+// evictOverBudgetInternal evicts unpinned entries until the given number of
+// extra bytes fits into the budget, or there is nothing left to evict
+func (c *lru) evictOverBudgetInternal(extra int) {
+	for c.budget.exceeded(extra) {
+		if !c.evictOnceInternal(metrics.CacheEvictionReasonSizeTagValue) {
+			return
+		}
+	}
+}
+
+func (c *lru) pinInternal(entry *entryImpl) {
+	entry.refCount++
+	if entry.refCount == 1 && c.budget != nil {
+		c.budget.pinned.Add(int64(entry.size))
+	}
+}
+
+func (c *lru) resizeInternal(entry *entryImpl) {
+	if c.budget == nil {
+		return
+	}
+	newSize := sizeOf(entry.value)
+	delta := int64(newSize - entry.size)
+	c.budget.used.Add(delta)
+	if entry.refCount > 0 {
+		c.budget.pinned.Add(delta)
+	}
+	entry.size = newSize
+	c.emitUsageInternal()
+}
+
+func (c *lru) emitUsageInternal() {
+	if c.budget == nil || c.metricsHandler == nil {
+		return
+	}
+	c.metricsHandler.Gauge(metrics.CacheUsageBytes.GetMetricName()).Record(float64(c.budget.Used()))
+	c.metricsHandler.Gauge(metrics.CachePinnedUsageBytes.GetMetricName()).Record(float64(c.budget.Pinned()))
 }
 
 func (c *lru) isEntryExpired(entry *entryImpl, currentTime time.Time) bool {
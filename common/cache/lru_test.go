@@ -302,3 +302,109 @@ func TestZeroSizeCache(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, 0, cache.Size())
 }
+
+type sizedValue struct {
+	size int
+}
+
+func (v *sizedValue) CacheSize() int {
+	return v.size
+}
+
+func TestBudget_EvictBySize(t *testing.T) {
+	budget := NewBudget(func() int { return 10 })
+	cache := New(100, &Options{Budget: budget})
+
+	cache.Put("A", &sizedValue{size: 4})
+	cache.Put("B", &sizedValue{size: 4})
+	assert.Equal(t, 8, budget.Used())
+
+	// evicts A, the least recently used entry
+	cache.Put("C", &sizedValue{size: 4})
+	assert.Equal(t, 2, cache.Size())
+	assert.Nil(t, cache.Get("A"))
+	assert.Equal(t, 8, budget.Used())
+
+	cache.Delete("B")
+	assert.Equal(t, 4, budget.Used())
+}
+
+func TestBudget_SharedAcrossCaches(t *testing.T) {
+	budget := NewBudget(func() int { return 10 })
+	cache1 := New(100, &Options{Budget: budget})
+	cache2 := New(100, &Options{Budget: budget})
+
+	cache1.Put("A", &sizedValue{size: 6})
+	cache2.Put("B", &sizedValue{size: 3})
+	assert.Equal(t, 9, budget.Used())
+
+	// cache2 has nothing else to evict, but admits the entry since cache1 entries are not pinned
+	cache2.Put("C", &sizedValue{size: 3})
+	assert.Equal(t, 1, cache2.Size())
+	assert.Equal(t, 9, budget.Used())
+
+	// next put into cache1 sheds its own entries
+	cache1.Put("D", &sizedValue{size: 2})
+	assert.Nil(t, cache1.Get("A"))
+	assert.Equal(t, 5, budget.Used())
+}
+
+func TestBudget_Pinned(t *testing.T) {
+	budget := NewBudget(func() int { return 10 })
+	cache := New(100, &Options{Budget: budget, Pin: true})
+
+	valueA := &sizedValue{size: 1}
+	_, err := cache.PutIfNotExist("A", valueA)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, budget.Pinned())
+
+	// size is re-evaluated on release
+	valueA.size = 8
+	cache.Release("A")
+	assert.Equal(t, 0, budget.Pinned())
+	assert.Equal(t, 8, budget.Used())
+
+	assert.Equal(t, valueA, cache.Get("A"))
+	assert.Equal(t, 8, budget.Pinned())
+
+	// the whole budget would be held by pinned entries
+	_, err = cache.PutIfNotExist("B", &sizedValue{size: 4})
+	assert.Equal(t, ErrCacheFull, err)
+	assert.Equal(t, 8, budget.Used())
+
+	cache.Release("A")
+	_, err = cache.PutIfNotExist("B", &sizedValue{size: 4})
+	assert.NoError(t, err)
+	assert.Nil(t, cache.Get("A"))
+	assert.Equal(t, 4, budget.Used())
+	assert.Equal(t, 4, budget.Pinned())
+}
+
+func TestBudget_Purge(t *testing.T) {
+	budget := NewBudget(func() int { return 10 })
+	cache1 := New(100, &Options{Budget: budget, Pin: true})
+	cache2 := New(100, &Options{Budget: budget, Pin: true})
+
+	_, err := cache1.PutIfNotExist("A", &sizedValue{size: 2})
+	assert.NoError(t, err)
+	cache1.Release("A")
+	_, err = cache1.PutIfNotExist("B", &sizedValue{size: 3})
+	assert.NoError(t, err)
+	_, err = cache2.PutIfNotExist("C", &sizedValue{size: 4})
+	assert.NoError(t, err)
+	assert.Equal(t, 9, budget.Used())
+	assert.Equal(t, 7, budget.Pinned())
+
+	// pinned entries are purged as well, releasing them later is a noop
+	cache1.Purge()
+	assert.Equal(t, 0, cache1.Size())
+	assert.Equal(t, 4, budget.Used())
+	assert.Equal(t, 4, budget.Pinned())
+	cache1.Release("B")
+	assert.Equal(t, 4, budget.Used())
+
+	cache2.Release("C")
+	cache2.Purge()
+	assert.Equal(t, 0, budget.Used())
+	assert.Equal(t, 0, budget.Pinned())
+}
@@ -166,6 +166,20 @@ func (c *simple) Size() int {
 	return len(c.accessMap)
 }
 
+// Purge deletes all entries from the cache
+func (c *simple) Purge() {
+	c.Lock()
+	defer c.Unlock()
+
+	for element := c.iterateList.Front(); element != nil; element = c.iterateList.Front() {
+		entry := c.iterateList.Remove(element).(*simpleEntry)
+		if c.rmFunc != nil {
+			go c.rmFunc(entry.value)
+		}
+		delete(c.accessMap, entry.key)
+	}
+}
+
 func (c *simple) Iterator() Iterator {
 	c.RLock()
 	iterator := &simpleItr{
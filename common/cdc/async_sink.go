@@ -0,0 +1,220 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cdc
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"go.uber.org/multierr"
+
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	// AsyncSinkOptions configures the workers of a Sink created by NewAsyncSink.
+	AsyncSinkOptions struct {
+		WorkerCount     int
+		QueueSize       int
+		MaxSendAttempts dynamicconfig.IntPropertyFn
+	}
+
+	asyncSink struct {
+		sink           Sink
+		dlq            Sink
+		options        AsyncSinkOptions
+		logger         log.Logger
+		metricsHandler metrics.Handler
+
+		workerWG sync.WaitGroup
+
+		sync.RWMutex
+		closed bool
+		queues []chan *sendRequest
+	}
+
+	sendRequest struct {
+		ctx     context.Context
+		event   *Event
+		resultC chan error
+	}
+)
+
+const (
+	sendRetryInitialInterval = 100 * time.Millisecond
+	sendRetryMaxInterval     = 10 * time.Second
+)
+
+var (
+	errAsyncSinkClosed = errors.New("cdc sink is closed")
+)
+
+var _ Sink = (*asyncSink)(nil)
+
+// NewAsyncSink returns a Sink which delivers events to sink from a pool of workers.
+//
+// Events are assigned to workers by Key, so events of a workflow are delivered one at a time
+// in the order they are sent. Send returns once the event is delivered: failed deliveries are
+// retried with backoff, and events which can't be delivered within MaxSendAttempts are sent to dlq.
+// Send returns an error if the event is neither delivered nor written to dlq (e.g. dlq is not
+// configured) or ctx is done first, so that the caller retries it and the event is never lost.
+func NewAsyncSink(
+	sink Sink,
+	dlq Sink,
+	options AsyncSinkOptions,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) Sink {
+	s := &asyncSink{
+		sink:           sink,
+		dlq:            dlq,
+		options:        options,
+		logger:         logger,
+		metricsHandler: metricsHandler,
+		queues:         make([]chan *sendRequest, options.WorkerCount),
+	}
+	for i := range s.queues {
+		s.queues[i] = make(chan *sendRequest, options.QueueSize)
+		s.workerWG.Add(1)
+		go s.worker(s.queues[i])
+	}
+	return s
+}
+
+func (s *asyncSink) Send(ctx context.Context, event *Event) error {
+	request := &sendRequest{
+		ctx:     ctx,
+		event:   event,
+		resultC: make(chan error, 1),
+	}
+	if err := s.enqueue(request); err != nil {
+		return err
+	}
+
+	select {
+	case err := <-request.resultC:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close stops accepting events, waits for the buffered events to be delivered and closes both sinks.
+func (s *asyncSink) Close() error {
+	s.Lock()
+	if s.closed {
+		s.Unlock()
+		return nil
+	}
+	s.closed = true
+	for _, queue := range s.queues {
+		close(queue)
+	}
+	s.Unlock()
+
+	// Workers drain their queues before exiting. Delivery of every buffered event is bounded
+	// by the context of its Send call.
+	s.workerWG.Wait()
+
+	return multierr.Combine(s.sink.Close(), s.dlq.Close())
+}
+
+func (s *asyncSink) enqueue(request *sendRequest) error {
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.closed {
+		return errAsyncSinkClosed
+	}
+
+	// Workers never wait for the lock, so a full queue is drained while the lock is held.
+	select {
+	case s.queues[s.workerIndex(request.event)] <- request:
+		return nil
+	case <-request.ctx.Done():
+		return request.ctx.Err()
+	}
+}
+
+func (s *asyncSink) workerIndex(event *Event) int {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(event.Key()))
+	return int(hash.Sum32() % uint32(len(s.queues)))
+}
+
+func (s *asyncSink) worker(queue <-chan *sendRequest) {
+	defer s.workerWG.Done()
+
+	for request := range queue {
+		request.resultC <- s.deliver(request.ctx, request.event)
+	}
+}
+
+func (s *asyncSink) deliver(ctx context.Context, event *Event) error {
+	// The caller gave up on the event and will send it again.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	policy := backoff.NewExponentialRetryPolicy(sendRetryInitialInterval).
+		WithMaximumInterval(sendRetryMaxInterval).
+		WithExpirationInterval(backoff.NoInterval).
+		WithMaximumAttempts(s.options.MaxSendAttempts())
+	op := func(ctx context.Context) error {
+		err := s.sink.Send(ctx, event)
+		if err != nil {
+			s.metricsHandler.Counter(metrics.CDCEventSendFailures.GetMetricName()).Record(1)
+		}
+		return err
+	}
+
+	err := backoff.ThrottleRetryContext(ctx, op, policy, nil)
+	if err == nil || ctx.Err() != nil {
+		return err
+	}
+	return s.sendToDLQ(ctx, event, err)
+}
+
+func (s *asyncSink) sendToDLQ(ctx context.Context, event *Event, cause error) error {
+	if err := s.dlq.Send(ctx, event); err != nil {
+		return multierr.Combine(cause, err)
+	}
+	s.metricsHandler.Counter(metrics.CDCEventDLQEnqueued.GetMetricName()).Record(1)
+	s.logger.Warn("Sent cdc event to dlq",
+		tag.WorkflowNamespaceID(event.NamespaceID),
+		tag.WorkflowID(event.WorkflowID),
+		tag.WorkflowRunID(event.RunID),
+		tag.TaskID(event.TaskID),
+		tag.Error(cause),
+	)
+	return nil
+}
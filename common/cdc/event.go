@@ -0,0 +1,78 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cdc
+
+import (
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+type (
+	// EventType identifies the workflow lifecycle transition an Event describes.
+	EventType string
+
+	// Event is a single workflow lifecycle change published to a Sink.
+	//
+	// Events are produced from the visibility task path and are delivered at least once,
+	// unless they end up in the dlq. Within a workflow execution, StateTransitionCount and
+	// TaskID increase monotonically, so consumers can use them to order events and to drop
+	// redeliveries.
+	Event struct {
+		Type                 EventType                       `json:"type"`
+		NamespaceID          string                          `json:"namespaceId"`
+		Namespace            string                          `json:"namespace"`
+		WorkflowID           string                          `json:"workflowId"`
+		RunID                string                          `json:"runId"`
+		WorkflowType         string                          `json:"workflowType"`
+		Status               enumspb.WorkflowExecutionStatus `json:"-"`
+		StatusName           string                          `json:"status"`
+		StartTime            time.Time                       `json:"startTime"`
+		CloseTime            *time.Time                      `json:"closeTime,omitempty"`
+		StateTransitionCount int64                           `json:"stateTransitionCount"`
+		TaskID               int64                           `json:"taskId"`
+		ShardID              int32                           `json:"shardId"`
+		SearchAttributes     map[string]string               `json:"searchAttributes,omitempty"`
+	}
+)
+
+const (
+	// EventTypeStarted is published once a workflow execution start is recorded.
+	EventTypeStarted EventType = "started"
+	// EventTypeSearchAttributesUpserted is published when a running workflow upserts
+	// search attributes or memo.
+	EventTypeSearchAttributesUpserted EventType = "search_attributes_upserted"
+	// EventTypeStatusChanged is published when a workflow execution leaves the running status.
+	EventTypeStatusChanged EventType = "status_changed"
+	// EventTypeClosed is published once a workflow execution close is recorded.
+	EventTypeClosed EventType = "closed"
+)
+
+// Key returns the per workflow ordering key of the event. It is shared by all runs of
+// a workflow. Sinks which partition their output must keep events with the same key
+// in the same partition.
+func (e *Event) Key() string {
+	return e.NamespaceID + "/" + e.WorkflowID
+}
@@ -0,0 +1,194 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination sink_mock.go
+
+package cdc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// Sink receives workflow lifecycle events.
+	//
+	// Send must return only once the event is durably accepted. Sinks which talk to
+	// remote systems are wrapped by NewAsyncSink, which calls Send sequentially for the
+	// events of a workflow and retries it on error, which may redeliver the event.
+	// An event for which Send returns an error must be sent again by the caller.
+	Sink interface {
+		Send(ctx context.Context, event *Event) error
+		Close() error
+	}
+
+	noopSink struct{}
+
+	dlqSink struct {
+		target dynamicconfig.StringPropertyFn
+		sink   Sink
+	}
+
+	dynamicSink struct {
+		sinkType dynamicconfig.StringPropertyFn
+		target   dynamicconfig.StringPropertyFn
+		logger   log.Logger
+
+		sync.Mutex
+		currentType   string
+		currentTarget string
+		current       Sink
+	}
+)
+
+const (
+	// SinkTypeNone disables the change data capture feed.
+	SinkTypeNone = ""
+	// SinkTypeFile appends events as JSON lines to a local file.
+	SinkTypeFile = "file"
+	// SinkTypeWebhook posts events as JSON to an HTTP endpoint.
+	SinkTypeWebhook = "webhook"
+)
+
+var _ Sink = (*noopSink)(nil)
+var _ Sink = (*dynamicSink)(nil)
+var _ Sink = (*dlqSink)(nil)
+
+var errDLQNotConfigured = errors.New("cdc dlq is not configured")
+
+// NewNoopSink returns a Sink which drops all events.
+func NewNoopSink() Sink {
+	return noopSink{}
+}
+
+func (noopSink) Send(_ context.Context, _ *Event) error {
+	return nil
+}
+
+func (noopSink) Close() error {
+	return nil
+}
+
+// NewSink creates a Sink of the given type writing to target.
+func NewSink(sinkType string, target string) (Sink, error) {
+	switch sinkType {
+	case SinkTypeNone:
+		return NewNoopSink(), nil
+	case SinkTypeFile:
+		return NewFileSink(target)
+	case SinkTypeWebhook:
+		return NewWebhookSink(target, defaultWebhookTimeout)
+	default:
+		return nil, fmt.Errorf("unknown cdc sink type: %q", sinkType)
+	}
+}
+
+// NewDynamicSink returns a Sink whose type and target are read from dynamic config.
+// The underlying sink is recreated whenever either value changes.
+func NewDynamicSink(
+	sinkType dynamicconfig.StringPropertyFn,
+	target dynamicconfig.StringPropertyFn,
+	logger log.Logger,
+) Sink {
+	return &dynamicSink{
+		sinkType: sinkType,
+		target:   target,
+		logger:   logger,
+		current:  NewNoopSink(),
+	}
+}
+
+func (s *dynamicSink) Send(ctx context.Context, event *Event) error {
+	sink, err := s.getSink()
+	if err != nil {
+		return err
+	}
+	return sink.Send(ctx, event)
+}
+
+func (s *dynamicSink) Close() error {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.current.Close()
+}
+
+func (s *dynamicSink) getSink() (Sink, error) {
+	sinkType := s.sinkType()
+	target := s.target()
+
+	s.Lock()
+	defer s.Unlock()
+
+	if sinkType == s.currentType && target == s.currentTarget {
+		return s.current, nil
+	}
+
+	sink, err := NewSink(sinkType, target)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.current.Close(); err != nil {
+		s.logger.Warn("Failed to close previous cdc sink", tag.Error(err))
+	}
+	s.logger.Info("CDC sink updated", tag.NewStringTag("cdc-sink-type", sinkType), tag.NewStringTag("cdc-sink-target", target))
+	s.current = sink
+	s.currentType = sinkType
+	s.currentTarget = target
+	return sink, nil
+}
+
+// NewDLQSink returns a Sink which appends events to the file at target, as read from
+// dynamic config. Send fails while target is empty, so that events which are not
+// dead lettered are never acknowledged.
+func NewDLQSink(
+	target dynamicconfig.StringPropertyFn,
+	logger log.Logger,
+) Sink {
+	return &dlqSink{
+		target: target,
+		sink: NewDynamicSink(
+			func() string { return SinkTypeFile },
+			target,
+			logger,
+		),
+	}
+}
+
+func (s *dlqSink) Send(ctx context.Context, event *Event) error {
+	if s.target() == "" {
+		return errDLQNotConfigured
+	}
+	return s.sink.Send(ctx, event)
+}
+
+func (s *dlqSink) Close() error {
+	return s.sink.Close()
+}
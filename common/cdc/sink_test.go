@@ -0,0 +1,274 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cdc
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+type recordingSink struct {
+	sync.Mutex
+	failures map[int64]int
+	taskIDs  []int64
+}
+
+func (s *recordingSink) Send(_ context.Context, event *Event) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.failures[event.TaskID] > 0 {
+		s.failures[event.TaskID]--
+		return errors.New("sink unavailable")
+	}
+	s.taskIDs = append(s.taskIDs, event.TaskID)
+	return nil
+}
+
+func (s *recordingSink) Close() error {
+	return nil
+}
+
+func testEvent(taskID int64) *Event {
+	return &Event{
+		Type:                 EventTypeStarted,
+		NamespaceID:          "ns-id",
+		Namespace:            "ns",
+		WorkflowID:           "wid",
+		RunID:                "rid",
+		WorkflowType:         "wf-type",
+		Status:               enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		StartTime:            time.Unix(1000, 0).UTC(),
+		StateTransitionCount: taskID,
+		TaskID:               taskID,
+		ShardID:              1,
+		SearchAttributes:     map[string]string{"CustomKeywordField": "value"},
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cdc.jsonl")
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Send(context.Background(), testEvent(1)))
+	require.NoError(t, sink.Send(context.Background(), testEvent(2)))
+	require.NoError(t, sink.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() { _ = file.Close() }()
+
+	var taskIDs []int64
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var event Event
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		require.Equal(t, "Running", event.StatusName)
+		require.Equal(t, "value", event.SearchAttributes["CustomKeywordField"])
+		taskIDs = append(taskIDs, event.TaskID)
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, []int64{1, 2}, taskIDs)
+}
+
+func TestWebhookSink(t *testing.T) {
+	var received []Event
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "ns-id/wid", r.Header.Get(WebhookEventKeyHeader))
+		var event Event
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		received = append(received, event)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	sink, err := NewWebhookSink(server.URL, time.Second)
+	require.NoError(t, err)
+	defer func() { _ = sink.Close() }()
+
+	require.NoError(t, sink.Send(context.Background(), testEvent(1)))
+
+	status = http.StatusServiceUnavailable
+	require.Error(t, sink.Send(context.Background(), testEvent(2)))
+
+	require.Len(t, received, 2)
+	require.Equal(t, EventTypeStarted, received[0].Type)
+	require.Equal(t, int64(1), received[0].TaskID)
+}
+
+func TestDynamicSink(t *testing.T) {
+	sinkType := SinkTypeNone
+	path := filepath.Join(t.TempDir(), "cdc.jsonl")
+	sink := NewDynamicSink(
+		func() string { return sinkType },
+		dynamicconfig.GetStringPropertyFn(path),
+		log.NewNoopLogger(),
+	)
+
+	require.NoError(t, sink.Send(context.Background(), testEvent(1)))
+	_, err := os.Stat(path)
+	require.True(t, os.IsNotExist(err))
+
+	sinkType = SinkTypeFile
+	require.NoError(t, sink.Send(context.Background(), testEvent(2)))
+	require.NoError(t, sink.Close())
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(content), `"taskId":2`)
+
+	sinkType = "unknown"
+	require.Error(t, sink.Send(context.Background(), testEvent(3)))
+}
+
+func newTestAsyncSink(sink Sink, dlqTarget string, workerCount int, maxSendAttempts int) Sink {
+	return NewAsyncSink(
+		sink,
+		NewDLQSink(dynamicconfig.GetStringPropertyFn(dlqTarget), log.NewNoopLogger()),
+		AsyncSinkOptions{
+			WorkerCount:     workerCount,
+			QueueSize:       10,
+			MaxSendAttempts: dynamicconfig.GetIntPropertyFn(maxSendAttempts),
+		},
+		log.NewNoopLogger(),
+		metrics.NoopMetricsHandler,
+	)
+}
+
+func TestAsyncSink_RetriesInWorkflowOrder(t *testing.T) {
+	sink := &recordingSink{failures: map[int64]int{1: 2, 3: 1}}
+	asyncSink := newTestAsyncSink(sink, "", 4, 5)
+
+	// Send returns once the event is delivered, including its retries
+	for taskID := int64(1); taskID <= 5; taskID++ {
+		require.NoError(t, asyncSink.Send(context.Background(), testEvent(taskID)))
+	}
+	require.Equal(t, []int64{1, 2, 3, 4, 5}, sink.taskIDs)
+
+	require.NoError(t, asyncSink.Close())
+	require.Error(t, asyncSink.Send(context.Background(), testEvent(6)))
+}
+
+func TestAsyncSink_DLQ(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cdc-dlq.jsonl")
+	sink := &recordingSink{failures: map[int64]int{1: 10}}
+	asyncSink := newTestAsyncSink(sink, path, 1, 2)
+
+	// an event which is dead lettered is acknowledged
+	require.NoError(t, asyncSink.Send(context.Background(), testEvent(1)))
+	require.NoError(t, asyncSink.Send(context.Background(), testEvent(2)))
+	require.NoError(t, asyncSink.Close())
+
+	require.Equal(t, []int64{2}, sink.taskIDs)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(content), `"taskId":1`)
+	require.NotContains(t, string(content), `"taskId":2`)
+}
+
+func TestAsyncSink_NoDLQ(t *testing.T) {
+	sink := &recordingSink{failures: map[int64]int{1: 10}}
+	asyncSink := newTestAsyncSink(sink, "", 1, 2)
+	defer func() { _ = asyncSink.Close() }()
+
+	// an event which is neither delivered nor dead lettered fails, so that the caller sends it again
+	require.Error(t, asyncSink.Send(context.Background(), testEvent(1)))
+	require.Empty(t, sink.taskIDs)
+
+	sink.failures[1] = 0
+	require.NoError(t, asyncSink.Send(context.Background(), testEvent(1)))
+	require.Equal(t, []int64{1}, sink.taskIDs)
+}
+
+func TestAsyncSink_CloseDrainsQueue(t *testing.T) {
+	sink := &gatedSink{
+		recordingSink: recordingSink{},
+		gate:          make(chan struct{}),
+	}
+	sender := newTestAsyncSink(sink, "", 1, 5)
+
+	var wg sync.WaitGroup
+	send := func(taskID int64) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, sender.Send(context.Background(), testEvent(taskID)))
+		}()
+	}
+	queue := sender.(*asyncSink).queues[0]
+
+	// the worker blocks on the first event while the others are buffered
+	send(1)
+	require.Eventually(t, func() bool { return len(queue) == 0 && sink.inFlight() }, 5*time.Second, time.Millisecond)
+	send(2)
+	require.Eventually(t, func() bool { return len(queue) == 1 }, 5*time.Second, time.Millisecond)
+	send(3)
+	require.Eventually(t, func() bool { return len(queue) == 2 }, 5*time.Second, time.Millisecond)
+
+	closed := make(chan error, 1)
+	go func() { closed <- sender.Close() }()
+	close(sink.gate)
+
+	require.NoError(t, <-closed)
+	wg.Wait()
+	require.Equal(t, []int64{1, 2, 3}, sink.taskIDs)
+}
+
+type gatedSink struct {
+	recordingSink
+	gate    chan struct{}
+	waiting bool
+}
+
+func (s *gatedSink) Send(ctx context.Context, event *Event) error {
+	s.Lock()
+	s.waiting = true
+	s.Unlock()
+	<-s.gate
+	return s.recordingSink.Send(ctx, event)
+}
+
+func (s *gatedSink) inFlight() bool {
+	s.Lock()
+	defer s.Unlock()
+	return s.waiting
+}
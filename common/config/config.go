@@ -222,6 +222,18 @@ type (
 		// This is generally used when BindOnIP would be the same across several nodes (ie: 0.0.0.0)
 		// and for nat traversal scenarios. Check net.ParseIP for supported syntax, only IPv4 is supported.
 		BroadcastAddress string `yaml:"broadcastAddress"`
+		// Provider selects how members are discovered: "ringpop" (default) gossips over the
+		// membership port, "static" uses StaticHosts and "dns" resolves DNSRecords.
+		Provider string `yaml:"provider"`
+		// StaticHosts is the gRPC host:port of every member, per service, for the static provider.
+		StaticHosts map[string][]string `yaml:"staticHosts"`
+		// DNSRecords is the SRV record listing the members, per service, for the dns provider.
+		DNSRecords map[string]string `yaml:"dnsRecords"`
+		// RefreshInterval is how often the dns provider resolves members, defaults to 10s.
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+		// HealthCheckTimeout is the TCP dial timeout used by the dns provider to drop unreachable
+		// members, defaults to 2s. A negative value disables health checking.
+		HealthCheckTimeout time.Duration `yaml:"healthCheckTimeout"`
 	}
 
 	// Persistence contains the configuration for data store / persistence layer
@@ -570,6 +582,12 @@ const (
 	ClusterMDStoreName DataStoreName = "ClusterMDStore"
 )
 
+const (
+	MembershipProviderRingpop = "ringpop"
+	MembershipProviderStatic  = "static"
+	MembershipProviderDNS     = "dns"
+)
+
 const (
 	ForceTLSConfigAuto      = ""
 	ForceTLSConfigInternode = "internode"
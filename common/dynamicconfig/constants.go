@@ -91,6 +91,9 @@ const (
 	EnableActivityEagerExecution = "system.enableActivityEagerExecution"
 	// NamespaceCacheRefreshInterval is the key for namespace cache refresh interval dynamic config
 	NamespaceCacheRefreshInterval = "system.namespaceCacheRefreshInterval"
+	// NamespaceCacheWatchInterval is the key for how often the namespace cache checks the namespace
+	// notification version for changes, triggering an immediate refresh when it changes. 0 disables the watch.
+	NamespaceCacheWatchInterval = "system.namespaceCacheWatchInterval"
 
 	// Whether the deadlock detector should dump goroutines
 	DeadlockDumpGoroutines = "system.deadlock.DumpGoroutines"
@@ -133,6 +136,30 @@ const (
 	HistoryCountLimitError = "limit.historyCount.error"
 	// HistoryCountLimitWarn is the per workflow execution history event count limit for warning
 	HistoryCountLimitWarn = "limit.historyCount.warn"
+	// NumPendingChildExecutionsLimitWarn is the number of pending child workflows above which a workflow is reported
+	// as approaching NumPendingChildExecutionsLimitError
+	NumPendingChildExecutionsLimitWarn = "limit.numPendingChildExecutions.warn"
+	// NumPendingActivitiesLimitWarn is the number of pending activities above which a workflow is reported
+	// as approaching NumPendingActivitiesLimitError
+	NumPendingActivitiesLimitWarn = "limit.numPendingActivities.warn"
+	// NumPendingSignalsLimitWarn is the number of pending signals above which a workflow is reported
+	// as approaching NumPendingSignalsLimitError
+	NumPendingSignalsLimitWarn = "limit.numPendingSignals.warn"
+	// NumPendingCancelRequestsLimitWarn is the number of pending requests to cancel other workflows above which
+	// a workflow is reported as approaching NumPendingCancelRequestsLimitError
+	NumPendingCancelRequestsLimitWarn = "limit.numPendingCancelRequests.warn"
+	// LimitWarningSearchAttribute is the name of a KeywordList search attribute. When set, the names of the limits
+	// whose warning level a workflow crossed are added to it when the workflow completes a workflow task.
+	// No history event is written for it. Empty disables it.
+	LimitWarningSearchAttribute = "limit.warningSearchAttribute"
+	// NamespaceMaxOpenWorkflows is the maximum number of concurrently open workflows in a namespace, 0 means unlimited
+	NamespaceMaxOpenWorkflows = "limit.namespace.maxOpenWorkflows"
+	// NamespaceMaxHistorySizeBytes is the maximum total history size of the closed workflows in a namespace, 0 means unlimited
+	NamespaceMaxHistorySizeBytes = "limit.namespace.maxHistorySizeBytes"
+	// NamespaceMaxSchedules is the maximum number of schedules in a namespace, 0 means unlimited
+	NamespaceMaxSchedules = "limit.namespace.maxSchedules"
+	// NamespaceUsageRefreshInterval is how often the namespace usage checked against the namespace quotas is read from visibility
+	NamespaceUsageRefreshInterval = "limit.namespace.usageRefreshInterval"
 	// MaxIDLengthLimit is the length limit for various IDs, including: Namespace, TaskQueue, WorkflowID, ActivityID, TimerID,
 	// WorkflowType, ActivityType, SignalName, MarkerName, ErrorReason/FailureReason/CancelCause, Identity, RequestID
 	MaxIDLengthLimit = "limit.maxIDLength"
@@ -249,6 +276,13 @@ const (
 	FrontendMaxExecutionCountBatchOperationPerNamespace = "frontend.MaxExecutionCountBatchOperationPerNamespace"
 	// FrontendEnableBatcher enables batcher-related RPCs in the frontend
 	FrontendEnableBatcher = "frontend.enableBatcher"
+	// FrontendEnableHistoryStreaming enables the history stream service in the frontend
+	FrontendEnableHistoryStreaming = "frontend.enableHistoryStreaming"
+	// FrontendHistoryStreamPollInterval is how often StreamWorkflowExecutions queries visibility for state transitions,
+	// which bounds how late a transition is pushed to the stream after it reaches visibility
+	FrontendHistoryStreamPollInterval = "frontend.historyStreamPollInterval"
+	// FrontendHistoryStreamPollRPS is the rate at which a single stream may poll history or visibility
+	FrontendHistoryStreamPollRPS = "frontend.historyStreamPollRPS"
 
 	// DeleteNamespaceDeleteActivityRPS is an RPS per every parallel delete executions activity.
 	// Total RPS is equal to DeleteNamespaceDeleteActivityRPS * DeleteNamespaceConcurrentDeleteExecutionsActivities.
@@ -339,6 +373,9 @@ const (
 	HistoryCacheInitialSize = "history.cacheInitialSize"
 	// HistoryCacheMaxSize is max size of history cache
 	HistoryCacheMaxSize = "history.cacheMaxSize"
+	// HistoryCacheHostLevelMaxSizeBytes is the approximate max size in bytes of all history caches on a host,
+	// shared across shards. Zero or negative means no byte limit.
+	HistoryCacheHostLevelMaxSizeBytes = "history.hostLevelCacheMaxSizeBytes"
 	// HistoryCacheTTL is TTL of history cache
 	HistoryCacheTTL = "history.cacheTTL"
 	// HistoryShutdownDrainDuration is the duration of traffic drain during shutdown
@@ -349,6 +386,31 @@ const (
 	EventsCacheMaxSize = "history.eventsCacheMaxSize"
 	// EventsCacheTTL is TTL of events cache
 	EventsCacheTTL = "history.eventsCacheTTL"
+	// EnableHostLevelEventsCache replaces the per shard events cache with a host level cache shared by all shards
+	EnableHostLevelEventsCache = "history.enableHostLevelEventsCache"
+	// EventsHostLevelCacheMaxSizeBytes is the approximate max size in bytes of the host level events cache
+	EventsHostLevelCacheMaxSizeBytes = "history.eventsHostLevelCacheMaxSizeBytes"
+	// EventsCacheShardSoftQuotaBytes is the size in bytes a shard can use in the host level events cache before
+	// its entries are evicted first. Zero or negative means a fair share of the host level cache.
+	EventsCacheShardSoftQuotaBytes = "history.eventsCacheShardSoftQuotaBytes"
+	// EventsCacheDiskSpillDir is the local directory used to spill large events evicted from
+	// the host level events cache. Empty disables spilling.
+	EventsCacheDiskSpillDir = "history.eventsCacheDiskSpillDir"
+	// EventsCacheDiskSpillMaxSizeBytes is the max size in bytes of events spilled to disk
+	EventsCacheDiskSpillMaxSizeBytes = "history.eventsCacheDiskSpillMaxSizeBytes"
+	// EventsCacheDiskSpillMinEventSizeBytes is the min size in bytes of an event to be spilled to disk
+	EventsCacheDiskSpillMinEventSizeBytes = "history.eventsCacheDiskSpillMinEventSizeBytes"
+	// EnableSLOMetrics enables the latency and workflow task outcome metrics tagged by workflow type and task queue
+	EnableSLOMetrics = "history.enableSLOMetrics"
+	// SLOMetricsWorkflowTypeAllowlist is a comma separated list of workflow types to tag SLO metrics with.
+	// Other workflow types are reported as "_other_". Empty means the first SLOMetricsMaxTagValues seen are used.
+	SLOMetricsWorkflowTypeAllowlist = "history.sloMetricsWorkflowTypeAllowlist"
+	// SLOMetricsTaskQueueAllowlist is a comma separated list of task queues to tag SLO metrics with.
+	// Other task queues are reported as "_other_". Empty means the first SLOMetricsMaxTagValues seen are used.
+	SLOMetricsTaskQueueAllowlist = "history.sloMetricsTaskQueueAllowlist"
+	// SLOMetricsMaxTagValues is the max number of distinct workflow types and task queues per namespace
+	// used to tag SLO metrics when no allowlist is configured
+	SLOMetricsMaxTagValues = "history.sloMetricsMaxTagValues"
 	// AcquireShardInterval is interval that timer used to acquire shard
 	AcquireShardInterval = "history.acquireShardInterval"
 	// AcquireShardConcurrency is number of goroutines that can be used to acquire shards in the shard controller.
@@ -492,6 +554,25 @@ const (
 	// close task has been processed. Must use Elasticsearch as visibility store, otherwise workflow
 	// data (eg: search attributes) will be lost after workflow is closed.
 	VisibilityProcessorEnableCloseWorkflowCleanup = "history.visibilityProcessorEnableCloseWorkflowCleanup"
+	// CDCSinkType is the sink for the workflow lifecycle change data capture feed.
+	// Allowed values are "" (disabled), "file" and "webhook".
+	CDCSinkType = "history.cdcSinkType"
+	// CDCSinkTarget is the file path of the "file" cdc sink, or the URL of the "webhook" cdc sink
+	CDCSinkTarget = "history.cdcSinkTarget"
+	// EnableCDC enables publishing workflow lifecycle events of a namespace to the cdc sink
+	EnableCDC = "history.enableCDC"
+	// CDCWorkerCount is the number of workers delivering cdc events to the sink. Events of a
+	// workflow are always delivered by the same worker. Changes take effect after a restart.
+	CDCWorkerCount = "history.cdcWorkerCount"
+	// CDCWorkerQueueSize is the number of cdc events buffered per worker. Publishing to a full
+	// worker queue waits for a free slot. Changes take effect after a restart.
+	CDCWorkerQueueSize = "history.cdcWorkerQueueSize"
+	// CDCMaxSendAttempts is the max number of attempts to deliver a cdc event to the sink
+	// before the event is written to the cdc dlq
+	CDCMaxSendAttempts = "history.cdcMaxSendAttempts"
+	// CDCDLQTarget is the file path of the cdc dlq. When it is empty, events which can't be
+	// delivered fail their visibility task, which is retried until the events are delivered.
+	CDCDLQTarget = "history.cdcDLQTarget"
 
 	// ArchivalTaskBatchSize is batch size for archivalQueueProcessor
 	ArchivalTaskBatchSize = "history.archivalTaskBatchSize"
@@ -686,6 +767,9 @@ const (
 	ExecutionDataDurationBuffer = "worker.executionDataDurationBuffer"
 	// ExecutionScannerWorkerCount is the execution scavenger worker count
 	ExecutionScannerWorkerCount = "worker.executionScannerWorkerCount"
+	// ExecutionScannerRepairMode is the repair mode of executions.Scanner for a namespace: "off" only reports
+	// corrupted executions, "dryrun" also reports the fixes it would apply, and "repair" applies them
+	ExecutionScannerRepairMode = "worker.executionScannerRepairMode"
 	// TaskQueueScannerEnabled indicates if task queue scanner should be started as part of worker.Scanner
 	TaskQueueScannerEnabled = "worker.taskQueueScannerEnabled"
 	// HistoryScannerEnabled indicates if history scanner should be started as part of worker.Scanner
@@ -697,6 +781,12 @@ const (
 	// HistoryScannerVerifyRetention indicates the history scanner verify data retention.
 	// If the service configures with archival feature enabled, update worker.historyScannerVerifyRetention to be double of the data retention.
 	HistoryScannerVerifyRetention = "worker.historyScannerVerifyRetention"
+	// ArchivalScannerEnabled indicates if archival scanner should be started as part of worker.Scanner
+	ArchivalScannerEnabled = "worker.archivalScannerEnabled"
+	// ArchivalScannerDataMinAge indicates how long a workflow must have been closed before its archived history is verified
+	ArchivalScannerDataMinAge = "worker.archivalScannerDataMinAge"
+	// ArchivalScannerSampleRate is the fraction of closed workflows whose archived history is verified per scan, 1.0 verifies all
+	ArchivalScannerSampleRate = "worker.archivalScannerSampleRate"
 	// EnableBatcher decides whether start batcher in our worker
 	EnableBatcher = "worker.enableBatcher"
 	// BatcherRPS controls number the rps of batch operations
@@ -52,6 +52,11 @@ func GetFloatPropertyFn(value float64) func() float64 {
 	return func() float64 { return value }
 }
 
+// GetFloatPropertyFnFilteredByNamespace returns value as FloatPropertyFnWithNamespaceFilter
+func GetFloatPropertyFnFilteredByNamespace(value float64) func(namespace string) float64 {
+	return func(namespace string) float64 { return value }
+}
+
 // GetBoolPropertyFn returns value as BoolPropertyFn
 func GetBoolPropertyFn(value bool) func() bool {
 	return func() bool { return value }
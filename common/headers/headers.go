@@ -26,6 +26,8 @@ package headers
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"google.golang.org/grpc/metadata"
 )
@@ -37,6 +39,21 @@ const (
 	SupportedFeaturesHeaderName       = "supported-features"
 	SupportedFeaturesHeaderDelim      = ","
 
+	// WorkflowStartDelayHeaderName carries the delay of the first workflow task of StartWorkflowExecution
+	// and SignalWithStartWorkflowExecution requests, formatted as a Go duration string, e.g. "90s".
+	// It is only read by the frontend, which passes the delay to the history service in the request.
+	WorkflowStartDelayHeaderName = "workflow-start-delay"
+
+	// WorkflowIDConflictPolicyHeaderName carries the behavior of StartWorkflowExecution
+	// when a workflow with the same workflow ID is already running. It is only read by the frontend,
+	// which passes the policy to the history service in the StartWorkflowExecution request.
+	WorkflowIDConflictPolicyHeaderName = "workflow-id-conflict-policy"
+	// WorkflowIDConflictPolicyFail fails the start request with WorkflowExecutionAlreadyStarted, this is the default.
+	WorkflowIDConflictPolicyFail = "fail"
+	// WorkflowIDConflictPolicyUseExisting returns the run ID of the running workflow
+	// and attaches the request ID of the start request to it.
+	WorkflowIDConflictPolicyUseExisting = "use-existing"
+
 	callerNameHeaderName = "caller-name"
 	callerTypeHeaderName = "caller-type"
 	callOriginHeaderName = "call-initiation"
@@ -97,6 +114,35 @@ func Propagate(ctx context.Context) context.Context {
 	return ctx
 }
 
+// GetWorkflowStartDelay returns the workflow start delay passed in the incoming context, zero if not set.
+func GetWorkflowStartDelay(ctx context.Context) (time.Duration, error) {
+	value := GetValues(ctx, WorkflowStartDelayHeaderName)[0]
+	if value == "" {
+		return 0, nil
+	}
+	delay, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if delay < 0 {
+		return 0, fmt.Errorf("negative duration %v", delay)
+	}
+	return delay, nil
+}
+
+// GetWorkflowIDConflictPolicy returns the workflow ID conflict policy passed in the incoming context,
+// WorkflowIDConflictPolicyFail if not set.
+func GetWorkflowIDConflictPolicy(ctx context.Context) (string, error) {
+	switch value := GetValues(ctx, WorkflowIDConflictPolicyHeaderName)[0]; value {
+	case "", WorkflowIDConflictPolicyFail:
+		return WorkflowIDConflictPolicyFail, nil
+	case WorkflowIDConflictPolicyUseExisting:
+		return value, nil
+	default:
+		return "", fmt.Errorf("unknown workflow ID conflict policy %q", value)
+	}
+}
+
 func getSingleHeaderValue(md metadata.MD, headerName string) string {
 	values := md.Get(headerName)
 	if len(values) == 0 {
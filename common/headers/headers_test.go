@@ -27,6 +27,7 @@ package headers
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -151,3 +152,37 @@ func (s *HeadersSuite) TestPropagate_EmptyIncomingContext() {
 	s.Equal("<21.04.16", md.Get(SupportedServerVersionsHeaderName)[0])
 	s.Equal("28.08.14", md.Get(ClientNameHeaderName)[0])
 }
+
+func (s *HeadersSuite) TestGetWorkflowStartDelay() {
+	delay, err := GetWorkflowStartDelay(context.Background())
+	s.NoError(err)
+	s.Zero(delay)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(WorkflowStartDelayHeaderName, "90s"))
+	delay, err = GetWorkflowStartDelay(ctx)
+	s.NoError(err)
+	s.Equal(90*time.Second, delay)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(WorkflowStartDelayHeaderName, "-1s"))
+	_, err = GetWorkflowStartDelay(ctx)
+	s.Error(err)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(WorkflowStartDelayHeaderName, "soon"))
+	_, err = GetWorkflowStartDelay(ctx)
+	s.Error(err)
+}
+
+func (s *HeadersSuite) TestGetWorkflowIDConflictPolicy() {
+	policy, err := GetWorkflowIDConflictPolicy(context.Background())
+	s.NoError(err)
+	s.Equal(WorkflowIDConflictPolicyFail, policy)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(WorkflowIDConflictPolicyHeaderName, WorkflowIDConflictPolicyUseExisting))
+	policy, err = GetWorkflowIDConflictPolicy(ctx)
+	s.NoError(err)
+	s.Equal(WorkflowIDConflictPolicyUseExisting, policy)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(WorkflowIDConflictPolicyHeaderName, "terminate"))
+	_, err = GetWorkflowIDConflictPolicy(ctx)
+	s.Error(err)
+}
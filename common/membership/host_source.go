@@ -0,0 +1,165 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/primitives"
+)
+
+const (
+	dnsLookupTimeout          = time.Second * 5
+	defaultHealthCheckTimeout = time.Second * 2
+)
+
+type (
+	staticHostSource struct {
+		hosts map[primitives.ServiceName][]string
+	}
+
+	dnsHostSource struct {
+		records            map[primitives.ServiceName]string
+		healthCheckTimeout time.Duration
+
+		lookupSRV  func(ctx context.Context, name string) ([]*net.SRV, error)
+		lookupHost func(ctx context.Context, host string) ([]string, error)
+		dial       func(ctx context.Context, address string) (net.Conn, error)
+	}
+)
+
+var _ HostSource = (*staticHostSource)(nil)
+var _ HostSource = (*dnsHostSource)(nil)
+
+// NewStaticHostSource returns a HostSource backed by a fixed list of host:port per service.
+func NewStaticHostSource(hosts map[primitives.ServiceName][]string) HostSource {
+	return &staticHostSource{hosts: hosts}
+}
+
+func (s *staticHostSource) Hosts(service primitives.ServiceName) ([]string, error) {
+	hosts, ok := s.hosts[service]
+	if !ok {
+		return nil, ErrUnknownService
+	}
+	return hosts, nil
+}
+
+// NewDNSHostSource returns a HostSource that resolves the members of each service from
+// a DNS SRV record. Targets are resolved to IP addresses so that members are identified
+// the same way they identify themselves. Unless healthCheckTimeout is negative, members
+// that don't accept a TCP connection on their gRPC port within the timeout are left out.
+func NewDNSHostSource(
+	records map[primitives.ServiceName]string,
+	healthCheckTimeout time.Duration,
+) HostSource {
+	if healthCheckTimeout == 0 {
+		healthCheckTimeout = defaultHealthCheckTimeout
+	}
+	resolver := net.DefaultResolver
+	dialer := &net.Dialer{}
+	return &dnsHostSource{
+		records:            records,
+		healthCheckTimeout: healthCheckTimeout,
+		lookupSRV: func(ctx context.Context, name string) ([]*net.SRV, error) {
+			_, srvs, err := resolver.LookupSRV(ctx, "", "", name)
+			return srvs, err
+		},
+		lookupHost: resolver.LookupHost,
+		dial: func(ctx context.Context, address string) (net.Conn, error) {
+			return dialer.DialContext(ctx, "tcp", address)
+		},
+	}
+}
+
+func (s *dnsHostSource) Hosts(service primitives.ServiceName) ([]string, error) {
+	record, ok := s.records[service]
+	if !ok {
+		return nil, ErrUnknownService
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dnsLookupTimeout)
+	defer cancel()
+
+	srvs, err := s.lookupSRV(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]string, 0, len(srvs))
+	for _, srv := range srvs {
+		target := strings.TrimSuffix(srv.Target, ".")
+		if net.ParseIP(target) == nil {
+			addrs, err := s.lookupHost(ctx, target)
+			if err != nil {
+				return nil, err
+			}
+			if len(addrs) == 0 {
+				continue
+			}
+			sort.Strings(addrs)
+			target = addrs[0]
+		}
+		candidates = append(candidates, net.JoinHostPort(target, strconv.Itoa(int(srv.Port))))
+	}
+
+	if s.healthCheckTimeout < 0 {
+		return candidates, nil
+	}
+	return s.healthyHosts(candidates), nil
+}
+
+func (s *dnsHostSource) healthyHosts(candidates []string) []string {
+	healthy := make([]bool, len(candidates))
+	var wg sync.WaitGroup
+	for i, addr := range candidates {
+		wg.Add(1)
+		go func(i int, addr string) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), s.healthCheckTimeout)
+			defer cancel()
+			conn, err := s.dial(ctx, addr)
+			if err != nil {
+				return
+			}
+			_ = conn.Close()
+			healthy[i] = true
+		}(i, addr)
+	}
+	wg.Wait()
+
+	hosts := make([]string, 0, len(candidates))
+	for i, addr := range candidates {
+		if healthy[i] {
+			hosts = append(hosts, addr)
+		}
+	}
+	return hosts
+}
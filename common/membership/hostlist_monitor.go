@@ -0,0 +1,202 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"net"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/primitives"
+)
+
+type hostListMonitor struct {
+	status int32
+
+	serviceName primitives.ServiceName
+	selfAddress string
+	rings       map[primitives.ServiceName]*hostListServiceResolver
+	logger      log.Logger
+}
+
+var _ Monitor = (*hostListMonitor)(nil)
+
+// NewStaticMonitor returns a membership monitor whose members are a fixed list of
+// host:port per service. selfAddress must match this host's entry in that list.
+func NewStaticMonitor(
+	serviceName primitives.ServiceName,
+	selfAddress string,
+	hosts map[primitives.ServiceName][]string,
+	logger log.Logger,
+) Monitor {
+	services := make([]primitives.ServiceName, 0, len(hosts))
+	for service := range hosts {
+		services = append(services, service)
+	}
+	return NewHostListMonitor(serviceName, selfAddress, services, NewStaticHostSource(hosts), defaultRefreshInterval, logger)
+}
+
+// NewDNSMonitor returns a membership monitor that discovers the members of each service
+// from a DNS SRV record, refreshed every refreshInterval.
+func NewDNSMonitor(
+	serviceName primitives.ServiceName,
+	selfAddress string,
+	records map[primitives.ServiceName]string,
+	refreshInterval time.Duration,
+	healthCheckTimeout time.Duration,
+	logger log.Logger,
+) Monitor {
+	services := make([]primitives.ServiceName, 0, len(records))
+	for service := range records {
+		services = append(services, service)
+	}
+	return NewHostListMonitor(serviceName, selfAddress, services, NewDNSHostSource(records, healthCheckTimeout), refreshInterval, logger)
+}
+
+// NewHostListMonitor returns a membership monitor that builds a consistent hash ring per
+// service from the members returned by source.
+func NewHostListMonitor(
+	serviceName primitives.ServiceName,
+	selfAddress string,
+	services []primitives.ServiceName,
+	source HostSource,
+	refreshInterval time.Duration,
+	logger log.Logger,
+) Monitor {
+	rings := make(map[primitives.ServiceName]*hostListServiceResolver, len(services))
+	for _, service := range services {
+		rings[service] = newHostListServiceResolver(service, source, refreshInterval, logger)
+	}
+	return &hostListMonitor{
+		status:      common.DaemonStatusInitialized,
+		serviceName: serviceName,
+		selfAddress: selfAddress,
+		rings:       rings,
+		logger:      logger,
+	}
+}
+
+func (m *hostListMonitor) Start() {
+	if !atomic.CompareAndSwapInt32(
+		&m.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+
+	for _, ring := range m.rings {
+		ring.Start()
+	}
+}
+
+func (m *hostListMonitor) Stop() {
+	if !atomic.CompareAndSwapInt32(
+		&m.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+
+	for _, ring := range m.rings {
+		ring.Stop()
+	}
+}
+
+func (m *hostListMonitor) WhoAmI() (*HostInfo, error) {
+	_, port, err := net.SplitHostPort(m.selfAddress)
+	if err != nil {
+		return nil, ErrIncorrectAddressFormat
+	}
+	return NewHostInfo(m.selfAddress, map[string]string{
+		RoleKey:  string(m.serviceName),
+		RolePort: port,
+	}), nil
+}
+
+// EvictSelf is a no-op: members are controlled by the host list, so a host leaves the
+// ring once it is removed from the list (or fails its health check).
+func (m *hostListMonitor) EvictSelf() error {
+	m.logger.Warn("EvictSelf is not supported by this membership provider, ignoring", tag.Address(m.selfAddress))
+	return nil
+}
+
+func (m *hostListMonitor) GetResolver(service primitives.ServiceName) (ServiceResolver, error) {
+	ring, found := m.rings[service]
+	if !found {
+		return nil, ErrUnknownService
+	}
+	return ring, nil
+}
+
+func (m *hostListMonitor) Lookup(service primitives.ServiceName, key string) (*HostInfo, error) {
+	ring, err := m.GetResolver(service)
+	if err != nil {
+		return nil, err
+	}
+	return ring.Lookup(key)
+}
+
+func (m *hostListMonitor) AddListener(service primitives.ServiceName, name string, notifyChannel chan<- *ChangedEvent) error {
+	ring, err := m.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	return ring.AddListener(name, notifyChannel)
+}
+
+func (m *hostListMonitor) RemoveListener(service primitives.ServiceName, name string) error {
+	ring, err := m.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	return ring.RemoveListener(name)
+}
+
+func (m *hostListMonitor) GetReachableMembers() ([]string, error) {
+	set := make(map[string]struct{})
+	for _, ring := range m.rings {
+		for _, host := range ring.Members() {
+			set[host.GetAddress()] = struct{}{}
+		}
+	}
+	members := make([]string, 0, len(set))
+	for addr := range set {
+		members = append(members, addr)
+	}
+	return members, nil
+}
+
+func (m *hostListMonitor) GetMemberCount(service primitives.ServiceName) (int, error) {
+	ring, err := m.GetResolver(service)
+	if err != nil {
+		return 0, err
+	}
+	return ring.MemberCount(), nil
+}
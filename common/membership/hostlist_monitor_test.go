@@ -0,0 +1,216 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	hostListMonitorSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+
+	testHostSource struct {
+		sync.Mutex
+		hosts map[primitives.ServiceName][]string
+	}
+)
+
+func TestHostListMonitorSuite(t *testing.T) {
+	suite.Run(t, new(hostListMonitorSuite))
+}
+
+func (s *hostListMonitorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *hostListMonitorSuite) TestStaticMonitor() {
+	hosts := map[primitives.ServiceName][]string{
+		primitives.HistoryService:  {"10.0.0.1:7234", "10.0.0.2:7234", "10.0.0.3:7234"},
+		primitives.MatchingService: {"10.0.0.1:7235"},
+	}
+	monitor := NewStaticMonitor(primitives.HistoryService, "10.0.0.2:7234", hosts, log.NewNoopLogger())
+	monitor.Start()
+	defer monitor.Stop()
+
+	self, err := monitor.WhoAmI()
+	s.NoError(err)
+	s.Equal("10.0.0.2:7234", self.GetAddress())
+	role, _ := self.Label(RoleKey)
+	s.Equal(string(primitives.HistoryService), role)
+
+	count, err := monitor.GetMemberCount(primitives.HistoryService)
+	s.NoError(err)
+	s.Equal(3, count)
+
+	members, err := monitor.GetReachableMembers()
+	s.NoError(err)
+	s.ElementsMatch([]string{"10.0.0.1:7234", "10.0.0.2:7234", "10.0.0.3:7234", "10.0.0.1:7235"}, members)
+
+	_, err = monitor.Lookup(primitives.FrontendService, "key")
+	s.ErrorIs(err, ErrUnknownService)
+}
+
+func (s *hostListMonitorSuite) TestLookup_SameRingAsRingpop() {
+	serviceName := primitives.HistoryService
+	testService := NewTestRingpopCluster(s.T(), "hostlist-test", 3, "0.0.0.0", "", serviceName, "127.0.0.1")
+	s.NotNil(testService, "Failed to create test service")
+	defer testService.Stop()
+
+	rpm := testService.rings[0]
+	time.Sleep(time.Second)
+	rpResolver, err := rpm.GetResolver(serviceName)
+	s.NoError(err)
+	s.NoError(rpResolver.(*ringpopServiceResolver).refresh())
+	s.Equal(3, rpResolver.MemberCount())
+
+	addrs := testService.GetHostAddrs()
+	monitor := NewStaticMonitor(serviceName, addrs[0], map[primitives.ServiceName][]string{
+		serviceName: addrs,
+	}, log.NewNoopLogger())
+	monitor.Start()
+	defer monitor.Stop()
+
+	// shards and task queues must be owned by the same hosts whichever provider is used
+	for _, key := range []string{"1", "2", "3", "42", "512", "workflow-id", "task-queue"} {
+		expected, err := rpm.Lookup(serviceName, key)
+		s.NoError(err)
+		host, err := monitor.Lookup(serviceName, key)
+		s.NoError(err)
+		s.Equal(expected.GetAddress(), host.GetAddress(), "owner of key %q", key)
+	}
+}
+
+func (s *hostListMonitorSuite) TestRefresh_NotifiesListeners() {
+	source := &testHostSource{hosts: map[primitives.ServiceName][]string{
+		primitives.MatchingService: {"10.0.0.1:7235", "10.0.0.2:7235"},
+	}}
+	monitor := NewHostListMonitor(
+		primitives.MatchingService,
+		"10.0.0.1:7235",
+		[]primitives.ServiceName{primitives.MatchingService},
+		source,
+		time.Hour,
+		log.NewNoopLogger(),
+	)
+	monitor.Start()
+	defer monitor.Stop()
+
+	listenCh := make(chan *ChangedEvent, 5)
+	s.NoError(monitor.AddListener(primitives.MatchingService, "test-listener", listenCh))
+	s.ErrorIs(monitor.AddListener(primitives.MatchingService, "test-listener", listenCh), ErrListenerAlreadyExist)
+
+	source.set(primitives.MatchingService, []string{"10.0.0.1:7235", "10.0.0.3:7235"})
+	resolver, err := monitor.GetResolver(primitives.MatchingService)
+	s.NoError(err)
+	s.NoError(resolver.(*hostListServiceResolver).refresh())
+
+	select {
+	case e := <-listenCh:
+		s.Len(e.HostsAdded, 1)
+		s.Equal("10.0.0.3:7235", e.HostsAdded[0].GetAddress())
+		s.Len(e.HostsRemoved, 1)
+		s.Equal("10.0.0.2:7235", e.HostsRemoved[0].GetAddress())
+	default:
+		s.Fail("expected a membership change event")
+	}
+
+	// no change, no event
+	s.NoError(resolver.(*hostListServiceResolver).refresh())
+	s.Empty(listenCh)
+}
+
+func (s *hostListMonitorSuite) TestDNSHostSource() {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.NoError(err)
+	defer func() { _ = listener.Close() }()
+	_, port, err := net.SplitHostPort(listener.Addr().String())
+	s.NoError(err)
+
+	source := NewDNSHostSource(map[primitives.ServiceName]string{
+		primitives.HistoryService: "_grpc._tcp.history.temporal",
+	}, time.Second).(*dnsHostSource)
+	source.lookupSRV = func(_ context.Context, name string) ([]*net.SRV, error) {
+		s.Equal("_grpc._tcp.history.temporal", name)
+		return []*net.SRV{
+			{Target: "history-0.history.temporal.", Port: parsePort(s.T(), port)},
+			{Target: "127.0.0.2.", Port: 1},
+		}, nil
+	}
+	source.lookupHost = func(_ context.Context, host string) ([]string, error) {
+		if host == "history-0.history.temporal" {
+			return []string{"127.0.0.1"}, nil
+		}
+		return nil, errors.New("unexpected host")
+	}
+
+	// the second member doesn't accept connections and is left out
+	hosts, err := source.Hosts(primitives.HistoryService)
+	s.NoError(err)
+	s.Equal([]string{listener.Addr().String()}, hosts)
+
+	_, err = source.Hosts(primitives.MatchingService)
+	s.ErrorIs(err, ErrUnknownService)
+
+	source.healthCheckTimeout = -1
+	hosts, err = source.Hosts(primitives.HistoryService)
+	s.NoError(err)
+	s.Equal([]string{listener.Addr().String(), "127.0.0.2:1"}, hosts)
+}
+
+func (t *testHostSource) Hosts(service primitives.ServiceName) ([]string, error) {
+	t.Lock()
+	defer t.Unlock()
+	hosts, ok := t.hosts[service]
+	if !ok {
+		return nil, ErrUnknownService
+	}
+	return hosts, nil
+}
+
+func (t *testHostSource) set(service primitives.ServiceName, hosts []string) {
+	t.Lock()
+	defer t.Unlock()
+	t.hosts[service] = hosts
+}
+
+func parsePort(t *testing.T, port string) uint16 {
+	p, err := net.LookupPort("tcp", port)
+	require.NoError(t, err)
+	return uint16(p)
+}
@@ -0,0 +1,322 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/temporalio/ringpop-go/hashring"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	// HostSource provides the gRPC addresses of the members of a service to
+	// membership monitors that don't discover members by gossip.
+	HostSource interface {
+		// Hosts returns the host:port of every healthy member of the service.
+		Hosts(service primitives.ServiceName) ([]string, error)
+	}
+
+	// hostListServiceResolver builds the same consistent hash ring as
+	// ringpopServiceResolver from the members returned by a HostSource.
+	hostListServiceResolver struct {
+		status          int32
+		service         primitives.ServiceName
+		source          HostSource
+		refreshInterval time.Duration
+		refreshChan     chan struct{}
+		shutdownCh      chan struct{}
+		shutdownWG      sync.WaitGroup
+		logger          log.Logger
+
+		ringValue atomic.Value // this stores the current hashring
+
+		refreshLock     sync.Mutex
+		lastRefreshTime time.Time
+		membersMap      map[string]struct{} // for de-duping change notifications
+
+		listenerLock sync.RWMutex
+		listeners    map[string]chan<- *ChangedEvent
+	}
+)
+
+var _ ServiceResolver = (*hostListServiceResolver)(nil)
+
+func newHostListServiceResolver(
+	service primitives.ServiceName,
+	source HostSource,
+	refreshInterval time.Duration,
+	logger log.Logger,
+) *hostListServiceResolver {
+	if refreshInterval <= 0 {
+		refreshInterval = defaultRefreshInterval
+	}
+
+	resolver := &hostListServiceResolver{
+		status:          common.DaemonStatusInitialized,
+		service:         service,
+		source:          source,
+		refreshInterval: refreshInterval,
+		refreshChan:     make(chan struct{}),
+		shutdownCh:      make(chan struct{}),
+		logger:          log.With(logger, tag.ComponentServiceResolver, tag.Service(service)),
+		membersMap:      make(map[string]struct{}),
+		listeners:       make(map[string]chan<- *ChangedEvent),
+	}
+	resolver.ringValue.Store(newHashRing())
+	return resolver
+}
+
+// Start starts the resolver
+func (r *hostListServiceResolver) Start() {
+	if !atomic.CompareAndSwapInt32(
+		&r.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+
+	// Unlike ringpop, the source may be temporarily unavailable (e.g. DNS not yet
+	// populated during a rollout), so failing the initial refresh is not fatal.
+	if err := r.refresh(); err != nil {
+		r.logger.Error("unable to load initial members", tag.Error(err))
+	}
+
+	r.shutdownWG.Add(1)
+	go r.refreshRingWorker()
+}
+
+// Stop stops the resolver
+func (r *hostListServiceResolver) Stop() {
+	if !atomic.CompareAndSwapInt32(
+		&r.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+
+	close(r.shutdownCh)
+	if success := common.AwaitWaitGroup(&r.shutdownWG, time.Minute); !success {
+		r.logger.Warn("service resolver timed out on shutdown.")
+	}
+
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	r.ringValue.Store(newHashRing())
+	r.listeners = make(map[string]chan<- *ChangedEvent)
+}
+
+func (r *hostListServiceResolver) RequestRefresh() {
+	select {
+	case r.refreshChan <- struct{}{}:
+	default:
+	}
+}
+
+// Lookup finds the host in the ring responsible for serving the given key
+func (r *hostListServiceResolver) Lookup(
+	key string,
+) (*HostInfo, error) {
+
+	addr, found := r.ring().Lookup(key)
+	if !found {
+		r.RequestRefresh()
+		return nil, ErrInsufficientHosts
+	}
+
+	return NewHostInfo(addr, r.getLabelsMap()), nil
+}
+
+func (r *hostListServiceResolver) AddListener(
+	name string,
+	notifyChannel chan<- *ChangedEvent,
+) error {
+
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	_, ok := r.listeners[name]
+	if ok {
+		return ErrListenerAlreadyExist
+	}
+	r.listeners[name] = notifyChannel
+	return nil
+}
+
+func (r *hostListServiceResolver) RemoveListener(
+	name string,
+) error {
+
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	delete(r.listeners, name)
+	return nil
+}
+
+func (r *hostListServiceResolver) MemberCount() int {
+	return r.ring().ServerCount()
+}
+
+func (r *hostListServiceResolver) Members() []*HostInfo {
+	var servers []*HostInfo
+	for _, s := range r.ring().Servers() {
+		servers = append(servers, NewHostInfo(s, r.getLabelsMap()))
+	}
+
+	return servers
+}
+
+func (r *hostListServiceResolver) refresh() error {
+	var event *ChangedEvent
+	var err error
+	defer func() {
+		if event != nil {
+			r.emitEvent(event)
+		}
+	}()
+	r.refreshLock.Lock()
+	defer r.refreshLock.Unlock()
+	event, err = r.refreshNoLock()
+	return err
+}
+
+func (r *hostListServiceResolver) refreshWithBackoff() error {
+	var event *ChangedEvent
+	var err error
+	defer func() {
+		if event != nil {
+			r.emitEvent(event)
+		}
+	}()
+	r.refreshLock.Lock()
+	defer r.refreshLock.Unlock()
+	if r.lastRefreshTime.After(time.Now().UTC().Add(-minRefreshInternal)) {
+		// refresh too frequently
+		return nil
+	}
+	event, err = r.refreshNoLock()
+	return err
+}
+
+func (r *hostListServiceResolver) refreshNoLock() (*ChangedEvent, error) {
+	addrs, err := r.source.Hosts(r.service)
+	if err != nil {
+		return nil, err
+	}
+	r.lastRefreshTime = time.Now().UTC()
+
+	newMembersMap, changedEvent := r.compareMembers(addrs)
+	if changedEvent == nil {
+		return nil, nil
+	}
+
+	ring := newHashRing()
+	for _, addr := range addrs {
+		host := NewHostInfo(addr, r.getLabelsMap())
+		ring.AddMembers(host)
+	}
+
+	r.membersMap = newMembersMap
+	r.ringValue.Store(ring)
+	r.logger.Info("Current reachable members", tag.Addresses(addrs))
+
+	return changedEvent, nil
+}
+
+func (r *hostListServiceResolver) emitEvent(event *ChangedEvent) {
+	// Notify listeners
+	r.listenerLock.RLock()
+	defer r.listenerLock.RUnlock()
+
+	for name, ch := range r.listeners {
+		select {
+		case ch <- event:
+		default:
+			r.logger.Error("Failed to send listener notification, channel full", tag.ListenerName(name))
+		}
+	}
+}
+
+func (r *hostListServiceResolver) refreshRingWorker() {
+	defer r.shutdownWG.Done()
+
+	refreshTicker := time.NewTicker(r.refreshInterval)
+	defer refreshTicker.Stop()
+
+	for {
+		select {
+		case <-r.shutdownCh:
+			return
+		case <-r.refreshChan:
+			if err := r.refreshWithBackoff(); err != nil {
+				r.logger.Error("error refreshing ring by request", tag.Error(err))
+			}
+		case <-refreshTicker.C:
+			if err := r.refresh(); err != nil {
+				r.logger.Error("error periodically refreshing ring", tag.Error(err))
+			}
+		}
+	}
+}
+
+func (r *hostListServiceResolver) ring() *hashring.HashRing {
+	return r.ringValue.Load().(*hashring.HashRing)
+}
+
+func (r *hostListServiceResolver) getLabelsMap() map[string]string {
+	labels := make(map[string]string)
+	labels[RoleKey] = string(r.service)
+	return labels
+}
+
+func (r *hostListServiceResolver) compareMembers(addrs []string) (map[string]struct{}, *ChangedEvent) {
+	event := &ChangedEvent{}
+	changed := false
+	newMembersMap := make(map[string]struct{}, len(addrs))
+	for _, addr := range addrs {
+		newMembersMap[addr] = struct{}{}
+		if _, ok := r.membersMap[addr]; !ok {
+			event.HostsAdded = append(event.HostsAdded, NewHostInfo(addr, r.getLabelsMap()))
+			changed = true
+		}
+	}
+	for addr := range r.membersMap {
+		if _, ok := newMembersMap[addr]; !ok {
+			event.HostsRemoved = append(event.HostsRemoved, NewHostInfo(addr, r.getLabelsMap()))
+			changed = true
+		}
+	}
+	if changed {
+		return newMembersMap, event
+	}
+	return newMembersMap, nil
+}
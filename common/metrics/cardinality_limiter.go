@@ -0,0 +1,415 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"hash/fnv"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"go.temporal.io/server/common/log"
+)
+
+const (
+	// tagSetCacheShards is the number of independently locked shards of the cache of limited tag sets
+	tagSetCacheShards = 32
+	// maxCachedTagSetsPerShard bounds the memory used by the cache of limited tag sets,
+	// tag sets which don't fit into the cache are limited on every record
+	maxCachedTagSetsPerShard = 2048
+)
+
+type (
+	// CardinalityLimitsConfig bounds the number of series the server reports.
+	// Zero or negative limits are not enforced.
+	CardinalityLimitsConfig struct {
+		// MaxTagValues is the max number of distinct values reported for each tag key.
+		// Values seen after the limit is reached are reported as "_other_".
+		MaxTagValues int `yaml:"maxTagValues"`
+		// PerTagMaxValues overrides MaxTagValues for the given tag keys.
+		PerTagMaxValues map[string]int `yaml:"perTagMaxValues"`
+		// MaxSeriesPerMetric is the max number of distinct tag sets reported for each metric, overflow series included.
+		// Series seen after the limit is reached are reported with the value of the tag having the most distinct
+		// values for the metric set to "_other_" if that series is already reported, otherwise with all tag values
+		// set to "_other_". The last series of the budget is reserved for the latter. Series which still don't fit
+		// (their tag keys differ from the ones of the reserved series) are dropped.
+		MaxSeriesPerMetric int `yaml:"maxSeriesPerMetric"`
+		// MaxTagValueLength truncates tag values longer than the given number of bytes.
+		MaxTagValueLength int `yaml:"maxTagValueLength"`
+	}
+
+	cardinalityLimiter struct {
+		config           CardinalityLimitsConfig
+		tagValueOverflow CounterIface
+		seriesOverflow   CounterIface
+
+		// cache holds the result of limiting a tag set, admitted values and series are never
+		// forgotten and limits never change, so the result of limiting a tag set never changes either
+		cache [tagSetCacheShards]tagSetCacheShard
+
+		sync.RWMutex
+		tagValues    map[string]*tagValueSet
+		metricSeries map[string]*metricSeriesSet
+	}
+
+	tagSetCacheShard struct {
+		sync.RWMutex
+		limited map[string]*limitedTagSet
+	}
+
+	limitedTagSet struct {
+		tags []Tag
+		// overflowTagKeys are the keys of tags whose values were replaced because of MaxTagValues
+		overflowTagKeys []string
+		seriesOverflow  bool
+		// dropped is true if the series doesn't fit into MaxSeriesPerMetric and is not reported
+		dropped bool
+	}
+
+	tagValueSet struct {
+		sync.RWMutex
+		values map[string]struct{}
+	}
+
+	metricSeriesSet struct {
+		sync.RWMutex
+		series map[string]struct{}
+		// tagValues are the distinct values of each tag key over the admitted series
+		tagValues map[string]map[string]struct{}
+	}
+
+	// cardinalityLimitingHandler is a Handler that sanitizes tag values and enforces
+	// CardinalityLimitsConfig before passing tags to the underlying Handler.
+	cardinalityLimitingHandler struct {
+		handler Handler
+		tags    []Tag
+		limiter *cardinalityLimiter
+	}
+)
+
+var _ Handler = (*cardinalityLimitingHandler)(nil)
+
+// NewCardinalityLimitingHandler wraps handler with one that enforces the given cardinality limits.
+// Tags added through WithTags are passed to handler when a metric is recorded.
+// Overflows are reported to handler directly and are not subject to the limits.
+func NewCardinalityLimitingHandler(handler Handler, config CardinalityLimitsConfig) Handler {
+	limiter := &cardinalityLimiter{
+		config:           config,
+		tagValueOverflow: handler.Counter(MetricsTagValueOverflow.GetMetricName()),
+		seriesOverflow:   handler.Counter(MetricsSeriesOverflow.GetMetricName()),
+		tagValues:        make(map[string]*tagValueSet),
+		metricSeries:     make(map[string]*metricSeriesSet),
+	}
+	for i := range limiter.cache {
+		limiter.cache[i].limited = make(map[string]*limitedTagSet)
+	}
+	return &cardinalityLimitingHandler{
+		handler: handler,
+		limiter: limiter,
+	}
+}
+
+// WithTags creates a new MetricProvder with provided []Tag
+// Tags are merged with registered Tags from the source MetricsHandler
+func (h *cardinalityLimitingHandler) WithTags(tags ...Tag) Handler {
+	merged := make([]Tag, 0, len(h.tags)+len(tags))
+	merged = append(merged, h.tags...)
+	merged = append(merged, tags...)
+	return &cardinalityLimitingHandler{
+		handler: h.handler,
+		tags:    merged,
+		limiter: h.limiter,
+	}
+}
+
+// Counter obtains a counter for the given name and MetricOptions.
+func (h *cardinalityLimitingHandler) Counter(counter string) CounterIface {
+	c := h.handler.Counter(counter)
+	return CounterFunc(func(i int64, t ...Tag) {
+		if tags, ok := h.limiter.limit(counter, h.tags, t); ok {
+			c.Record(i, tags...)
+		}
+	})
+}
+
+// Gauge obtains a gauge for the given name and MetricOptions.
+func (h *cardinalityLimitingHandler) Gauge(gauge string) GaugeIface {
+	g := h.handler.Gauge(gauge)
+	return GaugeFunc(func(f float64, t ...Tag) {
+		if tags, ok := h.limiter.limit(gauge, h.tags, t); ok {
+			g.Record(f, tags...)
+		}
+	})
+}
+
+// Timer obtains a timer for the given name and MetricOptions.
+func (h *cardinalityLimitingHandler) Timer(timer string) TimerIface {
+	tm := h.handler.Timer(timer)
+	return TimerFunc(func(d time.Duration, t ...Tag) {
+		if tags, ok := h.limiter.limit(timer, h.tags, t); ok {
+			tm.Record(d, tags...)
+		}
+	})
+}
+
+// Histogram obtains a histogram for the given name and MetricOptions.
+func (h *cardinalityLimitingHandler) Histogram(histogram string, unit MetricUnit) HistogramIface {
+	hg := h.handler.Histogram(histogram, unit)
+	return HistogramFunc(func(i int64, t ...Tag) {
+		if tags, ok := h.limiter.limit(histogram, h.tags, t); ok {
+			hg.Record(i, tags...)
+		}
+	})
+}
+
+func (h *cardinalityLimitingHandler) Stop(logger log.Logger) {
+	h.handler.Stop(logger)
+}
+
+// limit merges handler and record tags, later tags overriding earlier ones with the same key,
+// and returns them sanitized and with the cardinality limits applied, sorted by key.
+// It returns false if the series must not be reported.
+func (l *cardinalityLimiter) limit(metricName string, handlerTags []Tag, recordTags []Tag) ([]Tag, bool) {
+	if len(handlerTags)+len(recordTags) == 0 {
+		return nil, true
+	}
+
+	cacheKey := tagSetCacheKey(metricName, handlerTags, recordTags)
+	shard := &l.cache[shardIndex(cacheKey)]
+	shard.RLock()
+	limited, ok := shard.limited[cacheKey]
+	shard.RUnlock()
+	if !ok {
+		limited = l.limitTagSet(metricName, handlerTags, recordTags)
+		shard.Lock()
+		if len(shard.limited) < maxCachedTagSetsPerShard {
+			shard.limited[cacheKey] = limited
+		}
+		shard.Unlock()
+	}
+
+	for _, key := range limited.overflowTagKeys {
+		l.tagValueOverflow.Record(1, StringTag(tagKeyTagName, key))
+	}
+	if limited.seriesOverflow {
+		l.seriesOverflow.Record(1, StringTag(metricNameTagName, metricName))
+	}
+	return limited.tags, !limited.dropped
+}
+
+func (l *cardinalityLimiter) limitTagSet(metricName string, handlerTags []Tag, recordTags []Tag) *limitedTagSet {
+	values := make(map[string]string, len(handlerTags)+len(recordTags))
+	for _, t := range handlerTags {
+		values[t.Key()] = t.Value()
+	}
+	for _, t := range recordTags {
+		values[t.Key()] = t.Value()
+	}
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	limited := &limitedTagSet{}
+	for _, key := range keys {
+		value := l.sanitize(values[key])
+		if !l.admitTagValue(key, value) {
+			value = otherValue
+			limited.overflowTagKeys = append(limited.overflowTagKeys, key)
+		}
+		values[key] = value
+	}
+
+	if maxSeries := l.config.MaxSeriesPerMetric; maxSeries > 0 {
+		series := l.getMetricSeries(metricName)
+		// the last series is reserved for the series with all tag values set to "_other_"
+		if !series.admit(keys, values, maxSeries-1) {
+			limited.seriesOverflow = true
+			values[series.highestCardinalityKey(keys)] = otherValue
+			if !series.admit(keys, values, maxSeries-1) {
+				for _, key := range keys {
+					values[key] = otherValue
+				}
+				limited.dropped = !series.admit(keys, values, maxSeries)
+			}
+		}
+	}
+
+	limited.tags = make([]Tag, len(keys))
+	for i, key := range keys {
+		limited.tags[i] = StringTag(key, values[key])
+	}
+	return limited
+}
+
+func (l *cardinalityLimiter) sanitize(value string) string {
+	if len(value) == 0 {
+		return unknownValue
+	}
+	value = strings.ToValidUTF8(value, "_")
+	if maxLength := l.config.MaxTagValueLength; maxLength > 0 && len(value) > maxLength {
+		value = value[:maxLength]
+		// don't cut a multi-byte character in half
+		for len(value) > 0 && !utf8.ValidString(value) {
+			value = value[:len(value)-1]
+		}
+	}
+	return value
+}
+
+func (l *cardinalityLimiter) admitTagValue(key string, value string) bool {
+	maxValues := l.config.MaxTagValues
+	if perTag, ok := l.config.PerTagMaxValues[key]; ok {
+		maxValues = perTag
+	}
+	if maxValues <= 0 {
+		return true
+	}
+
+	l.RLock()
+	set, ok := l.tagValues[key]
+	l.RUnlock()
+	if !ok {
+		l.Lock()
+		if set, ok = l.tagValues[key]; !ok {
+			set = &tagValueSet{values: make(map[string]struct{})}
+			l.tagValues[key] = set
+		}
+		l.Unlock()
+	}
+	return set.admit(value, maxValues)
+}
+
+func (l *cardinalityLimiter) getMetricSeries(metricName string) *metricSeriesSet {
+	l.RLock()
+	series, ok := l.metricSeries[metricName]
+	l.RUnlock()
+	if ok {
+		return series
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	if series, ok = l.metricSeries[metricName]; !ok {
+		series = &metricSeriesSet{
+			series:    make(map[string]struct{}),
+			tagValues: make(map[string]map[string]struct{}),
+		}
+		l.metricSeries[metricName] = series
+	}
+	return series
+}
+
+func (s *tagValueSet) admit(value string, maxValues int) bool {
+	s.RLock()
+	_, ok := s.values[value]
+	full := len(s.values) >= maxValues
+	s.RUnlock()
+	if ok {
+		return true
+	}
+	if full {
+		return false
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	if _, ok := s.values[value]; ok {
+		return true
+	}
+	if len(s.values) >= maxValues {
+		return false
+	}
+	s.values[value] = struct{}{}
+	return true
+}
+
+func (s *metricSeriesSet) admit(keys []string, values map[string]string, maxSeries int) bool {
+	var builder strings.Builder
+	for _, key := range keys {
+		builder.WriteString(key)
+		builder.WriteByte('=')
+		builder.WriteString(values[key])
+		builder.WriteByte(',')
+	}
+	series := builder.String()
+
+	s.Lock()
+	defer s.Unlock()
+	if _, ok := s.series[series]; ok {
+		return true
+	}
+	if len(s.series) >= maxSeries {
+		return false
+	}
+	s.series[series] = struct{}{}
+	for _, key := range keys {
+		tagValues, ok := s.tagValues[key]
+		if !ok {
+			tagValues = make(map[string]struct{})
+			s.tagValues[key] = tagValues
+		}
+		tagValues[values[key]] = struct{}{}
+	}
+	return true
+}
+
+// highestCardinalityKey returns the key with the most distinct values over the admitted series
+// of the metric, it is the first key replaced with "_other_" when the series limit is reached
+func (s *metricSeriesSet) highestCardinalityKey(keys []string) string {
+	s.RLock()
+	defer s.RUnlock()
+
+	highest := keys[0]
+	for _, key := range keys[1:] {
+		if len(s.tagValues[key]) > len(s.tagValues[highest]) {
+			highest = key
+		}
+	}
+	return highest
+}
+
+func tagSetCacheKey(metricName string, handlerTags []Tag, recordTags []Tag) string {
+	var builder strings.Builder
+	builder.WriteString(metricName)
+	for _, tags := range [][]Tag{handlerTags, recordTags} {
+		for _, t := range tags {
+			builder.WriteByte(0)
+			builder.WriteString(t.Key())
+			builder.WriteByte(0)
+			builder.WriteString(t.Value())
+		}
+	}
+	return builder.String()
+}
+
+func shardIndex(key string) int {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(key))
+	return int(hash.Sum32() % tagSetCacheShards)
+}
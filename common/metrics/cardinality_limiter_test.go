@@ -0,0 +1,170 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally/v4"
+)
+
+func TestCardinalityLimitingHandler_MaxTagValues(t *testing.T) {
+	scope := tally.NewTestScope("test", map[string]string{})
+	handler := NewCardinalityLimitingHandler(
+		NewTallyMetricsHandler(ClientConfig{}, scope),
+		CardinalityLimitsConfig{
+			MaxTagValues:    2,
+			PerTagMaxValues: map[string]int{"operation": 0},
+		},
+	)
+
+	for _, ns := range []string{"ns1", "ns2", "ns3", "ns4", "ns1"} {
+		handler.WithTags(StringTag("namespace", ns)).Counter("requests").Record(1, StringTag("operation", ns))
+	}
+
+	counters := scope.Snapshot().Counters()
+	assert.EqualValues(t, 2, counters["test.requests+namespace=ns1,operation=ns1"].Value())
+	assert.EqualValues(t, 1, counters["test.requests+namespace=ns2,operation=ns2"].Value())
+	assert.EqualValues(t, 1, counters["test.requests+namespace=_other_,operation=ns3"].Value())
+	assert.EqualValues(t, 1, counters["test.requests+namespace=_other_,operation=ns4"].Value())
+	assert.EqualValues(t, 2, counters["test.metrics_tag_value_overflow+tag_key=namespace"].Value())
+}
+
+func TestCardinalityLimitingHandler_MaxSeriesPerMetric(t *testing.T) {
+	scope := tally.NewTestScope("test", map[string]string{})
+	handler := NewCardinalityLimitingHandler(
+		NewTallyMetricsHandler(ClientConfig{}, scope),
+		CardinalityLimitsConfig{
+			MaxSeriesPerMetric: 3,
+		},
+	)
+
+	for _, tq := range []string{"tq1", "tq2", "tq3", "tq1", "tq4", "tq3"} {
+		handler.Counter("requests").Record(1, StringTag("namespace", "ns"), StringTag("taskqueue", tq))
+	}
+	handler.Counter("other_requests").Record(1, StringTag("namespace", "ns"), StringTag("taskqueue", "tq3"))
+
+	counters := scope.Snapshot().Counters()
+	assert.EqualValues(t, 2, counters["test.requests+namespace=ns,taskqueue=tq1"].Value())
+	assert.EqualValues(t, 1, counters["test.requests+namespace=ns,taskqueue=tq2"].Value())
+	// the last series is reserved for the overflow series
+	assert.EqualValues(t, 3, counters["test.requests+namespace=_other_,taskqueue=_other_"].Value())
+	assert.EqualValues(t, 1, counters["test.other_requests+namespace=ns,taskqueue=tq3"].Value())
+	assert.EqualValues(t, 3, counters["test.metrics_series_overflow+metric_name=requests"].Value())
+	assert.Equal(t, 3, seriesCount(counters, "test.requests+"))
+}
+
+func TestCardinalityLimitingHandler_MaxSeriesPerMetric_CollapseHighestCardinalityTag(t *testing.T) {
+	scope := tally.NewTestScope("test", map[string]string{})
+	handler := NewCardinalityLimitingHandler(
+		NewTallyMetricsHandler(ClientConfig{}, scope),
+		CardinalityLimitsConfig{
+			PerTagMaxValues:    map[string]int{"taskqueue": 2},
+			MaxSeriesPerMetric: 4,
+		},
+	)
+
+	record := func(tags ...Tag) {
+		handler.Counter("requests").Record(1, tags...)
+	}
+	record(StringTag("namespace", "ns1"), StringTag("taskqueue", "tq1"))
+	record(StringTag("namespace", "ns2"), StringTag("taskqueue", "tq2"))
+	// taskqueue value overflow
+	record(StringTag("namespace", "ns1"), StringTag("taskqueue", "tq3"))
+	// series overflow, series with the highest cardinality tag collapsed is already reported
+	record(StringTag("namespace", "ns1"), StringTag("taskqueue", "tq2"))
+	// series overflow, all tags collapsed
+	record(StringTag("namespace", "ns3"), StringTag("taskqueue", "tq1"))
+	// series overflow with different tag keys, dropped
+	record(StringTag("namespace", "ns3"))
+
+	counters := scope.Snapshot().Counters()
+	assert.EqualValues(t, 1, counters["test.requests+namespace=ns1,taskqueue=tq1"].Value())
+	assert.EqualValues(t, 1, counters["test.requests+namespace=ns2,taskqueue=tq2"].Value())
+	assert.EqualValues(t, 2, counters["test.requests+namespace=ns1,taskqueue=_other_"].Value())
+	assert.EqualValues(t, 1, counters["test.requests+namespace=_other_,taskqueue=_other_"].Value())
+	assert.EqualValues(t, 3, counters["test.metrics_series_overflow+metric_name=requests"].Value())
+	assert.Equal(t, 4, seriesCount(counters, "test.requests+"))
+}
+
+func TestCardinalityLimitingHandler_MaxSeriesPerMetric_TotalSeries(t *testing.T) {
+	for _, maxSeries := range []int{1, 2, 10} {
+		scope := tally.NewTestScope("test", map[string]string{})
+		handler := NewCardinalityLimitingHandler(
+			NewTallyMetricsHandler(ClientConfig{}, scope),
+			CardinalityLimitsConfig{
+				MaxSeriesPerMetric: maxSeries,
+			},
+		)
+
+		for i := 0; i < 50; i++ {
+			handler.Counter("requests").Record(1,
+				StringTag("namespace", fmt.Sprintf("ns%d", i%7)),
+				StringTag("taskqueue", fmt.Sprintf("tq%d", i)),
+			)
+		}
+
+		counters := scope.Snapshot().Counters()
+		assert.Equal(t, maxSeries, seriesCount(counters, "test.requests+"), maxSeries)
+		var total int64
+		for name, counter := range counters {
+			if strings.HasPrefix(name, "test.requests+") {
+				total += counter.Value()
+			}
+		}
+		// nothing is dropped when all series have the same tag keys
+		assert.EqualValues(t, 50, total, maxSeries)
+	}
+}
+
+func seriesCount(counters map[string]tally.CounterSnapshot, prefix string) int {
+	count := 0
+	for name := range counters {
+		if strings.HasPrefix(name, prefix) {
+			count++
+		}
+	}
+	return count
+}
+
+func TestCardinalityLimitingHandler_Sanitize(t *testing.T) {
+	scope := tally.NewTestScope("test", map[string]string{})
+	handler := NewCardinalityLimitingHandler(
+		NewTallyMetricsHandler(ClientConfig{}, scope),
+		CardinalityLimitsConfig{
+			MaxTagValueLength: 4,
+		},
+	)
+
+	handler.Counter("requests").Record(1, StringTag("namespace", "namespace"), StringTag("taskqueue", ""))
+	handler.Counter("requests").Record(1, StringTag("namespace", "ns\xff"), StringTag("taskqueue", "tqxé"))
+
+	counters := scope.Snapshot().Counters()
+	assert.EqualValues(t, 1, counters["test.requests+namespace=name,taskqueue=_unknown_"].Value())
+	assert.EqualValues(t, 1, counters["test.requests+namespace=ns_,taskqueue=tqx"].Value())
+}
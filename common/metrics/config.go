@@ -73,6 +73,9 @@ type (
 		// - "milliseconds"
 		// - "bytes"
 		PerUnitHistogramBoundaries map[string][]float64 `yaml:"perUnitHistogramBoundaries"`
+
+		// CardinalityLimits if specified limits the number of distinct tag values and series reported.
+		CardinalityLimits *CardinalityLimitsConfig `yaml:"cardinalityLimits"`
 	}
 
 	// StatsdConfig contains the config items for statsd metrics reporter
@@ -455,6 +458,14 @@ func MetricsHandlerFromConfig(logger log.Logger, c *Config) Handler {
 
 	setDefaultPerUnitHistogramBoundaries(&c.ClientConfig)
 
+	handler := newMetricsHandler(logger, c)
+	if c.CardinalityLimits != nil {
+		handler = NewCardinalityLimitingHandler(handler, *c.CardinalityLimits)
+	}
+	return handler
+}
+
+func newMetricsHandler(logger log.Logger, c *Config) Handler {
 	if c.Prometheus != nil && c.Prometheus.Framework == FrameworkOpentelemetry {
 		otelProvider, err := NewOpenTelemetryProvider(logger, c.Prometheus, &c.ClientConfig)
 		if err != nil {
@@ -41,6 +41,7 @@ const (
 	OperationTagName           = "operation"
 	ServiceRoleTagName         = "service_role"
 	CacheTypeTagName           = "cache_type"
+	CacheEvictionReasonTagName = "eviction_reason"
 	FailureTagName             = "failure"
 	TaskCategoryTagName        = "task_category"
 	TaskTypeTagName            = "task_type"
@@ -48,9 +49,12 @@ const (
 	QueueReaderIDTagName       = "queue_reader_id"
 	QueueAlertTypeTagName      = "queue_alert_type"
 	QueueTypeTagName           = "queue_type"
+	RepairActionTagName        = "repair_action"
 	visibilityTypeTagName      = "visibility_type"
 	ErrorTypeTagName           = "error_type"
 	httpStatusTagName          = "http_status"
+	tagKeyTagName              = "tag_key"
+	metricNameTagName          = "metric_name"
 	resourceExhaustedTag       = "resource_exhausted_cause"
 	standardVisibilityTagValue = "standard_visibility"
 	advancedVisibilityTagValue = "advanced_visibility"
@@ -68,6 +72,11 @@ const (
 	MutableStateCacheTypeTagValue = "mutablestate"
 	EventsCacheTypeTagValue       = "events"
 
+	CacheEvictionReasonCapacityTagValue   = "capacity"
+	CacheEvictionReasonShardQuotaTagValue = "shard_quota"
+	CacheEvictionReasonSizeTagValue       = "size"
+	CacheEvictionReasonTTLTagValue        = "ttl"
+
 	InvalidHistoryURITagValue    = "invalid_history_uri"
 	InvalidVisibilityURITagValue = "invalid_visibility_uri"
 )
@@ -136,6 +145,14 @@ const (
 	AdminClientMergeDLQMessagesScope = "AdminClientMergeDLQMessages"
 	// AdminClientRefreshWorkflowTasksScope tracks RPC calls to admin service
 	AdminClientRefreshWorkflowTasksScope = "AdminClientRefreshWorkflowTasks"
+	// AdminClientRearchiveWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientRearchiveWorkflowExecutionScope = "AdminClientRearchiveWorkflowExecution"
+	// AdminClientCountWorkflowExecutionsScope tracks RPC calls to admin service
+	AdminClientCountWorkflowExecutionsScope = "AdminClientCountWorkflowExecutions"
+	// AdminClientRenameNamespaceScope tracks RPC calls to admin service
+	AdminClientRenameNamespaceScope = "AdminClientRenameNamespace"
+	// AdminClientGetReplicationStatusScope tracks RPC calls to admin service
+	AdminClientGetReplicationStatusScope = "AdminClientGetReplicationStatus"
 	// AdminClientResendReplicationTasksScope tracks RPC calls to admin service
 	AdminClientResendReplicationTasksScope = "AdminClientResendReplicationTasks"
 	// AdminClientGetTaskQueueTasksScope tracks RPC calls to admin service
@@ -167,6 +184,14 @@ const (
 	AdminReapplyEventsScope = "AdminReapplyEvents"
 	// AdminRefreshWorkflowTasksScope is the metric scope for admin.RefreshWorkflowTasks
 	AdminRefreshWorkflowTasksScope = "AdminRefreshWorkflowTasks"
+	// AdminRearchiveWorkflowExecutionScope is the metric scope for admin.RearchiveWorkflowExecution
+	AdminRearchiveWorkflowExecutionScope = "AdminRearchiveWorkflowExecution"
+	// AdminCountWorkflowExecutionsScope is the metric scope for admin.CountWorkflowExecutions
+	AdminCountWorkflowExecutionsScope = "AdminCountWorkflowExecutions"
+	// AdminRenameNamespaceScope is the metric scope for admin.RenameNamespace
+	AdminRenameNamespaceScope = "AdminRenameNamespace"
+	// AdminGetReplicationStatusScope is the metric scope for admin.GetReplicationStatus
+	AdminGetReplicationStatusScope = "AdminGetReplicationStatus"
 	// AdminResendReplicationTasksScope is the metric scope for admin.ResendReplicationTasks
 	AdminResendReplicationTasksScope = "AdminResendReplicationTasks"
 	// AdminGetTaskQueueTasksScope is the metric scope for admin.GetTaskQueueTasks
@@ -520,6 +545,8 @@ const (
 	HistoryClientMergeDLQMessagesScope = "HistoryClientMergeDLQMessages"
 	// HistoryClientRefreshWorkflowTasksScope tracks RPC calls to history service
 	HistoryClientRefreshWorkflowTasksScope = "HistoryClientRefreshWorkflowTasks"
+	// HistoryClientRearchiveWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientRearchiveWorkflowExecutionScope = "HistoryClientRearchiveWorkflowExecution"
 	// HistoryClientGenerateLastHistoryReplicationTasksScope tracks RPC calls to history service
 	HistoryClientGenerateLastHistoryReplicationTasksScope = "HistoryClientGenerateLastHistoryReplicationTasks"
 	// HistoryClientGetReplicationStatusScope tracks RPC calls to history service
@@ -800,6 +827,8 @@ const (
 	VisibilityPersistenceScanWorkflowExecutionsScope = "ScanWorkflowExecutions"
 	// VisibilityPersistenceCountWorkflowExecutionsScope tracks CountWorkflowExecutions calls made by service to visibility persistence layer
 	VisibilityPersistenceCountWorkflowExecutionsScope = "CountWorkflowExecutions"
+	// VisibilityPersistenceSumHistorySizeBytesScope tracks SumHistorySizeBytes calls made by service to visibility persistence layer
+	VisibilityPersistenceSumHistorySizeBytesScope = "SumHistorySizeBytes"
 	// VisibilityPersistenceGetWorkflowExecutionScope tracks GetWorkflowExecution calls made by service to visibility persistence layer
 	VisibilityPersistenceGetWorkflowExecutionScope = "GetWorkflowExecution"
 )
@@ -1005,6 +1034,8 @@ const (
 	HistoryReapplyEventsScope = "ReapplyEvents"
 	// HistoryRefreshWorkflowTasksScope is the scope used by refresh workflow tasks API
 	HistoryRefreshWorkflowTasksScope = "RefreshWorkflowTasks"
+	// HistoryRearchiveWorkflowExecutionScope is the scope used by rearchive workflow execution API
+	HistoryRearchiveWorkflowExecutionScope = "RearchiveWorkflowExecution"
 	// HistoryGenerateLastHistoryReplicationTasksScope is the scope used by generate last replication tasks API
 	HistoryGenerateLastHistoryReplicationTasksScope = "GenerateLastHistoryReplicationTasks"
 	// HistoryGetReplicationStatusScope is the scope used by GetReplicationStatus API
@@ -1229,6 +1260,8 @@ const (
 	VisibilityArchiverScope = "VisibilityArchiver"
 	// HistoryScavengerScope is scope used by all metrics emitted by worker.history.Scavenger module
 	HistoryScavengerScope = "HistoryScavenger"
+	// ArchivalVerifierScope is scope used by all metrics emitted by worker.archival.Verifier module
+	ArchivalVerifierScope = "ArchivalVerifier"
 	// ArchiverDeleteHistoryActivityScope is scope used by all metrics emitted by archiver.DeleteHistoryActivity
 	ArchiverDeleteHistoryActivityScope = "ArchiverDeleteHistoryActivity"
 	// ArchiverUploadHistoryActivityScope is scope used by all metrics emitted by archiver.UploadHistoryActivity
@@ -1243,6 +1276,8 @@ const (
 	ArchiverArchivalWorkflowScope = "ArchiverArchivalWorkflow"
 	// AddSearchAttributesWorkflowScope is scope used by all metrics emitted by worker.AddSearchAttributesWorkflowScope module
 	AddSearchAttributesWorkflowScope = "AddSearchAttributesWorkflow"
+	// ChangeSearchAttributeTypeWorkflowScope is scope used by all metrics emitted by worker.ChangeSearchAttributeTypeWorkflow
+	ChangeSearchAttributeTypeWorkflowScope = "ChangeSearchAttributeTypeWorkflow"
 	// BatcherScope is scope used by all metrics emitted by worker.Batcher module
 	BatcherScope = "Batcher"
 	// ElasticsearchBulkProcessor is scope used by all metric emitted by Elasticsearch bulk processor
@@ -1251,6 +1286,8 @@ const (
 	ElasticsearchVisibility = "ElasticsearchVisibility"
 	// MigrationWorkflowScope is scope used by metrics emitted by migration related workflows
 	MigrationWorkflowScope = "MigrationWorkflow"
+	// VisibilityMigrationWorkflowScope is scope used by metrics emitted by worker.VisibilityMigrationWorkflow
+	VisibilityMigrationWorkflowScope = "VisibilityMigrationWorkflow"
 	// ReplicatorScope is the scope used by all metric emitted by replicator
 	ReplicatorScope = "Replicator"
 	// NamespaceReplicationTaskScope is the scope used by namespace task replication processing
@@ -1272,6 +1309,7 @@ const (
 	DeleteNamespaceWorkflowScope    = "DeleteNamespaceWorkflow"
 	ReclaimResourcesWorkflowScope   = "ReclaimResourcesWorkflow"
 	DeleteExecutionsWorkflowScope   = "DeleteExecutionsWorkflow"
+	RenameNamespaceWorkflowScope    = "RenameNamespaceWorkflow"
 )
 
 // History task type
@@ -1362,6 +1400,7 @@ var (
 	TooManyPendingActivities                      = NewCounterDef("wf_too_many_pending_activities")
 	TooManyPendingCancelRequests                  = NewCounterDef("wf_too_many_pending_cancel_requests")
 	TooManyPendingSignalsToExternalWorkflows      = NewCounterDef("wf_too_many_pending_external_workflow_signals")
+	WorkflowLimitWarning                          = NewCounterDef("wf_limit_warning")
 
 	// Frontend
 	AddSearchAttributesWorkflowSuccessCount  = NewCounterDef("add_search_attributes_workflow_success")
@@ -1378,6 +1417,12 @@ var (
 	CacheFailures                                = NewCounterDef("cache_errors")
 	CacheLatency                                 = NewTimerDef("cache_latency")
 	CacheMissCounter                             = NewCounterDef("cache_miss")
+	CacheEvictions                               = NewCounterDef("cache_evictions")
+	CacheUsageBytes                              = NewGaugeDef("cache_usage_bytes")
+	CachePinnedUsageBytes                        = NewGaugeDef("cache_pinned_usage_bytes")
+	EventsCacheSpillHits                         = NewCounterDef("events_cache_spill_hit")
+	EventsCacheSpillWrites                       = NewCounterDef("events_cache_spill_writes")
+	EventsCacheSpillFailures                     = NewCounterDef("events_cache_spill_errors")
 	HistoryEventNotificationQueueingLatency      = NewTimerDef("history_event_notification_queueing_latency")
 	HistoryEventNotificationFanoutLatency        = NewTimerDef("history_event_notification_fanout_latency")
 	HistoryEventNotificationInFlightMessageGauge = NewGaugeDef("history_event_notification_inflight_message_gauge")
@@ -1514,6 +1559,7 @@ var (
 	TaskCount                                         = NewDimensionlessHistogramDef("task_count")
 	WorkflowRetryBackoffTimerCount                    = NewCounterDef("workflow_retry_backoff_timer")
 	WorkflowCronBackoffTimerCount                     = NewCounterDef("workflow_cron_backoff_timer")
+	WorkflowDelayedStartBackoffTimerCount             = NewCounterDef("workflow_delayed_start_backoff_timer")
 	WorkflowCleanupDeleteCount                        = NewCounterDef("workflow_cleanup_delete")
 	WorkflowCleanupArchiveCount                       = NewCounterDef("workflow_cleanup_archive")
 	WorkflowCleanupNopCount                           = NewCounterDef("workflow_cleanup_nop")
@@ -1524,6 +1570,12 @@ var (
 	WorkflowTimeoutCount                              = NewCounterDef("workflow_timeout")
 	WorkflowTerminateCount                            = NewCounterDef("workflow_terminate")
 	WorkflowContinuedAsNewCount                       = NewCounterDef("workflow_continued_as_new")
+	SLOTaskScheduleToStartLatency                     = NewTimerDef("slo_task_schedule_to_start_latency")
+	SLOWorkflowTaskCompleted                          = NewCounterDef("slo_workflow_task_completed")
+	SLOWorkflowTaskFailed                             = NewCounterDef("slo_workflow_task_failed")
+	SLOWorkflowEndToEndLatency                        = NewTimerDef("slo_workflow_end_to_end_latency")
+	CDCEventSendFailures                              = NewCounterDef("cdc_event_send_failures")
+	CDCEventDLQEnqueued                               = NewCounterDef("cdc_event_dlq_enqueued")
 	LastRetrievedMessageID                            = NewGaugeDef("last_retrieved_message_id")
 	LastProcessedMessageID                            = NewGaugeDef("last_processed_message_id")
 	ReplicationTasksApplied                           = NewCounterDef("replication_tasks_applied")
@@ -1564,6 +1616,7 @@ var (
 	ShardControllerLockLatency                        = NewTimerDef("shard_controller_lock_latency")
 	ShardLockLatency                                  = NewTimerDef("shard_lock_latency")
 	NamespaceRegistryLockLatency                      = NewTimerDef("namespace_registry_lock_latency")
+	NamespaceRegistryWatchRefreshCount                = NewCounterDef("namespace_registry_watch_refresh")
 	ClosedWorkflowBufferEventCount                    = NewCounterDef("closed_workflow_buffer_event_counter")
 
 	// Matching
@@ -1636,6 +1689,10 @@ var (
 	HistoryScavengerSuccessCount                              = NewCounterDef("scavenger_success")
 	HistoryScavengerErrorCount                                = NewCounterDef("scavenger_errors")
 	HistoryScavengerSkipCount                                 = NewCounterDef("scavenger_skips")
+	ArchivalVerifierSuccessCount                              = NewCounterDef("archival_verifier_success")
+	ArchivalVerifierMismatchCount                             = NewCounterDef("archival_verifier_mismatch")
+	ArchivalVerifierErrorCount                                = NewCounterDef("archival_verifier_errors")
+	ArchivalVerifierSkipCount                                 = NewCounterDef("archival_verifier_skips")
 	ExecutionsOutstandingCount                                = NewGaugeDef("executions_outstanding")
 	ArchiverNonRetryableErrorCount                            = NewCounterDef("archiver_non_retryable_error")
 	ArchiverStartedCount                                      = NewCounterDef("archiver_started")
@@ -1666,7 +1723,12 @@ var (
 	ScavengerValidationRequestsCount                          = NewCounterDef("scavenger_validation_requests")
 	ScavengerValidationFailuresCount                          = NewCounterDef("scavenger_validation_failures")
 	ScavengerValidationSkipsCount                             = NewCounterDef("scavenger_validation_skips")
+	ScavengerRepairProposedCount                              = NewCounterDef("scavenger_repairs_proposed")
+	ScavengerRepairAppliedCount                               = NewCounterDef("scavenger_repairs_applied")
+	ScavengerRepairFailuresCount                              = NewCounterDef("scavenger_repair_failures")
 	AddSearchAttributesFailuresCount                          = NewCounterDef("add_search_attributes_failures")
+	ChangeSearchAttributeTypeBackfilledCount                  = NewCounterDef("change_search_attribute_type_backfilled")
+	ChangeSearchAttributeTypeFailuresCount                    = NewCounterDef("change_search_attribute_type_failures")
 	DeleteNamespaceSuccessCount                               = NewCounterDef("delete_namespace_success")
 	RenameNamespaceSuccessCount                               = NewCounterDef("rename_namespace_success")
 	DeleteExecutionsSuccessCount                              = NewCounterDef("delete_executions_success")
@@ -1703,6 +1765,8 @@ var (
 	NamespaceReplicationEnqueueDLQCount                       = NewCounterDef("namespace_replication_dlq_enqueue_requests")
 	ParentClosePolicyProcessorSuccess                         = NewCounterDef("parent_close_policy_processor_requests")
 	ParentClosePolicyProcessorFailures                        = NewCounterDef("parent_close_policy_processor_errors")
+	VisibilityMigrationMigratedCount                          = NewCounterDef("visibility_migration_migrated")
+	VisibilityMigrationFailuresCount                          = NewCounterDef("visibility_migration_errors")
 
 	// Replication
 	NamespaceReplicationTaskAckLevelGauge = NewGaugeDef("namespace_replication_task_ack_level")
@@ -1730,4 +1794,8 @@ var (
 	VisibilityPersistenceFailures                       = NewCounterDef("visibility_persistence_errors")
 	VisibilityPersistenceResourceExhausted              = NewCounterDef("visibility_persistence_resource_exhausted")
 	VisibilityPersistenceLatency                        = NewTimerDef("visibility_persistence_latency")
+
+	// Metrics cardinality limits
+	MetricsTagValueOverflow = NewCounterDef("metrics_tag_value_overflow")
+	MetricsSeriesOverflow   = NewCounterDef("metrics_series_overflow")
 )
@@ -0,0 +1,194 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+type (
+	// SLOTagLimiter bounds the number of distinct values a tag used by the
+	// per-workflow-type and per-task-queue SLO metrics can take.
+	// Values in the namespace allowlist are always admitted. When the allowlist
+	// is empty, the first maxValues distinct values seen for a namespace are
+	// admitted. Everything else is reported as "_other_".
+	SLOTagLimiter struct {
+		allowlist func(namespace string) string
+		maxValues func(namespace string) int
+
+		sync.RWMutex
+		admitted map[string]map[string]struct{}
+	}
+
+	// SLOMetrics emits the opt-in latency and outcome metrics that are tagged by
+	// workflow type and task queue in addition to namespace.
+	SLOMetrics struct {
+		enabled       func(namespace string) bool
+		workflowTypes *SLOTagLimiter
+		taskQueues    *SLOTagLimiter
+	}
+)
+
+// NewSLOTagLimiter creates a new SLOTagLimiter. allowlist returns a comma
+// separated list of values that are always admitted for the namespace.
+func NewSLOTagLimiter(
+	allowlist func(namespace string) string,
+	maxValues func(namespace string) int,
+) *SLOTagLimiter {
+	return &SLOTagLimiter{
+		allowlist: allowlist,
+		maxValues: maxValues,
+		admitted:  make(map[string]map[string]struct{}),
+	}
+}
+
+// Value returns value if it is admitted for the namespace and "_other_" otherwise.
+func (l *SLOTagLimiter) Value(namespace string, value string) string {
+	if value == "" {
+		return unknownValue
+	}
+
+	if allowlist := l.allowlist(namespace); allowlist != "" {
+		for _, allowed := range strings.Split(allowlist, ",") {
+			if strings.TrimSpace(allowed) == value {
+				return value
+			}
+		}
+		return otherValue
+	}
+
+	l.RLock()
+	_, ok := l.admitted[namespace][value]
+	l.RUnlock()
+	if ok {
+		return value
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	values, ok := l.admitted[namespace]
+	if !ok {
+		values = make(map[string]struct{})
+		l.admitted[namespace] = values
+	}
+	if _, ok := values[value]; ok {
+		return value
+	}
+	if len(values) >= l.maxValues(namespace) {
+		return otherValue
+	}
+	values[value] = struct{}{}
+	return value
+}
+
+// NewSLOMetrics creates a new SLOMetrics.
+func NewSLOMetrics(
+	enabled func(namespace string) bool,
+	workflowTypes *SLOTagLimiter,
+	taskQueues *SLOTagLimiter,
+) *SLOMetrics {
+	return &SLOMetrics{
+		enabled:       enabled,
+		workflowTypes: workflowTypes,
+		taskQueues:    taskQueues,
+	}
+}
+
+// handler returns handler tagged with namespace, workflow type and task queue,
+// or nil if SLO metrics are disabled for the namespace.
+// Callers must pass the normal task queue of the workflow, not its sticky queue.
+func (s *SLOMetrics) handler(
+	handler Handler,
+	namespace string,
+	workflowType string,
+	taskQueue string,
+) Handler {
+	if s == nil || !s.enabled(namespace) {
+		return nil
+	}
+	return handler.WithTags(
+		NamespaceTag(namespace),
+		WorkflowTypeTag(s.workflowTypes.Value(namespace, workflowType)),
+		TaskQueueTag(s.taskQueues.Value(namespace, taskQueue)),
+	)
+}
+
+// RecordScheduleToStartLatency records the schedule to start latency of a workflow or activity task.
+func (s *SLOMetrics) RecordScheduleToStartLatency(
+	handler Handler,
+	namespace string,
+	workflowType string,
+	taskQueue string,
+	taskQueueType enumspb.TaskQueueType,
+	latency time.Duration,
+) {
+	if h := s.handler(handler, namespace, workflowType, taskQueue); h != nil {
+		h.Timer(SLOTaskScheduleToStartLatency.GetMetricName()).Record(latency, TaskQueueTypeTag(taskQueueType))
+	}
+}
+
+// RecordWorkflowTaskCompleted counts a workflow task that completed successfully.
+func (s *SLOMetrics) RecordWorkflowTaskCompleted(
+	handler Handler,
+	namespace string,
+	workflowType string,
+	taskQueue string,
+) {
+	if h := s.handler(handler, namespace, workflowType, taskQueue); h != nil {
+		h.Counter(SLOWorkflowTaskCompleted.GetMetricName()).Record(1)
+	}
+}
+
+// RecordWorkflowTaskFailed counts a workflow task that failed or timed out.
+// cause is the workflow task failed cause or the timeout type.
+func (s *SLOMetrics) RecordWorkflowTaskFailed(
+	handler Handler,
+	namespace string,
+	workflowType string,
+	taskQueue string,
+	cause string,
+) {
+	if h := s.handler(handler, namespace, workflowType, taskQueue); h != nil {
+		h.Counter(SLOWorkflowTaskFailed.GetMetricName()).Record(1, FailureTag(cause))
+	}
+}
+
+// RecordWorkflowEndToEndLatency records the time between workflow start and close.
+func (s *SLOMetrics) RecordWorkflowEndToEndLatency(
+	handler Handler,
+	namespace string,
+	workflowType string,
+	taskQueue string,
+	status enumspb.WorkflowExecutionStatus,
+	latency time.Duration,
+) {
+	if h := s.handler(handler, namespace, workflowType, taskQueue); h != nil {
+		h.Timer(SLOWorkflowEndToEndLatency.GetMetricName()).Record(latency, WorkflowStatusTag(status))
+	}
+}
@@ -0,0 +1,95 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally/v4"
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+func TestSLOTagLimiter_MaxValues(t *testing.T) {
+	limiter := NewSLOTagLimiter(
+		func(string) string { return "" },
+		func(string) int { return 2 },
+	)
+
+	assert.Equal(t, "a", limiter.Value("ns1", "a"))
+	assert.Equal(t, "b", limiter.Value("ns1", "b"))
+	assert.Equal(t, otherValue, limiter.Value("ns1", "c"))
+	assert.Equal(t, "a", limiter.Value("ns1", "a"))
+	assert.Equal(t, "c", limiter.Value("ns2", "c"))
+	assert.Equal(t, unknownValue, limiter.Value("ns1", ""))
+}
+
+func TestSLOTagLimiter_Allowlist(t *testing.T) {
+	limiter := NewSLOTagLimiter(
+		func(namespace string) string {
+			if namespace == "ns1" {
+				return "a, b"
+			}
+			return ""
+		},
+		func(string) int { return 0 },
+	)
+
+	assert.Equal(t, "a", limiter.Value("ns1", "a"))
+	assert.Equal(t, "b", limiter.Value("ns1", "b"))
+	assert.Equal(t, otherValue, limiter.Value("ns1", "c"))
+	assert.Equal(t, otherValue, limiter.Value("ns2", "a"))
+}
+
+func TestSLOMetrics(t *testing.T) {
+	scope := tally.NewTestScope("test", map[string]string{})
+	handler := NewTallyMetricsHandler(ClientConfig{}, scope)
+	sloMetrics := NewSLOMetrics(
+		func(namespace string) bool { return namespace == "enabled" },
+		NewSLOTagLimiter(func(string) string { return "" }, func(string) int { return 1 }),
+		NewSLOTagLimiter(func(string) string { return "tq" }, func(string) int { return 0 }),
+	)
+
+	sloMetrics.RecordWorkflowTaskCompleted(handler, "enabled", "wf1", "tq")
+	sloMetrics.RecordWorkflowTaskCompleted(handler, "enabled", "wf2", "tq")
+	sloMetrics.RecordWorkflowTaskCompleted(handler, "enabled", "wf1", "other-tq")
+	sloMetrics.RecordWorkflowTaskCompleted(handler, "disabled", "wf1", "tq")
+	sloMetrics.RecordScheduleToStartLatency(handler, "enabled", "wf1", "tq", enumspb.TASK_QUEUE_TYPE_ACTIVITY, time.Second)
+
+	// nil SLOMetrics is a no-op
+	var nilSLOMetrics *SLOMetrics
+	nilSLOMetrics.RecordWorkflowTaskCompleted(handler, "enabled", "wf1", "tq")
+
+	counters := scope.Snapshot().Counters()
+	assert.Len(t, counters, 3)
+	assert.EqualValues(t, 1, counters["test.slo_workflow_task_completed+namespace=enabled,taskqueue=tq,workflowType=wf1"].Value())
+	assert.EqualValues(t, 1, counters["test.slo_workflow_task_completed+namespace=enabled,taskqueue=tq,workflowType=_other_"].Value())
+	assert.EqualValues(t, 1, counters["test.slo_workflow_task_completed+namespace=enabled,taskqueue=_other_,workflowType=wf1"].Value())
+
+	timers := scope.Snapshot().Timers()
+	assert.Len(t, timers, 1)
+	assert.Equal(t, []time.Duration{time.Second}, timers["test.slo_task_schedule_to_start_latency+namespace=enabled,task_type=Activity,taskqueue=tq,workflowType=wf1"].Values())
+}
@@ -51,9 +51,13 @@ const (
 	commandType    = "commandType"
 	serviceName    = "service_name"
 	actionType     = "action_type"
+	workflowStatus = "workflow_status"
+	limitName      = "limit"
 
 	namespaceAllValue = "all"
 	unknownValue      = "_unknown_"
+	// otherValue replaces tag values rejected by a limit on the number of distinct values
+	otherValue        = "_other_"
 	totalMetricSuffix = "_total"
 	tagExcludedValue  = "_tag_excluded_"
 
@@ -155,6 +159,16 @@ func WorkflowTypeTag(value string) Tag {
 	return &tagImpl{key: workflowType, value: value}
 }
 
+// LimitTag returns a new tag with the name of a per workflow limit.
+func LimitTag(value string) Tag {
+	return &tagImpl{key: limitName, value: value}
+}
+
+// WorkflowStatusTag returns a new workflow status tag.
+func WorkflowStatusTag(status enumspb.WorkflowExecutionStatus) Tag {
+	return &tagImpl{key: workflowStatus, value: status.String()}
+}
+
 // ActivityTypeTag returns a new activity type tag.
 func ActivityTypeTag(value string) Tag {
 	if len(value) == 0 {
@@ -187,6 +201,14 @@ func FailureTag(value string) Tag {
 	return &tagImpl{key: FailureTagName, value: value}
 }
 
+// Returns a new executions scanner repair action tag
+func RepairActionTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return &tagImpl{key: RepairActionTagName, value: value}
+}
+
 func TaskCategoryTag(value string) Tag {
 	if len(value) == 0 {
 		value = unknownValue
@@ -278,3 +300,7 @@ func StringTag(key string, value string) Tag {
 func CacheTypeTag(value string) Tag {
 	return &tagImpl{key: CacheTypeTagName, value: value}
 }
+
+func CacheEvictionReasonTag(value string) Tag {
+	return &tagImpl{key: CacheEvictionReasonTagName, value: value}
+}
@@ -38,4 +38,21 @@ const (
 
 	// MaxBadBinaries is the maximal number of bad client binaries stored in a namespace
 	MaxBadBinaries = 10
+
+	// AliasesDataKey is the key in the namespace data map under which the previous
+	// names of a renamed namespace are stored as a comma separated list. The registry
+	// resolves these names to the renamed namespace.
+	AliasesDataKey = "__temporal_namespace_aliases"
+
+	// PreviousNamesDataKey is the key in the namespace data map under which all previous
+	// names of a renamed namespace are stored, in the same format as aliases. Unlike aliases,
+	// they are not resolved by the registry, but are used to look up configuration which is
+	// keyed by namespace name, e.g. by a custom search attributes mapper.
+	PreviousNamesDataKey = "__temporal_namespace_previous_names"
+
+	// ChangedSearchAttributesDataKey is the key in the namespace data map under which the custom
+	// search attributes whose type was changed are stored as a comma separated list. Each of them
+	// is an alias of the field of the new type. Elasticsearch namespaces otherwise use field names
+	// as is, and resolve only these search attributes through the namespace aliases.
+	ChangedSearchAttributesDataKey = "__temporal_changed_search_attributes"
 )
@@ -26,6 +26,7 @@ package namespace
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -169,6 +170,21 @@ func (ns *Namespace) Name() Name {
 	return Name(ns.info.Name)
 }
 
+// Aliases returns the previous names of this namespace which still resolve to it.
+func (ns *Namespace) Aliases() []Name {
+	return ParseAliases(ns.info.Data[AliasesDataKey])
+}
+
+// PreviousNames returns all previous names of this namespace, whether they are kept as aliases or not.
+func (ns *Namespace) PreviousNames() []Name {
+	return ParseAliases(ns.info.Data[PreviousNamesDataKey])
+}
+
+// ChangedSearchAttributes returns the custom search attributes of this namespace whose type was changed.
+func (ns *Namespace) ChangedSearchAttributes() []string {
+	return ParseChangedSearchAttributes(ns.info.Data[ChangedSearchAttributesDataKey])
+}
+
 func (ns *Namespace) State() enumspb.NamespaceState {
 	return ns.info.State
 }
@@ -333,3 +349,40 @@ func (m *CustomSearchAttributesMapper) GetFieldName(alias string, namespace stri
 func (m *CustomSearchAttributesMapper) FieldToAliasMap() map[string]string {
 	return maps.Clone(m.fieldToAlias)
 }
+
+// ParseAliases parses the namespace aliases stored in the namespace data map.
+func ParseAliases(value string) []Name {
+	if value == "" {
+		return nil
+	}
+	var aliases []Name
+	for _, alias := range strings.Split(value, ",") {
+		if alias = strings.TrimSpace(alias); alias != "" {
+			aliases = append(aliases, Name(alias))
+		}
+	}
+	return aliases
+}
+
+// ParseChangedSearchAttributes parses the changed search attributes stored in the namespace data map.
+func ParseChangedSearchAttributes(value string) []string {
+	if value == "" {
+		return nil
+	}
+	var searchAttributes []string
+	for _, searchAttribute := range strings.Split(value, ",") {
+		if searchAttribute = strings.TrimSpace(searchAttribute); searchAttribute != "" {
+			searchAttributes = append(searchAttributes, searchAttribute)
+		}
+	}
+	return searchAttributes
+}
+
+// FormatAliases formats namespace aliases to be stored in the namespace data map.
+func FormatAliases(aliases []Name) string {
+	values := make([]string, len(aliases))
+	for i, alias := range aliases {
+		values[i] = alias.String()
+	}
+	return strings.Join(values, ",")
+}
@@ -0,0 +1,332 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../../LICENSE -package $GOPACKAGE -source $GOFILE -destination checker_mock.go
+
+package nsquota
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/worker/scheduler"
+)
+
+const (
+	// Keys of the namespace usage which DescribeNamespace adds to the namespace data.
+	OpenWorkflowsDataKey    = "__temporal_usage_open_workflows"
+	HistorySizeBytesDataKey = "__temporal_usage_history_size_bytes"
+	SchedulesDataKey        = "__temporal_usage_schedules"
+)
+
+var (
+	openWorkflowsQuery   = fmt.Sprintf("%s = '%s'", searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String())
+	closedWorkflowsQuery = fmt.Sprintf("%s != '%s'", searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String())
+	schedulesQuery       = fmt.Sprintf("%s = '%s' AND %s", searchattribute.TemporalNamespaceDivision, scheduler.NamespaceDivision, openWorkflowsQuery)
+)
+
+type (
+	// Usage is the resource usage of a namespace as seen by visibility.
+	// HistorySizeBytes is summed over closed workflows only, as visibility records the history
+	// size of a workflow when it closes.
+	Usage struct {
+		OpenWorkflows    int64
+		HistorySizeBytes int64
+		Schedules        int64
+		// UpdateTime is the time the usage was read from visibility.
+		UpdateTime time.Time
+	}
+
+	usageEntry struct {
+		usage *Usage
+		// err is the error of the last read if it failed, it is cached as long as a usage would be.
+		err     error
+		errTime time.Time
+		refresh *usageRefresh
+	}
+
+	// usageRefresh is a read of the usage from visibility which is in progress. Concurrent
+	// callers for the same namespace wait for it instead of reading visibility again.
+	usageRefresh struct {
+		done  chan struct{}
+		usage *Usage
+		err   error
+	}
+
+	// Config holds the namespace quotas. A quota of 0 means unlimited.
+	Config struct {
+		MaxOpenWorkflows     dynamicconfig.IntPropertyFnWithNamespaceFilter
+		MaxHistorySizeBytes  dynamicconfig.IntPropertyFnWithNamespaceFilter
+		MaxSchedules         dynamicconfig.IntPropertyFnWithNamespaceFilter
+		UsageRefreshInterval dynamicconfig.DurationPropertyFn
+	}
+
+	// Checker enforces namespace level quotas. Usage is read from visibility and cached for
+	// UsageRefreshInterval, so quotas are soft: they can be exceeded by the number of
+	// workflows started within one refresh interval. If visibility can't be read, the last
+	// usage which was read is used, and visibility is not read again until UsageRefreshInterval
+	// has passed.
+	Checker interface {
+		// CheckStartWorkflow returns a ResourceExhausted error if no new workflow can be started in the namespace.
+		CheckStartWorkflow(ctx context.Context, nsName namespace.Name, nsID namespace.ID) error
+		// CheckCreateSchedule returns a ResourceExhausted error if no new schedule can be created in the namespace.
+		CheckCreateSchedule(ctx context.Context, nsName namespace.Name, nsID namespace.ID) error
+		// GetUsage returns the (cached) usage of the namespace. Failed reads are cached as well.
+		GetUsage(ctx context.Context, nsName namespace.Name, nsID namespace.ID) (*Usage, error)
+	}
+
+	checkerImpl struct {
+		config        *Config
+		visibilityMgr manager.VisibilityManager
+		timeSource    clock.TimeSource
+		logger        log.Logger
+
+		sync.Mutex
+		usages map[namespace.ID]usageEntry
+	}
+)
+
+var _ Checker = (*checkerImpl)(nil)
+
+// NewConfig reads the namespace quotas from dynamic config
+func NewConfig(dc *dynamicconfig.Collection) *Config {
+	return &Config{
+		MaxOpenWorkflows:     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.NamespaceMaxOpenWorkflows, 0),
+		MaxHistorySizeBytes:  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.NamespaceMaxHistorySizeBytes, 0),
+		MaxSchedules:         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.NamespaceMaxSchedules, 0),
+		UsageRefreshInterval: dc.GetDurationProperty(dynamicconfig.NamespaceUsageRefreshInterval, 30*time.Second),
+	}
+}
+
+// NewChecker creates a new namespace quota checker
+func NewChecker(
+	config *Config,
+	visibilityMgr manager.VisibilityManager,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) *checkerImpl {
+	return &checkerImpl{
+		config:        config,
+		visibilityMgr: visibilityMgr,
+		timeSource:    timeSource,
+		logger:        logger,
+		usages:        make(map[namespace.ID]usageEntry),
+	}
+}
+
+// Enabled returns true if any quota is set for the namespace
+func (c *Config) Enabled(nsName namespace.Name) bool {
+	return c.MaxOpenWorkflows(nsName.String()) > 0 ||
+		c.MaxHistorySizeBytes(nsName.String()) > 0 ||
+		c.MaxSchedules(nsName.String()) > 0
+}
+
+// Data returns the usage as namespace data entries
+func (u *Usage) Data() map[string]string {
+	return map[string]string{
+		OpenWorkflowsDataKey:    strconv.FormatInt(u.OpenWorkflows, 10),
+		HistorySizeBytesDataKey: strconv.FormatInt(u.HistorySizeBytes, 10),
+		SchedulesDataKey:        strconv.FormatInt(u.Schedules, 10),
+	}
+}
+
+func (c *checkerImpl) CheckStartWorkflow(
+	ctx context.Context,
+	nsName namespace.Name,
+	nsID namespace.ID,
+) error {
+	maxOpenWorkflows := int64(c.config.MaxOpenWorkflows(nsName.String()))
+	maxHistorySizeBytes := int64(c.config.MaxHistorySizeBytes(nsName.String()))
+	if maxOpenWorkflows <= 0 && maxHistorySizeBytes <= 0 {
+		return nil
+	}
+
+	usage, err := c.GetUsage(ctx, nsName, nsID)
+	if err != nil {
+		// quotas are best effort, don't block workflows if visibility is unavailable
+		return nil
+	}
+	if maxOpenWorkflows > 0 && usage.OpenWorkflows >= maxOpenWorkflows {
+		return serviceerror.NewResourceExhausted(
+			enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT,
+			fmt.Sprintf("Namespace %s reached its quota of %d open workflows.", nsName, maxOpenWorkflows),
+		)
+	}
+	if maxHistorySizeBytes > 0 && usage.HistorySizeBytes >= maxHistorySizeBytes {
+		return serviceerror.NewResourceExhausted(
+			enumspb.RESOURCE_EXHAUSTED_CAUSE_PERSISTENCE_LIMIT,
+			fmt.Sprintf("Namespace %s reached its quota of %d history bytes.", nsName, maxHistorySizeBytes),
+		)
+	}
+	return nil
+}
+
+func (c *checkerImpl) CheckCreateSchedule(
+	ctx context.Context,
+	nsName namespace.Name,
+	nsID namespace.ID,
+) error {
+	maxSchedules := int64(c.config.MaxSchedules(nsName.String()))
+	if maxSchedules <= 0 {
+		return nil
+	}
+
+	usage, err := c.GetUsage(ctx, nsName, nsID)
+	if err != nil {
+		return nil
+	}
+	if usage.Schedules >= maxSchedules {
+		return serviceerror.NewResourceExhausted(
+			enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT,
+			fmt.Sprintf("Namespace %s reached its quota of %d schedules.", nsName, maxSchedules),
+		)
+	}
+	return nil
+}
+
+func (c *checkerImpl) GetUsage(
+	ctx context.Context,
+	nsName namespace.Name,
+	nsID namespace.ID,
+) (*Usage, error) {
+	c.Lock()
+	entry := c.usages[nsID]
+	now := c.timeSource.Now()
+	refreshInterval := c.config.UsageRefreshInterval()
+	if entry.usage != nil && now.Sub(entry.usage.UpdateTime) < refreshInterval {
+		c.Unlock()
+		return entry.usage, nil
+	}
+	if entry.err != nil && now.Sub(entry.errTime) < refreshInterval {
+		c.Unlock()
+		if entry.usage != nil {
+			return entry.usage, nil
+		}
+		return nil, entry.err
+	}
+	refresh := entry.refresh
+	if refresh == nil {
+		refresh = &usageRefresh{done: make(chan struct{})}
+		entry.refresh = refresh
+		c.usages[nsID] = entry
+		c.Unlock()
+		c.refreshUsage(ctx, nsName, nsID, refresh)
+	} else {
+		c.Unlock()
+	}
+
+	select {
+	case <-refresh.done:
+		return refresh.usage, refresh.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *checkerImpl) refreshUsage(
+	ctx context.Context,
+	nsName namespace.Name,
+	nsID namespace.ID,
+	refresh *usageRefresh,
+) {
+	usage, err := c.readUsage(ctx, nsName, nsID)
+
+	c.Lock()
+	entry := c.usages[nsID]
+	entry.refresh = nil
+	switch {
+	case err == nil:
+		usage.UpdateTime = c.timeSource.Now()
+		entry.usage = usage
+		entry.err = nil
+	case ctx.Err() != nil:
+		// the read was abandoned by the caller, it says nothing about visibility
+	default:
+		// only log the first failure, later ones are expected until visibility is back
+		if entry.err == nil {
+			c.logger.Warn("Unable to read namespace usage from visibility, the last usage read is used if any, otherwise namespace quotas are not enforced.",
+				tag.WorkflowNamespace(nsName.String()), tag.Error(err))
+		}
+		entry.err = err
+		entry.errTime = c.timeSource.Now()
+	}
+	if err != nil && entry.usage != nil {
+		usage, err = entry.usage, nil
+	}
+	c.usages[nsID] = entry
+	c.Unlock()
+
+	refresh.usage, refresh.err = usage, err
+	close(refresh.done)
+}
+
+func (c *checkerImpl) readUsage(
+	ctx context.Context,
+	nsName namespace.Name,
+	nsID namespace.ID,
+) (*Usage, error) {
+	openWorkflows, err := c.visibilityMgr.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: nsID,
+		Namespace:   nsName,
+		Query:       openWorkflowsQuery,
+	})
+	if err != nil {
+		return nil, err
+	}
+	schedules, err := c.visibilityMgr.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: nsID,
+		Namespace:   nsName,
+		Query:       schedulesQuery,
+	})
+	if err != nil {
+		return nil, err
+	}
+	historySize, err := c.visibilityMgr.SumHistorySizeBytes(ctx, &manager.SumHistorySizeBytesRequest{
+		NamespaceID: nsID,
+		Namespace:   nsName,
+		Query:       closedWorkflowsQuery,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Usage{
+		OpenWorkflows:    openWorkflows.Count,
+		HistorySizeBytes: historySize.HistorySizeBytes,
+		Schedules:        schedules.Count,
+	}, nil
+}
@@ -0,0 +1,103 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: checker.go
+
+// Package nsquota is a generated GoMock package.
+package nsquota
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	namespace "go.temporal.io/server/common/namespace"
+)
+
+// MockChecker is a mock of Checker interface.
+type MockChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockCheckerMockRecorder
+}
+
+// MockCheckerMockRecorder is the mock recorder for MockChecker.
+type MockCheckerMockRecorder struct {
+	mock *MockChecker
+}
+
+// NewMockChecker creates a new mock instance.
+func NewMockChecker(ctrl *gomock.Controller) *MockChecker {
+	mock := &MockChecker{ctrl: ctrl}
+	mock.recorder = &MockCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChecker) EXPECT() *MockCheckerMockRecorder {
+	return m.recorder
+}
+
+// CheckCreateSchedule mocks base method.
+func (m *MockChecker) CheckCreateSchedule(ctx context.Context, nsName namespace.Name, nsID namespace.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckCreateSchedule", ctx, nsName, nsID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckCreateSchedule indicates an expected call of CheckCreateSchedule.
+func (mr *MockCheckerMockRecorder) CheckCreateSchedule(ctx, nsName, nsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckCreateSchedule", reflect.TypeOf((*MockChecker)(nil).CheckCreateSchedule), ctx, nsName, nsID)
+}
+
+// CheckStartWorkflow mocks base method.
+func (m *MockChecker) CheckStartWorkflow(ctx context.Context, nsName namespace.Name, nsID namespace.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckStartWorkflow", ctx, nsName, nsID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckStartWorkflow indicates an expected call of CheckStartWorkflow.
+func (mr *MockCheckerMockRecorder) CheckStartWorkflow(ctx, nsName, nsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckStartWorkflow", reflect.TypeOf((*MockChecker)(nil).CheckStartWorkflow), ctx, nsName, nsID)
+}
+
+// GetUsage mocks base method.
+func (m *MockChecker) GetUsage(ctx context.Context, nsName namespace.Name, nsID namespace.ID) (*Usage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsage", ctx, nsName, nsID)
+	ret0, _ := ret[0].(*Usage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsage indicates an expected call of GetUsage.
+func (mr *MockCheckerMockRecorder) GetUsage(ctx, nsName, nsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsage", reflect.TypeOf((*MockChecker)(nil).GetUsage), ctx, nsName, nsID)
+}
@@ -0,0 +1,196 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nsquota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store"
+)
+
+const (
+	testNamespace   = namespace.Name("test-namespace")
+	testNamespaceID = namespace.ID("test-namespace-id")
+)
+
+type (
+	checkerSuite struct {
+		suite.Suite
+		controller *gomock.Controller
+
+		mockVisibilityMgr *manager.MockVisibilityManager
+		timeSource        *clock.EventTimeSource
+		config            *Config
+
+		checker *checkerImpl
+	}
+)
+
+func TestCheckerSuite(t *testing.T) {
+	suite.Run(t, new(checkerSuite))
+}
+
+func (s *checkerSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockVisibilityMgr = manager.NewMockVisibilityManager(s.controller)
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+	s.config = &Config{
+		MaxOpenWorkflows:     dynamicconfig.GetIntPropertyFilteredByNamespace(10),
+		MaxHistorySizeBytes:  dynamicconfig.GetIntPropertyFilteredByNamespace(0),
+		MaxSchedules:         dynamicconfig.GetIntPropertyFilteredByNamespace(2),
+		UsageRefreshInterval: dynamicconfig.GetDurationPropertyFn(time.Minute),
+	}
+	s.checker = NewChecker(s.config, s.mockVisibilityMgr, s.timeSource, log.NewTestLogger())
+}
+
+func (s *checkerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *checkerSuite) TestCheckStartWorkflow_Unlimited() {
+	s.config.MaxOpenWorkflows = dynamicconfig.GetIntPropertyFilteredByNamespace(0)
+
+	s.NoError(s.checker.CheckStartWorkflow(context.Background(), testNamespace, testNamespaceID))
+}
+
+func (s *checkerSuite) TestCheckStartWorkflow_OpenWorkflows() {
+	s.mockUsage(9, 100, 0)
+	s.NoError(s.checker.CheckStartWorkflow(context.Background(), testNamespace, testNamespaceID))
+
+	// usage is cached until the refresh interval has passed
+	s.timeSource.Update(s.timeSource.Now().Add(30 * time.Second))
+	s.NoError(s.checker.CheckStartWorkflow(context.Background(), testNamespace, testNamespaceID))
+
+	s.timeSource.Update(s.timeSource.Now().Add(time.Minute))
+	s.mockUsage(10, 100, 0)
+	err := s.checker.CheckStartWorkflow(context.Background(), testNamespace, testNamespaceID)
+	var resourceExhausted *serviceerror.ResourceExhausted
+	s.ErrorAs(err, &resourceExhausted)
+	s.Equal(enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT, resourceExhausted.Cause)
+}
+
+func (s *checkerSuite) TestCheckStartWorkflow_HistorySize() {
+	s.config.MaxHistorySizeBytes = dynamicconfig.GetIntPropertyFilteredByNamespace(1024)
+
+	s.mockUsage(1, 2048, 0)
+	err := s.checker.CheckStartWorkflow(context.Background(), testNamespace, testNamespaceID)
+	var resourceExhausted *serviceerror.ResourceExhausted
+	s.ErrorAs(err, &resourceExhausted)
+	s.Equal(enumspb.RESOURCE_EXHAUSTED_CAUSE_PERSISTENCE_LIMIT, resourceExhausted.Cause)
+}
+
+func (s *checkerSuite) TestCheckCreateSchedule() {
+	s.mockUsage(0, 0, 2)
+	err := s.checker.CheckCreateSchedule(context.Background(), testNamespace, testNamespaceID)
+	var resourceExhausted *serviceerror.ResourceExhausted
+	s.ErrorAs(err, &resourceExhausted)
+}
+
+func (s *checkerSuite) TestCheckStartWorkflow_VisibilityNotSupported() {
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(nil, store.OperationNotSupportedErr).Times(1)
+
+	s.NoError(s.checker.CheckStartWorkflow(context.Background(), testNamespace, testNamespaceID))
+	// errors are cached until the refresh interval has passed
+	s.NoError(s.checker.CheckStartWorkflow(context.Background(), testNamespace, testNamespaceID))
+	_, err := s.checker.GetUsage(context.Background(), testNamespace, testNamespaceID)
+	s.ErrorIs(err, store.OperationNotSupportedErr)
+
+	s.timeSource.Update(s.timeSource.Now().Add(time.Minute))
+	s.mockUsage(10, 100, 0)
+	s.Error(s.checker.CheckStartWorkflow(context.Background(), testNamespace, testNamespaceID))
+}
+
+func (s *checkerSuite) TestGetUsage_LastUsageOnError() {
+	s.mockUsage(10, 100, 0)
+	usage, err := s.checker.GetUsage(context.Background(), testNamespace, testNamespaceID)
+	s.NoError(err)
+
+	s.timeSource.Update(s.timeSource.Now().Add(2 * time.Minute))
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewUnavailable("visibility unavailable"))
+	lastUsage, err := s.checker.GetUsage(context.Background(), testNamespace, testNamespaceID)
+	s.NoError(err)
+	s.Equal(usage, lastUsage)
+	// the quota is still enforced, without reading visibility again
+	s.Error(s.checker.CheckStartWorkflow(context.Background(), testNamespace, testNamespaceID))
+}
+
+func (s *checkerSuite) TestGetUsage_ConcurrentRefresh() {
+	readStarted := make(chan struct{})
+	readDone := make(chan struct{})
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *manager.CountWorkflowExecutionsRequest) (*manager.CountWorkflowExecutionsResponse, error) {
+			close(readStarted)
+			<-readDone
+			return nil, store.OperationNotSupportedErr
+		},
+	).Times(1)
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := s.checker.GetUsage(context.Background(), testNamespace, testNamespaceID)
+		errCh <- err
+	}()
+	<-readStarted
+
+	// a concurrent caller waits for the read in progress instead of reading visibility again
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := s.checker.GetUsage(ctx, testNamespace, testNamespaceID)
+	s.ErrorIs(err, context.Canceled)
+
+	close(readDone)
+	s.ErrorIs(<-errCh, store.OperationNotSupportedErr)
+}
+
+func (s *checkerSuite) mockUsage(openWorkflows int64, historySizeBytes int64, schedules int64) {
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       openWorkflowsQuery,
+	}).Return(&manager.CountWorkflowExecutionsResponse{Count: openWorkflows}, nil)
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       schedulesQuery,
+	}).Return(&manager.CountWorkflowExecutionsResponse{Count: schedules}, nil)
+	s.mockVisibilityMgr.EXPECT().SumHistorySizeBytes(gomock.Any(), &manager.SumHistorySizeBytesRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       closedWorkflowsQuery,
+	}).Return(
+		&manager.SumHistorySizeBytesResponse{HistorySizeBytes: historySizeBytes}, nil)
+}
@@ -146,6 +146,7 @@ type (
 	registry struct {
 		status                  int32
 		refresher               *goro.Handle
+		watcher                 *goro.Handle
 		triggerRefreshCh        chan chan struct{}
 		persistence             Persistence
 		globalNamespacesEnabled bool
@@ -153,6 +154,11 @@ type (
 		metricsHandler          metrics.Handler
 		logger                  log.Logger
 		refreshInterval         dynamicconfig.DurationPropertyFn
+		watchInterval           dynamicconfig.DurationPropertyFn
+
+		// notificationVersion is the last metadata notification version seen by the watcher,
+		// or -1 if the watcher has not read it yet. Only accessed by Start and the watcher.
+		notificationVersion int64
 
 		// cacheLock protects cachNameToID, cacheByID and stateChangeCallbacks.
 		// If the exclusive side is to be held at the same time as the
@@ -170,6 +176,7 @@ func NewRegistry(
 	persistence Persistence,
 	enableGlobalNamespaces bool,
 	refreshInterval dynamicconfig.DurationPropertyFn,
+	watchInterval dynamicconfig.DurationPropertyFn,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) Registry {
@@ -183,6 +190,8 @@ func NewRegistry(
 		cacheNameToID:           cache.New(cacheMaxSize, &cacheOpts),
 		cacheByID:               cache.New(cacheMaxSize, &cacheOpts),
 		refreshInterval:         refreshInterval,
+		watchInterval:           watchInterval,
+		notificationVersion:     -1,
 		stateChangeCallbacks:    make(map[any]StateChangeCallbackFn),
 	}
 	return reg
@@ -209,11 +218,21 @@ func (r *registry) Start() {
 		headers.SystemBackgroundCallerInfo,
 	)
 
+	if r.watchInterval() > 0 {
+		// read the notification version before the initial scan, so that any namespace
+		// mutation racing with the scan will be picked up by the watcher
+		metadata, err := r.persistence.GetMetadata(ctx)
+		if err != nil {
+			r.logger.Fatal("Unable to read namespace notification version", tag.Error(err))
+		}
+		r.notificationVersion = metadata.NotificationVersion
+	}
 	err := r.refreshNamespaces(ctx)
 	if err != nil {
 		r.logger.Fatal("Unable to initialize namespace cache", tag.Error(err))
 	}
 	r.refresher = goro.NewHandle(ctx).Go(r.refreshLoop)
+	r.watcher = goro.NewHandle(ctx).Go(r.watchLoop)
 }
 
 // Stop the background refresh of Namespace data
@@ -223,7 +242,9 @@ func (r *registry) Stop() {
 	}
 	defer atomic.StoreInt32(&r.status, stopped)
 	r.refresher.Cancel()
+	r.watcher.Cancel()
 	<-r.refresher.Done()
+	<-r.watcher.Done()
 }
 
 func (r *registry) GetPingChecks() []common.PingCheck {
@@ -282,7 +303,8 @@ func (r *registry) UnregisterStateChangeCallback(key any) {
 }
 
 // GetNamespace retrieves the information from the cache if it exists, otherwise retrieves the information from metadata
-// store and writes it to the cache with an expiry before returning back
+// store and writes it to the cache with an expiry before returning back.
+// The name may also be an alias of a renamed namespace, in which case the renamed namespace is returned.
 func (r *registry) GetNamespace(name Name) (*Namespace, error) {
 	if name == "" {
 		return nil, serviceerror.NewInvalidArgument("Namespace is empty.")
@@ -374,6 +396,46 @@ func (r *registry) refreshLoop(ctx context.Context) error {
 	}
 }
 
+// watchLoop polls the namespace notification version, which is bumped by every namespace
+// mutation, and triggers a refresh as soon as it changes. This propagates failovers and
+// other namespace updates to all hosts within watchInterval, while the much more expensive
+// full scan in refreshLoop remains as a fallback.
+func (r *registry) watchLoop(ctx context.Context) error {
+	for {
+		interval := r.watchInterval()
+		if interval <= 0 {
+			// watching is disabled, check again later in case it gets enabled
+			interval = r.refreshInterval()
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+		if r.watchInterval() <= 0 {
+			continue
+		}
+
+		metadata, err := r.persistence.GetMetadata(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				r.logger.Warn("Error reading namespace notification version", tag.Error(err))
+			}
+			continue
+		}
+		if metadata.NotificationVersion == r.notificationVersion {
+			continue
+		}
+		r.notificationVersion = metadata.NotificationVersion
+		r.metricsHandler.Counter(metrics.NamespaceRegistryWatchRefreshCount.GetMetricName()).Record(1)
+		select {
+		case r.triggerRefreshCh <- nil:
+		default:
+			// a refresh is already pending
+		}
+	}
+}
+
 func (r *registry) refreshNamespaces(ctx context.Context) error {
 	request := &persistence.ListNamespacesRequest{
 		PageSize:       CacheRefreshPageSize,
@@ -398,6 +460,8 @@ func (r *registry) refreshNamespaces(ctx context.Context) error {
 	}
 
 	// Make a copy of the existing namespace cache (excluding deleted), so we can calculate diff and do "compare and swap".
+	// The name cache is rebuilt from the database, so that names of renamed namespaces stop resolving
+	// unless they are kept as aliases.
 	newCacheNameToID := cache.New(cacheMaxSize, &cacheOpts)
 	newCacheByID := cache.New(cacheMaxSize, &cacheOpts)
 	var deletedEntries []*Namespace
@@ -406,10 +470,16 @@ func (r *registry) refreshNamespaces(ctx context.Context) error {
 			deletedEntries = append(deletedEntries, namespace)
 			continue
 		}
-		newCacheNameToID.Put(Name(namespace.info.Name), ID(namespace.info.Id))
 		newCacheByID.Put(ID(namespace.info.Id), namespace)
 	}
 
+	// aliases are added first so that they never shadow the actual name of a namespace
+	for _, namespace := range namespacesDb {
+		for _, alias := range namespace.Aliases() {
+			newCacheNameToID.Put(alias, namespace.ID())
+		}
+	}
+
 	var stateChanged []*Namespace
 	for _, namespace := range namespacesDb {
 		oldNS := r.updateIDToNamespaceCache(newCacheByID, namespace.ID(), namespace)
@@ -25,7 +25,9 @@
 package namespace_test
 
 import (
+	"context"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -74,6 +76,7 @@ func (s *registrySuite) SetupTest() {
 		s.regPersistence,
 		true,
 		dynamicconfig.GetDurationPropertyFn(time.Second),
+		dynamicconfig.GetDurationPropertyFn(0),
 		metrics.NoopMetricsHandler,
 		log.NewTestLogger())
 }
@@ -626,3 +629,105 @@ func (s *registrySuite) TestCacheByName() {
 	s.NoError(err)
 	s.Equal(namespace.Name("foo"), ns.Name())
 }
+
+func (s *registrySuite) TestCacheByAlias() {
+	nsrec := persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   namespace.NewID().String(),
+				Name: "foo",
+				Data: map[string]string{namespace.AliasesDataKey: "bar,baz"},
+			},
+			Config:            &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+		},
+	}
+	// "baz" is an alias of "foo", but also the actual name of another namespace
+	otherNsrec := persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   namespace.NewID().String(),
+				Name: "baz",
+			},
+			Config:            &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+		},
+	}
+
+	s.regPersistence.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).Return(&persistence.ListNamespacesResponse{
+		Namespaces: []*persistence.GetNamespaceResponse{&nsrec, &otherNsrec},
+	}, nil)
+
+	s.registry.Start()
+	defer s.registry.Stop()
+
+	ns, err := s.registry.GetNamespace(namespace.Name("bar"))
+	s.NoError(err)
+	s.Equal(namespace.Name("foo"), ns.Name())
+	s.Equal([]namespace.Name{"bar", "baz"}, ns.Aliases())
+
+	ns, err = s.registry.GetNamespace(namespace.Name("baz"))
+	s.NoError(err)
+	s.Equal(namespace.ID(otherNsrec.Namespace.Info.Id), ns.ID())
+}
+
+func (s *registrySuite) TestWatchNotificationVersion_TriggerRefresh() {
+	registry := namespace.NewRegistry(
+		s.regPersistence,
+		true,
+		dynamicconfig.GetDurationPropertyFn(time.Hour),
+		dynamicconfig.GetDurationPropertyFn(10*time.Millisecond),
+		metrics.NoopMetricsHandler,
+		log.NewTestLogger())
+
+	nsrec := persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   namespace.NewID().String(),
+				Name: "foo",
+			},
+			Config:            &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+		},
+	}
+	updatedNsrec := persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   nsrec.Namespace.Info.Id,
+				Name: "foo",
+			},
+			Config: &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: cluster.TestAlternativeClusterName,
+			},
+		},
+		NotificationVersion: 1,
+	}
+
+	var notificationVersion atomic.Int64
+	s.regPersistence.EXPECT().GetMetadata(gomock.Any()).DoAndReturn(
+		func(_ context.Context) (*persistence.GetMetadataResponse, error) {
+			return &persistence.GetMetadataResponse{NotificationVersion: notificationVersion.Load()}, nil
+		}).AnyTimes()
+	gomock.InOrder(
+		s.regPersistence.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).Return(&persistence.ListNamespacesResponse{
+			Namespaces: []*persistence.GetNamespaceResponse{&nsrec},
+		}, nil),
+		s.regPersistence.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).Return(&persistence.ListNamespacesResponse{
+			Namespaces: []*persistence.GetNamespaceResponse{&updatedNsrec},
+		}, nil),
+	)
+
+	registry.Start()
+	defer registry.Stop()
+
+	ns, err := registry.GetNamespace(namespace.Name("foo"))
+	s.NoError(err)
+	s.Equal("", ns.ActiveClusterName())
+
+	notificationVersion.Store(1)
+	s.Eventually(func() bool {
+		ns, err := registry.GetNamespace(namespace.Name("foo"))
+		return err == nil && ns.ActiveClusterName() == cluster.TestAlternativeClusterName
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -25,6 +25,7 @@
 package client
 
 import (
+	"go.opentelemetry.io/otel/trace"
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common"
@@ -66,6 +67,7 @@ type (
 		config           *config.Persistence
 		serializer       serialization.Serializer
 		metricsHandler   metrics.Handler
+		tracerProvider   trace.TracerProvider
 		logger           log.Logger
 		clusterName      string
 		ratelimiter      quotas.RequestRateLimiter
@@ -78,7 +80,8 @@ type (
 // also contains config for individual datastores themselves.
 //
 // The objects returned by this factory enforce ratelimit and maxconns according to
-// given configuration. In addition, all objects will emit metrics automatically
+// given configuration. In addition, all objects will emit metrics automatically,
+// and shard, execution and task managers will be traced if tracerProvider is not nil
 func NewFactory(
 	dataStoreFactory DataStoreFactory,
 	cfg *config.Persistence,
@@ -86,6 +89,7 @@ func NewFactory(
 	serializer serialization.Serializer,
 	clusterName string,
 	metricsHandler metrics.Handler,
+	tracerProvider trace.TracerProvider,
 	logger log.Logger,
 ) Factory {
 	return &factoryImpl{
@@ -93,6 +97,7 @@ func NewFactory(
 		config:           cfg,
 		serializer:       serializer,
 		metricsHandler:   metricsHandler,
+		tracerProvider:   tracerProvider,
 		logger:           logger,
 		clusterName:      clusterName,
 		ratelimiter:      ratelimiter,
@@ -113,6 +118,9 @@ func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 	if f.metricsHandler != nil {
 		result = p.NewTaskPersistenceMetricsClient(result, f.metricsHandler, f.logger)
 	}
+	if f.tracerProvider != nil {
+		result = p.NewTaskPersistenceTracingClient(result, f.tracerProvider)
+	}
 	return result, nil
 }
 
@@ -130,6 +138,9 @@ func (f *factoryImpl) NewShardManager() (p.ShardManager, error) {
 	if f.metricsHandler != nil {
 		result = p.NewShardPersistenceMetricsClient(result, f.metricsHandler, f.logger)
 	}
+	if f.tracerProvider != nil {
+		result = p.NewShardPersistenceTracingClient(result, f.tracerProvider)
+	}
 	result = p.NewShardPersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
 	return result, nil
 }
@@ -184,6 +195,9 @@ func (f *factoryImpl) NewExecutionManager() (p.ExecutionManager, error) {
 	if f.metricsHandler != nil {
 		result = p.NewExecutionPersistenceMetricsClient(result, f.metricsHandler, f.logger)
 	}
+	if f.tracerProvider != nil {
+		result = p.NewExecutionPersistenceTracingClient(result, f.tracerProvider)
+	}
 	result = p.NewExecutionPersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
 	return result, nil
 }
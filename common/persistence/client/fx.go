@@ -25,6 +25,7 @@
 package client
 
 import (
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/fx"
 
 	"go.temporal.io/server/common/cluster"
@@ -52,6 +53,7 @@ type (
 		EnablePriorityRateLimiting EnablePriorityRateLimiting
 		ClusterName                ClusterName
 		MetricsHandler             metrics.Handler
+		TracerProvider             trace.TracerProvider `optional:"true"`
 		Logger                     log.Logger
 	}
 
@@ -91,6 +93,7 @@ func FactoryProvider(
 		serialization.NewSerializer(),
 		string(params.ClusterName),
 		params.MetricsHandler,
+		params.TracerProvider,
 		params.Logger,
 	)
 }
@@ -0,0 +1,579 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"reflect"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"go.temporal.io/server/common/telemetry"
+)
+
+const (
+	tracerName     = "go.temporal.io/server/common/persistence"
+	spanNamePrefix = "persistence."
+
+	taskQueueNameKey = attribute.Key("io.temporal.task_queue.name")
+)
+
+type (
+	spanStarter struct {
+		tracer trace.Tracer
+	}
+
+	shardTracingClient struct {
+		spanStarter
+		persistence ShardManager
+	}
+
+	executionTracingClient struct {
+		spanStarter
+		persistence ExecutionManager
+	}
+
+	taskTracingClient struct {
+		spanStarter
+		persistence TaskManager
+	}
+)
+
+var _ ShardManager = (*shardTracingClient)(nil)
+var _ ExecutionManager = (*executionTracingClient)(nil)
+var _ TaskManager = (*taskTracingClient)(nil)
+
+// NewShardPersistenceTracingClient creates a client to manage shards which traces each call
+func NewShardPersistenceTracingClient(persistence ShardManager, tracerProvider trace.TracerProvider) ShardManager {
+	return &shardTracingClient{
+		spanStarter: spanStarter{tracer: tracerProvider.Tracer(tracerName)},
+		persistence: persistence,
+	}
+}
+
+// NewExecutionPersistenceTracingClient creates a client to manage executions which traces each call
+func NewExecutionPersistenceTracingClient(persistence ExecutionManager, tracerProvider trace.TracerProvider) ExecutionManager {
+	return &executionTracingClient{
+		spanStarter: spanStarter{tracer: tracerProvider.Tracer(tracerName)},
+		persistence: persistence,
+	}
+}
+
+// NewTaskPersistenceTracingClient creates a client to manage tasks which traces each call
+func NewTaskPersistenceTracingClient(persistence TaskManager, tracerProvider trace.TracerProvider) TaskManager {
+	return &taskTracingClient{
+		spanStarter: spanStarter{tracer: tracerProvider.Tracer(tracerName)},
+		persistence: persistence,
+	}
+}
+
+func (p *shardTracingClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *shardTracingClient) GetOrCreateShard(
+	ctx context.Context,
+	request *GetOrCreateShardRequest,
+) (_ *GetOrCreateShardResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "GetOrCreateShard", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.GetOrCreateShard(ctx, request)
+}
+
+func (p *shardTracingClient) UpdateShard(
+	ctx context.Context,
+	request *UpdateShardRequest,
+) (retErr error) {
+	ctx, span := p.startSpan(ctx, "UpdateShard", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.UpdateShard(ctx, request)
+}
+
+func (p *shardTracingClient) AssertShardOwnership(
+	ctx context.Context,
+	request *AssertShardOwnershipRequest,
+) (retErr error) {
+	ctx, span := p.startSpan(ctx, "AssertShardOwnership", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.AssertShardOwnership(ctx, request)
+}
+
+func (p *shardTracingClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *executionTracingClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *executionTracingClient) CreateWorkflowExecution(
+	ctx context.Context,
+	request *CreateWorkflowExecutionRequest,
+) (_ *CreateWorkflowExecutionResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "CreateWorkflowExecution", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.CreateWorkflowExecution(ctx, request)
+}
+
+func (p *executionTracingClient) GetWorkflowExecution(
+	ctx context.Context,
+	request *GetWorkflowExecutionRequest,
+) (_ *GetWorkflowExecutionResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "GetWorkflowExecution", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.GetWorkflowExecution(ctx, request)
+}
+
+func (p *executionTracingClient) SetWorkflowExecution(
+	ctx context.Context,
+	request *SetWorkflowExecutionRequest,
+) (_ *SetWorkflowExecutionResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "SetWorkflowExecution", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.SetWorkflowExecution(ctx, request)
+}
+
+func (p *executionTracingClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *UpdateWorkflowExecutionRequest,
+) (_ *UpdateWorkflowExecutionResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "UpdateWorkflowExecution", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.UpdateWorkflowExecution(ctx, request)
+}
+
+func (p *executionTracingClient) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *ConflictResolveWorkflowExecutionRequest,
+) (_ *ConflictResolveWorkflowExecutionResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "ConflictResolveWorkflowExecution", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.ConflictResolveWorkflowExecution(ctx, request)
+}
+
+func (p *executionTracingClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *DeleteWorkflowExecutionRequest,
+) (retErr error) {
+	ctx, span := p.startSpan(ctx, "DeleteWorkflowExecution", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.DeleteWorkflowExecution(ctx, request)
+}
+
+func (p *executionTracingClient) DeleteCurrentWorkflowExecution(
+	ctx context.Context,
+	request *DeleteCurrentWorkflowExecutionRequest,
+) (retErr error) {
+	ctx, span := p.startSpan(ctx, "DeleteCurrentWorkflowExecution", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.DeleteCurrentWorkflowExecution(ctx, request)
+}
+
+func (p *executionTracingClient) GetCurrentExecution(
+	ctx context.Context,
+	request *GetCurrentExecutionRequest,
+) (_ *GetCurrentExecutionResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "GetCurrentExecution", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.GetCurrentExecution(ctx, request)
+}
+
+func (p *executionTracingClient) ListConcreteExecutions(
+	ctx context.Context,
+	request *ListConcreteExecutionsRequest,
+) (_ *ListConcreteExecutionsResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "ListConcreteExecutions", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.ListConcreteExecutions(ctx, request)
+}
+
+func (p *executionTracingClient) AddHistoryTasks(
+	ctx context.Context,
+	request *AddHistoryTasksRequest,
+) (retErr error) {
+	ctx, span := p.startSpan(ctx, "AddHistoryTasks", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.AddHistoryTasks(ctx, request)
+}
+
+func (p *executionTracingClient) GetHistoryTask(
+	ctx context.Context,
+	request *GetHistoryTaskRequest,
+) (_ *GetHistoryTaskResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "GetHistoryTask", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.GetHistoryTask(ctx, request)
+}
+
+func (p *executionTracingClient) GetHistoryTasks(
+	ctx context.Context,
+	request *GetHistoryTasksRequest,
+) (_ *GetHistoryTasksResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "GetHistoryTasks", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.GetHistoryTasks(ctx, request)
+}
+
+func (p *executionTracingClient) CompleteHistoryTask(
+	ctx context.Context,
+	request *CompleteHistoryTaskRequest,
+) (retErr error) {
+	ctx, span := p.startSpan(ctx, "CompleteHistoryTask", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.CompleteHistoryTask(ctx, request)
+}
+
+func (p *executionTracingClient) RangeCompleteHistoryTasks(
+	ctx context.Context,
+	request *RangeCompleteHistoryTasksRequest,
+) (retErr error) {
+	ctx, span := p.startSpan(ctx, "RangeCompleteHistoryTasks", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.RangeCompleteHistoryTasks(ctx, request)
+}
+
+func (p *executionTracingClient) PutReplicationTaskToDLQ(
+	ctx context.Context,
+	request *PutReplicationTaskToDLQRequest,
+) (retErr error) {
+	ctx, span := p.startSpan(ctx, "PutReplicationTaskToDLQ", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.PutReplicationTaskToDLQ(ctx, request)
+}
+
+func (p *executionTracingClient) GetReplicationTasksFromDLQ(
+	ctx context.Context,
+	request *GetReplicationTasksFromDLQRequest,
+) (_ *GetHistoryTasksResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "GetReplicationTasksFromDLQ", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.GetReplicationTasksFromDLQ(ctx, request)
+}
+
+func (p *executionTracingClient) DeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *DeleteReplicationTaskFromDLQRequest,
+) (retErr error) {
+	ctx, span := p.startSpan(ctx, "DeleteReplicationTaskFromDLQ", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.DeleteReplicationTaskFromDLQ(ctx, request)
+}
+
+func (p *executionTracingClient) RangeDeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *RangeDeleteReplicationTaskFromDLQRequest,
+) (retErr error) {
+	ctx, span := p.startSpan(ctx, "RangeDeleteReplicationTaskFromDLQ", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.RangeDeleteReplicationTaskFromDLQ(ctx, request)
+}
+
+func (p *executionTracingClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *taskTracingClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *taskTracingClient) CreateTasks(
+	ctx context.Context,
+	request *CreateTasksRequest,
+) (_ *CreateTasksResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "CreateTasks", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.CreateTasks(ctx, request)
+}
+
+func (p *taskTracingClient) GetTasks(
+	ctx context.Context,
+	request *GetTasksRequest,
+) (_ *GetTasksResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "GetTasks", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.GetTasks(ctx, request)
+}
+
+func (p *taskTracingClient) CompleteTask(
+	ctx context.Context,
+	request *CompleteTaskRequest,
+) (retErr error) {
+	ctx, span := p.startSpan(ctx, "CompleteTask", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.CompleteTask(ctx, request)
+}
+
+func (p *taskTracingClient) CompleteTasksLessThan(
+	ctx context.Context,
+	request *CompleteTasksLessThanRequest,
+) (_ int, retErr error) {
+	ctx, span := p.startSpan(ctx, "CompleteTasksLessThan", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.CompleteTasksLessThan(ctx, request)
+}
+
+func (p *taskTracingClient) CreateTaskQueue(
+	ctx context.Context,
+	request *CreateTaskQueueRequest,
+) (_ *CreateTaskQueueResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "CreateTaskQueue", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.CreateTaskQueue(ctx, request)
+}
+
+func (p *taskTracingClient) UpdateTaskQueue(
+	ctx context.Context,
+	request *UpdateTaskQueueRequest,
+) (_ *UpdateTaskQueueResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "UpdateTaskQueue", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.UpdateTaskQueue(ctx, request)
+}
+
+func (p *taskTracingClient) GetTaskQueue(
+	ctx context.Context,
+	request *GetTaskQueueRequest,
+) (_ *GetTaskQueueResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "GetTaskQueue", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.GetTaskQueue(ctx, request)
+}
+
+func (p *taskTracingClient) ListTaskQueue(
+	ctx context.Context,
+	request *ListTaskQueueRequest,
+) (_ *ListTaskQueueResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "ListTaskQueue", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.ListTaskQueue(ctx, request)
+}
+
+func (p *taskTracingClient) DeleteTaskQueue(
+	ctx context.Context,
+	request *DeleteTaskQueueRequest,
+) (retErr error) {
+	ctx, span := p.startSpan(ctx, "DeleteTaskQueue", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.DeleteTaskQueue(ctx, request)
+}
+
+func (p *taskTracingClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *executionTracingClient) AppendHistoryNodes(
+	ctx context.Context,
+	request *AppendHistoryNodesRequest,
+) (_ *AppendHistoryNodesResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "AppendHistoryNodes", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.AppendHistoryNodes(ctx, request)
+}
+
+func (p *executionTracingClient) AppendRawHistoryNodes(
+	ctx context.Context,
+	request *AppendRawHistoryNodesRequest,
+) (_ *AppendHistoryNodesResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "AppendRawHistoryNodes", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.AppendRawHistoryNodes(ctx, request)
+}
+
+func (p *executionTracingClient) ParseHistoryBranchInfo(
+	ctx context.Context,
+	request *ParseHistoryBranchInfoRequest,
+) (_ *ParseHistoryBranchInfoResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "ParseHistoryBranchInfo", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.ParseHistoryBranchInfo(ctx, request)
+}
+
+func (p *executionTracingClient) UpdateHistoryBranchInfo(
+	ctx context.Context,
+	request *UpdateHistoryBranchInfoRequest,
+) (_ *UpdateHistoryBranchInfoResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "UpdateHistoryBranchInfo", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.UpdateHistoryBranchInfo(ctx, request)
+}
+
+func (p *executionTracingClient) NewHistoryBranch(
+	ctx context.Context,
+	request *NewHistoryBranchRequest,
+) (_ *NewHistoryBranchResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "NewHistoryBranch", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.NewHistoryBranch(ctx, request)
+}
+
+func (p *executionTracingClient) ReadHistoryBranch(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+) (_ *ReadHistoryBranchResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "ReadHistoryBranch", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.ReadHistoryBranch(ctx, request)
+}
+
+func (p *executionTracingClient) ReadHistoryBranchReverse(
+	ctx context.Context,
+	request *ReadHistoryBranchReverseRequest,
+) (_ *ReadHistoryBranchReverseResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "ReadHistoryBranchReverse", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.ReadHistoryBranchReverse(ctx, request)
+}
+
+func (p *executionTracingClient) ReadHistoryBranchByBatch(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+) (_ *ReadHistoryBranchByBatchResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "ReadHistoryBranchByBatch", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.ReadHistoryBranchByBatch(ctx, request)
+}
+
+func (p *executionTracingClient) ReadRawHistoryBranch(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+) (_ *ReadRawHistoryBranchResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "ReadRawHistoryBranch", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.ReadRawHistoryBranch(ctx, request)
+}
+
+func (p *executionTracingClient) ForkHistoryBranch(
+	ctx context.Context,
+	request *ForkHistoryBranchRequest,
+) (_ *ForkHistoryBranchResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "ForkHistoryBranch", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.ForkHistoryBranch(ctx, request)
+}
+
+func (p *executionTracingClient) DeleteHistoryBranch(
+	ctx context.Context,
+	request *DeleteHistoryBranchRequest,
+) (retErr error) {
+	ctx, span := p.startSpan(ctx, "DeleteHistoryBranch", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.DeleteHistoryBranch(ctx, request)
+}
+
+func (p *executionTracingClient) TrimHistoryBranch(
+	ctx context.Context,
+	request *TrimHistoryBranchRequest,
+) (_ *TrimHistoryBranchResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "TrimHistoryBranch", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.TrimHistoryBranch(ctx, request)
+}
+
+func (p *executionTracingClient) GetAllHistoryTreeBranches(
+	ctx context.Context,
+	request *GetAllHistoryTreeBranchesRequest,
+) (_ *GetAllHistoryTreeBranchesResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "GetAllHistoryTreeBranches", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.GetAllHistoryTreeBranches(ctx, request)
+}
+
+func (p *executionTracingClient) GetHistoryTree(
+	ctx context.Context,
+	request *GetHistoryTreeRequest,
+) (_ *GetHistoryTreeResponse, retErr error) {
+	ctx, span := p.startSpan(ctx, "GetHistoryTree", request)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	return p.persistence.GetHistoryTree(ctx, request)
+}
+
+func (p *spanStarter) startSpan(
+	ctx context.Context,
+	operation string,
+	request interface{},
+) (context.Context, trace.Span) {
+	ctx, span := p.tracer.Start(
+		ctx,
+		spanNamePrefix+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+	)
+	if span.IsRecording() {
+		span.SetAttributes(requestAttributes(request)...)
+	}
+	return ctx, span
+}
+
+// requestAttributes extracts shard, namespace and workflow identifiers from a persistence request
+func requestAttributes(request interface{}) []attribute.KeyValue {
+	switch request := request.(type) {
+	case *CreateWorkflowExecutionRequest:
+		return snapshotAttributes(request.ShardID, &request.NewWorkflowSnapshot)
+	case *UpdateWorkflowExecutionRequest:
+		return append(
+			[]attribute.KeyValue{telemetry.ShardIDKey.Int64(int64(request.ShardID))},
+			telemetry.WorkflowAttributes(
+				request.UpdateWorkflowMutation.ExecutionInfo.GetNamespaceId(),
+				request.UpdateWorkflowMutation.ExecutionInfo.GetWorkflowId(),
+				request.UpdateWorkflowMutation.ExecutionState.GetRunId(),
+			)...,
+		)
+	case *ConflictResolveWorkflowExecutionRequest:
+		return snapshotAttributes(request.ShardID, &request.ResetWorkflowSnapshot)
+	case *SetWorkflowExecutionRequest:
+		return snapshotAttributes(request.ShardID, &request.SetWorkflowSnapshot)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(request))
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+	var attrs []attribute.KeyValue
+	if field := value.FieldByName("ShardID"); field.IsValid() && field.Kind() == reflect.Int32 {
+		attrs = append(attrs, telemetry.ShardIDKey.Int64(field.Int()))
+	}
+	for name, key := range map[string]attribute.Key{
+		"NamespaceID": telemetry.NamespaceIDKey,
+		"WorkflowID":  telemetry.WorkflowIDKey,
+		"RunID":       telemetry.RunIDKey,
+		"TaskQueue":   taskQueueNameKey,
+	} {
+		if field := value.FieldByName(name); field.IsValid() && field.Kind() == reflect.String && field.String() != "" {
+			attrs = append(attrs, key.String(field.String()))
+		}
+	}
+	return attrs
+}
+
+func snapshotAttributes(shardID int32, snapshot *WorkflowSnapshot) []attribute.KeyValue {
+	return append(
+		[]attribute.KeyValue{telemetry.ShardIDKey.Int64(int64(shardID))},
+		telemetry.WorkflowAttributes(
+			snapshot.ExecutionInfo.GetNamespaceId(),
+			snapshot.ExecutionInfo.GetWorkflowId(),
+			snapshot.ExecutionState.GetRunId(),
+		)...,
+	)
+}
@@ -0,0 +1,95 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/telemetry"
+)
+
+func TestExecutionTracingClient(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	executionManager := NewMockExecutionManager(controller)
+	client := NewExecutionPersistenceTracingClient(executionManager, tracerProvider)
+
+	getRequest := &GetWorkflowExecutionRequest{
+		ShardID:     1,
+		NamespaceID: "test-namespace-id",
+		WorkflowID:  "test-workflow-id",
+		RunID:       "test-run-id",
+	}
+	executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), getRequest).Return(&GetWorkflowExecutionResponse{}, nil)
+	_, err := client.GetWorkflowExecution(context.Background(), getRequest)
+	require.NoError(t, err)
+
+	updateErr := errors.New("update failed")
+	updateRequest := &UpdateWorkflowExecutionRequest{
+		ShardID: 2,
+		UpdateWorkflowMutation: WorkflowMutation{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				NamespaceId: "test-namespace-id",
+				WorkflowId:  "test-workflow-id",
+			},
+			ExecutionState: &persistencespb.WorkflowExecutionState{
+				RunId: "test-run-id",
+			},
+		},
+	}
+	executionManager.EXPECT().UpdateWorkflowExecution(gomock.Any(), updateRequest).Return(nil, updateErr)
+	_, err = client.UpdateWorkflowExecution(context.Background(), updateRequest)
+	require.ErrorIs(t, err, updateErr)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	require.Equal(t, "persistence.GetWorkflowExecution", spans[0].Name())
+	require.ElementsMatch(t, spans[0].Attributes(), append(
+		[]attribute.KeyValue{telemetry.ShardIDKey.Int64(1)},
+		telemetry.WorkflowAttributes("test-namespace-id", "test-workflow-id", "test-run-id")...,
+	))
+	require.Equal(t, codes.Unset, spans[0].Status().Code)
+
+	require.Equal(t, "persistence.UpdateWorkflowExecution", spans[1].Name())
+	require.ElementsMatch(t, spans[1].Attributes(), append(
+		[]attribute.KeyValue{telemetry.ShardIDKey.Int64(2)},
+		telemetry.WorkflowAttributes("test-namespace-id", "test-workflow-id", "test-run-id")...,
+	))
+	require.Equal(t, codes.Error, spans[1].Status().Code)
+}
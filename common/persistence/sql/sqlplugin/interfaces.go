@@ -90,6 +90,8 @@ type (
 		UpdateSchemaVersion(database string, newVersion string, minCompatibleVersion string) error
 		WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error
 		ListTables(database string) ([]string, error)
+		ListColumns(database string, table string) ([]string, error)
+		ListIndexes(database string, table string) ([]string, error)
 		DropTable(table string) error
 		DropAllTables(database string) error
 		CreateDatabase(database string) error
@@ -64,6 +64,10 @@ const (
 
 	listTablesQuery = "SHOW TABLES FROM %v"
 
+	listColumnsQuery = "SELECT column_name FROM information_schema.columns WHERE table_schema=? AND table_name=?"
+
+	listIndexesQuery = "SELECT DISTINCT index_name FROM information_schema.statistics WHERE table_schema=? AND table_name=?"
+
 	dropTableQuery = "DROP TABLE %v"
 )
 
@@ -106,6 +110,20 @@ func (mdb *db) ListTables(database string) ([]string, error) {
 	return tables, err
 }
 
+// ListColumns returns a list of columns of the given table
+func (mdb *db) ListColumns(database string, table string) ([]string, error) {
+	var columns []string
+	err := mdb.db.Select(&columns, listColumnsQuery, database, table)
+	return columns, err
+}
+
+// ListIndexes returns a list of indexes on the given table
+func (mdb *db) ListIndexes(database string, table string) ([]string, error) {
+	var indexes []string
+	err := mdb.db.Select(&indexes, listIndexesQuery, database, table)
+	return indexes, err
+}
+
 // DropTable drops a given table from the database
 func (mdb *db) DropTable(name string) error {
 	return mdb.Exec(fmt.Sprintf(dropTableQuery, name))
@@ -70,6 +70,10 @@ const (
 
 	listTablesQuery = "select table_name from information_schema.tables where table_schema='public'"
 
+	listColumnsQuery = "select column_name from information_schema.columns where table_schema='public' and table_name=$1"
+
+	listIndexesQuery = "select indexname from pg_indexes where schemaname='public' and tablename=$1"
+
 	dropTableQuery = "DROP TABLE %v"
 )
 
@@ -112,6 +116,20 @@ func (pdb *db) ListTables(database string) ([]string, error) {
 	return tables, err
 }
 
+// ListColumns returns a list of columns of the given table
+func (pdb *db) ListColumns(database string, table string) ([]string, error) {
+	var columns []string
+	err := pdb.db.Select(&columns, listColumnsQuery, table)
+	return columns, err
+}
+
+// ListIndexes returns a list of indexes on the given table
+func (pdb *db) ListIndexes(database string, table string) ([]string, error) {
+	var indexes []string
+	err := pdb.db.Select(&indexes, listIndexesQuery, table)
+	return indexes, err
+}
+
 // DropTable drops a given table from the database
 func (pdb *db) DropTable(name string) error {
 	return pdb.Exec(fmt.Sprintf(dropTableQuery, name))
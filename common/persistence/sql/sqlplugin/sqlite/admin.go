@@ -58,6 +58,10 @@ const (
 
 	listTablesQuery = "SELECT name FROM sqlite_master WHERE type='table'"
 
+	listColumnsQuery = "SELECT name FROM pragma_table_info(?)"
+
+	listIndexesQuery = "SELECT name FROM sqlite_master WHERE type='index' AND tbl_name=?"
+
 	dropTableQuery = "DROP TABLE %v"
 )
 
@@ -100,6 +104,20 @@ func (mdb *db) ListTables(database string) ([]string, error) {
 	return tables, err
 }
 
+// ListColumns returns a list of columns of the given table
+func (mdb *db) ListColumns(database string, table string) ([]string, error) {
+	var columns []string
+	err := mdb.db.Select(&columns, listColumnsQuery, table)
+	return columns, err
+}
+
+// ListIndexes returns a list of indexes on the given table
+func (mdb *db) ListIndexes(database string, table string) ([]string, error) {
+	var indexes []string
+	err := mdb.db.Select(&indexes, listIndexesQuery, table)
+	return indexes, err
+}
+
 // DropTable drops a given table from the database
 func (mdb *db) DropTable(name string) error {
 	return mdb.Exec(fmt.Sprintf(dropTableQuery, name))
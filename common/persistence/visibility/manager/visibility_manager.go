@@ -63,6 +63,7 @@ type (
 		ListWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error)
 		ScanWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error)
 		CountWorkflowExecutions(ctx context.Context, request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error)
+		SumHistorySizeBytes(ctx context.Context, request *SumHistorySizeBytesRequest) (*SumHistorySizeBytesResponse, error)
 		GetWorkflowExecution(ctx context.Context, request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error)
 	}
 
@@ -143,6 +144,30 @@ type (
 	// CountWorkflowExecutionsResponse is response to CountWorkflowExecutions
 	CountWorkflowExecutionsResponse struct {
 		Count int64
+		// Groups is set only if the query has GROUP BY clause.
+		Groups []AggregationGroup
+		// OtherCount is the number of executions which are not counted in Groups,
+		// because the number of groups exceeded the store limit.
+		OtherCount int64
+	}
+
+	// AggregationGroup is a bucket of CountWorkflowExecutionsResponse with values of GROUP BY fields
+	AggregationGroup struct {
+		GroupValues []string
+		Count       int64
+	}
+
+	// SumHistorySizeBytesRequest is request from SumHistorySizeBytes
+	SumHistorySizeBytesRequest struct {
+		NamespaceID namespace.ID
+		Namespace   namespace.Name // namespace.Name is not persisted.
+		Query       string
+	}
+
+	// SumHistorySizeBytesResponse is response to SumHistorySizeBytes.
+	// Only closed executions have their history size recorded in visibility.
+	SumHistorySizeBytesResponse struct {
+		HistorySizeBytes int64
 	}
 
 	// ListWorkflowExecutionsByTypeRequest is used to list executions of
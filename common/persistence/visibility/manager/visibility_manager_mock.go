@@ -305,6 +305,21 @@ func (mr *MockVisibilityManagerMockRecorder) ScanWorkflowExecutions(ctx, request
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanWorkflowExecutions", reflect.TypeOf((*MockVisibilityManager)(nil).ScanWorkflowExecutions), ctx, request)
 }
 
+// SumHistorySizeBytes mocks base method.
+func (m *MockVisibilityManager) SumHistorySizeBytes(ctx context.Context, request *SumHistorySizeBytesRequest) (*SumHistorySizeBytesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumHistorySizeBytes", ctx, request)
+	ret0, _ := ret[0].(*SumHistorySizeBytesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumHistorySizeBytes indicates an expected call of SumHistorySizeBytes.
+func (mr *MockVisibilityManagerMockRecorder) SumHistorySizeBytes(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumHistorySizeBytes", reflect.TypeOf((*MockVisibilityManager)(nil).SumHistorySizeBytes), ctx, request)
+}
+
 // UpsertWorkflowExecution mocks base method.
 func (m *MockVisibilityManager) UpsertWorkflowExecution(ctx context.Context, request *UpsertWorkflowExecutionRequest) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,259 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+	"github.com/olivere/elastic/v7"
+)
+
+type (
+	// bulkProcessorV8 implements BulkProcessor using v8 bulk API. It works the same way as olivere/elastic
+	// bulk processor: requests are distributed between workers, every worker commits its own bulk request
+	// when it has BulkActions requests or BulkSize bytes, and all workers are flushed every FlushInterval.
+	// Failed items are not retried because visibility task processor has its own retries.
+	bulkProcessorV8 struct {
+		ctx         context.Context
+		transport   esapi.Transport
+		params      *BulkProcessorParameters
+		executionID int64
+
+		requestC chan elastic.BulkableRequest
+		workers  []*bulkWorkerV8
+		workerWG sync.WaitGroup
+
+		flusherStopC chan struct{}
+		flusherWG    sync.WaitGroup
+	}
+
+	bulkWorkerV8 struct {
+		processor *bulkProcessorV8
+		flushC    chan chan struct{}
+
+		requests []elastic.BulkableRequest
+		body     bytes.Buffer
+		// Error of the first request which can't be serialized. The whole bulk fails with it.
+		err error
+	}
+)
+
+const (
+	bulkProcessorStopTimeout = 5 * time.Second
+)
+
+var _ BulkProcessor = (*bulkProcessorV8)(nil)
+
+func newBulkProcessorV8(ctx context.Context, transport esapi.Transport, params *BulkProcessorParameters) *bulkProcessorV8 {
+	numOfWorkers := params.NumOfWorkers
+	if numOfWorkers < 1 {
+		numOfWorkers = 1
+	}
+
+	p := &bulkProcessorV8{
+		ctx:          ctx,
+		transport:    transport,
+		params:       params,
+		requestC:     make(chan elastic.BulkableRequest),
+		workers:      make([]*bulkWorkerV8, numOfWorkers),
+		flusherStopC: make(chan struct{}),
+	}
+
+	p.workerWG.Add(numOfWorkers)
+	for i := range p.workers {
+		p.workers[i] = &bulkWorkerV8{
+			processor: p,
+			flushC:    make(chan chan struct{}),
+		}
+		go p.workers[i].work()
+	}
+
+	if params.FlushInterval > 0 {
+		p.flusherWG.Add(1)
+		go p.flusher()
+	}
+	return p
+}
+
+func (p *bulkProcessorV8) Add(request *BulkableRequest) {
+	switch request.RequestType {
+	case BulkableRequestTypeIndex:
+		p.requestC <- elastic.NewBulkIndexRequest().
+			Index(request.Index).
+			Id(request.ID).
+			VersionType(versionTypeExternal).
+			Version(request.Version).
+			Doc(request.Doc)
+	case BulkableRequestTypeDelete:
+		p.requestC <- elastic.NewBulkDeleteRequest().
+			Index(request.Index).
+			Id(request.ID).
+			VersionType(versionTypeExternal).
+			Version(request.Version)
+	}
+}
+
+// Stop commits all pending requests and stops workers.
+func (p *bulkProcessorV8) Stop() error {
+	// Commit can block indefinitely if we can't reach ES. Wait with a timeout to avoid
+	// blocking server shutdown. Default fx app shutdown timeout is 15s, so use 5s.
+	doneC := make(chan struct{})
+	go func() {
+		// Flusher must be stopped first because it waits for workers.
+		close(p.flusherStopC)
+		p.flusherWG.Wait()
+		// Workers commit remaining requests when request channel is closed.
+		close(p.requestC)
+		p.workerWG.Wait()
+		close(doneC)
+	}()
+	select {
+	case <-doneC:
+		return nil
+	case <-time.After(bulkProcessorStopTimeout):
+		return errors.New("bulk processor Stop timed out")
+	}
+}
+
+func (p *bulkProcessorV8) flusher() {
+	defer p.flusherWG.Done()
+
+	ticker := time.NewTicker(p.params.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.flush()
+		case <-p.flusherStopC:
+			return
+		}
+	}
+}
+
+func (p *bulkProcessorV8) flush() {
+	for _, w := range p.workers {
+		ackC := make(chan struct{})
+		w.flushC <- ackC
+		<-ackC
+	}
+}
+
+func (w *bulkWorkerV8) work() {
+	defer w.processor.workerWG.Done()
+
+	for {
+		select {
+		case request, ok := <-w.processor.requestC:
+			if !ok {
+				w.commit()
+				return
+			}
+			w.add(request)
+			if w.committable() {
+				w.commit()
+			}
+		case ackC := <-w.flushC:
+			w.commit()
+			close(ackC)
+		}
+	}
+}
+
+func (w *bulkWorkerV8) add(request elastic.BulkableRequest) {
+	w.requests = append(w.requests, request)
+	lines, err := request.Source()
+	if err != nil {
+		if w.err == nil {
+			w.err = err
+		}
+		return
+	}
+	for _, line := range lines {
+		w.body.WriteString(line)
+		w.body.WriteByte('\n')
+	}
+}
+
+func (w *bulkWorkerV8) committable() bool {
+	params := w.processor.params
+	if params.BulkActions > 0 && len(w.requests) >= params.BulkActions {
+		return true
+	}
+	if params.BulkSize > 0 && w.body.Len() >= params.BulkSize {
+		return true
+	}
+	return false
+}
+
+func (w *bulkWorkerV8) commit() {
+	if len(w.requests) == 0 {
+		return
+	}
+	requests := w.requests
+	err := w.err
+	defer func() {
+		w.requests = nil
+		w.body.Reset()
+		w.err = nil
+	}()
+
+	p := w.processor
+	executionID := atomic.AddInt64(&p.executionID, 1)
+	if p.params.BeforeFunc != nil {
+		p.params.BeforeFunc(executionID, requests)
+	}
+
+	var response *elastic.BulkResponse
+	if err == nil {
+		response, err = p.bulk(bytes.NewReader(w.body.Bytes()))
+	}
+
+	if p.params.AfterFunc != nil {
+		p.params.AfterFunc(executionID, requests, response, err)
+	}
+}
+
+func (p *bulkProcessorV8) bulk(body *bytes.Reader) (*elastic.BulkResponse, error) {
+	res, err := esapi.BulkRequest{Body: body}.Do(p.ctx, p.transport)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.IsError() {
+		return nil, newResponseError(res)
+	}
+	var response elastic.BulkResponse
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
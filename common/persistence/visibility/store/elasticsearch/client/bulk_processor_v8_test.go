@@ -0,0 +1,149 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/elastic/elastic-transport-go/v8/elastictransport"
+	"github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/require"
+)
+
+type bulkCommit struct {
+	requests []elastic.BulkableRequest
+	response *elastic.BulkResponse
+	err      error
+}
+
+func newTestBulkProcessorV8(t *testing.T, handler http.HandlerFunc, params *BulkProcessorParameters) (*bulkProcessorV8, chan bulkCommit) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	transport, err := elastictransport.New(elastictransport.Config{URLs: []*url.URL{serverURL}, DisableRetry: true})
+	require.NoError(t, err)
+
+	commitC := make(chan bulkCommit, 10)
+	params.AfterFunc = func(_ int64, requests []elastic.BulkableRequest, response *elastic.BulkResponse, err error) {
+		commitC <- bulkCommit{requests: requests, response: response, err: err}
+	}
+	return newBulkProcessorV8(context.Background(), transport, params), commitC
+}
+
+func bulkHandler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/_bulk", r.URL.Path)
+		var items []map[string]interface{}
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			var action map[string]map[string]interface{}
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &action))
+			for op, meta := range action {
+				require.Equal(t, versionTypeExternal, meta["version_type"])
+				items = append(items, map[string]interface{}{op: map[string]interface{}{"_id": meta["_id"], "status": http.StatusCreated}})
+				if op == "index" {
+					// Skip document line.
+					require.True(t, scanner.Scan())
+				}
+			}
+		}
+		writeJSON(t, w, map[string]interface{}{"items": items})
+	}
+}
+
+func Test_BulkProcessorV8_CommitsBulkActions(t *testing.T) {
+	processor, commitC := newTestBulkProcessorV8(t, bulkHandler(t), &BulkProcessorParameters{
+		NumOfWorkers: 1,
+		BulkActions:  2,
+	})
+
+	processor.Add(&BulkableRequest{RequestType: BulkableRequestTypeIndex, Index: "test-index", ID: "1", Version: 1, Doc: map[string]interface{}{"WorkflowId": "wid1"}})
+	processor.Add(&BulkableRequest{RequestType: BulkableRequestTypeDelete, Index: "test-index", ID: "2", Version: 2})
+	processor.Add(&BulkableRequest{RequestType: BulkableRequestTypeIndex, Index: "test-index", ID: "3", Version: 3, Doc: map[string]interface{}{"WorkflowId": "wid3"}})
+
+	commit := <-commitC
+	require.NoError(t, commit.err)
+	require.Len(t, commit.requests, 2)
+	require.Len(t, commit.response.Items, 2)
+	require.Equal(t, "1", commit.response.Items[0]["index"].Id)
+	require.Equal(t, "2", commit.response.Items[1]["delete"].Id)
+
+	// Remaining request is committed on stop.
+	require.NoError(t, processor.Stop())
+	commit = <-commitC
+	require.NoError(t, commit.err)
+	require.Len(t, commit.requests, 1)
+	require.Equal(t, "3", commit.response.Items[0]["index"].Id)
+}
+
+func Test_BulkProcessorV8_FlushInterval(t *testing.T) {
+	processor, commitC := newTestBulkProcessorV8(t, bulkHandler(t), &BulkProcessorParameters{
+		NumOfWorkers:  2,
+		BulkActions:   100,
+		FlushInterval: 10 * time.Millisecond,
+	})
+	defer func() { require.NoError(t, processor.Stop()) }()
+
+	processor.Add(&BulkableRequest{RequestType: BulkableRequestTypeIndex, Index: "test-index", ID: "1", Version: 1, Doc: map[string]interface{}{"WorkflowId": "wid1"}})
+
+	select {
+	case commit := <-commitC:
+		require.NoError(t, commit.err)
+		require.Len(t, commit.requests, 1)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "bulk request was not flushed")
+	}
+}
+
+func Test_BulkProcessorV8_ErrorResponse(t *testing.T) {
+	processor, commitC := newTestBulkProcessorV8(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusTooManyRequests)
+		writeJSON(t, w, map[string]interface{}{
+			"error":  map[string]interface{}{"type": "es_rejected_execution_exception"},
+			"status": http.StatusTooManyRequests,
+		})
+	}, &BulkProcessorParameters{
+		NumOfWorkers: 1,
+		BulkActions:  1,
+	})
+	defer func() { require.NoError(t, processor.Stop()) }()
+
+	processor.Add(&BulkableRequest{RequestType: BulkableRequestTypeDelete, Index: "test-index", ID: "1", Version: 1})
+
+	commit := <-commitC
+	require.Nil(t, commit.response)
+	var esErr *elastic.Error
+	require.ErrorAs(t, commit.err, &esErr)
+	require.Equal(t, http.StatusTooManyRequests, esErr.Status)
+}
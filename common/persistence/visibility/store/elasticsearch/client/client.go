@@ -55,6 +55,7 @@ type (
 		PutMapping(ctx context.Context, index string, mapping map[string]enumspb.IndexedValueType) (bool, error)
 		WaitForYellowStatus(ctx context.Context, index string) (string, error)
 		GetMapping(ctx context.Context, index string) (map[string]string, error)
+		UpdateByQuery(ctx context.Context, index string, query elastic.Query, script *elastic.Script, maxDocs int) (*elastic.BulkIndexByScrollResponse, error)
 
 		IsPointInTimeSupported(ctx context.Context) bool
 		OpenPointInTime(ctx context.Context, index string, keepAliveInterval string) (string, error)
@@ -84,5 +85,10 @@ type (
 
 		SearchAfter []interface{}
 		PointInTime *elastic.PointInTime
+
+		// TrackTotalHits counts all matching documents instead of stopping at 10000.
+		TrackTotalHits bool
+
+		Aggregations map[string]elastic.Aggregation
 	}
 )
@@ -31,10 +31,21 @@ import (
 	"go.temporal.io/server/common/log"
 )
 
+const (
+	// Versions served by the client built on go-elasticsearch v8. Elasticsearch 8 is still served by
+	// the v7 client when the version is "v8", the native client has to be opted into.
+	versionV8Native          = "v8-native"
+	versionOpenSearch2Native = "opensearch2-native"
+)
+
 func NewClient(config *Config, httpClient *http.Client, logger log.Logger) (Client, error) {
 	switch config.Version {
 	case "v8", "v7", "":
 		return newClient(config, httpClient, logger)
+	case versionV8Native:
+		return newClientV8(config, httpClient, logger, distributionElasticsearch)
+	case versionOpenSearch2Native:
+		return newClientV8(config, httpClient, logger, distributionOpenSearch)
 	default:
 		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
 	}
@@ -44,6 +55,10 @@ func NewCLIClient(config *Config, logger log.Logger) (CLIClient, error) {
 	switch config.Version {
 	case "v8", "v7", "":
 		return newClient(config, nil, logger)
+	case versionV8Native:
+		return newClientV8(config, nil, logger, distributionElasticsearch)
+	case versionOpenSearch2Native:
+		return newClientV8(config, nil, logger, distributionOpenSearch)
 	default:
 		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
 	}
@@ -53,6 +68,10 @@ func NewIntegrationTestsClient(config *Config, logger log.Logger) (IntegrationTe
 	switch config.Version {
 	case "v8", "v7", "":
 		return newClient(config, nil, logger)
+	case versionV8Native:
+		return newClientV8(config, nil, logger, distributionElasticsearch)
+	case versionOpenSearch2Native:
+		return newClientV8(config, nil, logger, distributionOpenSearch)
 	default:
 		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
 	}
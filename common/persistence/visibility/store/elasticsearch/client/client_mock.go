@@ -238,6 +238,21 @@ func (mr *MockClientMockRecorder) Search(ctx, p interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockClient)(nil).Search), ctx, p)
 }
 
+// UpdateByQuery mocks base method.
+func (m *MockClient) UpdateByQuery(ctx context.Context, index string, query v7.Query, script *v7.Script, maxDocs int) (*v7.BulkIndexByScrollResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateByQuery", ctx, index, query, script, maxDocs)
+	ret0, _ := ret[0].(*v7.BulkIndexByScrollResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateByQuery indicates an expected call of UpdateByQuery.
+func (mr *MockClientMockRecorder) UpdateByQuery(ctx, index, query, script, maxDocs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByQuery", reflect.TypeOf((*MockClient)(nil).UpdateByQuery), ctx, index, query, script, maxDocs)
+}
+
 // WaitForYellowStatus mocks base method.
 func (m *MockClient) WaitForYellowStatus(ctx context.Context, index string) (string, error) {
 	m.ctrl.T.Helper()
@@ -468,6 +483,21 @@ func (mr *MockCLIClientMockRecorder) Search(ctx, p interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockCLIClient)(nil).Search), ctx, p)
 }
 
+// UpdateByQuery mocks base method.
+func (m *MockCLIClient) UpdateByQuery(ctx context.Context, index string, query v7.Query, script *v7.Script, maxDocs int) (*v7.BulkIndexByScrollResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateByQuery", ctx, index, query, script, maxDocs)
+	ret0, _ := ret[0].(*v7.BulkIndexByScrollResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateByQuery indicates an expected call of UpdateByQuery.
+func (mr *MockCLIClientMockRecorder) UpdateByQuery(ctx, index, query, script, maxDocs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByQuery", reflect.TypeOf((*MockCLIClient)(nil).UpdateByQuery), ctx, index, query, script, maxDocs)
+}
+
 // WaitForYellowStatus mocks base method.
 func (m *MockCLIClient) WaitForYellowStatus(ctx context.Context, index string) (string, error) {
 	m.ctrl.T.Helper()
@@ -82,20 +82,7 @@ func newClient(cfg *Config, httpClient *http.Client, logger log.Logger) (*client
 	}
 
 	// TODO (alex): Remove this when https://github.com/olivere/elastic/pull/1507 is merged.
-	if cfg.CloseIdleConnectionsInterval != time.Duration(0) {
-		if cfg.CloseIdleConnectionsInterval < minimumCloseIdleConnectionsInterval {
-			cfg.CloseIdleConnectionsInterval = minimumCloseIdleConnectionsInterval
-		}
-		go func(interval time.Duration, httpClient *http.Client) {
-			closeTimer := time.NewTimer(interval)
-			defer closeTimer.Stop()
-			for {
-				<-closeTimer.C
-				closeTimer.Reset(interval)
-				httpClient.CloseIdleConnections()
-			}
-		}(cfg.CloseIdleConnectionsInterval, httpClient)
-	}
+	startCloseIdleConnections(cfg, httpClient)
 
 	options = append(options, elastic.SetHttpClient(httpClient))
 
@@ -125,23 +112,7 @@ func (c *clientImpl) Get(ctx context.Context, index string, docID string) (*elas
 }
 
 func (c *clientImpl) Search(ctx context.Context, p *SearchParameters) (*elastic.SearchResult, error) {
-	searchSource := elastic.NewSearchSource().
-		Query(p.Query).
-		SortBy(p.Sorter...)
-
-	if p.PointInTime != nil {
-		searchSource.PointInTime(p.PointInTime)
-	}
-
-	if p.PageSize != 0 {
-		searchSource.Size(p.PageSize)
-	}
-
-	if len(p.SearchAfter) != 0 {
-		searchSource.SearchAfter(p.SearchAfter...)
-	}
-
-	searchService := c.esClient.Search().SearchSource(searchSource)
+	searchService := c.esClient.Search().SearchSource(buildSearchSource(p))
 	// When pit.id is specified index must not be used.
 	if p.PointInTime == nil {
 		searchService.Index(p.Index)
@@ -218,6 +189,24 @@ func (c *clientImpl) Count(ctx context.Context, index string, query elastic.Quer
 	return c.esClient.Count(index).Query(query).Do(ctx)
 }
 
+// UpdateByQuery runs script for at most maxDocs documents matching the query.
+// Documents updated concurrently are skipped and left for the next call.
+func (c *clientImpl) UpdateByQuery(
+	ctx context.Context,
+	index string,
+	query elastic.Query,
+	script *elastic.Script,
+	maxDocs int,
+) (*elastic.BulkIndexByScrollResponse, error) {
+	return c.esClient.UpdateByQuery(index).
+		Query(query).
+		Script(script).
+		MaxDocs(maxDocs).
+		ProceedOnVersionConflict().
+		Refresh("true").
+		Do(ctx)
+}
+
 func (c *clientImpl) RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (BulkProcessor, error) {
 	esBulkProcessor, err := c.esClient.BulkProcessor().
 		Name(p.Name).
@@ -347,6 +336,52 @@ func (c *clientImpl) Delete(ctx context.Context, indexName string, docID string,
 	return err
 }
 
+func buildSearchSource(p *SearchParameters) *elastic.SearchSource {
+	searchSource := elastic.NewSearchSource().
+		Query(p.Query).
+		SortBy(p.Sorter...)
+
+	if p.PointInTime != nil {
+		searchSource.PointInTime(p.PointInTime)
+	}
+
+	if p.PageSize != 0 {
+		searchSource.Size(p.PageSize)
+	}
+
+	if len(p.SearchAfter) != 0 {
+		searchSource.SearchAfter(p.SearchAfter...)
+	}
+
+	if p.TrackTotalHits {
+		searchSource.TrackTotalHits(true)
+	}
+
+	for name, aggregation := range p.Aggregations {
+		searchSource.Aggregation(name, aggregation)
+	}
+	return searchSource
+}
+
+// startCloseIdleConnections periodically closes idle connections of httpClient (if configured).
+func startCloseIdleConnections(cfg *Config, httpClient *http.Client) {
+	if cfg.CloseIdleConnectionsInterval == time.Duration(0) {
+		return
+	}
+	if cfg.CloseIdleConnectionsInterval < minimumCloseIdleConnectionsInterval {
+		cfg.CloseIdleConnectionsInterval = minimumCloseIdleConnectionsInterval
+	}
+	go func(interval time.Duration, httpClient *http.Client) {
+		closeTimer := time.NewTimer(interval)
+		defer closeTimer.Stop()
+		for {
+			<-closeTimer.C
+			closeTimer.Reset(interval)
+			httpClient.CloseIdleConnections()
+		}
+	}(cfg.CloseIdleConnectionsInterval, httpClient)
+}
+
 func getLoggerOptions(logLevel string, logger log.Logger) []elastic.ClientOptionFunc {
 	switch {
 	case strings.EqualFold(logLevel, "trace"):
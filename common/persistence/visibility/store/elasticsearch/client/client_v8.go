@@ -0,0 +1,535 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/elastic/elastic-transport-go/v8/elastictransport"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+	"github.com/olivere/elastic/v7"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	// clientV8 implements Client for Elasticsearch 8 and OpenSearch 2 using go-elasticsearch v8 API.
+	// Queries, sorters and aggregations are still built with olivere/elastic types and responses are decoded
+	// into olivere/elastic result types, because they are part of Client interface and are plain JSON.
+	// esapi requests are performed by the transport directly: elasticsearch.Client refuses to talk to
+	// servers which don't identify themselves as Elasticsearch and therefore can't be used with OpenSearch.
+	clientV8 struct {
+		transport    *elastictransport.Client
+		distribution string
+
+		initIsPointInTimeSupported sync.Once
+		isPointInTimeSupported     bool
+	}
+
+	// openSearchRequest is used for OpenSearch specific APIs which are not part of esapi.
+	openSearchRequest struct {
+		method string
+		path   string
+		params url.Values
+		body   io.Reader
+	}
+
+	acknowledgedResponse struct {
+		Acknowledged bool `json:"acknowledged"`
+	}
+
+	infoResponse struct {
+		Version struct {
+			Number       string `json:"number"`
+			Distribution string `json:"distribution"`
+		} `json:"version"`
+	}
+
+	openPointInTimeResponse struct {
+		ID string `json:"id"`
+	}
+
+	closePointInTimeResponse struct {
+		Succeeded bool `json:"succeeded"`
+	}
+
+	openSearchOpenPointInTimeResponse struct {
+		PitID string `json:"pit_id"`
+	}
+
+	openSearchClosePointInTimeResponse struct {
+		Pits []struct {
+			PitID      string `json:"pit_id"`
+			Successful bool   `json:"successful"`
+		} `json:"pits"`
+	}
+)
+
+const (
+	distributionElasticsearch = "elasticsearch"
+	distributionOpenSearch    = "opensearch"
+
+	// Same as olivere/elastic default sniffer interval.
+	discoverNodesIntervalV8 = 15 * time.Minute
+	minRetryBackoffV8       = 128 * time.Millisecond
+	maxRetryBackoffV8       = 513 * time.Millisecond
+)
+
+var (
+	pointInTimeSupportedInElasticsearchV8 = semver.MustParseRange(">=8.0.0")
+	pointInTimeSupportedInOpenSearch      = semver.MustParseRange(">=2.4.0")
+)
+
+var _ Client = (*clientV8)(nil)
+var _ CLIClient = (*clientV8)(nil)
+var _ IntegrationTestsClient = (*clientV8)(nil)
+
+// newClientV8 create a client for Elasticsearch 8 or OpenSearch 2 (depending on distribution).
+func newClientV8(cfg *Config, httpClient *http.Client, logger log.Logger, distribution string) (*clientV8, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	startCloseIdleConnections(cfg, httpClient)
+
+	// Pass transport explicitly, otherwise elastictransport clones the default one
+	// and idle connections of httpClient are never closed.
+	httpTransport := httpClient.Transport
+	if httpTransport == nil {
+		httpTransport = http.DefaultTransport
+	}
+
+	esURL := cfg.URL
+	transportConfig := elastictransport.Config{
+		URLs:         []*url.URL{&esURL},
+		Username:     cfg.Username,
+		Password:     cfg.Password,
+		Transport:    httpTransport,
+		RetryBackoff: retryBackoffV8,
+		Logger:       newTransportLogger(cfg.LogLevel, logger),
+	}
+	// Dead nodes are resurrected by the transport itself, therefore cfg.EnableHealthcheck is not used.
+	if cfg.EnableSniff {
+		transportConfig.DiscoverNodesInterval = discoverNodesIntervalV8
+	}
+
+	transport, err := elastictransport.New(transportConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clientV8{
+		transport:    transport,
+		distribution: distribution,
+	}, nil
+}
+
+func (c *clientV8) Get(ctx context.Context, index string, docID string) (*elastic.GetResult, error) {
+	var result elastic.GetResult
+	if err := c.do(ctx, esapi.GetRequest{Index: index, DocumentID: docID}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *clientV8) Search(ctx context.Context, p *SearchParameters) (*elastic.SearchResult, error) {
+	body, err := jsonBody(buildSearchSource(p))
+	if err != nil {
+		return nil, err
+	}
+
+	request := esapi.SearchRequest{Body: body}
+	// When pit.id is specified index must not be used.
+	if p.PointInTime == nil {
+		request.Index = []string{p.Index}
+	}
+
+	var result elastic.SearchResult
+	if err := c.do(ctx, request, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *clientV8) Count(ctx context.Context, index string, query elastic.Query) (int64, error) {
+	request := esapi.CountRequest{Index: []string{index}}
+	if query != nil {
+		body, err := jsonBody(elastic.NewSearchSource().Query(query))
+		if err != nil {
+			return 0, err
+		}
+		request.Body = body
+	}
+
+	var result struct {
+		Count int64 `json:"count"`
+	}
+	if err := c.do(ctx, request, &result); err != nil {
+		return 0, err
+	}
+	return result.Count, nil
+}
+
+func (c *clientV8) RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (BulkProcessor, error) {
+	return newBulkProcessorV8(ctx, c.transport, p), nil
+}
+
+// OpenScroll returns io.EOF as error if there are no more search results (same as olivere/elastic scroll service).
+func (c *clientV8) OpenScroll(ctx context.Context, p *SearchParameters, keepAliveInterval string) (*elastic.SearchResult, error) {
+	keepAlive, err := time.ParseDuration(keepAliveInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	searchSource := elastic.NewSearchSource().
+		Query(p.Query).
+		SortBy(p.Sorter...)
+	if p.PageSize != 0 {
+		searchSource.Size(p.PageSize)
+	}
+	body, err := jsonBody(searchSource)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scroll(ctx, esapi.SearchRequest{
+		Index:  []string{p.Index},
+		Body:   body,
+		Scroll: keepAlive,
+	})
+}
+
+// Scroll returns io.EOF as error if there are no more search results (same as olivere/elastic scroll service).
+func (c *clientV8) Scroll(ctx context.Context, scrollID string, keepAliveInterval string) (*elastic.SearchResult, error) {
+	body, err := jsonBody(map[string]interface{}{
+		"scroll":    keepAliveInterval,
+		"scroll_id": scrollID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.scroll(ctx, esapi.ScrollRequest{Body: body})
+}
+
+func (c *clientV8) scroll(ctx context.Context, request esapi.Request) (*elastic.SearchResult, error) {
+	var result elastic.SearchResult
+	if err := c.do(ctx, request, &result); err != nil {
+		return nil, err
+	}
+	if result.Hits == nil || len(result.Hits.Hits) == 0 {
+		return &result, io.EOF
+	}
+	return &result, nil
+}
+
+func (c *clientV8) CloseScroll(ctx context.Context, id string) error {
+	body, err := jsonBody(map[string][]string{"scroll_id": {id}})
+	if err != nil {
+		return err
+	}
+	return c.do(ctx, esapi.ClearScrollRequest{Body: body}, nil)
+}
+
+func (c *clientV8) PutMapping(ctx context.Context, index string, mapping map[string]enumspb.IndexedValueType) (bool, error) {
+	body, err := jsonBody(buildMappingBody(mapping))
+	if err != nil {
+		return false, err
+	}
+	return c.doAcknowledged(ctx, esapi.IndicesPutMappingRequest{Index: []string{index}, Body: body})
+}
+
+func (c *clientV8) WaitForYellowStatus(ctx context.Context, index string) (string, error) {
+	var result struct {
+		Status string `json:"status"`
+	}
+	if err := c.do(ctx, esapi.ClusterHealthRequest{Index: []string{index}, WaitForStatus: "yellow"}, &result); err != nil {
+		return "", err
+	}
+	return result.Status, nil
+}
+
+func (c *clientV8) GetMapping(ctx context.Context, index string) (map[string]string, error) {
+	var body map[string]interface{}
+	if err := c.do(ctx, esapi.IndicesGetMappingRequest{Index: []string{index}}, &body); err != nil {
+		return nil, err
+	}
+	return convertMappingBody(body, index), nil
+}
+
+// UpdateByQuery runs script for at most maxDocs documents matching the query.
+// Documents updated concurrently are skipped and left for the next call.
+func (c *clientV8) UpdateByQuery(
+	ctx context.Context,
+	index string,
+	query elastic.Query,
+	script *elastic.Script,
+	maxDocs int,
+) (*elastic.BulkIndexByScrollResponse, error) {
+	querySource, err := query.Source()
+	if err != nil {
+		return nil, err
+	}
+	scriptSource, err := script.Source()
+	if err != nil {
+		return nil, err
+	}
+	body, err := jsonBody(map[string]interface{}{
+		"query":  querySource,
+		"script": scriptSource,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	refresh := true
+	var result elastic.BulkIndexByScrollResponse
+	if err := c.do(ctx, esapi.UpdateByQueryRequest{
+		Index:     []string{index},
+		Body:      body,
+		MaxDocs:   &maxDocs,
+		Conflicts: "proceed",
+		Refresh:   &refresh,
+	}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *clientV8) IsPointInTimeSupported(ctx context.Context) bool {
+	c.initIsPointInTimeSupported.Do(func() {
+		c.isPointInTimeSupported = c.queryPointInTimeSupported(ctx)
+	})
+	return c.isPointInTimeSupported
+}
+
+func (c *clientV8) queryPointInTimeSupported(ctx context.Context) bool {
+	var result infoResponse
+	if err := c.do(ctx, esapi.InfoRequest{}, &result); err != nil {
+		return false
+	}
+	version, err := semver.ParseTolerant(result.Version.Number)
+	if err != nil {
+		return false
+	}
+	if c.distribution == distributionOpenSearch {
+		return pointInTimeSupportedInOpenSearch(version)
+	}
+	return pointInTimeSupportedInElasticsearchV8(version)
+}
+
+func (c *clientV8) OpenPointInTime(ctx context.Context, index string, keepAliveInterval string) (string, error) {
+	if c.distribution == distributionOpenSearch {
+		var result openSearchOpenPointInTimeResponse
+		if err := c.do(ctx, openSearchRequest{
+			method: http.MethodPost,
+			path:   "/" + url.PathEscape(index) + "/_search/point_in_time",
+			params: url.Values{"keep_alive": []string{keepAliveInterval}},
+		}, &result); err != nil {
+			return "", err
+		}
+		return result.PitID, nil
+	}
+
+	var result openPointInTimeResponse
+	if err := c.do(ctx, esapi.OpenPointInTimeRequest{Index: []string{index}, KeepAlive: keepAliveInterval}, &result); err != nil {
+		return "", err
+	}
+	return result.ID, nil
+}
+
+func (c *clientV8) ClosePointInTime(ctx context.Context, id string) (bool, error) {
+	if c.distribution == distributionOpenSearch {
+		body, err := jsonBody(map[string][]string{"pit_id": {id}})
+		if err != nil {
+			return false, err
+		}
+		var result openSearchClosePointInTimeResponse
+		if err := c.do(ctx, openSearchRequest{
+			method: http.MethodDelete,
+			path:   "/_search/point_in_time",
+			body:   body,
+		}, &result); err != nil {
+			return false, err
+		}
+		for _, pit := range result.Pits {
+			if pit.PitID == id {
+				return pit.Successful, nil
+			}
+		}
+		return false, nil
+	}
+
+	body, err := jsonBody(map[string]string{"id": id})
+	if err != nil {
+		return false, err
+	}
+	var result closePointInTimeResponse
+	if err := c.do(ctx, esapi.ClosePointInTimeRequest{Body: body}, &result); err != nil {
+		return false, err
+	}
+	return result.Succeeded, nil
+}
+
+func (c *clientV8) Delete(ctx context.Context, indexName string, docID string, version int64) error {
+	esVersion := int(version)
+	return c.do(ctx, esapi.DeleteRequest{
+		Index:       indexName,
+		DocumentID:  docID,
+		Version:     &esVersion,
+		VersionType: versionTypeExternal,
+	}, nil)
+}
+
+func (c *clientV8) CreateIndex(ctx context.Context, index string) (bool, error) {
+	return c.doAcknowledged(ctx, esapi.IndicesCreateRequest{Index: index})
+}
+
+// IndexPutTemplate puts composable index template (legacy templates are deprecated in Elasticsearch 8).
+func (c *clientV8) IndexPutTemplate(ctx context.Context, templateName string, bodyString string) (bool, error) {
+	return c.doAcknowledged(ctx, esapi.IndicesPutIndexTemplateRequest{Name: templateName, Body: strings.NewReader(bodyString)})
+}
+
+func (c *clientV8) IndexExists(ctx context.Context, indexName string) (bool, error) {
+	res, err := esapi.IndicesExistsRequest{Index: []string{indexName}}.Do(ctx, c.transport)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, newResponseError(res)
+	}
+}
+
+func (c *clientV8) DeleteIndex(ctx context.Context, indexName string) (bool, error) {
+	return c.doAcknowledged(ctx, esapi.IndicesDeleteRequest{Index: []string{indexName}})
+}
+
+func (c *clientV8) IndexPutSettings(ctx context.Context, indexName string, bodyString string) (bool, error) {
+	return c.doAcknowledged(ctx, esapi.IndicesPutSettingsRequest{Index: []string{indexName}, Body: strings.NewReader(bodyString)})
+}
+
+func (c *clientV8) IndexGetSettings(ctx context.Context, indexName string) (map[string]*elastic.IndicesGetSettingsResponse, error) {
+	var result map[string]*elastic.IndicesGetSettingsResponse
+	if err := c.do(ctx, esapi.IndicesGetSettingsRequest{Index: []string{indexName}}, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *clientV8) doAcknowledged(ctx context.Context, request esapi.Request) (bool, error) {
+	var result acknowledgedResponse
+	if err := c.do(ctx, request, &result); err != nil {
+		return false, err
+	}
+	return result.Acknowledged, nil
+}
+
+// do performs request and decodes response body into result (if not nil).
+// Responses with non 2xx status codes are returned as *elastic.Error, same as olivere/elastic does,
+// because callers check error status codes.
+func (c *clientV8) do(ctx context.Context, request esapi.Request, result interface{}) error {
+	res, err := request.Do(ctx, c.transport)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.IsError() {
+		return newResponseError(res)
+	}
+	if result == nil {
+		return nil
+	}
+	return decodeJSON(res.Body, result)
+}
+
+func (r openSearchRequest) Do(ctx context.Context, transport esapi.Transport) (*esapi.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, r.method, r.path, r.body)
+	if err != nil {
+		return nil, err
+	}
+	if len(r.params) > 0 {
+		req.URL.RawQuery = r.params.Encode()
+	}
+	if r.body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, err
+	}
+	return &esapi.Response{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		Body:       res.Body,
+	}, nil
+}
+
+func newResponseError(res *esapi.Response) error {
+	esErr := &elastic.Error{}
+	if err := json.NewDecoder(res.Body).Decode(esErr); err != nil || esErr.Status == 0 {
+		esErr.Status = res.StatusCode
+	}
+	return esErr
+}
+
+// decodeJSON uses json.Number for numbers to not lose precision of int64 values (same as elastic.NumberDecoder).
+func decodeJSON(body io.Reader, result interface{}) error {
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	return decoder.Decode(result)
+}
+
+func jsonBody(source interface{}) (io.Reader, error) {
+	body, err := json.Marshal(source)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(body), nil
+}
+
+func retryBackoffV8(attempt int) time.Duration {
+	backoff := minRetryBackoffV8 << (attempt - 1)
+	if backoff <= 0 || backoff > maxRetryBackoffV8 {
+		return maxRetryBackoffV8
+	}
+	return backoff
+}
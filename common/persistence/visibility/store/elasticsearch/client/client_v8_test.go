@@ -0,0 +1,239 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/log"
+)
+
+func newTestClientV8(t *testing.T, distribution string, handler http.HandlerFunc) *clientV8 {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	client, err := newClientV8(&Config{URL: *serverURL}, server.Client(), log.NewNoopLogger(), distribution)
+	require.NoError(t, err)
+	return client
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(t, json.NewEncoder(w).Encode(body))
+}
+
+func Test_ClientV8_IsPointInTimeSupported(t *testing.T) {
+	tests := []struct {
+		distribution string
+		version      string
+		expected     bool
+	}{
+		{distribution: distributionElasticsearch, version: "8.6.2", expected: true},
+		{distribution: distributionOpenSearch, version: "2.3.0", expected: false},
+		{distribution: distributionOpenSearch, version: "2.4.0", expected: true},
+		{distribution: distributionOpenSearch, version: "2.11.1", expected: true},
+	}
+
+	for _, test := range tests {
+		client := newTestClientV8(t, test.distribution, func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(t, w, map[string]interface{}{
+				"version": map[string]interface{}{
+					"distribution": test.distribution,
+					"number":       test.version,
+				},
+			})
+		})
+		require.Equal(t, test.expected, client.IsPointInTimeSupported(context.Background()), test.version)
+	}
+}
+
+func Test_ClientV8_OpenSearchPointInTime(t *testing.T) {
+	client := newTestClientV8(t, distributionOpenSearch, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/test-index/_search/point_in_time":
+			require.Equal(t, "1m", r.URL.Query().Get("keep_alive"))
+			writeJSON(t, w, map[string]interface{}{"pit_id": "pit-id"})
+		case r.Method == http.MethodDelete && r.URL.Path == "/_search/point_in_time":
+			var body map[string][]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.Equal(t, []string{"pit-id"}, body["pit_id"])
+			writeJSON(t, w, map[string]interface{}{
+				"pits": []map[string]interface{}{{"pit_id": "pit-id", "successful": true}},
+			})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	pitID, err := client.OpenPointInTime(context.Background(), "test-index", "1m")
+	require.NoError(t, err)
+	require.Equal(t, "pit-id", pitID)
+
+	closed, err := client.ClosePointInTime(context.Background(), pitID)
+	require.NoError(t, err)
+	require.True(t, closed)
+}
+
+func Test_ClientV8_IndexPutTemplate(t *testing.T) {
+	client := newTestClientV8(t, distributionElasticsearch, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+		require.Equal(t, "/_index_template/test-template", r.URL.Path)
+		writeJSON(t, w, map[string]interface{}{"acknowledged": true})
+	})
+
+	acknowledged, err := client.IndexPutTemplate(context.Background(), "test-template", `{"index_patterns":["test*"]}`)
+	require.NoError(t, err)
+	require.True(t, acknowledged)
+}
+
+func Test_ClientV8_ElasticsearchPointInTime(t *testing.T) {
+	client := newTestClientV8(t, distributionElasticsearch, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/test-index/_pit":
+			require.Equal(t, "1m", r.URL.Query().Get("keep_alive"))
+			writeJSON(t, w, map[string]interface{}{"id": "pit-id"})
+		case r.Method == http.MethodDelete && r.URL.Path == "/_pit":
+			var body map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.Equal(t, "pit-id", body["id"])
+			writeJSON(t, w, map[string]interface{}{"succeeded": true, "num_freed": 1})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	pitID, err := client.OpenPointInTime(context.Background(), "test-index", "1m")
+	require.NoError(t, err)
+	require.Equal(t, "pit-id", pitID)
+
+	closed, err := client.ClosePointInTime(context.Background(), pitID)
+	require.NoError(t, err)
+	require.True(t, closed)
+}
+
+func Test_ClientV8_Search(t *testing.T) {
+	client := newTestClientV8(t, distributionElasticsearch, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/test-index/_search", r.URL.Path)
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, true, body["track_total_hits"])
+		require.EqualValues(t, 10, body["size"])
+		require.Equal(t, map[string]interface{}{"term": map[string]interface{}{"WorkflowId": "wid"}}, body["query"])
+		writeJSON(t, w, map[string]interface{}{
+			"hits": map[string]interface{}{
+				"total": map[string]interface{}{"value": 12345, "relation": "eq"},
+				"hits": []map[string]interface{}{
+					{"_id": "doc-id", "_source": map[string]interface{}{"WorkflowId": "wid"}, "sort": []interface{}{int64(1675205712345678901)}},
+				},
+			},
+		})
+	})
+
+	result, err := client.Search(context.Background(), &SearchParameters{
+		Index:          "test-index",
+		Query:          elastic.NewTermQuery("WorkflowId", "wid"),
+		PageSize:       10,
+		TrackTotalHits: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(12345), result.TotalHits())
+	require.Len(t, result.Hits.Hits, 1)
+	require.Equal(t, "doc-id", result.Hits.Hits[0].Id)
+	// Sort values must not lose int64 precision.
+	require.Equal(t, json.Number("1675205712345678901"), result.Hits.Hits[0].Sort[0])
+}
+
+func Test_ClientV8_Scroll(t *testing.T) {
+	client := newTestClientV8(t, distributionElasticsearch, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/test-index/_search":
+			require.Equal(t, "60000ms", r.URL.Query().Get("scroll"))
+			writeJSON(t, w, map[string]interface{}{
+				"_scroll_id": "scroll-id",
+				"hits":       map[string]interface{}{"hits": []map[string]interface{}{{"_id": "doc-id"}}},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/_search/scroll":
+			var body map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.Equal(t, map[string]string{"scroll": "1m", "scroll_id": "scroll-id"}, body)
+			writeJSON(t, w, map[string]interface{}{
+				"_scroll_id": "scroll-id",
+				"hits":       map[string]interface{}{"hits": []map[string]interface{}{}},
+			})
+		case r.Method == http.MethodDelete && r.URL.Path == "/_search/scroll":
+			var body map[string][]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.Equal(t, []string{"scroll-id"}, body["scroll_id"])
+			writeJSON(t, w, map[string]interface{}{"succeeded": true})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	result, err := client.OpenScroll(context.Background(), &SearchParameters{Index: "test-index"}, "1m")
+	require.NoError(t, err)
+	require.Equal(t, "scroll-id", result.ScrollId)
+
+	result, err = client.Scroll(context.Background(), result.ScrollId, "1m")
+	require.ErrorIs(t, err, io.EOF)
+	require.Equal(t, "scroll-id", result.ScrollId)
+
+	require.NoError(t, client.CloseScroll(context.Background(), result.ScrollId))
+}
+
+func Test_ClientV8_ErrorResponse(t *testing.T) {
+	client := newTestClientV8(t, distributionElasticsearch, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		writeJSON(t, w, map[string]interface{}{
+			"error":  map[string]interface{}{"type": "index_not_found_exception", "reason": "no such index [test-index]"},
+			"status": http.StatusNotFound,
+		})
+	})
+
+	_, err := client.Get(context.Background(), "test-index", "doc-id")
+	var esErr *elastic.Error
+	require.ErrorAs(t, err, &esErr)
+	require.Equal(t, http.StatusNotFound, esErr.Status)
+	require.Equal(t, "index_not_found_exception", esErr.Details.Type)
+
+	exists, err := client.IndexExists(context.Background(), "test-index")
+	require.NoError(t, err)
+	require.False(t, exists)
+}
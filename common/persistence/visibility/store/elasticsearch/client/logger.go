@@ -26,6 +26,12 @@ package client
 
 import (
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/elastic/elastic-transport-go/v8/elastictransport"
 
 	"go.temporal.io/server/common/log"
 )
@@ -38,8 +44,19 @@ type (
 	infoLogger struct {
 		log.Logger
 	}
+
+	// transportLogger logs round trips of the v8 client transport.
+	// Failed round trips are always logged, successful ones only with "info" or "trace" level,
+	// request and response bodies only with "trace" level.
+	transportLogger struct {
+		log.Logger
+		logRoundTrips bool
+		logBodies     bool
+	}
 )
 
+var _ elastictransport.Logger = (*transportLogger)(nil)
+
 func newErrorLogger(logger log.Logger) *errorLogger {
 	return &errorLogger{logger}
 }
@@ -55,3 +72,50 @@ func newInfoLogger(logger log.Logger) *infoLogger {
 func (l *infoLogger) Printf(format string, v ...interface{}) {
 	l.Info(fmt.Sprintf(format, v...))
 }
+
+func newTransportLogger(logLevel string, logger log.Logger) elastictransport.Logger {
+	switch {
+	case strings.EqualFold(logLevel, "trace"):
+		return &transportLogger{Logger: logger, logRoundTrips: true, logBodies: true}
+	case strings.EqualFold(logLevel, "info"):
+		return &transportLogger{Logger: logger, logRoundTrips: true}
+	case strings.EqualFold(logLevel, "error"), logLevel == "": // Default is to log errors only.
+		return &transportLogger{Logger: logger}
+	default:
+		return nil
+	}
+}
+
+func (l *transportLogger) LogRoundTrip(req *http.Request, res *http.Response, err error, _ time.Time, duration time.Duration) error {
+	if err != nil {
+		l.Error(fmt.Sprintf("%s %s failed after %v: %v", req.Method, req.URL, duration, err))
+		return nil
+	}
+	if !l.logRoundTrips {
+		return nil
+	}
+	var statusCode int
+	if res != nil {
+		statusCode = res.StatusCode
+	}
+	l.Info(fmt.Sprintf("%s %s [status:%d, request:%v]", req.Method, req.URL, statusCode, duration))
+	if l.logBodies {
+		if req.Body != nil {
+			body, _ := io.ReadAll(req.Body)
+			l.Info(fmt.Sprintf("Request body: %s", body))
+		}
+		if res != nil && res.Body != nil {
+			body, _ := io.ReadAll(res.Body)
+			l.Info(fmt.Sprintf("Response body: %s", body))
+		}
+	}
+	return nil
+}
+
+func (l *transportLogger) RequestBodyEnabled() bool {
+	return l.logBodies
+}
+
+func (l *transportLogger) ResponseBodyEnabled() bool {
+	return l.logBodies
+}
@@ -112,6 +112,43 @@ var supportedWhereOrderCases = map[string]struct {
 	},
 }
 
+var supportedWhereGroupByCases = map[string]struct {
+	query   string
+	groupBy []string
+}{
+	"id > 1 group by status": {
+		query:   `{"bool":{"filter":{"range":{"id":{"from":1,"include_lower":false,"include_upper":true,"to":null}}}}}`,
+		groupBy: []string{"status"},
+	},
+	"id is null GROUP BY status, `type`": {
+		query:   `{"bool":{"must_not":{"exists":{"field":"id"}}}}`,
+		groupBy: []string{"status", "type"},
+	},
+	"group by status": {
+		query:   `null`,
+		groupBy: []string{"status"},
+	},
+	"id = 1": {
+		query:   `{"bool":{"filter":{"match":{"id":{"query":1}}}}}`,
+		groupBy: nil,
+	},
+	"id = 1 order by id desc": {
+		query:   `{"bool":{"filter":{"match":{"id":{"query":1}}}}}`,
+		groupBy: nil,
+	},
+	"order by id": {
+		query:   `null`,
+		groupBy: nil,
+	},
+}
+
+var groupByErrorCases = map[string]string{
+	"id > 1 group by status order by id": query.NotSupportedErrMessage,
+	"group by status having count > 1":   query.NotSupportedErrMessage,
+	"id > 1 group by status limit 1":     query.NotSupportedErrMessage,
+	"group by":                           query.MalformedSqlQueryErrMessage,
+}
+
 func TestSupportedSelectWhere(t *testing.T) {
 	c := newQueryConverter(nil, nil)
 
@@ -171,3 +208,31 @@ func TestErrors(t *testing.T) {
 		assert.Contains(t, err.Error(), expectedErrMessage, sql)
 	}
 }
+
+func TestSupportedSelectWhereGroupBy(t *testing.T) {
+	c := newQueryConverter(nil, nil)
+
+	for sql, expected := range supportedWhereGroupByCases {
+		query, groupBy, err := c.ConvertWhereGroupBy(sql)
+		assert.NoError(t, err)
+
+		var actualQueryJson []byte
+		if query != nil {
+			actualQueryMap, _ := query.Source()
+			actualQueryJson, _ = json.Marshal(actualQueryMap)
+		} else {
+			actualQueryJson, _ = json.Marshal(nil)
+		}
+		assert.Equal(t, expected.query, string(actualQueryJson), fmt.Sprintf("sql: %s", sql))
+		assert.Equal(t, expected.groupBy, groupBy, fmt.Sprintf("sql: %s", sql))
+	}
+}
+
+func TestGroupByErrors(t *testing.T) {
+	c := newQueryConverter(nil, nil)
+	for sql, expectedErrMessage := range groupByErrorCases {
+		_, _, err := c.ConvertWhereGroupBy(sql)
+		assert.Error(t, err, sql)
+		assert.Contains(t, err.Error(), expectedErrMessage, sql)
+	}
+}
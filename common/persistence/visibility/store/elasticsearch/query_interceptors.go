@@ -92,6 +92,12 @@ func (ni *nameInterceptor) Name(name string, usage query.FieldNameUsage) (string
 		}
 	}
 
+	if usage == query.FieldNameGroupBy {
+		if fieldType != enumspb.INDEXED_VALUE_TYPE_KEYWORD && fieldType != enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST {
+			return "", query.NewConverterError("unable to group by field of %s type, use field of type %s", fieldType.String(), enumspb.INDEXED_VALUE_TYPE_KEYWORD.String())
+		}
+	}
+
 	if fieldName == searchattribute.TemporalNamespaceDivision && usage == query.FieldNameFilter {
 		ni.seenNamespaceDivision = true
 	}
@@ -55,6 +55,9 @@ const (
 	PersistenceName = "elasticsearch"
 
 	delimiter                    = "~"
+	historySizeBytesAggName      = "historySizeBytes"
+	groupByAggName               = "groupBy"
+	groupByMaxBuckets            = 1000
 	pointInTimeKeepAliveInterval = "1m"
 	scrollKeepAliveInterval      = "1m"
 )
@@ -591,17 +594,126 @@ func (s *visibilityStore) CountWorkflowExecutions(
 	ctx context.Context,
 	request *manager.CountWorkflowExecutionsRequest,
 ) (*manager.CountWorkflowExecutionsResponse, error) {
-	boolQuery, _, err := s.convertQuery(request.Namespace, request.NamespaceID, request.Query)
+	boolQuery, groupBy, err := s.convertCountQuery(request.Namespace, request.NamespaceID, request.Query)
 	if err != nil {
 		return nil, err
 	}
 
-	count, err := s.esClient.Count(ctx, s.index, boolQuery)
+	if len(groupBy) == 0 {
+		count, err := s.esClient.Count(ctx, s.index, boolQuery)
+		if err != nil {
+			return nil, convertElasticsearchClientError("CountWorkflowExecutions failed", err)
+		}
+		return &manager.CountWorkflowExecutionsResponse{Count: count}, nil
+	}
+
+	// Nested terms aggregations: one level per group by field. The bucket size of each level is
+	// chosen so that the total number of leaf buckets doesn't exceed groupByMaxBuckets.
+	bucketsPerLevel := groupByBucketsPerLevel(len(groupBy))
+	var groupByAgg *elastic.TermsAggregation
+	for i := len(groupBy) - 1; i >= 0; i-- {
+		agg := elastic.NewTermsAggregation().Field(groupBy[i]).Size(bucketsPerLevel)
+		if groupByAgg != nil {
+			agg.SubAggregation(groupByAggName, groupByAgg)
+		}
+		groupByAgg = agg
+	}
+
+	searchResult, err := s.esClient.Search(ctx, &client.SearchParameters{
+		Index: s.index,
+		Query: boolQuery,
+		// Size 0 is not supported by SearchParameters, only one document is returned along with the aggregation.
+		PageSize:       1,
+		TrackTotalHits: true,
+		Aggregations: map[string]elastic.Aggregation{
+			groupByAggName: groupByAgg,
+		},
+	})
 	if err != nil {
 		return nil, convertElasticsearchClientError("CountWorkflowExecutions failed", err)
 	}
 
-	response := &manager.CountWorkflowExecutionsResponse{Count: count}
+	response := &manager.CountWorkflowExecutionsResponse{Count: searchResult.TotalHits()}
+	response.Groups, response.OtherCount = parseGroupByAggregation(searchResult.Aggregations, nil)
+	return response, nil
+}
+
+// groupByBucketsPerLevel returns the largest bucket size which keeps the product of the
+// bucket sizes of all levels within groupByMaxBuckets.
+func groupByBucketsPerLevel(levels int) int {
+	size := 1
+	for {
+		total := 1
+		for i := 0; i < levels; i++ {
+			total *= size + 1
+		}
+		if total > groupByMaxBuckets {
+			return size
+		}
+		size++
+	}
+}
+
+// parseGroupByAggregation flattens nested terms aggregation buckets into groups.
+// Only leaf buckets are returned, each with values of all group by fields. It also returns
+// the number of documents which fell out of the returned buckets at any level.
+func parseGroupByAggregation(aggs elastic.Aggregations, parentValues []string) ([]manager.AggregationGroup, int64) {
+	terms, ok := aggs.Terms(groupByAggName)
+	if !ok {
+		return nil, 0
+	}
+
+	var groups []manager.AggregationGroup
+	otherCount := terms.SumOfOtherDocCount
+	for _, bucket := range terms.Buckets {
+		value := fmt.Sprint(bucket.Key)
+		if bucket.KeyAsString != nil {
+			value = *bucket.KeyAsString
+		}
+		values := make([]string, len(parentValues), len(parentValues)+1)
+		copy(values, parentValues)
+		values = append(values, value)
+
+		if _, isNested := bucket.Aggregations.Terms(groupByAggName); isNested {
+			nestedGroups, nestedOtherCount := parseGroupByAggregation(bucket.Aggregations, values)
+			groups = append(groups, nestedGroups...)
+			otherCount += nestedOtherCount
+			continue
+		}
+		groups = append(groups, manager.AggregationGroup{
+			GroupValues: values,
+			Count:       bucket.DocCount,
+		})
+	}
+	return groups, otherCount
+}
+
+func (s *visibilityStore) SumHistorySizeBytes(
+	ctx context.Context,
+	request *manager.SumHistorySizeBytesRequest,
+) (*manager.SumHistorySizeBytesResponse, error) {
+	boolQuery, _, err := s.convertQuery(request.Namespace, request.NamespaceID, request.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	searchResult, err := s.esClient.Search(ctx, &client.SearchParameters{
+		Index: s.index,
+		Query: boolQuery,
+		// Size 0 is not supported by SearchParameters, only one document is returned along with the aggregation.
+		PageSize: 1,
+		Aggregations: map[string]elastic.Aggregation{
+			historySizeBytesAggName: elastic.NewSumAggregation().Field(searchattribute.HistorySizeBytes),
+		},
+	})
+	if err != nil {
+		return nil, convertElasticsearchClientError("SumHistorySizeBytes failed", err)
+	}
+
+	response := &manager.SumHistorySizeBytesResponse{}
+	if sum, ok := searchResult.Aggregations.Sum(historySizeBytesAggName); ok && sum.Value != nil {
+		response.HistorySizeBytes = int64(*sum.Value)
+	}
 	return response, nil
 }
 
@@ -726,28 +838,67 @@ func (s *visibilityStore) convertQuery(
 	namespaceID namespace.ID,
 	requestQueryStr string,
 ) (*elastic.BoolQuery, []*elastic.FieldSort, error) {
-	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
+	nameInterceptor, err := s.newNameInterceptor(namespace)
 	if err != nil {
-		return nil, nil, serviceerror.NewUnavailable(fmt.Sprintf("Unable to read search attribute types: %v", err))
+		return nil, nil, err
 	}
-	nameInterceptor := newNameInterceptor(namespace, s.index, saTypeMap, s.searchAttributesMapperProvider)
 	queryConverter := newQueryConverter(nameInterceptor, NewValuesInterceptor())
 	requestQuery, fieldSorts, err := queryConverter.ConvertWhereOrderBy(requestQueryStr)
 	if err != nil {
-		// Convert ConverterError to InvalidArgument and pass through all other errors (which should be only mapper errors).
-		var converterErr *query.ConverterError
-		if errors.As(err, &converterErr) {
-			return nil, nil, converterErr.ToInvalidArgument()
-		}
+		return nil, nil, convertQueryConverterError(err)
+	}
+
+	return buildNamespaceFilterQuery(namespaceID, requestQuery, nameInterceptor.seenNamespaceDivision), fieldSorts, nil
+}
+
+// convertCountQuery is the same as convertQuery but supports GROUP BY clause and ignores ORDER BY.
+func (s *visibilityStore) convertCountQuery(
+	namespace namespace.Name,
+	namespaceID namespace.ID,
+	requestQueryStr string,
+) (*elastic.BoolQuery, []string, error) {
+	nameInterceptor, err := s.newNameInterceptor(namespace)
+	if err != nil {
 		return nil, nil, err
 	}
+	queryConverter := newQueryConverter(nameInterceptor, NewValuesInterceptor())
+	requestQuery, groupBy, err := queryConverter.ConvertWhereGroupBy(requestQueryStr)
+	if err != nil {
+		return nil, nil, convertQueryConverterError(err)
+	}
+
+	return buildNamespaceFilterQuery(namespaceID, requestQuery, nameInterceptor.seenNamespaceDivision), groupBy, nil
+}
+
+func (s *visibilityStore) newNameInterceptor(namespace namespace.Name) (*nameInterceptor, error) {
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("Unable to read search attribute types: %v", err))
+	}
+	return newNameInterceptor(namespace, s.index, saTypeMap, s.searchAttributesMapperProvider), nil
+}
 
+// convertQueryConverterError converts ConverterError to InvalidArgument and passes through
+// all other errors (which should be only mapper errors).
+func convertQueryConverterError(err error) error {
+	var converterErr *query.ConverterError
+	if errors.As(err, &converterErr) {
+		return converterErr.ToInvalidArgument()
+	}
+	return err
+}
+
+func buildNamespaceFilterQuery(
+	namespaceID namespace.ID,
+	requestQuery *elastic.BoolQuery,
+	seenNamespaceDivision bool,
+) *elastic.BoolQuery {
 	// Create new bool query because request query might have only "should" (="or") queries.
 	namespaceFilterQuery := elastic.NewBoolQuery().Filter(elastic.NewTermQuery(searchattribute.NamespaceID, namespaceID.String()))
 
 	// If the query did not explicitly filter on TemporalNamespaceDivision somehow, then add a
 	// "must not exist" (i.e. "is null") query for it.
-	if !nameInterceptor.seenNamespaceDivision {
+	if !seenNamespaceDivision {
 		namespaceFilterQuery.MustNot(elastic.NewExistsQuery(searchattribute.TemporalNamespaceDivision))
 	}
 
@@ -755,7 +906,7 @@ func (s *visibilityStore) convertQuery(
 		namespaceFilterQuery.Filter(requestQuery)
 	}
 
-	return namespaceFilterQuery, fieldSorts, nil
+	return namespaceFilterQuery
 }
 
 func (s *visibilityStore) setDefaultFieldSort(fieldSorts []*elastic.FieldSort) []elastic.Sorter {
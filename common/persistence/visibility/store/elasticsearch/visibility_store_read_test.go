@@ -1246,6 +1246,23 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions() {
 	s.True(ok)
 	s.Contains(err.Error(), "CountWorkflowExecutions failed")
 
+	// order by is ignored when counting
+	s.mockESClient.EXPECT().Count(gomock.Any(), testIndex, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, index string, query elastic.Query) (int64, error) {
+			s.Equal(
+				elastic.NewBoolQuery().Filter(
+					elastic.NewTermQuery(searchattribute.NamespaceID, testNamespaceID.String()),
+					elastic.NewBoolQuery().Filter(elastic.NewMatchQuery("ExecutionStatus", "Terminated")),
+				).MustNot(namespaceDivisionExists),
+				query,
+			)
+			return int64(1), nil
+		})
+	request.Query = `ExecutionStatus = "Terminated" order by StartTime desc`
+	resp, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.Equal(int64(1), resp.Count)
+
 	// test bad request
 	request.Query = `invalid query`
 	_, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
@@ -1255,6 +1272,106 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions() {
 	s.True(strings.HasPrefix(err.Error(), "invalid query"), err.Error())
 }
 
+func (s *ESVisibilitySuite) TestGroupByBucketsPerLevel() {
+	s.Equal(groupByMaxBuckets, groupByBucketsPerLevel(1))
+	s.Equal(31, groupByBucketsPerLevel(2))
+	s.Equal(10, groupByBucketsPerLevel(3))
+}
+
+func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
+	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, p *client.SearchParameters) (*elastic.SearchResult, error) {
+			s.Equal(
+				elastic.NewBoolQuery().Filter(
+					elastic.NewTermQuery(searchattribute.NamespaceID, testNamespaceID.String()),
+				).MustNot(namespaceDivisionExists),
+				p.Query,
+			)
+			s.True(p.TrackTotalHits)
+			// 31 * 31 buckets is the most which fits in groupByMaxBuckets
+			s.Equal(
+				map[string]elastic.Aggregation{
+					groupByAggName: elastic.NewTermsAggregation().Field(searchattribute.WorkflowType).Size(31).
+						SubAggregation(groupByAggName, elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).Size(31)),
+				},
+				p.Aggregations,
+			)
+			return &elastic.SearchResult{
+				Hits: &elastic.SearchHits{TotalHits: &elastic.TotalHits{Value: 10}},
+				Aggregations: elastic.Aggregations{
+					groupByAggName: json.RawMessage(`{"sum_other_doc_count":3,"buckets":[
+						{"key":"type1","doc_count":5,"groupBy":{"sum_other_doc_count":0,"buckets":[{"key":"Running","doc_count":3},{"key":"Completed","doc_count":2}]}},
+						{"key":"type2","doc_count":2,"groupBy":{"sum_other_doc_count":1,"buckets":[{"key":"Failed","doc_count":1}]}}
+					]}`),
+				},
+			}, nil
+		})
+
+	request := &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       `group by WorkflowType, ExecutionStatus`,
+	}
+	resp, err := s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.Equal(int64(10), resp.Count)
+	s.Equal([]manager.AggregationGroup{
+		{GroupValues: []string{"type1", "Running"}, Count: 3},
+		{GroupValues: []string{"type1", "Completed"}, Count: 2},
+		{GroupValues: []string{"type2", "Failed"}, Count: 1},
+	}, resp.Groups)
+	s.Equal(int64(4), resp.OtherCount)
+
+	// test group by field of unsupported type
+	request.Query = `group by CustomTextField`
+	_, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	s.Error(err)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.Equal("invalid query: unable to convert 'group by' column name: unable to group by field of Text type, use field of type Keyword", err.Error())
+
+	// test order by is not allowed together with group by
+	request.Query = `group by WorkflowType order by StartTime`
+	_, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	s.Error(err)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *ESVisibilitySuite) TestSumHistorySizeBytes() {
+	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, p *client.SearchParameters) (*elastic.SearchResult, error) {
+			s.Equal(
+				elastic.NewBoolQuery().Filter(
+					elastic.NewTermQuery(searchattribute.NamespaceID, testNamespaceID.String()),
+					elastic.NewBoolQuery().Filter(elastic.NewMatchQuery("ExecutionStatus", "Completed")),
+				).MustNot(namespaceDivisionExists),
+				p.Query,
+			)
+			s.Contains(p.Aggregations, historySizeBytesAggName)
+			return &elastic.SearchResult{
+				Aggregations: elastic.Aggregations{
+					historySizeBytesAggName: json.RawMessage(`{"value":1024.0}`),
+				},
+			}, nil
+		})
+
+	request := &manager.SumHistorySizeBytesRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       `ExecutionStatus = "Completed"`,
+	}
+	resp, err := s.visibilityStore.SumHistorySizeBytes(context.Background(), request)
+	s.NoError(err)
+	s.Equal(int64(1024), resp.HistorySizeBytes)
+
+	// test unavailable error
+	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, errTestESSearch)
+	_, err = s.visibilityStore.SumHistorySizeBytes(context.Background(), request)
+	s.Error(err)
+	_, ok := err.(*serviceerror.Unavailable)
+	s.True(ok)
+	s.Contains(err.Error(), "SumHistorySizeBytes failed")
+}
+
 func (s *ESVisibilitySuite) TestGetWorkflowExecution() {
 	now := timestamp.TimePtr(time.Now())
 	s.mockESClient.EXPECT().Get(gomock.Any(), testIndex, gomock.Any()).DoAndReturn(
@@ -188,14 +188,39 @@ func NewNotSupportedExprConverter() ExprConverter {
 // ConvertWhereOrderBy transforms WHERE SQL statement to Elasticsearch query.
 // It also supports ORDER BY clause.
 func (c *Converter) ConvertWhereOrderBy(whereOrderBy string) (*elastic.BoolQuery, []*elastic.FieldSort, error) {
-	whereOrderBy = strings.TrimSpace(whereOrderBy)
+	return c.ConvertSql(selectSql(whereOrderBy))
+}
+
+// ConvertWhereGroupBy transforms WHERE SQL statement to Elasticsearch query.
+// It also supports GROUP BY clause and returns names of group by fields (if any).
+// It is used for counting, so ORDER BY clause is validated and ignored, unless it is
+// combined with GROUP BY, which is not supported.
+func (c *Converter) ConvertWhereGroupBy(whereGroupBy string) (*elastic.BoolQuery, []string, error) {
+	stmt, err := sqlparser.Parse(selectSql(whereGroupBy))
+	if err != nil {
+		return nil, nil, NewConverterError("%s: %v", MalformedSqlQueryErrMessage, err)
+	}
+
+	selectStmt, isSelect := stmt.(*sqlparser.Select)
+	if !isSelect {
+		return nil, nil, NewConverterError("%s: statement must be 'select' not %T", NotSupportedErrMessage, stmt)
+	}
+
+	return c.convertSelectGroupBy(selectStmt)
+}
 
-	if whereOrderBy != "" && !strings.HasPrefix(strings.ToLower(whereOrderBy), "order by ") {
-		whereOrderBy = "where " + whereOrderBy
+// selectSql builds SQL statement from WHERE, ORDER BY and GROUP BY clauses,
+// because sqlparser can't parse just WHERE clause but instead accepts only valid SQL statement.
+func selectSql(whereClause string) string {
+	whereClause = strings.TrimSpace(whereClause)
+
+	lowerWhereClause := strings.ToLower(whereClause)
+	if whereClause != "" &&
+		!strings.HasPrefix(lowerWhereClause, "order by ") &&
+		!strings.HasPrefix(lowerWhereClause, "group by ") {
+		whereClause = "where " + whereClause
 	}
-	// sqlparser can't parse just WHERE clause but instead accepts only valid SQL statement.
-	sql := fmt.Sprintf("select * from table1 %s", whereOrderBy)
-	return c.ConvertSql(sql)
+	return fmt.Sprintf("select * from table1 %s", whereClause)
 }
 
 // ConvertSql transforms SQL to Elasticsearch query.
@@ -222,17 +247,9 @@ func (c *Converter) convertSelect(sel *sqlparser.Select) (*elastic.BoolQuery, []
 		return nil, nil, NewConverterError("%s: 'limit' clause", NotSupportedErrMessage)
 	}
 
-	var query *elastic.BoolQuery
-	if sel.Where != nil {
-		q, err := c.whereConverter.Convert(sel.Where.Expr)
-		if err != nil {
-			return nil, nil, wrapConverterError("unable to convert filter expression", err)
-		}
-		// Result must be BoolQuery.
-		var isBoolQuery bool
-		if query, isBoolQuery = q.(*elastic.BoolQuery); !isBoolQuery {
-			query = elastic.NewBoolQuery().Filter(q)
-		}
+	query, err := c.convertWhere(sel.Where)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	var fieldSorts []*elastic.FieldSort
@@ -251,6 +268,60 @@ func (c *Converter) convertSelect(sel *sqlparser.Select) (*elastic.BoolQuery, []
 	return query, fieldSorts, nil
 }
 
+func (c *Converter) convertSelectGroupBy(sel *sqlparser.Select) (*elastic.BoolQuery, []string, error) {
+	if sel.OrderBy != nil && sel.GroupBy != nil {
+		return nil, nil, NewConverterError("%s: 'order by' clause combined with 'group by' clause", NotSupportedErrMessage)
+	}
+
+	if sel.Having != nil {
+		return nil, nil, NewConverterError("%s: 'having' clause", NotSupportedErrMessage)
+	}
+
+	if sel.Limit != nil {
+		return nil, nil, NewConverterError("%s: 'limit' clause", NotSupportedErrMessage)
+	}
+
+	query, err := c.convertWhere(sel.Where)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Order doesn't matter for counting, but the columns are still validated.
+	for _, orderByExpr := range sel.OrderBy {
+		if _, err := convertColName(c.fnInterceptor, orderByExpr.Expr, FieldNameSorter); err != nil {
+			return nil, nil, wrapConverterError("unable to convert 'order by' column name", err)
+		}
+	}
+
+	var groupBy []string
+	for _, groupByExpr := range sel.GroupBy {
+		colName, err := convertColName(c.fnInterceptor, groupByExpr, FieldNameGroupBy)
+		if err != nil {
+			return nil, nil, wrapConverterError("unable to convert 'group by' column name", err)
+		}
+		groupBy = append(groupBy, colName)
+	}
+
+	return query, groupBy, nil
+}
+
+func (c *Converter) convertWhere(where *sqlparser.Where) (*elastic.BoolQuery, error) {
+	if where == nil {
+		return nil, nil
+	}
+
+	q, err := c.whereConverter.Convert(where.Expr)
+	if err != nil {
+		return nil, wrapConverterError("unable to convert filter expression", err)
+	}
+	// Result must be BoolQuery.
+	query, isBoolQuery := q.(*elastic.BoolQuery)
+	if !isBoolQuery {
+		query = elastic.NewBoolQuery().Filter(q)
+	}
+	return query, nil
+}
+
 func (w *WhereConverter) Convert(expr sqlparser.Expr) (elastic.Query, error) {
 	if expr == nil {
 		return nil, errors.New("cannot be nil")
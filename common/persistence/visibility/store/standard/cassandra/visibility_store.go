@@ -521,6 +521,13 @@ func (v *visibilityStore) CountWorkflowExecutions(
 	return nil, store.OperationNotSupportedErr
 }
 
+func (v *visibilityStore) SumHistorySizeBytes(
+	_ context.Context,
+	_ *manager.SumHistorySizeBytesRequest,
+) (*manager.SumHistorySizeBytesResponse, error) {
+	return nil, store.OperationNotSupportedErr
+}
+
 func (v *visibilityStore) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
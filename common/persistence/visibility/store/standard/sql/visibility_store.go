@@ -327,6 +327,13 @@ func (s *visibilityStore) CountWorkflowExecutions(
 	return nil, store.OperationNotSupportedErr
 }
 
+func (s *visibilityStore) SumHistorySizeBytes(
+	_ context.Context,
+	_ *manager.SumHistorySizeBytesRequest,
+) (*manager.SumHistorySizeBytesResponse, error) {
+	return nil, store.OperationNotSupportedErr
+}
+
 func (s *visibilityStore) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -168,6 +168,13 @@ func (s *standardStore) CountWorkflowExecutions(
 	return s.store.CountWorkflowExecutions(ctx, request)
 }
 
+func (s *standardStore) SumHistorySizeBytes(
+	ctx context.Context,
+	request *manager.SumHistorySizeBytesRequest,
+) (*manager.SumHistorySizeBytesResponse, error) {
+	return s.store.SumHistorySizeBytes(ctx, request)
+}
+
 func (s *standardStore) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
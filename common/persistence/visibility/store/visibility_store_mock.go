@@ -306,6 +306,21 @@ func (mr *MockVisibilityStoreMockRecorder) ScanWorkflowExecutions(ctx, request i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanWorkflowExecutions", reflect.TypeOf((*MockVisibilityStore)(nil).ScanWorkflowExecutions), ctx, request)
 }
 
+// SumHistorySizeBytes mocks base method.
+func (m *MockVisibilityStore) SumHistorySizeBytes(ctx context.Context, request *manager.SumHistorySizeBytesRequest) (*manager.SumHistorySizeBytesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumHistorySizeBytes", ctx, request)
+	ret0, _ := ret[0].(*manager.SumHistorySizeBytesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumHistorySizeBytes indicates an expected call of SumHistorySizeBytes.
+func (mr *MockVisibilityStoreMockRecorder) SumHistorySizeBytes(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumHistorySizeBytes", reflect.TypeOf((*MockVisibilityStore)(nil).SumHistorySizeBytes), ctx, request)
+}
+
 // UpsertWorkflowExecution mocks base method.
 func (m *MockVisibilityStore) UpsertWorkflowExecution(ctx context.Context, request *InternalUpsertWorkflowExecutionRequest) error {
 	m.ctrl.T.Helper()
@@ -206,6 +206,13 @@ func (v *visibilityManagerDual) CountWorkflowExecutions(
 	return v.managerSelector.readManager(request.Namespace).CountWorkflowExecutions(ctx, request)
 }
 
+func (v *visibilityManagerDual) SumHistorySizeBytes(
+	ctx context.Context,
+	request *manager.SumHistorySizeBytesRequest,
+) (*manager.SumHistorySizeBytesResponse, error) {
+	return v.managerSelector.readManager(request.Namespace).SumHistorySizeBytes(ctx, request)
+}
+
 func (v *visibilityManagerDual) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -215,6 +215,16 @@ func (m *visibilityManagerRateLimited) CountWorkflowExecutions(
 	return m.delegate.CountWorkflowExecutions(ctx, request)
 }
 
+func (m *visibilityManagerRateLimited) SumHistorySizeBytes(
+	ctx context.Context,
+	request *manager.SumHistorySizeBytesRequest,
+) (*manager.SumHistorySizeBytesResponse, error) {
+	if ok := m.readRateLimiter.Allow(); !ok {
+		return nil, persistence.ErrPersistenceLimitExceeded
+	}
+	return m.delegate.SumHistorySizeBytes(ctx, request)
+}
+
 func (m *visibilityManagerRateLimited) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
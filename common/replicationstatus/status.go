@@ -0,0 +1,88 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package replicationstatus aggregates the per shard replication status reported by the history
+// service into the per remote cluster status returned by the admin GetReplicationStatus API.
+package replicationstatus
+
+import (
+	"sort"
+	"time"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+// NewClusterReplicationStatus aggregates the shard statuses of one remote cluster.
+// The shard details are kept only if includeShards is set.
+func NewClusterReplicationStatus(
+	clusterName string,
+	shards []*adminservice.ShardReplicationStatus,
+	includeShards bool,
+) *adminservice.ClusterReplicationStatus {
+	status := &adminservice.ClusterReplicationStatus{
+		ClusterName: clusterName,
+		ShardCount:  int32(len(shards)),
+	}
+
+	var lagTaskIDs []int64
+	var lags []time.Duration
+	for _, shard := range shards {
+		status.DlqSize += shard.DlqSize
+		status.DlqSizeIsLowerBound = status.DlqSizeIsLowerBound || shard.DlqSizeIsLowerBound
+		if shard.Missing {
+			status.MissingShardCount++
+			continue
+		}
+		lagTaskIDs = append(lagTaskIDs, shard.LagTaskIds)
+		lags = append(lags, timestamp.DurationValue(shard.Lag))
+	}
+
+	sort.Slice(lagTaskIDs, func(i, j int) bool { return lagTaskIDs[i] < lagTaskIDs[j] })
+	sort.Slice(lags, func(i, j int) bool { return lags[i] < lags[j] })
+	status.MaxLag = timestamp.DurationPtr(0)
+	status.P99Lag = timestamp.DurationPtr(0)
+	if n := len(lagTaskIDs); n > 0 {
+		p99 := percentileIndex(n, 99)
+		status.MaxLagTaskIds = lagTaskIDs[n-1]
+		status.P99LagTaskIds = lagTaskIDs[p99]
+		status.MaxLag = timestamp.DurationPtr(lags[n-1])
+		status.P99Lag = timestamp.DurationPtr(lags[p99])
+	}
+
+	if includeShards {
+		sort.Slice(shards, func(i, j int) bool { return shards[i].ShardId < shards[j].ShardId })
+		status.Shards = shards
+	}
+	return status
+}
+
+// percentileIndex returns the nearest-rank index of the percentile p in a sorted slice of length n.
+func percentileIndex(n int, p int) int {
+	rank := (p*n + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return rank - 1
+}
@@ -0,0 +1,77 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replicationstatus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+func TestNewClusterReplicationStatus(t *testing.T) {
+	var shards []*adminservice.ShardReplicationStatus
+	for i := 1; i <= 200; i++ {
+		shards = append(shards, &adminservice.ShardReplicationStatus{
+			ShardId:    int32(i),
+			LagTaskIds: int64(i),
+			Lag:        timestamp.DurationPtr(time.Duration(i) * time.Second),
+			DlqSize:    1,
+		})
+	}
+	shards = append(shards, &adminservice.ShardReplicationStatus{
+		ShardId:             201,
+		Missing:             true,
+		DlqSize:             10,
+		DlqSizeIsLowerBound: true,
+	})
+
+	status := NewClusterReplicationStatus("remote", shards, false)
+	require.Equal(t, "remote", status.ClusterName)
+	require.Equal(t, int32(201), status.ShardCount)
+	require.Equal(t, int32(1), status.MissingShardCount)
+	require.Equal(t, int64(200), status.MaxLagTaskIds)
+	require.Equal(t, int64(198), status.P99LagTaskIds)
+	require.Equal(t, 200*time.Second, *status.MaxLag)
+	require.Equal(t, 198*time.Second, *status.P99Lag)
+	require.Equal(t, int64(210), status.DlqSize)
+	require.True(t, status.DlqSizeIsLowerBound)
+	require.Nil(t, status.Shards)
+
+	status = NewClusterReplicationStatus("remote", shards[:1], true)
+	require.Equal(t, int64(1), status.MaxLagTaskIds)
+	require.Equal(t, int64(1), status.P99LagTaskIds)
+	require.Len(t, status.Shards, 1)
+}
+
+func TestNewClusterReplicationStatus_NoShards(t *testing.T) {
+	status := NewClusterReplicationStatus("remote", nil, true)
+	require.Equal(t, int32(0), status.ShardCount)
+	require.Zero(t, *status.MaxLag)
+	require.Zero(t, status.P99LagTaskIds)
+}
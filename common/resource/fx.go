@@ -30,6 +30,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"strconv"
 	"time"
 
 	"go.uber.org/fx"
@@ -210,6 +211,7 @@ func NamespaceRegistryProvider(
 		metadataManager,
 		clusterMetadata.IsGlobalNamespaceEnabled(),
 		dynamicCollection.GetDurationProperty(dynamicconfig.NamespaceCacheRefreshInterval, 10*time.Second),
+		dynamicCollection.GetDurationProperty(dynamicconfig.NamespaceCacheWatchInterval, time.Second),
 		metricsHandler,
 		logger,
 	)
@@ -262,6 +264,11 @@ func MembershipMonitorProvider(
 
 	rpcConfig := cfg.Services[string(svcName)].RPC
 
+	switch cfg.Global.Membership.Provider {
+	case config.MembershipProviderStatic, config.MembershipProviderDNS:
+		return hostListMembershipMonitorProvider(lc, logger, &cfg.Global.Membership, svcName, &rpcConfig)
+	}
+
 	factory, err := ringpop.NewRingpopFactory(
 		&cfg.Global.Membership,
 		svcName,
@@ -298,6 +305,80 @@ func MembershipMonitorProvider(
 	return monitor, nil
 }
 
+func hostListMembershipMonitorProvider(
+	lc fx.Lifecycle,
+	logger log.Logger,
+	membershipConfig *config.Membership,
+	svcName primitives.ServiceName,
+	rpcConfig *config.RPC,
+) (membership.Monitor, error) {
+	if err := ringpop.ValidateRingpopConfig(membershipConfig); err != nil {
+		return nil, err
+	}
+	selfIP, err := membershipSelfIP(membershipConfig, rpcConfig)
+	if err != nil {
+		return nil, err
+	}
+	selfAddress := net.JoinHostPort(selfIP, strconv.Itoa(rpcConfig.GRPCPort))
+
+	var monitor membership.Monitor
+	switch membershipConfig.Provider {
+	case config.MembershipProviderStatic:
+		hosts := make(map[primitives.ServiceName][]string, len(membershipConfig.StaticHosts))
+		for sn, hostPorts := range membershipConfig.StaticHosts {
+			hosts[primitives.ServiceName(sn)] = hostPorts
+		}
+		monitor = membership.NewStaticMonitor(svcName, selfAddress, hosts, logger)
+	default:
+		records := make(map[primitives.ServiceName]string, len(membershipConfig.DNSRecords))
+		for sn, record := range membershipConfig.DNSRecords {
+			records[primitives.ServiceName(sn)] = record
+		}
+		monitor = membership.NewDNSMonitor(
+			svcName,
+			selfAddress,
+			records,
+			membershipConfig.RefreshInterval,
+			membershipConfig.HealthCheckTimeout,
+			logger,
+		)
+	}
+
+	lc.Append(
+		fx.Hook{
+			OnStart: func(context.Context) error {
+				monitor.Start()
+				return nil
+			},
+			OnStop: func(context.Context) error {
+				monitor.Stop()
+				return nil
+			},
+		},
+	)
+
+	return monitor, nil
+}
+
+// membershipSelfIP returns the IP other members know this host by, following the same
+// precedence as the ringpop broadcast address.
+func membershipSelfIP(membershipConfig *config.Membership, rpcConfig *config.RPC) (string, error) {
+	if membershipConfig.BroadcastAddress != "" {
+		return membershipConfig.BroadcastAddress, nil
+	}
+	if rpcConfig.BindOnLocalHost {
+		return ringpop.IPV4Localhost.String(), nil
+	}
+	if ip := net.ParseIP(rpcConfig.BindOnIP); ip != nil && !ip.IsUnspecified() {
+		return ip.String(), nil
+	}
+	ip, err := config.ListenIP()
+	if err != nil {
+		return "", err
+	}
+	return ip.String(), nil
+}
+
 func FrontendClientProvider(clientBean client.Bean) workflowservice.WorkflowServiceClient {
 	frontendRawClient := clientBean.GetFrontendClient()
 	return frontend.NewRetryableClient(
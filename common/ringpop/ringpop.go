@@ -109,6 +109,19 @@ func ValidateRingpopConfig(rpConfig *config.Membership) error {
 	if rpConfig.BroadcastAddress != "" && net.ParseIP(rpConfig.BroadcastAddress) == nil {
 		return fmt.Errorf("ringpop config malformed `broadcastAddress` param")
 	}
+	switch rpConfig.Provider {
+	case "", config.MembershipProviderRingpop:
+	case config.MembershipProviderStatic:
+		if len(rpConfig.StaticHosts) == 0 {
+			return fmt.Errorf("membership config `staticHosts` is required by the static provider")
+		}
+	case config.MembershipProviderDNS:
+		if len(rpConfig.DNSRecords) == 0 {
+			return fmt.Errorf("membership config `dnsRecords` is required by the dns provider")
+		}
+	default:
+		return fmt.Errorf("unknown membership provider %q", rpConfig.Provider)
+	}
 	return nil
 }
 
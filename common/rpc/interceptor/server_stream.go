@@ -0,0 +1,153 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+)
+
+type (
+	// unaryServerStream runs a chain of unary interceptors around a server-streaming call.
+	// The chain is started when the handler receives the request message, and its innermost
+	// handler blocks until the stream handler returns, so interceptors which hold resources
+	// or measure latency cover the whole stream.
+	unaryServerStream struct {
+		grpc.ServerStream
+		chain grpc.UnaryServerInterceptor
+		info  *grpc.UnaryServerInfo
+		ctx   context.Context
+
+		received     bool
+		chainDone    bool
+		admittedCh   chan context.Context
+		handlerErrCh chan error
+		chainErrCh   chan error
+	}
+)
+
+var (
+	errStreamNotAdmitted        = serviceerror.NewInternal("stream was not admitted by the interceptor chain")
+	errStreamMultipleRequests   = serviceerror.NewInvalidArgument("stream accepts a single request message")
+	errStreamHandlerCalledTwice = serviceerror.NewInternal("stream handler was called more than once by the interceptor chain")
+)
+
+// NewUnaryServerStreamInterceptor returns a stream interceptor which runs the given unary
+// interceptors around server-streaming calls, so streams go through the same validation,
+// authorization, rate limiting and telemetry as unary calls. The interceptors see the single
+// request message of the stream, and the context they pass on becomes the stream context.
+// Interceptors which call their handler more than once, like retries, must not be included.
+func NewUnaryServerStreamInterceptor(
+	interceptors ...grpc.UnaryServerInterceptor,
+) grpc.StreamServerInterceptor {
+	chain := chainUnaryServerInterceptors(interceptors)
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if info.IsClientStream {
+			return handler(srv, stream)
+		}
+
+		s := &unaryServerStream{
+			ServerStream: stream,
+			chain:        chain,
+			info:         &grpc.UnaryServerInfo{Server: srv, FullMethod: info.FullMethod},
+			ctx:          stream.Context(),
+			admittedCh:   make(chan context.Context),
+			handlerErrCh: make(chan error),
+			chainErrCh:   make(chan error, 1),
+		}
+		err := handler(srv, s)
+		if !s.received || s.chainDone {
+			return err
+		}
+		s.handlerErrCh <- err
+		return <-s.chainErrCh
+	}
+}
+
+func (s *unaryServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *unaryServerStream) RecvMsg(m interface{}) error {
+	if s.received {
+		return errStreamMultipleRequests
+	}
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	s.received = true
+
+	go func() {
+		handlerCalled := false
+		_, err := s.chain(s.ctx, m, s.info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			if handlerCalled {
+				return nil, errStreamHandlerCalledTwice
+			}
+			handlerCalled = true
+			s.admittedCh <- ctx
+			return nil, <-s.handlerErrCh
+		})
+		s.chainErrCh <- err
+	}()
+
+	select {
+	case ctx := <-s.admittedCh:
+		s.ctx = ctx
+		return nil
+	case err := <-s.chainErrCh:
+		s.chainDone = true
+		if err == nil {
+			return errStreamNotAdmitted
+		}
+		return err
+	}
+}
+
+func chainUnaryServerInterceptors(
+	interceptors []grpc.UnaryServerInterceptor,
+) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		chained := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chained
+			chained = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return chained(ctx, req)
+	}
+}
@@ -0,0 +1,136 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+)
+
+type (
+	serverStreamSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+
+	testServerStream struct {
+		grpc.ServerStream
+		request *workflowservice.GetWorkflowExecutionHistoryRequest
+	}
+
+	serverStreamContextKey struct{}
+)
+
+var (
+	testStreamInfo = &grpc.StreamServerInfo{
+		FullMethod:     "/temporal.server.api.historystreamservice.v1.HistoryStreamService/StreamWorkflowHistory",
+		IsServerStream: true,
+	}
+)
+
+func TestServerStreamSuite(t *testing.T) {
+	s := new(serverStreamSuite)
+	suite.Run(t, s)
+}
+
+func (s *serverStreamSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *serverStreamSuite) TestChainCoversStream() {
+	var calls []string
+	outer := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		s.Equal(testStreamInfo.FullMethod, info.FullMethod)
+		s.Equal("test-namespace", req.(*workflowservice.GetWorkflowExecutionHistoryRequest).GetNamespace())
+		calls = append(calls, "outer-before")
+		resp, err := handler(context.WithValue(ctx, serverStreamContextKey{}, "outer"), req)
+		calls = append(calls, "outer-after")
+		return resp, serviceerror.NewUnavailable(err.Error())
+	}
+	inner := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		calls = append(calls, "inner")
+		return handler(ctx, req)
+	}
+
+	streamErr := errors.New("stream failed")
+	interceptor := NewUnaryServerStreamInterceptor(outer, inner)
+	err := interceptor(nil, s.newStream(), testStreamInfo, func(_ interface{}, stream grpc.ServerStream) error {
+		request := &workflowservice.GetWorkflowExecutionHistoryRequest{}
+		s.NoError(stream.RecvMsg(request))
+		s.Equal("outer", stream.Context().Value(serverStreamContextKey{}))
+		calls = append(calls, "handler")
+		return streamErr
+	})
+	s.Equal(serviceerror.NewUnavailable(streamErr.Error()), err)
+	s.Equal([]string{"outer-before", "inner", "handler", "outer-after"}, calls)
+}
+
+func (s *serverStreamSuite) TestChainRejectsStream() {
+	rejectErr := serviceerror.NewResourceExhausted(0, "rejected")
+	reject := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return nil, rejectErr
+	}
+
+	interceptor := NewUnaryServerStreamInterceptor(reject)
+	err := interceptor(nil, s.newStream(), testStreamInfo, func(_ interface{}, stream grpc.ServerStream) error {
+		if err := stream.RecvMsg(&workflowservice.GetWorkflowExecutionHistoryRequest{}); err != nil {
+			return err
+		}
+		s.Fail("stream handler should not run when the chain rejects the request")
+		return nil
+	})
+	s.Equal(rejectErr, err)
+}
+
+func (s *serverStreamSuite) TestMultipleRequests() {
+	interceptor := NewUnaryServerStreamInterceptor()
+	err := interceptor(nil, s.newStream(), testStreamInfo, func(_ interface{}, stream grpc.ServerStream) error {
+		s.NoError(stream.RecvMsg(&workflowservice.GetWorkflowExecutionHistoryRequest{}))
+		return stream.RecvMsg(&workflowservice.GetWorkflowExecutionHistoryRequest{})
+	})
+	s.Equal(errStreamMultipleRequests, err)
+}
+
+func (s *serverStreamSuite) newStream() *testServerStream {
+	return &testServerStream{
+		request: &workflowservice.GetWorkflowExecutionHistoryRequest{Namespace: "test-namespace"},
+	}
+}
+
+func (t *testServerStream) Context() context.Context {
+	return context.Background()
+}
+
+func (t *testServerStream) RecvMsg(m interface{}) error {
+	*m.(*workflowservice.GetWorkflowExecutionHistoryRequest) = *t.request
+	return nil
+}
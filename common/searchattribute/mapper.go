@@ -27,6 +27,8 @@
 package searchattribute
 
 import (
+	"fmt"
+
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/namespace"
@@ -52,6 +54,21 @@ type (
 		emptyStringNameTypeMap NameTypeMap
 	}
 
+	// fieldNameFallbackMapper is used with Elasticsearch, where custom search attributes are used by their field names.
+	// Search attributes whose type was changed are aliases of the fields of the new type, and hide the fields
+	// which have the same names.
+	fieldNameFallbackMapper struct {
+		fieldToAlias map[string]string
+		aliasToField map[string]string
+	}
+
+	// previousNamesMapper is used with a custom mapper for a renamed namespace. The custom mapper may only
+	// know the namespace by one of its previous names, which are looked up if the current name is unknown.
+	previousNamesMapper struct {
+		mapper        Mapper
+		previousNames []namespace.Name
+	}
+
 	MapperProvider interface {
 		GetMapper(nsName namespace.Name) (Mapper, error)
 	}
@@ -66,6 +83,8 @@ type (
 
 var _ Mapper = (*noopMapper)(nil)
 var _ Mapper = (*backCompMapper_v1_20)(nil)
+var _ Mapper = (*fieldNameFallbackMapper)(nil)
+var _ Mapper = (*previousNamesMapper)(nil)
 var _ Mapper = (*namespace.CustomSearchAttributesMapper)(nil)
 var _ MapperProvider = (*mapperProviderImpl)(nil)
 
@@ -103,6 +122,52 @@ func (m *backCompMapper_v1_20) GetFieldName(alias string, namespaceName string)
 	return fieldName, nil
 }
 
+func (m *fieldNameFallbackMapper) GetAlias(fieldName string, namespaceName string) (string, error) {
+	if alias, ok := m.fieldToAlias[fieldName]; ok {
+		return alias, nil
+	}
+	if _, ok := m.aliasToField[fieldName]; ok {
+		// field name is used as an alias of another field
+		return "", serviceerror.NewInvalidArgument(
+			fmt.Sprintf("Namespace %s has no mapping defined for field name %s", namespaceName, fieldName),
+		)
+	}
+	return fieldName, nil
+}
+
+func (m *fieldNameFallbackMapper) GetFieldName(alias string, _ string) (string, error) {
+	if fieldName, ok := m.aliasToField[alias]; ok {
+		return fieldName, nil
+	}
+	return alias, nil
+}
+
+func (m *previousNamesMapper) GetAlias(fieldName string, namespaceName string) (string, error) {
+	alias, firstErr := m.mapper.GetAlias(fieldName, namespaceName)
+	if firstErr == nil {
+		return alias, nil
+	}
+	for _, previousName := range m.previousNames {
+		if alias, err := m.mapper.GetAlias(fieldName, previousName.String()); err == nil {
+			return alias, nil
+		}
+	}
+	return "", firstErr
+}
+
+func (m *previousNamesMapper) GetFieldName(alias string, namespaceName string) (string, error) {
+	fieldName, firstErr := m.mapper.GetFieldName(alias, namespaceName)
+	if firstErr == nil {
+		return fieldName, nil
+	}
+	for _, previousName := range m.previousNames {
+		if fieldName, err := m.mapper.GetFieldName(alias, previousName.String()); err == nil {
+			return fieldName, nil
+		}
+	}
+	return "", firstErr
+}
+
 func NewMapperProvider(
 	customMapper Mapper,
 	namespaceRegistry namespace.Registry,
@@ -119,10 +184,10 @@ func NewMapperProvider(
 
 func (m *mapperProviderImpl) GetMapper(nsName namespace.Name) (Mapper, error) {
 	if m.customMapper != nil {
-		return m.customMapper, nil
+		return m.getCustomMapper(nsName), nil
 	}
 	if !m.enableMapperFromNamespace {
-		return &noopMapper{}, nil
+		return m.getFieldNameFallbackMapper(nsName), nil
 	}
 	ns, err := m.namespaceRegistry.GetNamespace(nsName)
 	if err != nil {
@@ -137,6 +202,46 @@ func (m *mapperProviderImpl) GetMapper(nsName namespace.Name) (Mapper, error) {
 	}, nil
 }
 
+func (m *mapperProviderImpl) getCustomMapper(nsName namespace.Name) Mapper {
+	if m.namespaceRegistry == nil {
+		return m.customMapper
+	}
+	ns, err := m.namespaceRegistry.GetNamespace(nsName)
+	if err != nil || len(ns.PreviousNames()) == 0 {
+		return m.customMapper
+	}
+	return &previousNamesMapper{
+		mapper:        m.customMapper,
+		previousNames: ns.PreviousNames(),
+	}
+}
+
+func (m *mapperProviderImpl) getFieldNameFallbackMapper(nsName namespace.Name) Mapper {
+	if m.namespaceRegistry == nil {
+		return &noopMapper{}
+	}
+	ns, err := m.namespaceRegistry.GetNamespace(nsName)
+	if err != nil || len(ns.ChangedSearchAttributes()) == 0 {
+		// Field names don't depend on namespace if no search attribute type was changed.
+		return &noopMapper{}
+	}
+	saMapper := ns.CustomSearchAttributesMapper()
+	mapper := &fieldNameFallbackMapper{
+		fieldToAlias: make(map[string]string),
+		aliasToField: make(map[string]string),
+	}
+	for _, alias := range ns.ChangedSearchAttributes() {
+		fieldName, err := saMapper.GetFieldName(alias, nsName.String())
+		if err != nil {
+			// type change hasn't reached the alias swap yet
+			continue
+		}
+		mapper.fieldToAlias[fieldName] = alias
+		mapper.aliasToField[alias] = fieldName
+	}
+	return mapper
+}
+
 // AliasFields returns SearchAttributes struct where each search attribute name is replaced with alias.
 // If no replacement where made, it returns nil which means that original SearchAttributes struct should be used.
 func AliasFields(
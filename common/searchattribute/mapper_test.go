@@ -27,9 +27,14 @@ package searchattribute
 import (
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 )
 
 func Test_AliasFields(t *testing.T) {
@@ -161,3 +166,112 @@ func Test_UnaliasFields(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Nil(t, sa)
 }
+
+func Test_FieldNameFallbackMapper(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	nsRegistry := namespace.NewMockRegistry(ctrl)
+	mapperProvider := NewMapperProvider(nil, nsRegistry, NewTestProvider(), false)
+
+	newNamespace := func(aliases map[string]string, changedSearchAttributes string) *namespace.Namespace {
+		return namespace.FromPersistentState(&persistence.GetNamespaceResponse{
+			Namespace: &persistencespb.NamespaceDetail{
+				Info: &persistencespb.NamespaceInfo{
+					Id:   "test-namespace-id",
+					Name: "test-namespace",
+					Data: map[string]string{namespace.ChangedSearchAttributesDataKey: changedSearchAttributes},
+				},
+				Config:            &persistencespb.NamespaceConfig{CustomSearchAttributeAliases: aliases},
+				ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+			},
+		})
+	}
+
+	// Namespace without changed search attributes uses field names as is, even if it has aliases.
+	nsRegistry.EXPECT().GetNamespace(namespace.Name("test-namespace")).Return(newNamespace(map[string]string{
+		"CustomKeywordListField": "CustomKeywordField",
+	}, ""), nil)
+	mapper, err := mapperProvider.GetMapper("test-namespace")
+	assert.NoError(t, err)
+	assert.IsType(t, &noopMapper{}, mapper)
+
+	// Unknown namespace uses field names as is.
+	nsRegistry.EXPECT().GetNamespace(namespace.Name("unknown-namespace")).Return(nil, serviceerror.NewNamespaceNotFound("unknown-namespace"))
+	mapper, err = mapperProvider.GetMapper("unknown-namespace")
+	assert.NoError(t, err)
+	assert.IsType(t, &noopMapper{}, mapper)
+
+	// Type of CustomKeywordField was changed: it is an alias of CustomKeywordListField, which hides original
+	// CustomKeywordField. Other aliases are ignored.
+	nsRegistry.EXPECT().GetNamespace(namespace.Name("test-namespace")).Return(newNamespace(map[string]string{
+		"CustomKeywordListField": "CustomKeywordField",
+		"CustomTextField":        "CustomIntField",
+	}, "CustomKeywordField"), nil)
+	mapper, err = mapperProvider.GetMapper("test-namespace")
+	assert.NoError(t, err)
+
+	fieldName, err := mapper.GetFieldName("CustomKeywordField", "test-namespace")
+	assert.NoError(t, err)
+	assert.Equal(t, "CustomKeywordListField", fieldName)
+	fieldName, err = mapper.GetFieldName("CustomIntField", "test-namespace")
+	assert.NoError(t, err)
+	assert.Equal(t, "CustomIntField", fieldName)
+
+	alias, err := mapper.GetAlias("CustomKeywordListField", "test-namespace")
+	assert.NoError(t, err)
+	assert.Equal(t, "CustomKeywordField", alias)
+	alias, err = mapper.GetAlias("CustomIntField", "test-namespace")
+	assert.NoError(t, err)
+	assert.Equal(t, "CustomIntField", alias)
+	_, err = mapper.GetAlias("CustomKeywordField", "test-namespace")
+	var invalidArgumentErr *serviceerror.InvalidArgument
+	assert.ErrorAs(t, err, &invalidArgumentErr)
+}
+
+func Test_PreviousNamesMapper(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	nsRegistry := namespace.NewMockRegistry(ctrl)
+	customMapper := NewMockMapper(ctrl)
+	mapperProvider := NewMapperProvider(customMapper, nsRegistry, NewTestProvider(), false)
+
+	newNamespace := func(previousNames string) *namespace.Namespace {
+		return namespace.FromPersistentState(&persistence.GetNamespaceResponse{
+			Namespace: &persistencespb.NamespaceDetail{
+				Info: &persistencespb.NamespaceInfo{
+					Id:   "test-namespace-id",
+					Name: "test-namespace",
+					Data: map[string]string{namespace.PreviousNamesDataKey: previousNames},
+				},
+				Config:            &persistencespb.NamespaceConfig{},
+				ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+			},
+		})
+	}
+
+	// Namespace which was never renamed uses the custom mapper as is.
+	nsRegistry.EXPECT().GetNamespace(namespace.Name("test-namespace")).Return(newNamespace(""), nil)
+	mapper, err := mapperProvider.GetMapper("test-namespace")
+	assert.NoError(t, err)
+	assert.Equal(t, customMapper, mapper)
+
+	// Renamed namespace falls back to the previous names known by the custom mapper.
+	nsRegistry.EXPECT().GetNamespace(namespace.Name("test-namespace")).Return(newNamespace("old-namespace,oldest-namespace"), nil)
+	mapper, err = mapperProvider.GetMapper("test-namespace")
+	assert.NoError(t, err)
+
+	notFoundErr := serviceerror.NewInvalidArgument("unknown namespace")
+	customMapper.EXPECT().GetAlias("field", "test-namespace").Return("", notFoundErr)
+	customMapper.EXPECT().GetAlias("field", "old-namespace").Return("", notFoundErr)
+	customMapper.EXPECT().GetAlias("field", "oldest-namespace").Return("alias", nil)
+	alias, err := mapper.GetAlias("field", "test-namespace")
+	assert.NoError(t, err)
+	assert.Equal(t, "alias", alias)
+
+	customMapper.EXPECT().GetFieldName("alias", "test-namespace").Return("field", nil)
+	fieldName, err := mapper.GetFieldName("alias", "test-namespace")
+	assert.NoError(t, err)
+	assert.Equal(t, "field", fieldName)
+
+	customMapper.EXPECT().GetFieldName("unknown", gomock.Any()).Return("", notFoundErr).Times(3)
+	_, err = mapper.GetFieldName("unknown", "test-namespace")
+	assert.Equal(t, notFoundErr, err)
+}
@@ -0,0 +1,72 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package telemetry
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Span attribute keys identifying the namespace, workflow and shard a span
+// belongs to. They are shared by the spans of all services so that a trace
+// can be followed end-to-end, more specific keys live in the packages
+// creating the spans.
+const (
+	NamespaceKey   = attribute.Key("io.temporal.namespace")
+	NamespaceIDKey = attribute.Key("io.temporal.namespace.id")
+	WorkflowIDKey  = attribute.Key("io.temporal.workflow.id")
+	RunIDKey       = attribute.Key("io.temporal.workflow.run_id")
+	ShardIDKey     = attribute.Key("io.temporal.shard.id")
+)
+
+// WorkflowAttributes returns the attributes identifying a workflow execution,
+// empty values are omitted
+func WorkflowAttributes(
+	namespaceID string,
+	workflowID string,
+	runID string,
+) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0, 3)
+	if namespaceID != "" {
+		attrs = append(attrs, NamespaceIDKey.String(namespaceID))
+	}
+	if workflowID != "" {
+		attrs = append(attrs, WorkflowIDKey.String(workflowID))
+	}
+	if runID != "" {
+		attrs = append(attrs, RunIDKey.String(runID))
+	}
+	return attrs
+}
+
+// EndSpan records the error, if any, on the span and ends it
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
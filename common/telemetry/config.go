@@ -31,7 +31,9 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	otelsdkmetricexp "go.opentelemetry.io/otel/sdk/metric/export"
 	otelsdktrace "go.opentelemetry.io/otel/sdk/trace"
@@ -101,17 +103,19 @@ type (
 		Spec     interface{} `yaml:"-"`
 	}
 
+	retryConfig struct {
+		Enabled         bool
+		InitialInterval time.Duration `yaml:"initial_interval"`
+		MaxInterval     time.Duration `yaml:"max_interval"`
+		MaxElapsedTime  time.Duration `yaml:"max_elapsed_time"`
+	}
+
 	otlpGrpcExporter struct {
 		ConnectionName string `yaml:"connection_name"`
 		Connection     grpcconn
 		Headers        map[string]string
 		Timeout        time.Duration
-		Retry          struct {
-			Enabled         bool
-			InitialInterval time.Duration `yaml:"initial_interval"`
-			MaxInterval     time.Duration `yaml:"max_interval"`
-			MaxElapsedTime  time.Duration `yaml:"max_elapsed_time"`
-		}
+		Retry          retryConfig
 	}
 
 	otlpGrpcSpanExporter struct {
@@ -121,6 +125,37 @@ type (
 		otlpGrpcExporter `yaml:",inline"`
 	}
 
+	// otlpHTTPExporter exports OTLP protobuf payloads over HTTP. The endpoint
+	// is a host:port, https is used unless insecure is set.
+	otlpHTTPExporter struct {
+		Endpoint    string
+		URLPath     string `yaml:"url_path"`
+		Insecure    bool
+		Headers     map[string]string
+		Timeout     time.Duration
+		Compression string
+		Retry       retryConfig
+	}
+
+	otlpHTTPSpanExporter struct {
+		otlpHTTPExporter `yaml:",inline"`
+	}
+	otlpHTTPMetricExporter struct {
+		otlpHTTPExporter `yaml:",inline"`
+	}
+
+	// fileExporter appends OTLP JSON lines to a local file
+	fileExporter struct {
+		Path string
+	}
+
+	fileSpanExporter struct {
+		fileExporter `yaml:",inline"`
+	}
+	fileMetricExporter struct {
+		fileExporter `yaml:",inline"`
+	}
+
 	exportConfig struct {
 		Connections []connection
 		Exporters   []exporter
@@ -225,6 +260,18 @@ func (ec *exportConfig) SpanExporters() ([]otelsdktrace.SpanExporter, error) {
 				return nil, err
 			}
 			out = append(out, spanexp)
+		case *otlpHTTPSpanExporter:
+			spanexp, err := ec.buildOtlpHTTPSpanExporter(spec)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, spanexp)
+		case *fileSpanExporter:
+			client, err := newOtlpFileClient(&spec.fileExporter)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, otlptrace.NewUnstarted(&otlpFileTraceClient{otlpFileClient: client}))
 		default:
 			return nil, fmt.Errorf("unsupported span exporter type: %T", spec)
 		}
@@ -245,6 +292,18 @@ func (ec *exportConfig) MetricExporters() ([]otelsdkmetricexp.Exporter, error) {
 				return nil, err
 			}
 			out = append(out, metricexp)
+		case *otlpHTTPMetricExporter:
+			metricexp, err := ec.buildOtlpHTTPMetricExporter(spec)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, metricexp)
+		case *fileMetricExporter:
+			client, err := newOtlpFileClient(&spec.fileExporter)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, otlpmetric.NewUnstarted(&otlpFileMetricClient{otlpFileClient: client}))
 		default:
 			return nil, fmt.Errorf("unsupported metric exporter type: %T", spec)
 		}
@@ -405,6 +464,14 @@ func (e *exporter) UnmarshalYAML(n *yaml.Node) error {
 		e.Spec = new(otlpGrpcSpanExporter)
 	case "metrics+otlp+grpc", "metric+otlp+grpc":
 		e.Spec = new(otlpGrpcMetricExporter)
+	case "traces+otlp+http", "trace+otlp+http":
+		e.Spec = new(otlpHTTPSpanExporter)
+	case "metrics+otlp+http", "metric+otlp+http":
+		e.Spec = new(otlpHTTPMetricExporter)
+	case "traces+otlp+file", "trace+otlp+file":
+		e.Spec = new(fileSpanExporter)
+	case "metrics+otlp+file", "metric+otlp+file":
+		e.Spec = new(fileMetricExporter)
 	default:
 		return fmt.Errorf(
 			"unsupported exporter kind: signal=%q; model=%q; protocol=%q",
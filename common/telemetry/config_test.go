@@ -154,3 +154,63 @@ func TestOTLPTraceGRPC(t *testing.T) {
 	require.Equal(t, "localhost:4317", conn.Endpoint)
 	require.False(t, conn.Block)
 }
+
+var otlpHTTPAndFileConfig = `
+exporters:
+  - kind:
+      signal: traces
+      model: otlp
+      protocol: http
+    spec:
+      endpoint: collector:4318
+      url_path: /custom/traces
+      compression: gzip
+      headers:
+        a: b
+      timeout: 5s
+  - kind:
+      signal: metrics
+      model: otlp
+      protocol: http
+    spec:
+      endpoint: collector:4318
+      insecure: true
+  - kind:
+      signal: traces
+      model: otlp
+      protocol: file
+    spec:
+      path: /tmp/temporal-traces.jsonl
+`
+
+func TestOTLPHTTPAndFile(t *testing.T) {
+	cfg := telemetry.PrivateExportConfig{}
+	err := yaml.Unmarshal([]byte(otlpHTTPAndFileConfig), &cfg)
+	require.NoError(t, err)
+	require.Len(t, cfg.Exporters, 3)
+
+	spec, ok := cfg.Exporters[0].Spec.(*telemetry.OTLPHTTPSpanExporter)
+	require.True(t, ok)
+	require.Equal(t, "collector:4318", spec.Endpoint)
+	require.Equal(t, "/custom/traces", spec.URLPath)
+	require.Equal(t, "gzip", spec.Compression)
+	require.Equal(t, map[string]string{"a": "b"}, spec.Headers)
+	require.Equal(t, 5*time.Second, spec.Timeout)
+	require.False(t, spec.Insecure)
+
+	mspec, ok := cfg.Exporters[1].Spec.(*telemetry.OTLPHTTPMetricExporter)
+	require.True(t, ok)
+	require.True(t, mspec.Insecure)
+
+	fspec, ok := cfg.Exporters[2].Spec.(*telemetry.FileSpanExporter)
+	require.True(t, ok)
+	require.Equal(t, "/tmp/temporal-traces.jsonl", fspec.Path)
+
+	spanExporters, err := cfg.SpanExporters()
+	require.NoError(t, err)
+	require.Len(t, spanExporters, 2)
+
+	metricExporters, err := cfg.MetricExporters()
+	require.NoError(t, err)
+	require.Len(t, metricExporters, 1)
+}
@@ -28,4 +28,9 @@ type (
 	OTLPGRPCSpanExporter   = otlpGrpcSpanExporter
 	OTLPGRPCMetricExporter = otlpGrpcMetricExporter
 	PrivateExportConfig    = exportConfig
+	OTLPHTTPSpanExporter   = otlpHTTPSpanExporter
+	OTLPHTTPMetricExporter = otlpHTTPMetricExporter
+	FileSpanExporter       = fileSpanExporter
 )
+
+var RequestAttributes = requestAttributes
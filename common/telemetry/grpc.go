@@ -25,9 +25,13 @@
 package telemetry
 
 import (
+	"context"
+
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/trace"
+	commonpb "go.temporal.io/api/common/v1"
 	"google.golang.org/grpc"
 )
 
@@ -43,17 +47,29 @@ type (
 
 // NewServerTraceInterceptor creates a new gRPC server interceptor that tracks
 // each request with an encapsulating span using the provided TracerProvider and
-// TextMapPropagator.
+// TextMapPropagator. The span is annotated with the namespace, workflow and
+// shard identifiers found in the request.
 func NewServerTraceInterceptor(
 	tp trace.TracerProvider,
 	tmp propagation.TextMapPropagator,
 ) ServerTraceInterceptor {
-	return ServerTraceInterceptor(
-		otelgrpc.UnaryServerInterceptor(
-			otelgrpc.WithPropagators(tmp),
-			otelgrpc.WithTracerProvider(tp),
-		),
+	otelInterceptor := otelgrpc.UnaryServerInterceptor(
+		otelgrpc.WithPropagators(tmp),
+		otelgrpc.WithTracerProvider(tp),
 	)
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		return otelInterceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			if span := trace.SpanFromContext(ctx); span.IsRecording() {
+				span.SetAttributes(requestAttributes(req)...)
+			}
+			return handler(ctx, req)
+		})
+	}
 }
 
 // NewClientTraceInterceptor creates a new gRPC client interceptor that tracks
@@ -70,3 +86,27 @@ func NewClientTraceInterceptor(
 		),
 	)
 }
+
+// requestAttributes extracts the namespace and workflow identifiers carried by
+// most frontend, history and matching requests
+func requestAttributes(req interface{}) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	if r, ok := req.(interface{ GetNamespace() string }); ok && r.GetNamespace() != "" {
+		attrs = append(attrs, NamespaceKey.String(r.GetNamespace()))
+	}
+	if r, ok := req.(interface{ GetNamespaceId() string }); ok && r.GetNamespaceId() != "" {
+		attrs = append(attrs, NamespaceIDKey.String(r.GetNamespaceId()))
+	}
+	if r, ok := req.(interface{ GetWorkflowId() string }); ok && r.GetWorkflowId() != "" {
+		attrs = append(attrs, WorkflowIDKey.String(r.GetWorkflowId()))
+	}
+	if r, ok := req.(interface {
+		GetWorkflowExecution() *commonpb.WorkflowExecution
+	}); ok && r.GetWorkflowExecution() != nil {
+		attrs = append(attrs, WorkflowAttributes("", r.GetWorkflowExecution().GetWorkflowId(), r.GetWorkflowExecution().GetRunId())...)
+	}
+	if r, ok := req.(interface{ GetShardId() int32 }); ok && r.GetShardId() != 0 {
+		attrs = append(attrs, ShardIDKey.Int64(int64(r.GetShardId())))
+	}
+	return attrs
+}
@@ -0,0 +1,65 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package telemetry_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/telemetry"
+)
+
+func TestRequestAttributes(t *testing.T) {
+	t.Parallel()
+
+	attrs := telemetry.RequestAttributes(&workflowservice.StartWorkflowExecutionRequest{
+		Namespace:  "test-namespace",
+		WorkflowId: "test-workflow-id",
+	})
+	require.ElementsMatch(t, []attribute.KeyValue{
+		telemetry.NamespaceKey.String("test-namespace"),
+		telemetry.WorkflowIDKey.String("test-workflow-id"),
+	}, attrs)
+
+	attrs = telemetry.RequestAttributes(&historyservice.RecordWorkflowTaskStartedRequest{
+		NamespaceId: "test-namespace-id",
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: "test-workflow-id",
+			RunId:      "test-run-id",
+		},
+	})
+	require.ElementsMatch(t, []attribute.KeyValue{
+		telemetry.NamespaceIDKey.String("test-namespace-id"),
+		telemetry.WorkflowIDKey.String("test-workflow-id"),
+		telemetry.RunIDKey.String("test-run-id"),
+	}, attrs)
+
+	require.Empty(t, telemetry.RequestAttributes(&workflowservice.GetSystemInfoRequest{}))
+}
@@ -0,0 +1,118 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type (
+	// otlpFileClient appends each export request to a file as a single line of
+	// OTLP JSON, the format read by the OpenTelemetry collector's otlpjsonfile
+	// receiver.
+	otlpFileClient struct {
+		path string
+
+		sync.Mutex
+		file *os.File
+	}
+
+	otlpFileTraceClient struct {
+		*otlpFileClient
+	}
+
+	otlpFileMetricClient struct {
+		*otlpFileClient
+	}
+)
+
+func newOtlpFileClient(cfg *fileExporter) (*otlpFileClient, error) {
+	if cfg.Path == "" {
+		return nil, fmt.Errorf("file exporter path is required")
+	}
+	return &otlpFileClient{path: cfg.Path}, nil
+}
+
+// Start opens the file for appending, creating it if needed
+func (c *otlpFileClient) Start(context.Context) error {
+	c.Lock()
+	defer c.Unlock()
+	file, err := os.OpenFile(c.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	c.file = file
+	return nil
+}
+
+// Stop closes the file
+func (c *otlpFileClient) Stop(context.Context) error {
+	c.Lock()
+	defer c.Unlock()
+	if c.file == nil {
+		return nil
+	}
+	err := c.file.Close()
+	c.file = nil
+	return err
+}
+
+// UploadTraces appends the spans to the file
+func (c *otlpFileTraceClient) UploadTraces(_ context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	return c.write(&coltracepb.ExportTraceServiceRequest{ResourceSpans: protoSpans})
+}
+
+// UploadMetrics appends the metrics to the file
+func (c *otlpFileMetricClient) UploadMetrics(_ context.Context, protoMetrics *metricpb.ResourceMetrics) error {
+	return c.write(&colmetricpb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricpb.ResourceMetrics{protoMetrics},
+	})
+}
+
+func (c *otlpFileClient) write(msg proto.Message) error {
+	line, err := protojson.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	c.Lock()
+	defer c.Unlock()
+	if c.file == nil {
+		return fmt.Errorf("file exporter %q is not started", c.path)
+	}
+	_, err = c.file.Write(line)
+	return err
+}
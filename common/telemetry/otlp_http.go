@@ -0,0 +1,125 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package telemetry
+
+import (
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	otelsdkmetricexp "go.opentelemetry.io/otel/sdk/metric/export"
+	otelsdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	gzipCompression = "gzip"
+)
+
+func (ec *exportConfig) buildOtlpHTTPSpanExporter(
+	cfg *otlpHTTPSpanExporter,
+) (otelsdktrace.SpanExporter, error) {
+	opts, err := otlpHTTPTraceOptions(&cfg.otlpHTTPExporter)
+	if err != nil {
+		return nil, err
+	}
+	return otlptracehttp.NewUnstarted(opts...), nil
+}
+
+func (ec *exportConfig) buildOtlpHTTPMetricExporter(
+	cfg *otlpHTTPMetricExporter,
+) (otelsdkmetricexp.Exporter, error) {
+	opts, err := otlpHTTPMetricOptions(&cfg.otlpHTTPExporter)
+	if err != nil {
+		return nil, err
+	}
+	return otlpmetrichttp.NewUnstarted(opts...), nil
+}
+
+func otlpHTTPTraceOptions(cfg *otlpHTTPExporter) ([]otlptracehttp.Option, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	opts := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(cfg.Endpoint),
+		otlptracehttp.WithHeaders(cfg.Headers),
+		otlptracehttp.WithTimeout(coalesce(cfg.Timeout, 10*time.Second)),
+		otlptracehttp.WithRetry(otlptracehttp.RetryConfig{
+			Enabled:         coalesce(cfg.Retry.Enabled, retryDefaultEnabled),
+			InitialInterval: coalesce(cfg.Retry.InitialInterval, retryDefaultInitialInterval),
+			MaxInterval:     coalesce(cfg.Retry.MaxInterval, retryDefaultMaxInterval),
+			MaxElapsedTime:  coalesce(cfg.Retry.MaxElapsedTime, retryDefaultMaxElapsedTime),
+		}),
+	}
+	if cfg.URLPath != "" {
+		opts = append(opts, otlptracehttp.WithURLPath(cfg.URLPath))
+	}
+	if cfg.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	if cfg.Compression == gzipCompression {
+		opts = append(opts, otlptracehttp.WithCompression(otlptracehttp.GzipCompression))
+	}
+	return opts, nil
+}
+
+func otlpHTTPMetricOptions(cfg *otlpHTTPExporter) ([]otlpmetrichttp.Option, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	opts := []otlpmetrichttp.Option{
+		otlpmetrichttp.WithEndpoint(cfg.Endpoint),
+		otlpmetrichttp.WithHeaders(cfg.Headers),
+		otlpmetrichttp.WithTimeout(coalesce(cfg.Timeout, 10*time.Second)),
+		otlpmetrichttp.WithRetry(otlpmetrichttp.RetryConfig{
+			Enabled:         coalesce(cfg.Retry.Enabled, retryDefaultEnabled),
+			InitialInterval: coalesce(cfg.Retry.InitialInterval, retryDefaultInitialInterval),
+			MaxInterval:     coalesce(cfg.Retry.MaxInterval, retryDefaultMaxInterval),
+			MaxElapsedTime:  coalesce(cfg.Retry.MaxElapsedTime, retryDefaultMaxElapsedTime),
+		}),
+	}
+	if cfg.URLPath != "" {
+		opts = append(opts, otlpmetrichttp.WithURLPath(cfg.URLPath))
+	}
+	if cfg.Insecure {
+		opts = append(opts, otlpmetrichttp.WithInsecure())
+	}
+	if cfg.Compression == gzipCompression {
+		opts = append(opts, otlpmetrichttp.WithCompression(otlpmetrichttp.GzipCompression))
+	}
+	return opts, nil
+}
+
+func (cfg *otlpHTTPExporter) validate() error {
+	if cfg.Endpoint == "" {
+		return fmt.Errorf("OTLP/HTTP exporter endpoint is required")
+	}
+	switch cfg.Compression {
+	case "", "none", gzipCompression:
+		return nil
+	default:
+		return fmt.Errorf("unsupported OTLP/HTTP exporter compression: %q", cfg.Compression)
+	}
+}
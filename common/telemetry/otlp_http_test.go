@@ -0,0 +1,101 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package telemetry
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestOtlpHTTPTraceOptions_Upload(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+	var received coltracepb.ExportTraceServiceRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		require.Equal(t, "/custom/traces", r.URL.Path)
+		require.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+		require.Equal(t, "gzip", r.Header.Get("Content-Encoding"))
+		require.Equal(t, "b", r.Header.Get("a"))
+		gz, err := gzip.NewReader(r.Body)
+		require.NoError(t, err)
+		body, err := io.ReadAll(gz)
+		require.NoError(t, err)
+		require.NoError(t, proto.Unmarshal(body, &received))
+	}))
+	defer server.Close()
+
+	opts, err := otlpHTTPTraceOptions(&otlpHTTPExporter{
+		Endpoint:    strings.TrimPrefix(server.URL, "http://"),
+		URLPath:     "/custom/traces",
+		Insecure:    true,
+		Headers:     map[string]string{"a": "b"},
+		Compression: "gzip",
+		Retry: retryConfig{
+			Enabled:         true,
+			InitialInterval: time.Millisecond,
+			MaxInterval:     time.Millisecond,
+			MaxElapsedTime:  time.Second,
+		},
+	})
+	require.NoError(t, err)
+	client := otlptracehttp.NewClient(opts...)
+	require.NoError(t, client.Start(context.Background()))
+	defer func() { _ = client.Stop(context.Background()) }()
+
+	spans := []*tracepb.ResourceSpans{{SchemaUrl: "test-schema"}}
+	require.NoError(t, client.UploadTraces(context.Background(), spans))
+	require.Equal(t, int32(2), attempts.Load())
+	require.Len(t, received.ResourceSpans, 1)
+	require.Equal(t, "test-schema", received.ResourceSpans[0].SchemaUrl)
+}
+
+func TestOtlpHTTPOptions_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	_, err := otlpHTTPTraceOptions(&otlpHTTPExporter{})
+	require.Error(t, err)
+
+	_, err = otlpHTTPMetricOptions(&otlpHTTPExporter{Endpoint: "localhost:4318", Compression: "zstd"})
+	require.Error(t, err)
+}
@@ -82,6 +82,7 @@ import (
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker"
 	"go.temporal.io/server/service/worker/addsearchattributes"
+	"go.temporal.io/server/service/worker/renamenamespace"
 )
 
 const (
@@ -1311,6 +1312,34 @@ func (adh *AdminHandler) RefreshWorkflowTasks(
 	return &adminservice.RefreshWorkflowTasksResponse{}, nil
 }
 
+// RearchiveWorkflowExecution schedules a new archival task for a closed workflow execution
+func (adh *AdminHandler) RearchiveWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RearchiveWorkflowExecutionRequest,
+) (_ *adminservice.RearchiveWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	namespaceEntry, err := adh.namespaceRegistry.GetNamespaceByID(namespace.ID(request.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.historyClient.RearchiveWorkflowExecution(ctx, &historyservice.RearchiveWorkflowExecutionRequest{
+		NamespaceId: namespaceEntry.ID().String(),
+		Request:     request,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.RearchiveWorkflowExecutionResponse{}, nil
+}
+
 // ResendReplicationTasks requests replication task from remote cluster
 func (adh *AdminHandler) ResendReplicationTasks(
 	ctx context.Context,
@@ -1383,6 +1412,116 @@ func (adh *AdminHandler) GetTaskQueueTasks(
 	}, nil
 }
 
+// CountWorkflowExecutions counts the executions matching a visibility query, together with the
+// counts of each group if the query has a GROUP BY clause.
+func (adh *AdminHandler) CountWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.CountWorkflowExecutionsRequest,
+) (_ *adminservice.CountWorkflowExecutionsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	namespaceName := namespace.Name(request.GetNamespace())
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.visibilityMgr.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: namespaceID,
+		Namespace:   namespaceName,
+		Query:       request.GetQuery(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]*adminservice.AggregationGroup, 0, len(resp.Groups))
+	for _, group := range resp.Groups {
+		groups = append(groups, &adminservice.AggregationGroup{
+			GroupValues: group.GroupValues,
+			Count:       group.Count,
+		})
+	}
+	return &adminservice.CountWorkflowExecutionsResponse{
+		Count:      resp.Count,
+		Groups:     groups,
+		OtherCount: resp.OtherCount,
+	}, nil
+}
+
+// RenameNamespace renames a namespace by running the rename namespace system workflow.
+func (adh *AdminHandler) RenameNamespace(
+	ctx context.Context,
+	request *adminservice.RenameNamespaceRequest,
+) (_ *adminservice.RenameNamespaceResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetNewName() == "" {
+		return nil, errNewNamespaceNameNotSet
+	}
+	if request.GetNamespace() == primitives.SystemLocalNamespace {
+		return nil, errUnableRenameSystemNamespace
+	}
+	ns, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	if ns.IsGlobalNamespace() {
+		return nil, errUnableRenameGlobalNamespace
+	}
+
+	// Execute workflow.
+	wfParams := renamenamespace.RenameNamespaceWorkflowParams{
+		Namespace:             namespace.Name(request.GetNamespace()),
+		NewName:               namespace.Name(request.GetNewName()),
+		KeepAlias:             request.GetKeepAlias(),
+		HistoryArchivalURI:    request.GetHistoryArchivalUri(),
+		VisibilityArchivalURI: request.GetVisibilityArchivalUri(),
+	}
+
+	workflowID := fmt.Sprintf("%s/%s", renamenamespace.WorkflowName, request.GetNamespace())
+	sdkClient := adh.sdkClientFactory.GetSystemClient()
+	run, err := sdkClient.ExecuteWorkflow(
+		ctx,
+		sdkclient.StartWorkflowOptions{
+			TaskQueue: worker.DefaultWorkerTaskQueue,
+			ID:        workflowID,
+		},
+		renamenamespace.WorkflowName,
+		wfParams,
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf(errUnableToStartWorkflowMessage, renamenamespace.WorkflowName, err))
+	}
+
+	// Wait for workflow to complete.
+	var wfResult renamenamespace.RenameNamespaceWorkflowResult
+	err = run.Get(ctx, &wfResult)
+	if err != nil {
+		execution := &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: run.GetRunID()}
+		return nil, serviceerror.NewSystemWorkflow(execution, err)
+	}
+
+	aliases := make([]string, 0, len(wfResult.Aliases))
+	for _, alias := range wfResult.Aliases {
+		aliases = append(aliases, alias.String())
+	}
+	return &adminservice.RenameNamespaceResponse{
+		NamespaceId: wfResult.NamespaceID.String(),
+		Aliases:     aliases,
+	}, nil
+}
+
 func (adh *AdminHandler) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -67,7 +67,9 @@ import (
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/worker/renamenamespace"
 )
 
 type (
@@ -590,6 +592,85 @@ func (s *adminHandlerSuite) Test_AddSearchAttributes() {
 	s.NotNil(resp)
 }
 
+func (s *adminHandlerSuite) Test_RenameNamespace() {
+	handler := s.handler
+	ctx := context.Background()
+
+	resp, err := handler.RenameNamespace(ctx, &adminservice.RenameNamespaceRequest{Namespace: "namespace"})
+	s.Equal(errNewNamespaceNameNotSet, err)
+	s.Nil(resp)
+	resp, err = handler.RenameNamespace(ctx, &adminservice.RenameNamespaceRequest{Namespace: primitives.SystemLocalNamespace, NewName: "new-namespace"})
+	s.Equal(errUnableRenameSystemNamespace, err)
+	s.Nil(resp)
+
+	s.mockNamespaceCache.EXPECT().GetNamespace(namespace.Name("global-namespace")).Return(
+		namespace.NewGlobalNamespaceForTest(&persistencespb.NamespaceInfo{Name: "global-namespace"}, nil, nil, 0), nil)
+	resp, err = handler.RenameNamespace(ctx, &adminservice.RenameNamespaceRequest{Namespace: "global-namespace", NewName: "new-namespace"})
+	s.Equal(errUnableRenameGlobalNamespace, err)
+	s.Nil(resp)
+
+	s.mockNamespaceCache.EXPECT().GetNamespace(namespace.Name("namespace")).Return(
+		namespace.NewLocalNamespaceForTest(&persistencespb.NamespaceInfo{Name: "namespace"}, nil, ""), nil)
+
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient).AnyTimes()
+	mockRun := mocksdk.NewMockWorkflowRun(s.controller)
+	mockRun.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, valuePtr interface{}) error {
+		*valuePtr.(*renamenamespace.RenameNamespaceWorkflowResult) = renamenamespace.RenameNamespaceWorkflowResult{
+			NamespaceID: "namespace-id",
+			Aliases:     []namespace.Name{"namespace"},
+		}
+		return nil
+	})
+	mockSdkClient.EXPECT().ExecuteWorkflow(gomock.Any(), gomock.Any(), renamenamespace.WorkflowName, renamenamespace.RenameNamespaceWorkflowParams{
+		Namespace: "namespace",
+		NewName:   "new-namespace",
+		KeepAlias: true,
+	}).Return(mockRun, nil)
+
+	resp, err = handler.RenameNamespace(ctx, &adminservice.RenameNamespaceRequest{
+		Namespace: "namespace",
+		NewName:   "new-namespace",
+		KeepAlias: true,
+	})
+	s.NoError(err)
+	s.Equal(&adminservice.RenameNamespaceResponse{
+		NamespaceId: "namespace-id",
+		Aliases:     []string{"namespace"},
+	}, resp)
+}
+
+func (s *adminHandlerSuite) Test_CountWorkflowExecutions_GroupBy() {
+	query := "GROUP BY ExecutionStatus"
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: s.namespaceID,
+		Namespace:   s.namespace,
+		Query:       query,
+	}).Return(&manager.CountWorkflowExecutionsResponse{
+		Count: 5,
+		Groups: []manager.AggregationGroup{
+			{GroupValues: []string{"Running"}, Count: 3},
+			{GroupValues: []string{"Completed"}, Count: 1},
+		},
+		OtherCount: 1,
+	}, nil)
+
+	resp, err := s.handler.CountWorkflowExecutions(context.Background(), &adminservice.CountWorkflowExecutionsRequest{
+		Namespace: s.namespace.String(),
+		Query:     query,
+	})
+	s.NoError(err)
+	s.Equal(&adminservice.CountWorkflowExecutionsResponse{
+		Count: 5,
+		Groups: []*adminservice.AggregationGroup{
+			{GroupValues: []string{"Running"}, Count: 3},
+			{GroupValues: []string{"Completed"}, Count: 1},
+		},
+		OtherCount: 1,
+	}, resp)
+}
+
 func (s *adminHandlerSuite) Test_GetSearchAttributes_EmptyIndexName() {
 	handler := s.handler
 	ctx := context.Background()
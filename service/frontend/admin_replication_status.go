@@ -0,0 +1,213 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"sync"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/replicationstatus"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+// replicationDLQCountConcurrency bounds the number of shard DLQs read concurrently by GetReplicationStatus
+const replicationDLQCountConcurrency = 16
+
+// GetReplicationStatus reports, for each remote cluster, how far behind the remote cluster
+// is in acknowledging the replication tasks of this cluster, and how many tasks from the
+// remote cluster are stuck in the replication DLQ of this cluster.
+func (adh *AdminHandler) GetReplicationStatus(
+	ctx context.Context,
+	request *adminservice.GetReplicationStatusRequest,
+) (_ *adminservice.GetReplicationStatusResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	remoteClusters := request.RemoteClusters
+	if len(remoteClusters) == 0 {
+		currentClusterName := adh.clusterMetadata.GetCurrentClusterName()
+		for clusterName, clusterInfo := range adh.clusterMetadata.GetAllClusterInfo() {
+			if clusterName != currentClusterName && clusterInfo.Enabled {
+				remoteClusters = append(remoteClusters, clusterName)
+			}
+		}
+	}
+	maxDLQSize := int(request.MaxDlqSize)
+	if maxDLQSize <= 0 {
+		maxDLQSize = common.ReadDLQMessagesPageSize
+	}
+
+	resp, err := adh.historyClient.GetReplicationStatus(ctx, &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: remoteClusters,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	clusterShards := make([][]*adminservice.ShardReplicationStatus, len(remoteClusters))
+	for i, clusterName := range remoteClusters {
+		clusterShards[i] = make([]*adminservice.ShardReplicationStatus, 0, len(resp.Shards))
+		for _, shard := range resp.Shards {
+			clusterShards[i] = append(clusterShards[i], newShardReplicationStatus(shard, clusterName))
+		}
+	}
+	if err := adh.countReplicationDLQs(ctx, remoteClusters, clusterShards, maxDLQSize); err != nil {
+		return nil, err
+	}
+
+	response := &adminservice.GetReplicationStatusResponse{}
+	for i, clusterName := range remoteClusters {
+		response.Clusters = append(response.Clusters, replicationstatus.NewClusterReplicationStatus(
+			clusterName,
+			clusterShards[i],
+			request.IncludeShards,
+		))
+	}
+	return response, nil
+}
+
+func newShardReplicationStatus(
+	shard *historyservice.ShardReplicationStatus,
+	clusterName string,
+) *adminservice.ShardReplicationStatus {
+	status := &adminservice.ShardReplicationStatus{
+		ShardId:     shard.ShardId,
+		MaxTaskId:   shard.MaxReplicationTaskId,
+		MaxTaskTime: shard.MaxReplicationTaskVisibilityTime,
+		Lag:         timestamp.DurationPtr(0),
+	}
+	clusterInfo, ok := shard.RemoteClusters[clusterName]
+	if !ok {
+		status.Missing = true
+		return status
+	}
+
+	status.AckedTaskId = clusterInfo.AckedTaskId
+	status.AckedTaskTime = clusterInfo.AckedTaskVisibilityTime
+	if status.MaxTaskId > status.AckedTaskId {
+		status.LagTaskIds = status.MaxTaskId - status.AckedTaskId
+		maxTaskTime := timestamp.TimeValue(status.MaxTaskTime)
+		ackedTaskTime := timestamp.TimeValue(status.AckedTaskTime)
+		if maxTaskTime.After(ackedTaskTime) {
+			status.Lag = timestamp.DurationPtr(maxTaskTime.Sub(ackedTaskTime))
+		}
+	}
+	return status
+}
+
+// countReplicationDLQs fills in the DLQ sizes of the given shard statuses, reading up to
+// replicationDLQCountConcurrency shard DLQs at a time.
+func (adh *AdminHandler) countReplicationDLQs(
+	ctx context.Context,
+	remoteClusters []string,
+	clusterShards [][]*adminservice.ShardReplicationStatus,
+	maxDLQSize int,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var errOnce sync.Once
+	var firstErr error
+	semaphore := make(chan struct{}, replicationDLQCountConcurrency)
+
+count:
+	for i, clusterName := range remoteClusters {
+		for _, shardStatus := range clusterShards[i] {
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				break count
+			}
+			wg.Add(1)
+			go func(clusterName string, shardStatus *adminservice.ShardReplicationStatus) {
+				defer func() {
+					<-semaphore
+					wg.Done()
+				}()
+				size, isLowerBound, err := adh.countReplicationDLQTasks(ctx, shardStatus.ShardId, clusterName, maxDLQSize)
+				if err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					return
+				}
+				shardStatus.DlqSize = size
+				shardStatus.DlqSizeIsLowerBound = isLowerBound
+			}(clusterName, shardStatus)
+		}
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// countReplicationDLQTasks counts the DLQ tasks of a shard which come from sourceCluster,
+// stopping once more than maxCount tasks are found.
+func (adh *AdminHandler) countReplicationDLQTasks(
+	ctx context.Context,
+	shardID int32,
+	sourceCluster string,
+	maxCount int,
+) (int64, bool, error) {
+	var count int64
+	var pageToken []byte
+	for {
+		resp, err := adh.persistenceExecutionManager.GetReplicationTasksFromDLQ(ctx, &persistence.GetReplicationTasksFromDLQRequest{
+			GetHistoryTasksRequest: persistence.GetHistoryTasksRequest{
+				ShardID:             shardID,
+				TaskCategory:        tasks.CategoryReplication,
+				InclusiveMinTaskKey: tasks.NewImmediateKey(0),
+				ExclusiveMaxTaskKey: tasks.NewImmediateKey(common.EndMessageID),
+				BatchSize:           maxCount - int(count) + 1,
+				NextPageToken:       pageToken,
+			},
+			SourceClusterName: sourceCluster,
+		})
+		if err != nil {
+			return 0, false, err
+		}
+		count += int64(len(resp.Tasks))
+		if count > int64(maxCount) {
+			return int64(maxCount), true, nil
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return count, false, nil
+		}
+	}
+}
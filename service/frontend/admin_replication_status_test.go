@@ -0,0 +1,113 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+func (s *adminHandlerSuite) TestGetReplicationStatus() {
+	now := time.Now().UTC()
+	s.mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: []string{"remote"},
+	}).Return(&historyservice.GetReplicationStatusResponse{
+		Shards: []*historyservice.ShardReplicationStatus{
+			{
+				ShardId:                          2,
+				MaxReplicationTaskId:             100,
+				MaxReplicationTaskVisibilityTime: timestamp.TimePtr(now),
+				RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+					"remote": {
+						AckedTaskId:             40,
+						AckedTaskVisibilityTime: timestamp.TimePtr(now.Add(-time.Minute)),
+					},
+				},
+			},
+			{
+				ShardId:                          1,
+				MaxReplicationTaskId:             10,
+				MaxReplicationTaskVisibilityTime: timestamp.TimePtr(now),
+				RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+					"remote": {
+						AckedTaskId:             10,
+						AckedTaskVisibilityTime: timestamp.TimePtr(now),
+					},
+				},
+			},
+			{
+				ShardId:                          3,
+				MaxReplicationTaskId:             10,
+				MaxReplicationTaskVisibilityTime: timestamp.TimePtr(now),
+			},
+		},
+	}, nil)
+	s.mockExecutionMgr.EXPECT().GetReplicationTasksFromDLQ(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.GetReplicationTasksFromDLQRequest) (*persistence.GetHistoryTasksResponse, error) {
+			s.Equal("remote", request.SourceClusterName)
+			s.Equal(3, request.BatchSize)
+			if request.ShardID == 2 {
+				return &persistence.GetHistoryTasksResponse{
+					Tasks: []tasks.Task{&tasks.HistoryReplicationTask{}, &tasks.HistoryReplicationTask{}, &tasks.HistoryReplicationTask{}},
+				}, nil
+			}
+			return &persistence.GetHistoryTasksResponse{
+				Tasks: []tasks.Task{&tasks.HistoryReplicationTask{}},
+			}, nil
+		},
+	).Times(3)
+
+	resp, err := s.handler.GetReplicationStatus(context.Background(), &adminservice.GetReplicationStatusRequest{
+		RemoteClusters: []string{"remote"},
+		IncludeShards:  true,
+		MaxDlqSize:     2,
+	})
+	s.NoError(err)
+	s.Len(resp.Clusters, 1)
+
+	status := resp.Clusters[0]
+	s.Equal("remote", status.ClusterName)
+	s.Equal(int32(3), status.ShardCount)
+	s.Equal(int32(1), status.MissingShardCount)
+	s.Equal(int64(60), status.MaxLagTaskIds)
+	s.Equal(time.Minute, *status.MaxLag)
+	s.Equal(int64(4), status.DlqSize)
+	s.True(status.DlqSizeIsLowerBound)
+
+	s.Len(status.Shards, 3)
+	s.Equal(int32(1), status.Shards[0].ShardId)
+	s.Zero(status.Shards[0].LagTaskIds)
+	s.Equal(int64(60), status.Shards[1].LagTaskIds)
+	s.True(status.Shards[1].DlqSizeIsLowerBound)
+	s.True(status.Shards[2].Missing)
+}
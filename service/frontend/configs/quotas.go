@@ -35,6 +35,10 @@ var (
 	ExecutionAPICountLimitOverride = map[string]int{
 		"PollActivityTaskQueue": 1,
 		"PollWorkflowTaskQueue": 1,
+
+		// streams hold their token for as long as they are open, like long polls
+		"StreamWorkflowHistory":    1,
+		"StreamWorkflowExecutions": 1,
 	}
 
 	ExecutionAPIToPriority = map[string]int{
@@ -70,6 +74,7 @@ var (
 		"GetWorkerBuildIdOrdering":           2,
 		"UpdateWorkerBuildIdOrdering":        2,
 		"DeleteWorkflowExecution":            2,
+		"StreamWorkflowHistory":              2,
 
 		// priority 3
 		"ResetStickyTaskQueue":    3,
@@ -86,6 +91,7 @@ var (
 		"ListClosedWorkflowExecutions":   0,
 		"ListWorkflowExecutions":         0,
 		"ListArchivedWorkflowExecutions": 0,
+		"StreamWorkflowExecutions":       0,
 	}
 
 	VisibilityAPIPrioritiesOrdered = []int{0}
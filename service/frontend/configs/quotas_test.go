@@ -32,6 +32,8 @@ import (
 	"github.com/stretchr/testify/suite"
 	"go.temporal.io/api/workflowservice/v1"
 	"golang.org/x/exp/slices"
+
+	"go.temporal.io/server/api/historystreamservice/v1"
 )
 
 type (
@@ -129,17 +131,15 @@ func (s *quotasSuite) TestExecutionAPIs() {
 		"GetWorkerBuildIdOrdering":    {},
 		"UpdateWorkerBuildIdOrdering": {},
 		"DeleteWorkflowExecution":     {},
+		"StreamWorkflowHistory":       {},
 
 		"ResetStickyTaskQueue":    {},
 		"DescribeTaskQueue":       {},
 		"ListTaskQueuePartitions": {},
 	}
 
-	var service workflowservice.WorkflowServiceServer
-	t := reflect.TypeOf(&service).Elem()
-	apiToPriority := make(map[string]int, t.NumMethod())
-	for i := 0; i < t.NumMethod(); i++ {
-		apiName := t.Method(i).Name
+	apiToPriority := make(map[string]int)
+	for _, apiName := range frontendAPINames() {
 		if _, ok := apis[apiName]; ok {
 			apiToPriority[apiName] = ExecutionAPIToPriority[apiName]
 		}
@@ -156,13 +156,11 @@ func (s *quotasSuite) TestVisibilityAPIs() {
 		"ListClosedWorkflowExecutions":   {},
 		"ListWorkflowExecutions":         {},
 		"ListArchivedWorkflowExecutions": {},
+		"StreamWorkflowExecutions":       {},
 	}
 
-	var service workflowservice.WorkflowServiceServer
-	t := reflect.TypeOf(&service).Elem()
-	apiToPriority := make(map[string]int, t.NumMethod())
-	for i := 0; i < t.NumMethod(); i++ {
-		apiName := t.Method(i).Name
+	apiToPriority := make(map[string]int)
+	for _, apiName := range frontendAPINames() {
 		if _, ok := apis[apiName]; ok {
 			apiToPriority[apiName] = VisibilityAPIToPriority[apiName]
 		}
@@ -196,11 +194,8 @@ func (s *quotasSuite) TestOtherAPIs() {
 		"StopBatchOperation":     {},
 	}
 
-	var service workflowservice.WorkflowServiceServer
-	t := reflect.TypeOf(&service).Elem()
-	apiToPriority := make(map[string]int, t.NumMethod())
-	for i := 0; i < t.NumMethod(); i++ {
-		apiName := t.Method(i).Name
+	apiToPriority := make(map[string]int)
+	for _, apiName := range frontendAPINames() {
 		if _, ok := apis[apiName]; ok {
 			apiToPriority[apiName] = OtherAPIToPriority[apiName]
 		}
@@ -209,11 +204,9 @@ func (s *quotasSuite) TestOtherAPIs() {
 }
 
 func (s *quotasSuite) TestAllAPIs() {
-	var service workflowservice.WorkflowServiceServer
-	t := reflect.TypeOf(&service).Elem()
-	expectedAPIs := make(map[string]struct{}, t.NumMethod())
-	for i := 0; i < t.NumMethod(); i++ {
-		expectedAPIs[t.Method(i).Name] = struct{}{}
+	expectedAPIs := make(map[string]struct{})
+	for _, apiName := range frontendAPINames() {
+		expectedAPIs[apiName] = struct{}{}
 	}
 
 	actualAPIs := make(map[string]struct{})
@@ -228,3 +221,19 @@ func (s *quotasSuite) TestAllAPIs() {
 	}
 	s.Equal(expectedAPIs, actualAPIs)
 }
+
+// frontendAPINames returns the methods of every service served by the frontend.
+func frontendAPINames() []string {
+	var workflowService workflowservice.WorkflowServiceServer
+	var historyStreamService historystreamservice.HistoryStreamServiceServer
+	var apiNames []string
+	for _, t := range []reflect.Type{
+		reflect.TypeOf(&workflowService).Elem(),
+		reflect.TypeOf(&historyStreamService).Elem(),
+	} {
+		for i := 0; i < t.NumMethod(); i++ {
+			apiNames = append(apiNames, t.Method(i).Name)
+		}
+	}
+	return apiNames
+}
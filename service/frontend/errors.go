@@ -44,6 +44,10 @@ var (
 	errInvalidWorkflowExecutionTimeoutSeconds             = serviceerror.NewInvalidArgument("An invalid WorkflowExecutionTimeoutSeconds is set on request.")
 	errInvalidWorkflowRunTimeoutSeconds                   = serviceerror.NewInvalidArgument("An invalid WorkflowRunTimeoutSeconds is set on request.")
 	errInvalidWorkflowTaskTimeoutSeconds                  = serviceerror.NewInvalidArgument("An invalid WorkflowTaskTimeoutSeconds is set on request.")
+	errInvalidWorkflowStartDelay                          = serviceerror.NewInvalidArgument("An invalid workflow start delay is set on request.")
+	errCronAndStartDelaySet                               = serviceerror.NewInvalidArgument("CronSchedule and workflow start delay may not be used together.")
+	errInvalidWorkflowIDConflictPolicy                    = serviceerror.NewInvalidArgument("An invalid workflow ID conflict policy is set on request.")
+	errUseExistingAndTerminateIfRunningSet                = serviceerror.NewInvalidArgument("Workflow ID conflict policy use-existing and WorkflowIdReusePolicy TerminateIfRunning may not be used together.")
 	errQueryDisallowedForNamespace                        = serviceerror.NewInvalidArgument("Namespace is not allowed to query, please contact temporal team to re-enable queries.")
 	errClusterNameNotSet                                  = serviceerror.NewInvalidArgument("Cluster name is not set.")
 	errEmptyReplicationInfo                               = serviceerror.NewInvalidArgument("Replication task info is not set.")
@@ -74,6 +78,9 @@ var (
 	errIDReusePolicyNotAllowed                            = serviceerror.NewInvalidArgument("Scheduled workflow must not contain WorkflowIDReusePolicy")
 	errShuttingDown                                       = serviceerror.NewUnavailable("Shutting down")
 	errUnableDeleteSystemNamespace                        = serviceerror.NewInvalidArgument("Unable to delete system namespace.")
+	errUnableRenameSystemNamespace                        = serviceerror.NewInvalidArgument("Unable to rename system namespace.")
+	errUnableRenameGlobalNamespace                        = serviceerror.NewInvalidArgument("Unable to rename global namespace, renaming is not replicated to other clusters.")
+	errNewNamespaceNameNotSet                             = serviceerror.NewInvalidArgument("New namespace name is not set on request.")
 	errBatchJobIDNotSet                                   = serviceerror.NewInvalidArgument("JobId is not set on request.")
 	errNamespaceNotSet                                    = serviceerror.NewInvalidArgument("Namespace is not set on request.")
 	errReasonNotSet                                       = serviceerror.NewInvalidArgument("Reason is not set on request.")
@@ -101,6 +108,10 @@ var (
 	errListNotAllowed      = serviceerror.NewPermissionDenied("List is disabled on this namespace.", "")
 	errSchedulesNotAllowed = serviceerror.NewPermissionDenied("Schedules are disabled on this namespace.", "")
 
+	errHistoryStreamingNotAllowed = serviceerror.NewPermissionDenied("History streaming is disabled on this namespace.", "")
+	errInvalidStreamCursor        = serviceerror.NewInvalidArgument("Invalid stream cursor.")
+	errStreamRequiresAdvancedVis  = serviceerror.NewFailedPrecondition("Streaming workflow executions requires advanced visibility.")
+
 	errBatchAPINotAllowed                = serviceerror.NewPermissionDenied("Batch operation feature are disabled on this namespace.", "")
 	errBatchOpsWorkflowFilterNotSet      = serviceerror.NewInvalidArgument("Workflow executions and visibility filter are not set on request.")
 	errBatchOpsWorkflowFiltersNotAllowed = serviceerror.NewInvalidArgument("Workflow executions and visibility filter are both set on request. Only one of them is allowed.")
@@ -92,6 +92,7 @@ var Module = fx.Options(
 	fx.Provide(HandlerProvider),
 	fx.Provide(AdminHandlerProvider),
 	fx.Provide(OperatorHandlerProvider),
+	fx.Provide(HistoryStreamHandlerProvider),
 	fx.Provide(NewVersionChecker),
 	fx.Provide(ServiceResolverProvider),
 	fx.Provide(NewServiceProvider),
@@ -105,6 +106,7 @@ func NewServiceProvider(
 	handler Handler,
 	adminHandler *AdminHandler,
 	operatorHandler *OperatorHandlerImpl,
+	historyStreamHandler *HistoryStreamHandler,
 	versionChecker *VersionChecker,
 	visibilityMgr manager.VisibilityManager,
 	logger log.SnTaggedLogger,
@@ -119,6 +121,7 @@ func NewServiceProvider(
 		handler,
 		adminHandler,
 		operatorHandler,
+		historyStreamHandler,
 		versionChecker,
 		visibilityMgr,
 		logger,
@@ -199,6 +202,9 @@ func GrpcServerOptionsProvider(
 		// TODO: Deprecate WithChainedFrontendGrpcInterceptors and provide a inner custom interceptor
 		interceptors = append(interceptors, customInterceptors...)
 	}
+	// streams run the same interceptors as unary calls, except for retries which would
+	// call the stream handler again
+	streamInterceptor := interceptor.NewUnaryServerStreamInterceptor(interceptors...)
 	// retry interceptor should be the most inner interceptor
 	interceptors = append(interceptors, retryableInterceptor.Intercept)
 
@@ -207,6 +213,7 @@ func GrpcServerOptionsProvider(
 		grpc.KeepaliveParams(kp),
 		grpc.KeepaliveEnforcementPolicy(kep),
 		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptor),
 	)
 }
 
@@ -556,6 +563,14 @@ func HandlerProvider(
 	return handler
 }
 
+func HistoryStreamHandlerProvider(
+	serviceConfig *Config,
+	handler Handler,
+	logger log.SnTaggedLogger,
+) *HistoryStreamHandler {
+	return NewHistoryStreamHandler(serviceConfig, handler, logger)
+}
+
 func ServiceLifetimeHooks(
 	lc fx.Lifecycle,
 	svcStoppedCh chan struct{},
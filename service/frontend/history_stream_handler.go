@@ -0,0 +1,280 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/historystreamservice/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	// historyStreamLongPollTimeout bounds a single long poll for new events; history
+	// returns earlier with no events when its own long poll expires.
+	historyStreamLongPollTimeout = time.Minute
+)
+
+type (
+	// HistoryStreamHandler implements the history stream service on top of the workflow
+	// handler: history events are long polled from history (which waits on the events
+	// notifier), and state transitions are polled from visibility.
+	HistoryStreamHandler struct {
+		historystreamservice.UnimplementedHistoryStreamServiceServer
+
+		config  *Config
+		handler Handler
+		logger  log.Logger
+	}
+
+	// executionsStreamCursor resumes StreamWorkflowExecutions after the transitions
+	// up to TransitionTime.
+	executionsStreamCursor struct {
+		TransitionTime time.Time `json:"transitionTime"`
+	}
+)
+
+var _ historystreamservice.HistoryStreamServiceServer = (*HistoryStreamHandler)(nil)
+
+func NewHistoryStreamHandler(
+	config *Config,
+	handler Handler,
+	logger log.Logger,
+) *HistoryStreamHandler {
+	return &HistoryStreamHandler{
+		config:  config,
+		handler: handler,
+		logger:  logger,
+	}
+}
+
+// StreamWorkflowHistory pushes the history events of a workflow as they are written, until it closes.
+func (h *HistoryStreamHandler) StreamWorkflowHistory(
+	request *workflowservice.GetWorkflowExecutionHistoryRequest,
+	stream historystreamservice.HistoryStreamService_StreamWorkflowHistoryServer,
+) (retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	if request == nil {
+		return errRequestNotSet
+	}
+	if !h.config.EnableHistoryStreaming(request.GetNamespace()) {
+		return errHistoryStreamingNotAllowed
+	}
+
+	ctx := stream.Context()
+	rateLimiter := h.pollRateLimiter(request.GetNamespace())
+	request.WaitNewEvent = true
+	for {
+		if err := rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+		response, err := h.pollHistory(ctx, request)
+		if err != nil {
+			return err
+		}
+		if len(response.GetHistory().GetEvents()) > 0 || len(response.GetRawHistory()) > 0 {
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+		}
+		if len(response.NextPageToken) == 0 {
+			// workflow is closed and all its events were sent
+			return nil
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+}
+
+// StreamWorkflowExecutions pushes the executions matching the request query each time one starts or closes.
+// Transitions are found by querying visibility every frontend.historyStreamPollInterval (10s by default),
+// so they are pushed up to one interval, plus the visibility processing lag, after they happen.
+// It requires advanced visibility, since the query filters on StartTime and CloseTime.
+// Delivery is at least once: executions may be sent again after resuming from a cursor.
+func (h *HistoryStreamHandler) StreamWorkflowExecutions(
+	request *workflowservice.ListWorkflowExecutionsRequest,
+	stream historystreamservice.HistoryStreamService_StreamWorkflowExecutionsServer,
+) (retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	if request == nil {
+		return errRequestNotSet
+	}
+	namespaceName := request.GetNamespace()
+	if !h.config.EnableHistoryStreaming(namespaceName) {
+		return errHistoryStreamingNotAllowed
+	}
+	if !h.config.EnableReadVisibilityFromES(namespaceName) {
+		return errStreamRequiresAdvancedVis
+	}
+
+	cursor := executionsStreamCursor{TransitionTime: time.Now().UTC()}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, &cursor); err != nil {
+			return errInvalidStreamCursor
+		}
+	}
+
+	ctx := stream.Context()
+	rateLimiter := h.pollRateLimiter(namespaceName)
+	// transitions reach visibility asynchronously, so each poll overlaps the previous one
+	// by a poll interval, and executions already sent in that window are skipped.
+	sent := make(map[string]time.Time)
+	for {
+		pollInterval := h.config.HistoryStreamPollInterval(namespaceName)
+		var err error
+		cursor, err = h.pushTransitions(ctx, request, cursor, pollInterval, sent, rateLimiter, stream)
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// pollRateLimiter throttles the polls of a single stream, so that streams of busy workflows
+// or broad queries cannot turn into a tight loop against history and visibility.
+func (h *HistoryStreamHandler) pollRateLimiter(namespaceName string) quotas.RateLimiter {
+	return quotas.NewDefaultOutgoingRateLimiter(func() float64 {
+		return h.config.HistoryStreamPollRPS(namespaceName)
+	})
+}
+
+func (h *HistoryStreamHandler) pollHistory(
+	ctx context.Context,
+	request *workflowservice.GetWorkflowExecutionHistoryRequest,
+) (*workflowservice.GetWorkflowExecutionHistoryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, historyStreamLongPollTimeout)
+	defer cancel()
+	return h.handler.GetWorkflowExecutionHistory(ctx, request)
+}
+
+func (h *HistoryStreamHandler) pushTransitions(
+	ctx context.Context,
+	request *workflowservice.ListWorkflowExecutionsRequest,
+	cursor executionsStreamCursor,
+	overlap time.Duration,
+	sent map[string]time.Time,
+	rateLimiter quotas.RateLimiter,
+	stream historystreamservice.HistoryStreamService_StreamWorkflowExecutionsServer,
+) (executionsStreamCursor, error) {
+	from := cursor.TransitionTime.Add(-overlap)
+	for key, transitionTime := range sent {
+		if transitionTime.Before(from) {
+			delete(sent, key)
+		}
+	}
+
+	query := fmt.Sprintf("(%s >= '%s' OR %s >= '%s')",
+		searchattribute.StartTime, from.Format(time.RFC3339Nano),
+		searchattribute.CloseTime, from.Format(time.RFC3339Nano),
+	)
+	if request.GetQuery() != "" {
+		query = fmt.Sprintf("(%s) AND %s", request.GetQuery(), query)
+	}
+
+	next := cursor
+	var pageToken []byte
+	for {
+		if err := rateLimiter.Wait(ctx); err != nil {
+			return cursor, err
+		}
+		response, err := h.handler.ListWorkflowExecutions(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace:     request.GetNamespace(),
+			PageSize:      request.GetPageSize(),
+			NextPageToken: pageToken,
+			Query:         query,
+		})
+		if err != nil {
+			return cursor, err
+		}
+
+		var executions []*workflowpb.WorkflowExecutionInfo
+		for _, execution := range response.Executions {
+			transitionTime := executionTransitionTime(execution)
+			key := execution.GetExecution().GetRunId() + "/" + execution.GetStatus().String()
+			if _, ok := sent[key]; ok {
+				continue
+			}
+			sent[key] = transitionTime
+			if transitionTime.After(next.TransitionTime) {
+				next.TransitionTime = transitionTime
+			}
+			executions = append(executions, execution)
+		}
+
+		pageToken = response.NextPageToken
+		if len(executions) > 0 {
+			// pages are not ordered by transition time, so only the last page of a poll
+			// moves the cursor forward.
+			responseCursor := cursor
+			if len(pageToken) == 0 {
+				responseCursor = next
+			}
+			cursorBytes, err := json.Marshal(responseCursor)
+			if err != nil {
+				return cursor, err
+			}
+			if err := stream.Send(&workflowservice.ListWorkflowExecutionsResponse{
+				Executions:    executions,
+				NextPageToken: cursorBytes,
+			}); err != nil {
+				return cursor, err
+			}
+		}
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+
+	h.logger.Debug("history stream pushed state transitions",
+		tag.WorkflowNamespace(request.GetNamespace()),
+		tag.Timestamp(next.TransitionTime),
+	)
+	return next, nil
+}
+
+func executionTransitionTime(execution *workflowpb.WorkflowExecutionInfo) time.Time {
+	if closeTime := execution.GetCloseTime(); closeTime != nil && !closeTime.IsZero() {
+		return closeTime.UTC()
+	}
+	if startTime := execution.GetStartTime(); startTime != nil {
+		return startTime.UTC()
+	}
+	return time.Time{}
+}
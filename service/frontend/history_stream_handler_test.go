@@ -0,0 +1,199 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	historyStreamHandlerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller  *gomock.Controller
+		mockHandler *MockHandler
+		config      *Config
+
+		historyStreamHandler *HistoryStreamHandler
+	}
+
+	testHistoryStream struct {
+		grpc.ServerStream
+		ctx       context.Context
+		responses []*workflowservice.GetWorkflowExecutionHistoryResponse
+	}
+
+	testExecutionsStream struct {
+		grpc.ServerStream
+		ctx       context.Context
+		cancel    context.CancelFunc
+		responses []*workflowservice.ListWorkflowExecutionsResponse
+	}
+)
+
+func TestHistoryStreamHandlerSuite(t *testing.T) {
+	suite.Run(t, new(historyStreamHandlerSuite))
+}
+
+func (s *historyStreamHandlerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockHandler = NewMockHandler(s.controller)
+	s.config = &Config{
+		EnableHistoryStreaming:    dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
+		HistoryStreamPollInterval: dynamicconfig.GetDurationPropertyFnFilteredByNamespace(time.Minute),
+		HistoryStreamPollRPS:      dynamicconfig.GetFloatPropertyFnFilteredByNamespace(1000),
+
+		EnableReadVisibilityFromES: dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
+	}
+	s.historyStreamHandler = NewHistoryStreamHandler(s.config, s.mockHandler, log.NewNoopLogger())
+}
+
+func (s *historyStreamHandlerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *historyStreamHandlerSuite) TestStreamWorkflowHistory() {
+	request := &workflowservice.GetWorkflowExecutionHistoryRequest{
+		Namespace: "test-namespace",
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "test-workflow-id"},
+	}
+	responses := []*workflowservice.GetWorkflowExecutionHistoryResponse{
+		{History: &historypb.History{Events: []*historypb.HistoryEvent{{EventId: 1}, {EventId: 2}}}, NextPageToken: []byte("token-1")},
+		{History: &historypb.History{}, NextPageToken: []byte("token-1")},
+		{History: &historypb.History{Events: []*historypb.HistoryEvent{{EventId: 3}}}},
+	}
+	for i := range responses {
+		response := responses[i]
+		s.mockHandler.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, r *workflowservice.GetWorkflowExecutionHistoryRequest) (*workflowservice.GetWorkflowExecutionHistoryResponse, error) {
+				s.True(r.WaitNewEvent)
+				return response, nil
+			},
+		)
+	}
+
+	stream := &testHistoryStream{ctx: context.Background()}
+	s.NoError(s.historyStreamHandler.StreamWorkflowHistory(request, stream))
+	s.Len(stream.responses, 2)
+	s.Equal(int64(3), stream.responses[1].History.Events[0].EventId)
+}
+
+func (s *historyStreamHandlerSuite) TestStreamWorkflowHistory_Disabled() {
+	s.config.EnableHistoryStreaming = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false)
+
+	err := s.historyStreamHandler.StreamWorkflowHistory(
+		&workflowservice.GetWorkflowExecutionHistoryRequest{Namespace: "test-namespace"},
+		&testHistoryStream{ctx: context.Background()},
+	)
+	s.Equal(errHistoryStreamingNotAllowed, err)
+}
+
+func (s *historyStreamHandlerSuite) TestStreamWorkflowExecutions() {
+	cursorTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	cursor, err := json.Marshal(executionsStreamCursor{TransitionTime: cursorTime})
+	s.NoError(err)
+
+	closeTime := cursorTime.Add(time.Second)
+	s.mockHandler.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, r *workflowservice.ListWorkflowExecutionsRequest) (*workflowservice.ListWorkflowExecutionsResponse, error) {
+			s.True(strings.HasPrefix(r.Query, "(WorkflowType = 'test') AND "))
+			s.Contains(r.Query, "CloseTime >= '2022-12-31T23:59:00Z'")
+			return &workflowservice.ListWorkflowExecutionsResponse{
+				Executions: []*workflowpb.WorkflowExecutionInfo{{
+					Execution: &commonpb.WorkflowExecution{WorkflowId: "test-workflow-id", RunId: "test-run-id"},
+					Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+					StartTime: timestamp.TimePtr(cursorTime.Add(-time.Hour)),
+					CloseTime: timestamp.TimePtr(closeTime),
+				}},
+			}, nil
+		},
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &testExecutionsStream{ctx: ctx, cancel: cancel}
+	s.NoError(s.historyStreamHandler.StreamWorkflowExecutions(&workflowservice.ListWorkflowExecutionsRequest{
+		Namespace:     "test-namespace",
+		Query:         "WorkflowType = 'test'",
+		NextPageToken: cursor,
+	}, stream))
+
+	s.Len(stream.responses, 1)
+	s.Equal("test-run-id", stream.responses[0].Executions[0].Execution.RunId)
+	var next executionsStreamCursor
+	s.NoError(json.Unmarshal(stream.responses[0].NextPageToken, &next))
+	s.Equal(closeTime, next.TransitionTime)
+}
+
+func (s *historyStreamHandlerSuite) TestStreamWorkflowExecutions_NoAdvancedVisibility() {
+	s.config.EnableReadVisibilityFromES = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err := s.historyStreamHandler.StreamWorkflowExecutions(
+		&workflowservice.ListWorkflowExecutionsRequest{Namespace: "test-namespace"},
+		&testExecutionsStream{ctx: ctx, cancel: cancel},
+	)
+	s.Equal(errStreamRequiresAdvancedVis, err)
+}
+
+func (t *testHistoryStream) Context() context.Context {
+	return t.ctx
+}
+
+func (t *testHistoryStream) Send(response *workflowservice.GetWorkflowExecutionHistoryResponse) error {
+	t.responses = append(t.responses, response)
+	return nil
+}
+
+func (t *testExecutionsStream) Context() context.Context {
+	return t.ctx
+}
+
+func (t *testExecutionsStream) Send(response *workflowservice.ListWorkflowExecutionsResponse) error {
+	t.responses = append(t.responses, response)
+	// stop streaming after the first poll
+	t.cancel()
+	return nil
+}
@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 
 	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historystreamservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -47,6 +48,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/namespace/nsquota"
 	"go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/util"
@@ -95,6 +97,9 @@ type Config struct {
 	BlobSizeLimitError dynamicconfig.IntPropertyFnWithNamespaceFilter
 	BlobSizeLimitWarn  dynamicconfig.IntPropertyFnWithNamespaceFilter
 
+	// namespace quotas
+	NamespaceQuota *nsquota.Config
+
 	ThrottledLogRPS dynamicconfig.IntPropertyFn
 
 	// Namespace specific config
@@ -165,6 +170,11 @@ type Config struct {
 	// Batch operation dynamic configs
 	MaxConcurrentBatchOperation     dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxExecutionCountBatchOperation dynamicconfig.IntPropertyFnWithNamespaceFilter
+
+	// Enable history stream service
+	EnableHistoryStreaming    dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	HistoryStreamPollInterval dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	HistoryStreamPollRPS      dynamicconfig.FloatPropertyFnWithNamespaceFilter
 }
 
 // NewConfig returns new service config with default values
@@ -203,6 +213,7 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int32, enableReadF
 		DisableListVisibilityByFilter:          dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.DisableListVisibilityByFilter, false),
 		BlobSizeLimitError:                     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
 		BlobSizeLimitWarn:                      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitWarn, 256*1024),
+		NamespaceQuota:                         nsquota.NewConfig(dc),
 		ThrottledLogRPS:                        dc.GetIntProperty(dynamicconfig.FrontendThrottledLogRPS, 20),
 		ShutdownDrainDuration:                  dc.GetDurationProperty(dynamicconfig.FrontendShutdownDrainDuration, 0*time.Second),
 		EnableNamespaceNotActiveAutoForwarding: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableNamespaceNotActiveAutoForwarding, true),
@@ -235,6 +246,10 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int32, enableReadF
 		EnableBatcher:                   dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.FrontendEnableBatcher, true),
 		MaxConcurrentBatchOperation:     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxConcurrentBatchOperationPerNamespace, 1),
 		MaxExecutionCountBatchOperation: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxExecutionCountBatchOperationPerNamespace, 1000),
+
+		EnableHistoryStreaming:    dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.FrontendEnableHistoryStreaming, false),
+		HistoryStreamPollInterval: dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.FrontendHistoryStreamPollInterval, 10*time.Second),
+		HistoryStreamPollRPS:      dc.GetFloatPropertyFilteredByNamespace(dynamicconfig.FrontendHistoryStreamPollRPS, 5),
 	}
 }
 
@@ -243,13 +258,14 @@ type Service struct {
 	status int32
 	config *Config
 
-	healthServer      *health.Server
-	handler           Handler
-	adminHandler      *AdminHandler
-	operatorHandler   *OperatorHandlerImpl
-	versionChecker    *VersionChecker
-	visibilityManager manager.VisibilityManager
-	server            *grpc.Server
+	healthServer         *health.Server
+	handler              Handler
+	adminHandler         *AdminHandler
+	operatorHandler      *OperatorHandlerImpl
+	historyStreamHandler *HistoryStreamHandler
+	versionChecker       *VersionChecker
+	visibilityManager    manager.VisibilityManager
+	server               *grpc.Server
 
 	logger                         log.Logger
 	grpcListener                   net.Listener
@@ -264,6 +280,7 @@ func NewService(
 	handler Handler,
 	adminHandler *AdminHandler,
 	operatorHandler *OperatorHandlerImpl,
+	historyStreamHandler *HistoryStreamHandler,
 	versionChecker *VersionChecker,
 	visibilityMgr manager.VisibilityManager,
 	logger log.Logger,
@@ -279,6 +296,7 @@ func NewService(
 		handler:                        handler,
 		adminHandler:                   adminHandler,
 		operatorHandler:                operatorHandler,
+		historyStreamHandler:           historyStreamHandler,
 		versionChecker:                 versionChecker,
 		visibilityManager:              visibilityMgr,
 		logger:                         logger,
@@ -301,6 +319,7 @@ func (s *Service) Start() {
 	workflowservice.RegisterWorkflowServiceServer(s.server, s.handler)
 	adminservice.RegisterAdminServiceServer(s.server, s.adminHandler)
 	operatorservice.RegisterOperatorServiceServer(s.server, s.operatorHandler)
+	historystreamservice.RegisterHistoryStreamServiceServer(s.server, s.historyStreamHandler)
 
 	reflection.Register(s.server)
 
@@ -49,6 +49,7 @@ import (
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
@@ -68,6 +69,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/namespace/nsquota"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
@@ -121,6 +123,7 @@ type (
 		archivalMetadata                archiver.ArchivalMetadata
 		healthServer                    *health.Server
 		overrides                       *Overrides
+		namespaceQuotaChecker           nsquota.Checker
 	}
 )
 
@@ -187,6 +190,12 @@ func NewWorkflowHandler(
 		archivalMetadata: archivalMetadata,
 		healthServer:     healthServer,
 		overrides:        NewOverrides(),
+		namespaceQuotaChecker: nsquota.NewChecker(
+			config.NamespaceQuota,
+			visibilityMrg,
+			timeSource,
+			logger,
+		),
 	}
 
 	return handler
@@ -266,6 +275,7 @@ func (wh *WorkflowHandler) DescribeNamespace(ctx context.Context, request *workf
 	if err != nil {
 		return resp, err
 	}
+	wh.addNamespaceUsage(ctx, resp)
 	return resp, err
 }
 
@@ -375,6 +385,16 @@ func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *
 		return nil, err
 	}
 
+	startDelay, err := wh.validateWorkflowStartDelay(ctx, request.GetCronSchedule())
+	if err != nil {
+		return nil, err
+	}
+
+	conflictPolicy, err := wh.validateWorkflowIDConflictPolicy(ctx, request.GetWorkflowIdReusePolicy())
+	if err != nil {
+		return nil, err
+	}
+
 	if request.GetRequestId() == "" {
 		return nil, errRequestIDNotSet
 	}
@@ -383,7 +403,7 @@ func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *
 		return nil, errRequestIDTooLong
 	}
 
-	request, err := wh.unaliasStartWorkflowExecutionRequestSearchAttributes(request, namespaceName)
+	request, err = wh.unaliasStartWorkflowExecutionRequestSearchAttributes(request, namespaceName)
 	if err != nil {
 		return nil, err
 	}
@@ -401,7 +421,12 @@ func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *
 	}
 	wh.logger.Debug("Start workflow execution request namespaceID.", tag.WorkflowNamespaceID(namespaceID.String()))
 
-	resp, err := wh.historyClient.StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(namespaceID.String(), request, nil, time.Now().UTC()))
+	histRequest := common.CreateHistoryStartWorkflowRequest(namespaceID.String(), request, nil, time.Now().UTC())
+	if startDelay > 0 {
+		histRequest.FirstWorkflowTaskBackoff = timestamp.DurationPtr(startDelay)
+	}
+	histRequest.WorkflowIdConflictPolicy = conflictPolicy
+	resp, err := wh.historyClient.StartWorkflowExecution(ctx, histRequest)
 
 	if err != nil {
 		return nil, err
@@ -2009,7 +2034,12 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, err
 	}
 
-	request, err := wh.unaliasSignalWithStartWorkflowExecutionRequestSearchAttributes(request, namespaceName)
+	startDelay, err := wh.validateWorkflowStartDelay(ctx, request.GetCronSchedule())
+	if err != nil {
+		return nil, err
+	}
+
+	request, err = wh.unaliasSignalWithStartWorkflowExecutionRequestSearchAttributes(request, namespaceName)
 	if err != nil {
 		return nil, err
 	}
@@ -2028,6 +2058,7 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 	resp, err := wh.historyClient.SignalWithStartWorkflowExecution(ctx, &historyservice.SignalWithStartWorkflowExecutionRequest{
 		NamespaceId:            namespaceID.String(),
 		SignalWithStartRequest: request,
+		WorkflowStartDelay:     timestamp.DurationPtr(startDelay),
 	})
 
 	if err != nil {
@@ -2943,6 +2974,10 @@ func (wh *WorkflowHandler) CreateSchedule(ctx context.Context, request *workflow
 		return nil, err
 	}
 
+	if err := wh.namespaceQuotaChecker.CheckCreateSchedule(ctx, namespaceName, namespaceID); err != nil {
+		return nil, err
+	}
+
 	if request.Schedule == nil {
 		request.Schedule = &schedpb.Schedule{}
 	}
@@ -4606,6 +4641,27 @@ func (wh *WorkflowHandler) checkBadBinary(namespaceEntry *namespace.Namespace, b
 	return nil
 }
 
+// addNamespaceUsage adds the usage of namespaces with quotas to the returned namespace data.
+// The data is not persisted.
+func (wh *WorkflowHandler) addNamespaceUsage(ctx context.Context, resp *workflowservice.DescribeNamespaceResponse) {
+	info := resp.GetNamespaceInfo()
+	nsName := namespace.Name(info.GetName())
+	if info == nil || !wh.config.NamespaceQuota.Enabled(nsName) {
+		return
+	}
+
+	usage, err := wh.namespaceQuotaChecker.GetUsage(ctx, nsName, namespace.ID(info.GetId()))
+	if err != nil {
+		return
+	}
+	if info.Data == nil {
+		info.Data = make(map[string]string)
+	}
+	for key, value := range usage.Data() {
+		info.Data[key] = value
+	}
+}
+
 func (wh *WorkflowHandler) validateRetryPolicy(namespaceName namespace.Name, retryPolicy *commonpb.RetryPolicy) error {
 	if retryPolicy == nil {
 		// By default, if the user does not explicitly set a retry policy for a Workflow, do not perform any retries.
@@ -4617,6 +4673,37 @@ func (wh *WorkflowHandler) validateRetryPolicy(namespaceName namespace.Name, ret
 	return common.ValidateRetryPolicy(retryPolicy)
 }
 
+func (wh *WorkflowHandler) validateWorkflowStartDelay(
+	ctx context.Context,
+	cronSchedule string,
+) (time.Duration, error) {
+	startDelay, err := headers.GetWorkflowStartDelay(ctx)
+	if err != nil {
+		return 0, errInvalidWorkflowStartDelay
+	}
+	if startDelay > 0 && cronSchedule != "" {
+		return 0, errCronAndStartDelaySet
+	}
+	return startDelay, nil
+}
+
+func (wh *WorkflowHandler) validateWorkflowIDConflictPolicy(
+	ctx context.Context,
+	reusePolicy enumspb.WorkflowIdReusePolicy,
+) (enumsspb.WorkflowIdConflictPolicy, error) {
+	conflictPolicy, err := headers.GetWorkflowIDConflictPolicy(ctx)
+	if err != nil {
+		return enumsspb.WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED, errInvalidWorkflowIDConflictPolicy
+	}
+	if conflictPolicy != headers.WorkflowIDConflictPolicyUseExisting {
+		return enumsspb.WORKFLOW_ID_CONFLICT_POLICY_FAIL, nil
+	}
+	if reusePolicy == enumspb.WORKFLOW_ID_REUSE_POLICY_TERMINATE_IF_RUNNING {
+		return enumsspb.WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED, errUseExistingAndTerminateIfRunningSet
+	}
+	return enumsspb.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING, nil
+}
+
 func (wh *WorkflowHandler) validateStartWorkflowTimeouts(
 	request *workflowservice.StartWorkflowExecutionRequest,
 ) error {
@@ -50,6 +50,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/metadata"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
@@ -68,6 +69,7 @@ import (
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/namespace/nsquota"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
@@ -654,6 +656,32 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidTaskTime
 	s.Equal(errInvalidWorkflowTaskTimeoutSeconds, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidStartDelay() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	startWorkflowExecutionRequest := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:  "test-namespace",
+		WorkflowId: "workflow-id",
+		WorkflowType: &commonpb.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskQueue: &taskqueuepb.TaskQueue{
+			Name: "task-queue",
+		},
+		RequestId: uuid.New(),
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(headers.WorkflowStartDelayHeaderName, "-1s"))
+	_, err := wh.StartWorkflowExecution(ctx, startWorkflowExecutionRequest)
+	s.Equal(errInvalidWorkflowStartDelay, err)
+
+	startWorkflowExecutionRequest.CronSchedule = "@every 1m"
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(headers.WorkflowStartDelayHeaderName, "1m"))
+	_, err = wh.StartWorkflowExecution(ctx, startWorkflowExecutionRequest)
+	s.Equal(errCronAndStartDelaySet, err)
+}
+
 func (s *workflowHandlerSuite) TestRegisterNamespace_Failure_InvalidArchivalURI() {
 	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(false)
 	s.mockArchivalMetadata.EXPECT().GetHistoryConfig().Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI"))
@@ -958,6 +986,28 @@ func (s *workflowHandlerSuite) TestDeleteNamespace_Error() {
 	s.Nil(respDelete)
 }
 
+func (s *workflowHandlerSuite) TestDescribeNamespace_Success_NamespaceUsage() {
+	getNamespaceResp := persistenceGetNamespaceResponse(
+		&namespace.ArchivalConfigState{State: enumspb.ARCHIVAL_STATE_DISABLED, URI: ""},
+		&namespace.ArchivalConfigState{State: enumspb.ARCHIVAL_STATE_DISABLED, URI: ""},
+	)
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(getNamespaceResp, nil)
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.CountWorkflowExecutionsResponse{Count: 3}, nil).Times(2)
+	s.mockVisibilityMgr.EXPECT().SumHistorySizeBytes(gomock.Any(), gomock.Any()).Return(&manager.SumHistorySizeBytesResponse{HistorySizeBytes: 1024}, nil)
+
+	config := s.newConfig()
+	config.NamespaceQuota.MaxSchedules = dc.GetIntPropertyFilteredByNamespace(10)
+	wh := s.getWorkflowHandler(config)
+
+	result, err := wh.DescribeNamespace(context.Background(), &workflowservice.DescribeNamespaceRequest{
+		Namespace: "test-namespace",
+	})
+	s.NoError(err)
+	s.Equal("3", result.NamespaceInfo.Data[nsquota.OpenWorkflowsDataKey])
+	s.Equal("3", result.NamespaceInfo.Data[nsquota.SchedulesDataKey])
+	s.Equal("1024", result.NamespaceInfo.Data[nsquota.HistorySizeBytesDataKey])
+}
+
 func (s *workflowHandlerSuite) TestDescribeNamespace_Success_ArchivalDisabled() {
 	getNamespaceResp := persistenceGetNamespaceResponse(
 		&namespace.ArchivalConfigState{State: enumspb.ARCHIVAL_STATE_DISABLED, URI: ""},
@@ -131,6 +131,14 @@ func Invoke(
 				scheduleToStartLatency,
 				metrics.TaskQueueTypeTag(enumspb.TASK_QUEUE_TYPE_ACTIVITY),
 			)
+			shard.GetSLOMetrics().RecordScheduleToStartLatency(
+				taggedMetrics,
+				namespaceName.String(),
+				mutableState.GetExecutionInfo().WorkflowTypeName,
+				taskQueueName,
+				enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+				scheduleToStartLatency,
+			)
 
 			response.StartedTime = ai.StartedTime
 			response.Attempt = ai.Attempt
@@ -27,12 +27,15 @@ package signalwithstartworkflow
 import (
 	"context"
 
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/namespace/nsquota"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/shard"
 )
@@ -42,6 +45,7 @@ func Invoke(
 	signalWithStartRequest *historyservice.SignalWithStartWorkflowExecutionRequest,
 	shard shard.Context,
 	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	namespaceQuotaChecker nsquota.Checker,
 ) (_ *historyservice.SignalWithStartWorkflowExecutionResponse, retError error) {
 	namespaceEntry, err := api.GetActiveNamespace(shard, namespace.ID(signalWithStartRequest.GetNamespaceId()))
 	if err != nil {
@@ -69,10 +73,20 @@ func Invoke(
 		return nil, err
 	}
 
+	// namespace quota only applies if a new workflow is started
+	if currentWorkflowContext == nil ||
+		!currentWorkflowContext.GetMutableState().IsWorkflowExecutionRunning() ||
+		signalWithStartRequest.SignalWithStartRequest.WorkflowIdReusePolicy == enumspb.WORKFLOW_ID_REUSE_POLICY_TERMINATE_IF_RUNNING {
+		if err := namespaceQuotaChecker.CheckStartWorkflow(ctx, namespaceEntry.Name(), namespaceID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Start workflow and signal
 	startRequest := ConvertToStartRequest(
 		namespaceID,
 		signalWithStartRequest.SignalWithStartRequest,
+		timestamp.DurationValue(signalWithStartRequest.GetWorkflowStartDelay()),
 		shard.GetTimeSource().Now(),
 	)
 	request := startRequest.StartRequest
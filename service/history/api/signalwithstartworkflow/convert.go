@@ -32,11 +32,13 @@ import (
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives/timestamp"
 )
 
 func ConvertToStartRequest(
 	namespaceID namespace.ID,
 	request *workflowservice.SignalWithStartWorkflowExecutionRequest,
+	startDelay time.Duration,
 	now time.Time,
 ) *historyservice.StartWorkflowExecutionRequest {
 	req := &workflowservice.StartWorkflowExecutionRequest{
@@ -58,5 +60,9 @@ func ConvertToStartRequest(
 		Header:                   request.GetHeader(),
 	}
 
-	return common.CreateHistoryStartWorkflowRequest(namespaceID.String(), req, nil, now)
+	histRequest := common.CreateHistoryStartWorkflowRequest(namespaceID.String(), req, nil, now)
+	if startDelay > 0 {
+		histRequest.FirstWorkflowTaskBackoff = timestamp.DurationPtr(startDelay)
+	}
+	return histRequest
 }
@@ -285,8 +285,8 @@ func signalWorkflow(
 		return err
 	}
 
-	// Create a transfer task to schedule a workflow task
-	if !mutableState.HasPendingWorkflowTask() {
+	// Create a transfer task to schedule a workflow task, unless the workflow is still pending on its start delay
+	if !mutableState.HasPendingWorkflowTask() && !mutableState.IsWorkflowPendingOnWorkflowTaskBackoff() {
 		_, err := mutableState.AddWorkflowTaskScheduledEvent(false, enumsspb.WORKFLOW_TASK_TYPE_NORMAL)
 		if err != nil {
 			return err
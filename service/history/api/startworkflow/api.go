@@ -30,10 +30,12 @@ import (
 	"github.com/google/uuid"
 	"go.temporal.io/api/serviceerror"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/namespace/nsquota"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/consts"
@@ -41,11 +43,16 @@ import (
 	"go.temporal.io/server/service/history/workflow"
 )
 
+var (
+	errWorkflowCompletedWhileAttaching = serviceerror.NewUnavailable("Running workflow completed while attaching the start request, please retry.")
+)
+
 func Invoke(
 	ctx context.Context,
 	startRequest *historyservice.StartWorkflowExecutionRequest,
 	shard shard.Context,
 	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	namespaceQuotaChecker nsquota.Checker,
 ) (resp *historyservice.StartWorkflowExecutionResponse, retError error) {
 	namespaceEntry, err := api.GetActiveNamespace(shard, namespace.ID(startRequest.GetNamespaceId()))
 	if err != nil {
@@ -59,6 +66,11 @@ func Invoke(
 	if err != nil {
 		return nil, err
 	}
+	// child workflows are started through here as well, their start is retried by the
+	// transfer task of the parent until the quota frees up
+	if quotaErr := namespaceQuotaChecker.CheckStartWorkflow(ctx, namespaceEntry.Name(), namespaceID); quotaErr != nil {
+		return startOverQuota(ctx, shard, workflowConsistencyChecker, namespaceID, startRequest, quotaErr)
+	}
 
 	workflowID := request.GetWorkflowId()
 	runID := uuid.New()
@@ -103,7 +115,8 @@ func Invoke(
 	)
 	if err == nil {
 		return &historyservice.StartWorkflowExecutionResponse{
-			RunId: runID.String(),
+			RunId:   runID.String(),
+			Started: true,
 		}, nil
 	}
 
@@ -115,11 +128,26 @@ func Invoke(
 	// handle CurrentWorkflowConditionFailedError
 	if t.RequestID == request.GetRequestId() {
 		return &historyservice.StartWorkflowExecutionResponse{
-			RunId: t.RunID,
+			RunId:   t.RunID,
+			Started: true,
 		}, nil
 		// delete history is expected here because duplicate start request will create history with different rid
 	}
 
+	if startRequest.GetWorkflowIdConflictPolicy() == enumsspb.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING {
+		resp, err := useExistingWorkflow(
+			ctx,
+			shard,
+			workflowConsistencyChecker,
+			definition.NewWorkflowKey(namespaceID.String(), workflowID, t.RunID),
+			t.State,
+			startRequest,
+		)
+		if err != nil || resp != nil {
+			return resp, err
+		}
+	}
+
 	// create as ID reuse
 	prevRunID = t.RunID
 	prevLastWriteVersion = t.LastWriteVersion
@@ -178,7 +206,8 @@ func Invoke(
 		switch err {
 		case nil:
 			return &historyservice.StartWorkflowExecutionResponse{
-				RunId: runID.String(),
+				RunId:   runID.String(),
+				Started: true,
 			}, nil
 		case consts.ErrWorkflowCompleted:
 			// previous workflow already closed
@@ -201,7 +230,118 @@ func Invoke(
 		return nil, err
 	}
 	return &historyservice.StartWorkflowExecutionResponse{
-		RunId: runID.String(),
+		RunId:   runID.String(),
+		Started: true,
 	}, nil
 
 }
+
+// startOverQuota serves a start request while the namespace quota is exhausted. The quota only
+// limits new executions: retries of a request which already started its run, and requests using
+// the existing workflow, are served as usual, all others fail with quotaErr.
+func startOverQuota(
+	ctx context.Context,
+	shard shard.Context,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	namespaceID namespace.ID,
+	startRequest *historyservice.StartWorkflowExecutionRequest,
+	quotaErr error,
+) (*historyservice.StartWorkflowExecutionResponse, error) {
+	workflowID := startRequest.StartRequest.GetWorkflowId()
+	current, err := shard.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     shard.GetShardID(),
+		NamespaceID: namespaceID.String(),
+		WorkflowID:  workflowID,
+	})
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		return nil, quotaErr
+	default:
+		return nil, err
+	}
+
+	if current.StartRequestID == startRequest.StartRequest.GetRequestId() {
+		return &historyservice.StartWorkflowExecutionResponse{
+			RunId:   current.RunID,
+			Started: true,
+		}, nil
+	}
+
+	if startRequest.GetWorkflowIdConflictPolicy() == enumsspb.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING {
+		resp, err := useExistingWorkflow(
+			ctx,
+			shard,
+			workflowConsistencyChecker,
+			definition.NewWorkflowKey(namespaceID.String(), workflowID, current.RunID),
+			current.State,
+			startRequest,
+		)
+		if err != nil || resp != nil {
+			return resp, err
+		}
+	}
+	return nil, quotaErr
+}
+
+// useExistingWorkflow returns the run of the conflicting workflow if it is running, attaching the request ID to it,
+// or if it already completed but the same request was attached to it before.
+// Nil response means a new run should be started according to the workflow ID reuse policy.
+func useExistingWorkflow(
+	ctx context.Context,
+	shard shard.Context,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	workflowKey definition.WorkflowKey,
+	state enumsspb.WorkflowExecutionState,
+	startRequest *historyservice.StartWorkflowExecutionRequest,
+) (_ *historyservice.StartWorkflowExecutionResponse, retError error) {
+	switch state {
+	case enumsspb.WORKFLOW_EXECUTION_STATE_CREATED,
+		enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING:
+		err := api.GetAndUpdateWorkflowWithNew(
+			ctx,
+			nil,
+			api.BypassMutableStateConsistencyPredicate,
+			workflowKey,
+			api.AttachRequestIDToRunningWorkflow(startRequest.StartRequest.GetRequestId()),
+			nil,
+			shard,
+			workflowConsistencyChecker,
+		)
+		switch err {
+		case nil:
+			return &historyservice.StartWorkflowExecutionResponse{
+				RunId: workflowKey.RunID,
+			}, nil
+		case consts.ErrWorkflowCompleted:
+			// the reuse policy has to be applied against the final status of the previous run
+			return nil, errWorkflowCompletedWhileAttaching
+		default:
+			return nil, err
+		}
+	case enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED:
+		workflowContext, err := workflowConsistencyChecker.GetWorkflowContext(
+			ctx,
+			nil,
+			api.BypassMutableStateConsistencyPredicate,
+			workflowKey,
+		)
+		switch err.(type) {
+		case nil:
+			defer func() { workflowContext.GetReleaseFn()(retError) }()
+		case *serviceerror.NotFound:
+			return nil, nil
+		default:
+			return nil, err
+		}
+		requestID := startRequest.StartRequest.GetRequestId()
+		if requestID != "" && workflowContext.GetMutableState().IsRequestIDAttached(requestID) {
+			return &historyservice.StartWorkflowExecutionResponse{
+				RunId: workflowKey.RunID,
+			}, nil
+		}
+		return nil, nil
+	default:
+		return nil, nil
+	}
+}
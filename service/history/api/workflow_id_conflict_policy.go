@@ -0,0 +1,52 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"go.temporal.io/server/service/history/consts"
+)
+
+// AttachRequestIDToRunningWorkflow returns updateWorkflowActionFunc recording the request ID of a
+// start request which used the running workflow instead of starting a new one, so retries of the
+// same request keep returning this run after it completes.
+func AttachRequestIDToRunningWorkflow(
+	requestID string,
+) UpdateWorkflowActionFunc {
+	return func(workflowContext WorkflowContext) (*UpdateWorkflowAction, error) {
+		mutableState := workflowContext.GetMutableState()
+		if !mutableState.IsWorkflowExecutionRunning() {
+			return nil, consts.ErrWorkflowCompleted
+		}
+		if requestID == "" || mutableState.IsRequestIDAttached(requestID) {
+			return &UpdateWorkflowAction{
+				Noop:               true,
+				CreateWorkflowTask: false,
+			}, nil
+		}
+
+		mutableState.AttachRequestID(requestID)
+		return UpdateWorkflowWithoutWorkflowTask, nil
+	}
+}
@@ -0,0 +1,62 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/workflow"
+)
+
+func TestAttachRequestIDToRunningWorkflow(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	requestID := "start-request-id"
+	mutableState := workflow.NewMockMutableState(controller)
+	workflowContext := NewWorkflowContext(nil, nil, mutableState)
+	actionFn := AttachRequestIDToRunningWorkflow(requestID)
+
+	mutableState.EXPECT().IsWorkflowExecutionRunning().Return(true)
+	mutableState.EXPECT().IsRequestIDAttached(requestID).Return(false)
+	mutableState.EXPECT().AttachRequestID(requestID)
+	action, err := actionFn(workflowContext)
+	require.NoError(t, err)
+	require.Equal(t, UpdateWorkflowWithoutWorkflowTask, action)
+
+	mutableState.EXPECT().IsWorkflowExecutionRunning().Return(true)
+	mutableState.EXPECT().IsRequestIDAttached(requestID).Return(true)
+	action, err = actionFn(workflowContext)
+	require.NoError(t, err)
+	require.True(t, action.Noop)
+
+	mutableState.EXPECT().IsWorkflowExecutionRunning().Return(false)
+	_, err = actionFn(workflowContext)
+	require.ErrorIs(t, err, consts.ErrWorkflowCompleted)
+}
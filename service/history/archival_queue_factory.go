@@ -162,7 +162,7 @@ func (f *archivalQueueFactory) newScheduledQueue(shard shard.Context, executor q
 		f.HostScheduler,
 		rescheduler,
 		f.HostPriorityAssigner,
-		executor,
+		queues.NewTracingExecutor(shard.GetShardID(), f.TracerProvider, executor),
 		&queues.Options{
 			ReaderOptions: queues.ReaderOptions{
 				BatchSize:            f.Config.ArchivalTaskBatchSize,
@@ -52,6 +52,7 @@ func TestArchivalQueueFactory(t *testing.T) {
 	})
 	shardContext := shard.NewMockContext(ctrl)
 	shardContext.EXPECT().GetLogger().Return(log.NewNoopLogger())
+	shardContext.EXPECT().GetShardID().Return(int32(1))
 	shardContext.EXPECT().GetQueueState(tasks.CategoryArchival).Return(&persistence.QueueState{
 		ReaderStates: nil,
 		ExclusiveReaderHighWatermark: &persistence.TaskKey{
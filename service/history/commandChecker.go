@@ -36,6 +36,7 @@ import (
 	protocolpb "go.temporal.io/api/protocol/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"golang.org/x/exp/slices"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
@@ -48,6 +49,7 @@ import (
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/workflow"
 )
 
@@ -63,24 +65,32 @@ type (
 	}
 
 	workflowSizeLimits struct {
-		blobSizeLimitWarn              int
-		blobSizeLimitError             int
-		memoSizeLimitWarn              int
-		memoSizeLimitError             int
-		numPendingChildExecutionsLimit int
-		numPendingActivitiesLimit      int
-		numPendingSignalsLimit         int
-		numPendingCancelsRequestLimit  int
+		blobSizeLimitWarn                  int
+		blobSizeLimitError                 int
+		memoSizeLimitWarn                  int
+		memoSizeLimitError                 int
+		numPendingChildExecutionsLimit     int
+		numPendingActivitiesLimit          int
+		numPendingSignalsLimit             int
+		numPendingCancelsRequestLimit      int
+		numPendingChildExecutionsLimitWarn int
+		numPendingActivitiesLimitWarn      int
+		numPendingSignalsLimitWarn         int
+		numPendingCancelsRequestLimitWarn  int
 	}
 
 	workflowSizeChecker struct {
 		workflowSizeLimits
 
+		shard                     shard.Context
 		mutableState              workflow.MutableState
 		searchAttributesValidator *searchattribute.Validator
 		executionStats            *persistencespb.ExecutionStats
 		metricsHandler            metrics.Handler
 		logger                    log.Logger
+
+		// limitWarnings are the limits whose warning level the workflow crossed
+		limitWarnings []string
 	}
 )
 
@@ -106,6 +116,7 @@ func newCommandAttrValidator(
 
 func newWorkflowSizeChecker(
 	limits workflowSizeLimits,
+	shard shard.Context,
 	mutableState workflow.MutableState,
 	searchAttributesValidator *searchattribute.Validator,
 	executionStats *persistencespb.ExecutionStats,
@@ -114,6 +125,7 @@ func newWorkflowSizeChecker(
 ) *workflowSizeChecker {
 	return &workflowSizeChecker{
 		workflowSizeLimits:        limits,
+		shard:                     shard,
 		mutableState:              mutableState,
 		searchAttributesValidator: searchAttributesValidator,
 		executionStats:            executionStats,
@@ -185,8 +197,10 @@ func withinLimit(value int, limit int) bool {
 
 func (c *workflowSizeChecker) checkCountConstraint(
 	numPending int,
+	warnLimit int,
 	errLimit int,
 	metricName string,
+	limitName string,
 	resourceName string,
 ) error {
 	key := c.mutableState.GetWorkflowKey()
@@ -198,6 +212,12 @@ func (c *workflowSizeChecker) checkCountConstraint(
 	)
 
 	if withinLimit(numPending, errLimit) {
+		if !withinLimit(numPending, warnLimit) {
+			workflow.RecordLimitWarning(c.shard, c.mutableState, limitName, numPending, warnLimit, errLimit)
+			if !slices.Contains(c.limitWarnings, limitName) {
+				c.limitWarnings = append(c.limitWarnings, limitName)
+			}
+		}
 		return nil
 	}
 	c.metricsHandler.Counter(metricName).Record(1)
@@ -221,8 +241,10 @@ const (
 func (c *workflowSizeChecker) checkIfNumChildWorkflowsExceedsLimit() error {
 	return c.checkCountConstraint(
 		len(c.mutableState.GetPendingChildExecutionInfos()),
+		c.numPendingChildExecutionsLimitWarn,
 		c.numPendingChildExecutionsLimit,
 		metrics.TooManyPendingChildWorkflows.GetMetricName(),
+		workflow.LimitPendingChildExecutions,
 		PendingChildWorkflowExecutionsDescription,
 	)
 }
@@ -230,8 +252,10 @@ func (c *workflowSizeChecker) checkIfNumChildWorkflowsExceedsLimit() error {
 func (c *workflowSizeChecker) checkIfNumPendingActivitiesExceedsLimit() error {
 	return c.checkCountConstraint(
 		len(c.mutableState.GetPendingActivityInfos()),
+		c.numPendingActivitiesLimitWarn,
 		c.numPendingActivitiesLimit,
 		metrics.TooManyPendingActivities.GetMetricName(),
+		workflow.LimitPendingActivities,
 		PendingActivitiesDescription,
 	)
 }
@@ -239,8 +263,10 @@ func (c *workflowSizeChecker) checkIfNumPendingActivitiesExceedsLimit() error {
 func (c *workflowSizeChecker) checkIfNumPendingCancelRequestsExceedsLimit() error {
 	return c.checkCountConstraint(
 		len(c.mutableState.GetPendingRequestCancelExternalInfos()),
+		c.numPendingCancelsRequestLimitWarn,
 		c.numPendingCancelsRequestLimit,
 		metrics.TooManyPendingCancelRequests.GetMetricName(),
+		workflow.LimitPendingCancelRequests,
 		PendingCancelRequestsDescription,
 	)
 }
@@ -248,8 +274,10 @@ func (c *workflowSizeChecker) checkIfNumPendingCancelRequestsExceedsLimit() erro
 func (c *workflowSizeChecker) checkIfNumPendingSignalsExceedsLimit() error {
 	return c.checkCountConstraint(
 		len(c.mutableState.GetPendingSignalExternalInfos()),
+		c.numPendingSignalsLimitWarn,
 		c.numPendingSignalsLimit,
 		metrics.TooManyPendingSignalsToExternalWorkflows.GetMetricName(),
+		workflow.LimitPendingSignals,
 		PendingSignalsDescription,
 	)
 }
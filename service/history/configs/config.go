@@ -32,6 +32,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/namespace/nsquota"
 	"go.temporal.io/server/common/persistence/visibility"
 )
 
@@ -69,12 +70,31 @@ type Config struct {
 	HistoryCacheMaxSize     dynamicconfig.IntPropertyFn
 	HistoryCacheTTL         dynamicconfig.DurationPropertyFn
 
+	// HistoryCacheHostLevelMaxSizeBytes is shared by all shards and can be changed without shard restart
+	HistoryCacheHostLevelMaxSizeBytes dynamicconfig.IntPropertyFn
+
 	// EventsCache settings
 	// Change of these configs require shard restart
 	EventsCacheInitialSize dynamicconfig.IntPropertyFn
 	EventsCacheMaxSize     dynamicconfig.IntPropertyFn
 	EventsCacheTTL         dynamicconfig.DurationPropertyFn
 
+	// Host level EventsCache settings
+	// Change of EnableHostLevelEventsCache requires shard restart, change of the disk spill settings requires host restart
+	EnableHostLevelEventsCache            dynamicconfig.BoolPropertyFn
+	EventsHostLevelCacheMaxSizeBytes      dynamicconfig.IntPropertyFn
+	EventsCacheShardSoftQuotaBytes        dynamicconfig.IntPropertyFn
+	EventsCacheDiskSpillDir               dynamicconfig.StringPropertyFn
+	EventsCacheDiskSpillMaxSizeBytes      dynamicconfig.IntPropertyFn
+	EventsCacheDiskSpillMinEventSizeBytes dynamicconfig.IntPropertyFn
+
+	// SLO metrics settings
+	// Change of the workflow type and task queue limits only affects values not seen yet
+	EnableSLOMetrics                dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	SLOMetricsWorkflowTypeAllowlist dynamicconfig.StringPropertyFnWithNamespaceFilter
+	SLOMetricsTaskQueueAllowlist    dynamicconfig.StringPropertyFnWithNamespaceFilter
+	SLOMetricsMaxTagValues          dynamicconfig.IntPropertyFnWithNamespaceFilter
+
 	// ShardController settings
 	RangeSizeBits           uint
 	AcquireShardInterval    dynamicconfig.DurationPropertyFn
@@ -192,6 +212,16 @@ type Config struct {
 	NumPendingSignalsLimit         dynamicconfig.IntPropertyFnWithNamespaceFilter
 	NumPendingCancelsRequestLimit  dynamicconfig.IntPropertyFnWithNamespaceFilter
 
+	// Warning levels of the per workflow limits above
+	NumPendingChildExecutionsLimitWarn dynamicconfig.IntPropertyFnWithNamespaceFilter
+	NumPendingActivitiesLimitWarn      dynamicconfig.IntPropertyFnWithNamespaceFilter
+	NumPendingSignalsLimitWarn         dynamicconfig.IntPropertyFnWithNamespaceFilter
+	NumPendingCancelsRequestLimitWarn  dynamicconfig.IntPropertyFnWithNamespaceFilter
+	LimitWarningSearchAttribute        dynamicconfig.StringPropertyFnWithNamespaceFilter
+
+	// NamespaceQuota holds the namespace level limits on open workflows and history size
+	NamespaceQuota *nsquota.Config
+
 	// DefaultActivityRetryOptions specifies the out-of-box retry policy if
 	// none is configured on the Activity by the user.
 	DefaultActivityRetryPolicy dynamicconfig.MapPropertyFnWithNamespaceFilter
@@ -261,6 +291,14 @@ type Config struct {
 	VisibilityProcessorEnsureCloseBeforeDelete            dynamicconfig.BoolPropertyFn
 	VisibilityProcessorEnableCloseWorkflowCleanup         dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
+	CDCSinkType        dynamicconfig.StringPropertyFn
+	CDCSinkTarget      dynamicconfig.StringPropertyFn
+	EnableCDC          dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	CDCWorkerCount     dynamicconfig.IntPropertyFn
+	CDCWorkerQueueSize dynamicconfig.IntPropertyFn
+	CDCMaxSendAttempts dynamicconfig.IntPropertyFn
+	CDCDLQTarget       dynamicconfig.StringPropertyFn
+
 	SearchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithNamespaceFilter
 	SearchAttributesSizeOfValueLimit  dynamicconfig.IntPropertyFnWithNamespaceFilter
 	SearchAttributesTotalSizeLimit    dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -320,10 +358,23 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		EnableReadFromSecondaryAdvancedVisibility: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableReadFromSecondaryAdvancedVisibility, false),
 		VisibilityDisableOrderByClause:            dc.GetBoolProperty(dynamicconfig.VisibilityDisableOrderByClause, false),
 
+		EnableHostLevelEventsCache:            dc.GetBoolProperty(dynamicconfig.EnableHostLevelEventsCache, false),
+		EventsHostLevelCacheMaxSizeBytes:      dc.GetIntProperty(dynamicconfig.EventsHostLevelCacheMaxSizeBytes, 256*1024*1024),
+		EventsCacheShardSoftQuotaBytes:        dc.GetIntProperty(dynamicconfig.EventsCacheShardSoftQuotaBytes, 0),
+		EventsCacheDiskSpillDir:               dc.GetStringProperty(dynamicconfig.EventsCacheDiskSpillDir, ""),
+		EventsCacheDiskSpillMaxSizeBytes:      dc.GetIntProperty(dynamicconfig.EventsCacheDiskSpillMaxSizeBytes, 1024*1024*1024),
+		EventsCacheDiskSpillMinEventSizeBytes: dc.GetIntProperty(dynamicconfig.EventsCacheDiskSpillMinEventSizeBytes, 64*1024),
+
+		EnableSLOMetrics:                dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableSLOMetrics, false),
+		SLOMetricsWorkflowTypeAllowlist: dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.SLOMetricsWorkflowTypeAllowlist, ""),
+		SLOMetricsTaskQueueAllowlist:    dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.SLOMetricsTaskQueueAllowlist, ""),
+		SLOMetricsMaxTagValues:          dc.GetIntPropertyFilteredByNamespace(dynamicconfig.SLOMetricsMaxTagValues, 100),
+
 		EmitShardLagLog:                      dc.GetBoolProperty(dynamicconfig.EmitShardLagLog, false),
 		HistoryCacheInitialSize:              dc.GetIntProperty(dynamicconfig.HistoryCacheInitialSize, 128),
 		HistoryCacheMaxSize:                  dc.GetIntProperty(dynamicconfig.HistoryCacheMaxSize, 512),
 		HistoryCacheTTL:                      dc.GetDurationProperty(dynamicconfig.HistoryCacheTTL, time.Hour),
+		HistoryCacheHostLevelMaxSizeBytes:    dc.GetIntProperty(dynamicconfig.HistoryCacheHostLevelMaxSizeBytes, 512*1024*1024),
 		EventsCacheInitialSize:               dc.GetIntProperty(dynamicconfig.EventsCacheInitialSize, 128),
 		EventsCacheMaxSize:                   dc.GetIntProperty(dynamicconfig.EventsCacheMaxSize, 512),
 		EventsCacheTTL:                       dc.GetDurationProperty(dynamicconfig.EventsCacheTTL, time.Hour),
@@ -424,6 +475,13 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		HistorySizeLimitWarn:           dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistorySizeLimitWarn, 10*1024*1024),
 		HistoryCountLimitError:         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistoryCountLimitError, 50*1024),
 		HistoryCountLimitWarn:          dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistoryCountLimitWarn, 10*1024),
+		NamespaceQuota:                 nsquota.NewConfig(dc),
+
+		NumPendingChildExecutionsLimitWarn: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.NumPendingChildExecutionsLimitWarn, 40000),
+		NumPendingActivitiesLimitWarn:      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.NumPendingActivitiesLimitWarn, 40000),
+		NumPendingSignalsLimitWarn:         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.NumPendingSignalsLimitWarn, 40000),
+		NumPendingCancelsRequestLimitWarn:  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.NumPendingCancelRequestsLimitWarn, 40000),
+		LimitWarningSearchAttribute:        dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.LimitWarningSearchAttribute, ""),
 
 		ThrottledLogRPS:   dc.GetIntProperty(dynamicconfig.HistoryThrottledLogRPS, 4),
 		EnableStickyQuery: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableStickyQuery, true),
@@ -474,6 +532,14 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		VisibilityProcessorEnsureCloseBeforeDelete:            dc.GetBoolProperty(dynamicconfig.VisibilityProcessorEnsureCloseBeforeDelete, false),
 		VisibilityProcessorEnableCloseWorkflowCleanup:         dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.VisibilityProcessorEnableCloseWorkflowCleanup, false),
 
+		CDCSinkType:        dc.GetStringProperty(dynamicconfig.CDCSinkType, ""),
+		CDCSinkTarget:      dc.GetStringProperty(dynamicconfig.CDCSinkTarget, ""),
+		EnableCDC:          dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableCDC, false),
+		CDCWorkerCount:     dc.GetIntProperty(dynamicconfig.CDCWorkerCount, 16),
+		CDCWorkerQueueSize: dc.GetIntProperty(dynamicconfig.CDCWorkerQueueSize, 1000),
+		CDCMaxSendAttempts: dc.GetIntProperty(dynamicconfig.CDCMaxSendAttempts, 10),
+		CDCDLQTarget:       dc.GetStringProperty(dynamicconfig.CDCDLQTarget, ""),
+
 		SearchAttributesNumberOfKeysLimit: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.SearchAttributesNumberOfKeysLimit, 100),
 		SearchAttributesSizeOfValueLimit:  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.SearchAttributesSizeOfValueLimit, 2*1024),
 		SearchAttributesTotalSizeLimit:    dc.GetIntPropertyFilteredByNamespace(dynamicconfig.SearchAttributesTotalSizeLimit, 40*1024),
@@ -51,6 +51,7 @@ var (
 		"RecordChildExecutionCompleted":          0,
 		"VerifyChildExecutionCompletionRecorded": 0,
 		"RecordWorkflowTaskStarted":              0,
+		"RearchiveWorkflowExecution":             0,
 		"RefreshWorkflowTasks":                   0,
 		"RemoveSignalMutableState":               0,
 		"RemoveTask":                             0,
@@ -0,0 +1,236 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package events
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	historypb "go.temporal.io/api/history/v1"
+)
+
+const (
+	diskSpillSubDir = "events-cache-spill"
+)
+
+type (
+	// DiskSpillOptions configures the local disk tier of the host level events cache
+	DiskSpillOptions struct {
+		// Dir is the directory holding spilled events, empty disables spilling.
+		// Events are written to a dedicated sub directory which is cleared on startup.
+		Dir string
+		// MaxSize is the max total size in bytes of spilled events
+		MaxSize int
+		// MinEventSize is the min size in bytes of an event to be spilled,
+		// smaller events are cheap enough to reload from persistence
+		MinEventSize int
+	}
+
+	// diskSpillCache is an LRU of serialized events stored as files in a local directory
+	diskSpillCache struct {
+		dir          string
+		maxSize      int
+		minEventSize int
+
+		mu       sync.Mutex
+		byAccess *list.List
+		byKey    map[hostCacheKey]*list.Element
+		size     int
+	}
+
+	diskSpillEntry struct {
+		key  hostCacheKey
+		path string
+		size int
+	}
+)
+
+func newDiskSpillCache(options DiskSpillOptions) (*diskSpillCache, error) {
+	dir := filepath.Join(options.Dir, diskSpillSubDir)
+	// spilled events are only indexed in memory, so anything left over from a previous run is unreachable
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &diskSpillCache{
+		dir:          dir,
+		maxSize:      options.MaxSize,
+		minEventSize: options.MinEventSize,
+		byAccess:     list.New(),
+		byKey:        make(map[hostCacheKey]*list.Element),
+	}, nil
+}
+
+func (d *diskSpillCache) get(key hostCacheKey) (*historypb.HistoryEvent, bool) {
+	d.mu.Lock()
+	element, ok := d.byKey[key]
+	if !ok {
+		d.mu.Unlock()
+		return nil, false
+	}
+	d.byAccess.MoveToFront(element)
+	entry := element.Value.(*diskSpillEntry)
+	d.mu.Unlock()
+
+	// files are never modified once indexed, a concurrent delete only turns this read into a miss
+	data, err := os.ReadFile(entry.path)
+	if err != nil {
+		d.deleteEntry(entry)
+		return nil, false
+	}
+	event := &historypb.HistoryEvent{}
+	if err := event.Unmarshal(data); err != nil {
+		d.deleteEntry(entry)
+		return nil, false
+	}
+	return event, true
+}
+
+func (d *diskSpillCache) put(key hostCacheKey, event *historypb.HistoryEvent) error {
+	data, err := event.Marshal()
+	if err != nil {
+		return err
+	}
+	if len(data) > d.maxSize {
+		return nil
+	}
+
+	// every put writes its own file, which is only indexed after it is completely written,
+	// so concurrent puts and gets of the same key never see a partially written file
+	path, err := writeSpillFile(d.dir, spillFileName(key), data)
+	if err != nil {
+		return err
+	}
+
+	var removed []string
+	d.mu.Lock()
+	if element, ok := d.byKey[key]; ok {
+		removed = append(removed, d.deleteLocked(element))
+	}
+	d.byKey[key] = d.byAccess.PushFront(&diskSpillEntry{
+		key:  key,
+		path: path,
+		size: len(data),
+	})
+	d.size += len(data)
+	for d.size > d.maxSize {
+		removed = append(removed, d.deleteLocked(d.byAccess.Back()))
+	}
+	d.mu.Unlock()
+
+	removeSpillFiles(removed)
+	return nil
+}
+
+func (d *diskSpillCache) delete(key hostCacheKey) {
+	d.mu.Lock()
+	element, ok := d.byKey[key]
+	if !ok {
+		d.mu.Unlock()
+		return
+	}
+	path := d.deleteLocked(element)
+	d.mu.Unlock()
+
+	removeSpillFiles([]string{path})
+}
+
+func (d *diskSpillCache) purgeShard(shardID int32) {
+	var removed []string
+	d.mu.Lock()
+	for key, element := range d.byKey {
+		if key.shardID == shardID {
+			removed = append(removed, d.deleteLocked(element))
+		}
+	}
+	d.mu.Unlock()
+
+	removeSpillFiles(removed)
+}
+
+// deleteEntry removes an entry which could not be read, unless it was already replaced or removed
+func (d *diskSpillCache) deleteEntry(entry *diskSpillEntry) {
+	d.mu.Lock()
+	element, ok := d.byKey[entry.key]
+	if !ok || element.Value.(*diskSpillEntry) != entry {
+		d.mu.Unlock()
+		return
+	}
+	path := d.deleteLocked(element)
+	d.mu.Unlock()
+
+	removeSpillFiles([]string{path})
+}
+
+// deleteLocked removes the entry from the index and returns the path of its file,
+// which should be removed by the caller after releasing the lock
+func (d *diskSpillCache) deleteLocked(element *list.Element) string {
+	entry := d.byAccess.Remove(element).(*diskSpillEntry)
+	delete(d.byKey, entry.key)
+	d.size -= entry.size
+	return entry.path
+}
+
+func writeSpillFile(dir string, prefix string, data []byte) (string, error) {
+	file, err := os.CreateTemp(dir, prefix+"-*")
+	if err != nil {
+		return "", err
+	}
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+		return "", err
+	}
+	return file.Name(), nil
+}
+
+func removeSpillFiles(paths []string) {
+	for _, path := range paths {
+		_ = os.Remove(path)
+	}
+}
+
+func spillFileName(key hostCacheKey) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf(
+		"%d/%s/%s/%s/%d/%d",
+		key.shardID,
+		key.key.NamespaceID,
+		key.key.WorkflowID,
+		key.key.RunID,
+		key.key.EventID,
+		key.key.Version,
+	)))
+	return hex.EncodeToString(hash[:])
+}
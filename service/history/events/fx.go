@@ -0,0 +1,58 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package events
+
+import (
+	"go.uber.org/fx"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/service/history/configs"
+)
+
+var Module = fx.Options(
+	fx.Provide(HostLevelCacheProvider),
+)
+
+// HostLevelCacheProvider provides the events cache shared by all shards on the host.
+// It is only used by shards when EnableHostLevelEventsCache is on.
+func HostLevelCacheProvider(
+	config *configs.Config,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) *HostLevelCache {
+	return NewHostLevelEventsCache(
+		config.EventsHostLevelCacheMaxSizeBytes,
+		config.EventsCacheShardSoftQuotaBytes,
+		config.EventsCacheTTL(),
+		DiskSpillOptions{
+			Dir:          config.EventsCacheDiskSpillDir(),
+			MaxSize:      config.EventsCacheDiskSpillMaxSizeBytes(),
+			MinEventSize: config.EventsCacheDiskSpillMinEventSizeBytes(),
+		},
+		logger,
+		metricsHandler,
+	)
+}
@@ -0,0 +1,419 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package events
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	historypb "go.temporal.io/api/history/v1"
+
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	// maxQuotaEvictionScan bounds the number of least recently used entries
+	// inspected when looking for an entry of a shard exceeding its soft quota
+	maxQuotaEvictionScan = 64
+)
+
+type (
+	// HostLevelCache is an events cache shared by all shards on a host. It is bounded
+	// by the approximate size in bytes of the cached events. When it is full, entries of
+	// shards exceeding their soft quota are evicted first, so hot shards can borrow
+	// capacity that cold shards do not use. Evicted events larger than a threshold are
+	// optionally spilled to a local disk cache instead of being dropped.
+	HostLevelCache struct {
+		mu         sync.Mutex
+		byAccess   *list.List
+		byKey      map[hostCacheKey]*list.Element
+		byShard    map[int32]map[hostCacheKey]*list.Element
+		shardUsage map[int32]int
+		usage      int
+
+		maxSize        func() int
+		shardSoftQuota func() int
+		ttl            time.Duration
+		spill          *diskSpillCache
+
+		logger         log.Logger
+		metricsHandler metrics.Handler
+	}
+
+	hostCacheKey struct {
+		shardID int32
+		key     EventKey
+	}
+
+	hostCacheEntry struct {
+		key        hostCacheKey
+		event      *historypb.HistoryEvent
+		size       int
+		createTime time.Time
+	}
+
+	// shardLevelCache is the view of a single shard on the host level cache
+	shardLevelCache struct {
+		shardID int32
+		host    *HostLevelCache
+	}
+
+	shardLevelCacheIterator struct {
+		entries []cache.Entry
+	}
+)
+
+var _ cache.Cache = (*shardLevelCache)(nil)
+
+// NewHostLevelEventsCache creates a new host level events cache. maxSize is the approximate
+// size in bytes of all cached events, shardSoftQuota is the size in bytes a single shard can
+// use before its entries are evicted first. A non-positive shard soft quota means every shard
+// with cached events gets a fair share of maxSize.
+func NewHostLevelEventsCache(
+	maxSize func() int,
+	shardSoftQuota func() int,
+	ttl time.Duration,
+	spillOptions DiskSpillOptions,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) *HostLevelCache {
+	logger = log.With(logger, tag.ComponentEventsCache)
+	metricsHandler = metricsHandler.WithTags(metrics.StringTag(metrics.CacheTypeTagName, metrics.EventsCacheTypeTagValue))
+
+	var spill *diskSpillCache
+	if spillOptions.Dir != "" {
+		var err error
+		spill, err = newDiskSpillCache(spillOptions)
+		if err != nil {
+			logger.Error("Unable to initialize events cache disk spill, spilling is disabled", tag.Error(err))
+			spill = nil
+		}
+	}
+
+	return &HostLevelCache{
+		byAccess:       list.New(),
+		byKey:          make(map[hostCacheKey]*list.Element),
+		byShard:        make(map[int32]map[hostCacheKey]*list.Element),
+		shardUsage:     make(map[int32]int),
+		maxSize:        maxSize,
+		shardSoftQuota: shardSoftQuota,
+		ttl:            ttl,
+		spill:          spill,
+		logger:         logger,
+		metricsHandler: metricsHandler,
+	}
+}
+
+// NewShardLevelEventsCache creates a per shard events cache backed by the host level cache
+func NewShardLevelEventsCache(
+	shardID int32,
+	hostLevelCache *HostLevelCache,
+	eventsMgr persistence.ExecutionManager,
+	disabled bool,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) *CacheImpl {
+	return &CacheImpl{
+		Cache: &shardLevelCache{
+			shardID: shardID,
+			host:    hostLevelCache,
+		},
+		eventsMgr:      eventsMgr,
+		disabled:       disabled,
+		logger:         log.With(logger, tag.ComponentEventsCache),
+		metricsHandler: metricsHandler.WithTags(metrics.StringTag(metrics.CacheTypeTagName, metrics.EventsCacheTypeTagValue)),
+		shardID:        shardID,
+	}
+}
+
+func (c *HostLevelCache) get(key hostCacheKey) *historypb.HistoryEvent {
+	c.mu.Lock()
+	element, ok := c.byKey[key]
+	if ok {
+		entry := element.Value.(*hostCacheEntry)
+		if !c.isExpired(entry, time.Now().UTC()) {
+			c.byAccess.MoveToFront(element)
+			c.mu.Unlock()
+			return entry.event
+		}
+		c.evictLocked(element, metrics.CacheEvictionReasonTTLTagValue)
+	}
+	c.mu.Unlock()
+
+	if c.spill == nil {
+		return nil
+	}
+	event, ok := c.spill.get(key)
+	if !ok {
+		return nil
+	}
+	c.metricsHandler.Counter(metrics.EventsCacheSpillHits.GetMetricName()).Record(1)
+	// promote back to memory, the spilled copy is dropped
+	c.spill.delete(key)
+	c.put(key, event)
+	return event
+}
+
+func (c *HostLevelCache) put(key hostCacheKey, event *historypb.HistoryEvent) {
+	entry := &hostCacheEntry{
+		key:   key,
+		event: event,
+		size:  event.Size(),
+	}
+	if c.ttl != 0 {
+		entry.createTime = time.Now().UTC()
+	}
+
+	c.mu.Lock()
+	if element, ok := c.byKey[key]; ok {
+		c.deleteLocked(element)
+	}
+	element := c.byAccess.PushFront(entry)
+	c.byKey[key] = element
+	shardElements, ok := c.byShard[key.shardID]
+	if !ok {
+		shardElements = make(map[hostCacheKey]*list.Element)
+		c.byShard[key.shardID] = shardElements
+	}
+	shardElements[key] = element
+	c.usage += entry.size
+	c.shardUsage[key.shardID] += entry.size
+	spilled := c.evictOverCapacityLocked()
+	c.emitUsageLocked()
+	c.mu.Unlock()
+
+	// disk IO happens outside the memory lock
+	for _, entry := range spilled {
+		if err := c.spill.put(entry.key, entry.event); err != nil {
+			c.metricsHandler.Counter(metrics.EventsCacheSpillFailures.GetMetricName()).Record(1)
+			c.logger.Warn("Unable to spill event to disk", tag.Error(err))
+			continue
+		}
+		c.metricsHandler.Counter(metrics.EventsCacheSpillWrites.GetMetricName()).Record(1)
+	}
+}
+
+func (c *HostLevelCache) delete(key hostCacheKey) {
+	c.mu.Lock()
+	if element, ok := c.byKey[key]; ok {
+		c.deleteLocked(element)
+		c.emitUsageLocked()
+	}
+	c.mu.Unlock()
+
+	if c.spill != nil {
+		c.spill.delete(key)
+	}
+}
+
+func (c *HostLevelCache) shardEntries(shardID int32) []cache.Entry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var entries []cache.Entry
+	now := time.Now().UTC()
+	for _, element := range c.byShard[shardID] {
+		entry := element.Value.(*hostCacheEntry)
+		if !c.isExpired(entry, now) {
+			entries = append(entries, &hostCacheEntry{
+				key:        entry.key,
+				event:      entry.event,
+				size:       entry.size,
+				createTime: entry.createTime,
+			})
+		}
+	}
+	return entries
+}
+
+func (c *HostLevelCache) shardSize(shardID int32) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.byShard[shardID])
+}
+
+// PurgeShard drops all cached events of a shard, it is called when the shard is closed on this host
+// as the events are not going to be read by this host anymore.
+func (c *HostLevelCache) PurgeShard(shardID int32) {
+	c.mu.Lock()
+	for _, element := range c.byShard[shardID] {
+		c.deleteLocked(element)
+	}
+	c.emitUsageLocked()
+	c.mu.Unlock()
+
+	if c.spill != nil {
+		c.spill.purgeShard(shardID)
+	}
+}
+
+// evictOverCapacityLocked evicts entries until the cache fits into its max size,
+// returning the evicted entries which should be spilled to disk
+func (c *HostLevelCache) evictOverCapacityLocked() []*hostCacheEntry {
+	var spilled []*hostCacheEntry
+	maxSize := c.maxSize()
+	for c.usage > maxSize && c.byAccess.Len() > 0 {
+		element := c.findOverQuotaLocked()
+		reason := metrics.CacheEvictionReasonShardQuotaTagValue
+		if element == nil {
+			element = c.byAccess.Back()
+			reason = metrics.CacheEvictionReasonCapacityTagValue
+		}
+
+		entry := element.Value.(*hostCacheEntry)
+		c.evictLocked(element, reason)
+		if c.spill != nil && entry.size >= c.spill.minEventSize {
+			spilled = append(spilled, entry)
+		}
+	}
+	return spilled
+}
+
+// findOverQuotaLocked returns the least recently used entry of a shard exceeding its soft quota
+func (c *HostLevelCache) findOverQuotaLocked() *list.Element {
+	quota := c.shardSoftQuota()
+	if quota <= 0 && len(c.shardUsage) > 0 {
+		quota = c.maxSize() / len(c.shardUsage)
+	}
+
+	element := c.byAccess.Back()
+	for i := 0; element != nil && i < maxQuotaEvictionScan; i++ {
+		entry := element.Value.(*hostCacheEntry)
+		if c.shardUsage[entry.key.shardID] > quota {
+			return element
+		}
+		element = element.Prev()
+	}
+	return nil
+}
+
+func (c *HostLevelCache) evictLocked(element *list.Element, reason string) {
+	c.deleteLocked(element)
+	c.metricsHandler.Counter(metrics.CacheEvictions.GetMetricName()).Record(
+		1,
+		metrics.CacheEvictionReasonTag(reason),
+	)
+}
+
+func (c *HostLevelCache) deleteLocked(element *list.Element) {
+	entry := c.byAccess.Remove(element).(*hostCacheEntry)
+	delete(c.byKey, entry.key)
+	shardElements := c.byShard[entry.key.shardID]
+	delete(shardElements, entry.key)
+	if len(shardElements) == 0 {
+		delete(c.byShard, entry.key.shardID)
+	}
+	c.usage -= entry.size
+	c.shardUsage[entry.key.shardID] -= entry.size
+	if c.shardUsage[entry.key.shardID] <= 0 {
+		delete(c.shardUsage, entry.key.shardID)
+	}
+}
+
+func (c *HostLevelCache) isExpired(entry *hostCacheEntry, now time.Time) bool {
+	return !entry.createTime.IsZero() && now.After(entry.createTime.Add(c.ttl))
+}
+
+func (c *HostLevelCache) emitUsageLocked() {
+	c.metricsHandler.Gauge(metrics.CacheUsageBytes.GetMetricName()).Record(float64(c.usage))
+}
+
+func (e *hostCacheEntry) Key() interface{} {
+	return e.key.key
+}
+
+func (e *hostCacheEntry) Value() interface{} {
+	return e.event
+}
+
+func (e *hostCacheEntry) CreateTime() time.Time {
+	return e.createTime
+}
+
+func (s *shardLevelCache) Get(key interface{}) interface{} {
+	event := s.host.get(hostCacheKey{shardID: s.shardID, key: key.(EventKey)})
+	if event == nil {
+		return nil
+	}
+	return event
+}
+
+func (s *shardLevelCache) Put(key interface{}, value interface{}) interface{} {
+	s.host.put(hostCacheKey{shardID: s.shardID, key: key.(EventKey)}, value.(*historypb.HistoryEvent))
+	return nil
+}
+
+func (s *shardLevelCache) PutIfNotExist(key interface{}, value interface{}) (interface{}, error) {
+	if existing := s.Get(key); existing != nil {
+		return existing, nil
+	}
+	s.Put(key, value)
+	return value, nil
+}
+
+func (s *shardLevelCache) Delete(key interface{}) {
+	s.host.delete(hostCacheKey{shardID: s.shardID, key: key.(EventKey)})
+}
+
+// Release is a noop, events cache entries are never pinned
+func (s *shardLevelCache) Release(_ interface{}) {}
+
+// Iterator returns an iterator over a snapshot of the entries of the shard in no particular order
+func (s *shardLevelCache) Iterator() cache.Iterator {
+	return &shardLevelCacheIterator{
+		entries: s.host.shardEntries(s.shardID),
+	}
+}
+
+// Size returns the number of entries of the shard
+func (s *shardLevelCache) Size() int {
+	return s.host.shardSize(s.shardID)
+}
+
+// Purge drops all cached events of the shard
+func (s *shardLevelCache) Purge() {
+	s.host.PurgeShard(s.shardID)
+}
+
+func (it *shardLevelCacheIterator) Close() {}
+
+func (it *shardLevelCacheIterator) HasNext() bool {
+	return len(it.entries) > 0
+}
+
+func (it *shardLevelCacheIterator) Next() cache.Entry {
+	if len(it.entries) == 0 {
+		panic("shard level events cache iterator Next called when there is no next item")
+	}
+	entry := it.entries[0]
+	it.entries = it.entries[1:]
+	return entry
+}
@@ -0,0 +1,157 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package events
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+)
+
+func newTestHostLevelCache(maxSize int, shardSoftQuota int, spillOptions DiskSpillOptions) *HostLevelCache {
+	return NewHostLevelEventsCache(
+		func() int { return maxSize },
+		func() int { return shardSoftQuota },
+		time.Minute,
+		spillOptions,
+		log.NewNoopLogger(),
+		metrics.NoopMetricsHandler,
+	)
+}
+
+func newTestEvent(eventID int64) *historypb.HistoryEvent {
+	return &historypb.HistoryEvent{
+		EventId:   eventID,
+		EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+		Attributes: &historypb.HistoryEvent_ActivityTaskScheduledEventAttributes{ActivityTaskScheduledEventAttributes: &historypb.ActivityTaskScheduledEventAttributes{
+			Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{{Data: []byte(strings.Repeat("a", 1024))}}},
+		}},
+	}
+}
+
+func newTestEventKey(eventID int64) EventKey {
+	return EventKey{namespace.ID("namespace-id"), "workflow-id", "run-id", eventID, common.EmptyVersion}
+}
+
+func TestHostLevelCache_ShardSoftQuota(t *testing.T) {
+	eventSize := newTestEvent(1).Size()
+	host := newTestHostLevelCache(4*eventSize, 2*eventSize, DiskSpillOptions{})
+	shard1 := NewShardLevelEventsCache(1, host, nil, false, log.NewNoopLogger(), metrics.NoopMetricsHandler)
+	shard2 := NewShardLevelEventsCache(2, host, nil, false, log.NewNoopLogger(), metrics.NoopMetricsHandler)
+
+	shard2.PutEvent(newTestEventKey(1), newTestEvent(1))
+	shard1.PutEvent(newTestEventKey(2), newTestEvent(2))
+	shard1.PutEvent(newTestEventKey(3), newTestEvent(3))
+	shard1.PutEvent(newTestEventKey(4), newTestEvent(4))
+
+	// shard1 exceeds its soft quota, so its least recently used event is evicted
+	// instead of the least recently used event of the host
+	shard2.PutEvent(newTestEventKey(5), newTestEvent(5))
+	require.NotNil(t, shard2.Get(newTestEventKey(1)))
+	require.Nil(t, shard1.Get(newTestEventKey(2)))
+	require.Equal(t, 2, shard1.Size())
+	require.Equal(t, 2, shard2.Size())
+}
+
+func TestHostLevelCache_ShardIsolation(t *testing.T) {
+	host := newTestHostLevelCache(1024*1024, 0, DiskSpillOptions{})
+	shard1 := NewShardLevelEventsCache(1, host, nil, false, log.NewNoopLogger(), metrics.NoopMetricsHandler)
+	shard2 := NewShardLevelEventsCache(2, host, nil, false, log.NewNoopLogger(), metrics.NoopMetricsHandler)
+
+	event := newTestEvent(1)
+	shard1.PutEvent(newTestEventKey(1), event)
+	require.Equal(t, event, shard1.Get(newTestEventKey(1)))
+	require.Nil(t, shard2.Get(newTestEventKey(1)))
+
+	it := shard1.Iterator()
+	require.True(t, it.HasNext())
+	require.Equal(t, newTestEventKey(1), it.Next().Key())
+	require.False(t, it.HasNext())
+	it.Close()
+
+	shard1.DeleteEvent(newTestEventKey(1))
+	require.Nil(t, shard1.Get(newTestEventKey(1)))
+	require.Equal(t, 0, host.usage)
+}
+
+func TestHostLevelCache_DiskSpill(t *testing.T) {
+	eventSize := newTestEvent(1).Size()
+	host := newTestHostLevelCache(eventSize, 0, DiskSpillOptions{
+		Dir:          t.TempDir(),
+		MaxSize:      1024 * 1024,
+		MinEventSize: eventSize,
+	})
+	require.NotNil(t, host.spill)
+	shard := NewShardLevelEventsCache(1, host, nil, false, log.NewNoopLogger(), metrics.NoopMetricsHandler)
+
+	event1 := newTestEvent(1)
+	shard.PutEvent(newTestEventKey(1), event1)
+	shard.PutEvent(newTestEventKey(2), newTestEvent(2))
+	require.Len(t, host.spill.byKey, 1)
+
+	// served from disk and promoted back to memory, which spills the other event
+	require.Equal(t, event1, shard.Get(newTestEventKey(1)))
+	require.Len(t, host.spill.byKey, 1)
+	_, ok := host.spill.byKey[hostCacheKey{shardID: 1, key: newTestEventKey(2)}]
+	require.True(t, ok)
+
+	shard.DeleteEvent(newTestEventKey(2))
+	require.Len(t, host.spill.byKey, 0)
+	require.Nil(t, shard.Get(newTestEventKey(2)))
+}
+
+func TestHostLevelCache_PurgeShard(t *testing.T) {
+	eventSize := newTestEvent(1).Size()
+	host := newTestHostLevelCache(2*eventSize, 0, DiskSpillOptions{
+		Dir:          t.TempDir(),
+		MaxSize:      1024 * 1024,
+		MinEventSize: eventSize,
+	})
+	shard1 := NewShardLevelEventsCache(1, host, nil, false, log.NewNoopLogger(), metrics.NoopMetricsHandler)
+	shard2 := NewShardLevelEventsCache(2, host, nil, false, log.NewNoopLogger(), metrics.NoopMetricsHandler)
+
+	shard1.PutEvent(newTestEventKey(1), newTestEvent(1))
+	shard1.PutEvent(newTestEventKey(2), newTestEvent(2))
+	shard2.PutEvent(newTestEventKey(3), newTestEvent(3))
+	require.Len(t, host.spill.byKey, 1)
+
+	host.PurgeShard(1)
+	require.Equal(t, 0, shard1.Size())
+	require.Len(t, host.spill.byKey, 0)
+	require.Nil(t, shard1.Get(newTestEventKey(1)))
+	require.Nil(t, shard1.Get(newTestEventKey(2)))
+	require.Equal(t, 1, shard2.Size())
+	require.Equal(t, eventSize, host.usage)
+}
@@ -41,6 +41,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/namespace/nsquota"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -69,6 +70,7 @@ var Module = fx.Options(
 	workflow.Module,
 	shard.Module,
 	cache.Module,
+	events.Module,
 	archival.Module,
 	fx.Provide(dynamicconfig.NewCollection),
 	fx.Provide(ConfigProvider), // might be worth just using provider for configs.Config directly
@@ -78,6 +80,7 @@ var Module = fx.Options(
 	fx.Provide(service.GrpcServerOptionsProvider),
 	fx.Provide(ESProcessorConfigProvider),
 	fx.Provide(VisibilityManagerProvider),
+	fx.Provide(NamespaceQuotaCheckerProvider),
 	fx.Provide(ThrottledLoggerRpsFnProvider),
 	fx.Provide(PersistenceRateLimitingParamsProvider),
 	fx.Provide(ServiceResolverProvider),
@@ -272,6 +275,20 @@ func VisibilityManagerProvider(
 	)
 }
 
+func NamespaceQuotaCheckerProvider(
+	serviceConfig *configs.Config,
+	visibilityMgr manager.VisibilityManager,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) nsquota.Checker {
+	return nsquota.NewChecker(
+		serviceConfig.NamespaceQuota,
+		visibilityMgr,
+		timeSource,
+		logger,
+	)
+}
+
 func EventNotifierProvider(
 	timeSource clock.TimeSource,
 	metricsHandler metrics.Handler,
@@ -46,18 +46,21 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/namespace/nsquota"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/telemetry"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/api/deleteworkflow"
 	"go.temporal.io/server/service/history/api/describemutablestate"
 	"go.temporal.io/server/service/history/api/describeworkflow"
 	"go.temporal.io/server/service/history/api/queryworkflow"
 	"go.temporal.io/server/service/history/api/reapplyevents"
+	"go.temporal.io/server/service/history/api/rearchiveworkflow"
 	"go.temporal.io/server/service/history/api/recordactivitytaskheartbeat"
 	"go.temporal.io/server/service/history/api/recordactivitytaskstarted"
 	"go.temporal.io/server/service/history/api/recordchildworkflowcompleted"
@@ -103,6 +106,7 @@ type (
 		clusterMetadata            cluster.Metadata
 		executionManager           persistence.ExecutionManager
 		queueProcessors            map[tasks.Category]queues.Queue
+		workflowCache              wcache.Cache
 		replicationAckMgr          replication.AckManager
 		nDCReplicator              ndc.HistoryReplicator
 		nDCActivityReplicator      ndc.ActivityReplicator
@@ -126,6 +130,7 @@ type (
 		eventSerializer            serialization.Serializer
 		workflowConsistencyChecker api.WorkflowConsistencyChecker
 		tracer                     trace.Tracer
+		namespaceQuotaChecker      nsquota.Checker
 	}
 )
 
@@ -147,6 +152,7 @@ func NewEngineWithShardContext(
 	workflowConsistencyChecker api.WorkflowConsistencyChecker,
 	tracerProvider trace.TracerProvider,
 	persistenceVisibilityMgr manager.VisibilityManager,
+	namespaceQuotaChecker nsquota.Checker,
 ) shard.Engine {
 	currentClusterName := shard.GetClusterMetadata().GetCurrentClusterName()
 
@@ -168,6 +174,7 @@ func NewEngineWithShardContext(
 		clusterMetadata:            shard.GetClusterMetadata(),
 		timeSource:                 shard.GetTimeSource(),
 		executionManager:           executionManager,
+		workflowCache:              workflowCache,
 		tokenSerializer:            common.NewProtoTaskTokenSerializer(),
 		logger:                     log.With(logger, tag.ComponentHistoryEngine),
 		throttledLogger:            log.With(shard.GetThrottledLogger(), tag.ComponentHistoryEngine),
@@ -182,6 +189,7 @@ func NewEngineWithShardContext(
 		eventSerializer:            eventSerializer,
 		workflowConsistencyChecker: workflowConsistencyChecker,
 		tracer:                     tracerProvider.Tracer(consts.LibraryName),
+		namespaceQuotaChecker:      namespaceQuotaChecker,
 	}
 
 	historyEngImpl.queueProcessors = make(map[tasks.Category]queues.Queue)
@@ -296,6 +304,8 @@ func (e *historyEngineImpl) Stop() {
 	e.replicationProcessorMgr.Stop()
 	// unset the failover callback
 	e.shard.GetNamespaceRegistry().UnregisterStateChangeCallback(e)
+	// workflows of the shard are not loaded on this host anymore
+	e.workflowCache.Purge()
 }
 
 func (e *historyEngineImpl) registerNamespaceStateChangeCallback() {
@@ -329,7 +339,9 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	ctx context.Context,
 	startRequest *historyservice.StartWorkflowExecutionRequest,
 ) (resp *historyservice.StartWorkflowExecutionResponse, retError error) {
-	return startworkflow.Invoke(ctx, startRequest, e.shard, e.workflowConsistencyChecker)
+	ctx, span := e.startSpan(ctx, "StartWorkflowExecution", startRequest.GetNamespaceId(), startRequest.GetStartRequest().GetWorkflowId(), "")
+	defer func() { telemetry.EndSpan(span, retError) }()
+	return startworkflow.Invoke(ctx, startRequest, e.shard, e.workflowConsistencyChecker, e.namespaceQuotaChecker)
 }
 
 // GetMutableState retrieves the mutable state of the workflow execution
@@ -430,7 +442,9 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 func (e *historyEngineImpl) ScheduleWorkflowTask(
 	ctx context.Context,
 	req *historyservice.ScheduleWorkflowTaskRequest,
-) error {
+) (retError error) {
+	ctx, span := e.startSpan(ctx, "ScheduleWorkflowTask", req.GetNamespaceId(), req.GetWorkflowExecution().GetWorkflowId(), req.GetWorkflowExecution().GetRunId())
+	defer func() { telemetry.EndSpan(span, retError) }()
 	return e.workflowTaskHandler.handleWorkflowTaskScheduled(ctx, req)
 }
 
@@ -445,7 +459,9 @@ func (e *historyEngineImpl) VerifyFirstWorkflowTaskScheduled(
 func (e *historyEngineImpl) RecordWorkflowTaskStarted(
 	ctx context.Context,
 	request *historyservice.RecordWorkflowTaskStartedRequest,
-) (*historyservice.RecordWorkflowTaskStartedResponse, error) {
+) (_ *historyservice.RecordWorkflowTaskStartedResponse, retError error) {
+	ctx, span := e.startSpan(ctx, "RecordWorkflowTaskStarted", request.GetNamespaceId(), request.GetWorkflowExecution().GetWorkflowId(), request.GetWorkflowExecution().GetRunId())
+	defer func() { telemetry.EndSpan(span, retError) }()
 	return e.workflowTaskHandler.handleWorkflowTaskStarted(ctx, request)
 }
 
@@ -453,7 +469,9 @@ func (e *historyEngineImpl) RecordWorkflowTaskStarted(
 func (e *historyEngineImpl) RespondWorkflowTaskCompleted(
 	ctx context.Context,
 	req *historyservice.RespondWorkflowTaskCompletedRequest,
-) (*historyservice.RespondWorkflowTaskCompletedResponse, error) {
+) (_ *historyservice.RespondWorkflowTaskCompletedResponse, retError error) {
+	ctx, span := e.startTaskTokenSpan(ctx, "RespondWorkflowTaskCompleted", req.GetNamespaceId(), req.GetCompleteRequest().GetTaskToken())
+	defer func() { telemetry.EndSpan(span, retError) }()
 	return e.workflowTaskHandler.handleWorkflowTaskCompleted(ctx, req)
 }
 
@@ -461,7 +479,9 @@ func (e *historyEngineImpl) RespondWorkflowTaskCompleted(
 func (e *historyEngineImpl) RespondWorkflowTaskFailed(
 	ctx context.Context,
 	req *historyservice.RespondWorkflowTaskFailedRequest,
-) error {
+) (retError error) {
+	ctx, span := e.startTaskTokenSpan(ctx, "RespondWorkflowTaskFailed", req.GetNamespaceId(), req.GetFailedRequest().GetTaskToken())
+	defer func() { telemetry.EndSpan(span, retError) }()
 	return e.workflowTaskHandler.handleWorkflowTaskFailed(ctx, req)
 }
 
@@ -521,7 +541,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	ctx context.Context,
 	req *historyservice.SignalWithStartWorkflowExecutionRequest,
 ) (_ *historyservice.SignalWithStartWorkflowExecutionResponse, retError error) {
-	return signalwithstartworkflow.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
+	return signalwithstartworkflow.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker, e.namespaceQuotaChecker)
 }
 
 func (e *historyEngineImpl) UpdateWorkflowExecution(
@@ -724,6 +744,19 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 	)
 }
 
+func (e *historyEngineImpl) RearchiveWorkflowExecution(
+	ctx context.Context,
+	namespaceUUID namespace.ID,
+	execution commonpb.WorkflowExecution,
+) (retError error) {
+	return rearchiveworkflow.Invoke(
+		ctx,
+		definition.NewWorkflowKey(namespaceUUID.String(), execution.WorkflowId, execution.RunId),
+		e.shard,
+		e.workflowConsistencyChecker,
+	)
+}
+
 func (e *historyEngineImpl) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
@@ -737,3 +770,35 @@ func (e *historyEngineImpl) GetReplicationStatus(
 ) (_ *historyservice.ShardReplicationStatus, retError error) {
 	return replicationapi.GetStatus(ctx, request, e.shard, e.replicationAckMgr)
 }
+
+// startSpan starts a span for a workflow (task) lifecycle operation, tagged with the
+// workflow execution and the shard it's owned by
+func (e *historyEngineImpl) startSpan(
+	ctx context.Context,
+	operation string,
+	namespaceID string,
+	workflowID string,
+	runID string,
+) (context.Context, trace.Span) {
+	ctx, span := e.tracer.Start(ctx, "history."+operation)
+	if span.IsRecording() {
+		span.SetAttributes(telemetry.ShardIDKey.Int64(int64(e.shard.GetShardID())))
+		span.SetAttributes(telemetry.WorkflowAttributes(namespaceID, workflowID, runID)...)
+	}
+	return ctx, span
+}
+
+func (e *historyEngineImpl) startTaskTokenSpan(
+	ctx context.Context,
+	operation string,
+	namespaceID string,
+	taskToken []byte,
+) (context.Context, trace.Span) {
+	ctx, span := e.startSpan(ctx, operation, namespaceID, "", "")
+	if span.IsRecording() {
+		if token, err := e.tokenSerializer.Deserialize(taskToken); err == nil {
+			span.SetAttributes(telemetry.WorkflowAttributes("", token.GetWorkflowId(), token.GetRunId())...)
+		}
+	}
+	return ctx, span
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/trace"
 	commandpb "go.temporal.io/api/command/v1"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -73,6 +74,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/namespace/nsquota"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 )
@@ -170,6 +172,7 @@ func (s *engine2Suite) SetupTest() {
 	})
 
 	h := &historyEngineImpl{
+		tracer:             trace.NewNoopTracerProvider().Tracer(consts.LibraryName),
 		currentClusterName: s.mockShard.GetClusterMetadata().GetCurrentClusterName(),
 		shard:              s.mockShard,
 		clusterMetadata:    s.mockClusterMetadata,
@@ -196,6 +199,7 @@ func (s *engine2Suite) SetupTest() {
 			s.config.DefaultVisibilityIndexName,
 		),
 		workflowConsistencyChecker: api.NewWorkflowConsistencyChecker(mockShard, s.workflowCache),
+		namespaceQuotaChecker:      nsquota.NewChecker(s.config.NamespaceQuota, nil, clock.NewRealTimeSource(), s.logger),
 	}
 	s.mockShard.SetEngineForTesting(h)
 	h.workflowTaskHandler = newWorkflowTaskHandlerCallback(h)
@@ -1049,6 +1053,63 @@ func (s *engine2Suite) TestStartWorkflowExecution_BrandNew() {
 	s.NotNil(resp.RunId)
 }
 
+func (s *engine2Suite) TestStartWorkflowExecution_NamespaceQuotaExceeded() {
+	namespaceID := tests.NamespaceID
+	workflowID := "workflowID"
+	runID := "runID"
+	requestID := "requestID"
+	quotaErr := serviceerror.NewResourceExhausted(enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT, "quota exceeded")
+	mockQuotaChecker := nsquota.NewMockChecker(s.controller)
+	mockQuotaChecker.EXPECT().CheckStartWorkflow(gomock.Any(), tests.Namespace, namespaceID).Return(quotaErr).AnyTimes()
+	s.historyEngine.namespaceQuotaChecker = mockQuotaChecker
+
+	startRequest := &historyservice.StartWorkflowExecutionRequest{
+		Attempt:     1,
+		NamespaceId: namespaceID.String(),
+		StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+			Namespace:                namespaceID.String(),
+			WorkflowId:               workflowID,
+			WorkflowType:             &commonpb.WorkflowType{Name: "workflowType"},
+			TaskQueue:                &taskqueuepb.TaskQueue{Name: "testTaskQueue"},
+			WorkflowExecutionTimeout: timestamp.DurationPtr(20 * time.Second),
+			WorkflowRunTimeout:       timestamp.DurationPtr(1 * time.Second),
+			WorkflowTaskTimeout:      timestamp.DurationPtr(2 * time.Second),
+			Identity:                 "testIdentity",
+			RequestId:                requestID,
+		},
+	}
+	currentExecutionRequest := &persistence.GetCurrentExecutionRequest{
+		ShardID:     s.mockShard.GetShardID(),
+		NamespaceID: namespaceID.String(),
+		WorkflowID:  workflowID,
+	}
+
+	// no new execution can be created
+	s.mockExecutionMgr.EXPECT().GetCurrentExecution(gomock.Any(), currentExecutionRequest).Return(nil, serviceerror.NewNotFound("not found"))
+	_, err := s.historyEngine.StartWorkflowExecution(metrics.AddMetricsContext(context.Background()), startRequest)
+	s.Equal(quotaErr, err)
+
+	s.mockExecutionMgr.EXPECT().GetCurrentExecution(gomock.Any(), currentExecutionRequest).Return(&persistence.GetCurrentExecutionResponse{
+		StartRequestID: "oldRequestID",
+		RunID:          runID,
+		State:          enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		Status:         enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+	}, nil)
+	_, err = s.historyEngine.StartWorkflowExecution(metrics.AddMetricsContext(context.Background()), startRequest)
+	s.Equal(quotaErr, err)
+
+	// retries of a request which already started its run are not subject to the quota
+	s.mockExecutionMgr.EXPECT().GetCurrentExecution(gomock.Any(), currentExecutionRequest).Return(&persistence.GetCurrentExecutionResponse{
+		StartRequestID: requestID,
+		RunID:          runID,
+		State:          enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		Status:         enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+	}, nil)
+	resp, err := s.historyEngine.StartWorkflowExecution(metrics.AddMetricsContext(context.Background()), startRequest)
+	s.NoError(err)
+	s.Equal(runID, resp.GetRunId())
+}
+
 func (s *engine2Suite) TestStartWorkflowExecution_BrandNew_SearchAttributes() {
 	namespaceID := tests.NamespaceID
 	workflowID := "workflowID"
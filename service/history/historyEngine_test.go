@@ -37,6 +37,7 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/trace"
 	commandpb "go.temporal.io/api/command/v1"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -58,11 +59,13 @@ import (
 	tokenspb "go.temporal.io/server/api/token/v1"
 	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/namespace/nsquota"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
@@ -188,6 +191,7 @@ func (s *engineSuite) SetupTest() {
 	)
 
 	h := &historyEngineImpl{
+		tracer:             trace.NewNoopTracerProvider().Tracer(consts.LibraryName),
 		currentClusterName: s.mockShard.GetClusterMetadata().GetCurrentClusterName(),
 		shard:              s.mockShard,
 		clusterMetadata:    s.mockClusterMetadata,
@@ -207,6 +211,7 @@ func (s *engineSuite) SetupTest() {
 		workflowResetter:           s.mockWorkflowResetter,
 		workflowConsistencyChecker: api.NewWorkflowConsistencyChecker(s.mockShard, s.workflowCache),
 		throttledLogger:            log.NewNoopLogger(),
+		namespaceQuotaChecker:      nsquota.NewChecker(s.config.NamespaceQuota, nil, clock.NewRealTimeSource(), log.NewNoopLogger()),
 	}
 	s.mockShard.SetEngineForTesting(h)
 	h.workflowTaskHandler = newWorkflowTaskHandlerCallback(h)
@@ -222,6 +227,42 @@ func (s *engineSuite) TearDownTest() {
 	s.mockHistoryEngine.eventNotifier.Stop()
 }
 
+func (s *engineSuite) TestStop_ReleasesWorkflowCacheBudget() {
+	budget := cache.NewBudget(func() int { return 1024 * 1024 })
+	s.mockHistoryEngine.workflowCache = wcache.NewCacheWithBudget(s.mockShard, budget)
+	s.mockHistoryEngine.replicationProcessorMgr = &noopDaemon{}
+	s.mockHistoryEngine.status = common.DaemonStatusStarted
+
+	for _, workflowID := range []string{"wid-1", "wid-2"} {
+		_, release, err := s.mockHistoryEngine.workflowCache.GetOrCreateWorkflowExecution(
+			context.Background(),
+			tests.NamespaceID,
+			commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: tests.RunID},
+			workflow.CallerTypeAPI,
+		)
+		s.NoError(err)
+		release(nil)
+	}
+	s.Positive(budget.Used())
+
+	s.mockTxProcessor.EXPECT().Stop()
+	s.mockTimerProcessor.EXPECT().Stop()
+	s.mockVisibilityProcessor.EXPECT().Stop()
+	s.mockArchivalProcessor.EXPECT().Stop()
+	s.mockNamespaceCache.EXPECT().UnregisterStateChangeCallback(s.mockHistoryEngine)
+
+	// the engine is stopped when its shard is closed
+	s.mockHistoryEngine.Stop()
+	s.Zero(budget.Used())
+	s.Zero(budget.Pinned())
+}
+
+type noopDaemon struct{}
+
+func (d *noopDaemon) Start() {}
+
+func (d *noopDaemon) Stop() {}
+
 func (s *engineSuite) TestGetMutableStateSync() {
 	ctx := context.Background()
 
@@ -28,10 +28,12 @@ import (
 	"context"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/fx"
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/cdc"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -77,6 +79,7 @@ type (
 		MetricsHandler       metrics.Handler
 		Logger               log.SnTaggedLogger
 		SchedulerRateLimiter queues.SchedulerRateLimiter
+		TracerProvider       trace.TracerProvider `optional:"true"`
 	}
 
 	QueueFactoryBase struct {
@@ -95,6 +98,7 @@ type (
 
 var QueueModule = fx.Options(
 	fx.Provide(QueueSchedulerRateLimiterProvider),
+	fx.Provide(CDCSinkProvider),
 	fx.Provide(
 		fx.Annotated{
 			Name:   "transferQueueFactory",
@@ -159,6 +163,35 @@ func QueueSchedulerRateLimiterProvider(
 	)
 }
 
+func CDCSinkProvider(
+	lc fx.Lifecycle,
+	config *configs.Config,
+	logger log.SnTaggedLogger,
+	metricsHandler metrics.Handler,
+) cdc.Sink {
+	sink := cdc.NewAsyncSink(
+		cdc.NewDynamicSink(
+			config.CDCSinkType,
+			config.CDCSinkTarget,
+			logger,
+		),
+		cdc.NewDLQSink(config.CDCDLQTarget, logger),
+		cdc.AsyncSinkOptions{
+			WorkerCount:     config.CDCWorkerCount(),
+			QueueSize:       config.CDCWorkerQueueSize(),
+			MaxSendAttempts: config.CDCMaxSendAttempts,
+		},
+		logger,
+		metricsHandler,
+	)
+	lc.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			return sink.Close()
+		},
+	})
+	return sink
+}
+
 func QueueFactoryLifetimeHooks(
 	params QueueFactoriesLifetimeHookParams,
 ) {
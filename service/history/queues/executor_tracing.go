@@ -0,0 +1,101 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/telemetry"
+	"go.temporal.io/server/service/history/consts"
+)
+
+const (
+	taskTypeKey    = attribute.Key("io.temporal.task.type")
+	taskIDKey      = attribute.Key("io.temporal.task.id")
+	taskAttemptKey = attribute.Key("io.temporal.task.attempt")
+	// taskActiveKey records whether the task was processed by the active or the standby logic
+	taskActiveKey = attribute.Key("io.temporal.task.active")
+)
+
+type (
+	tracingExecutor struct {
+		shardID  int32
+		tracer   trace.Tracer
+		executor Executor
+	}
+)
+
+// NewTracingExecutor wraps the executor so that each task execution attempt is
+// recorded as a span, which becomes the parent of the persistence and RPC spans
+// created while executing the task. The executor is returned as is if
+// tracerProvider is nil.
+func NewTracingExecutor(
+	shardID int32,
+	tracerProvider trace.TracerProvider,
+	executor Executor,
+) Executor {
+	if tracerProvider == nil {
+		return executor
+	}
+	return &tracingExecutor{
+		shardID:  shardID,
+		tracer:   tracerProvider.Tracer(consts.LibraryName),
+		executor: executor,
+	}
+}
+
+func (e *tracingExecutor) Execute(
+	ctx context.Context,
+	executable Executable,
+) (_ []metrics.Tag, isActive bool, retErr error) {
+	ctx, span := e.tracer.Start(
+		ctx,
+		"queues.Execute"+executable.GetType().String(),
+		trace.WithSpanKind(trace.SpanKindInternal),
+	)
+	defer func() { telemetry.EndSpan(span, retErr) }()
+
+	if span.IsRecording() {
+		span.SetAttributes(telemetry.WorkflowAttributes(
+			executable.GetNamespaceID(),
+			executable.GetWorkflowID(),
+			executable.GetRunID(),
+		)...)
+		span.SetAttributes(
+			telemetry.ShardIDKey.Int64(int64(e.shardID)),
+			taskTypeKey.String(executable.GetType().String()),
+			taskIDKey.Int64(executable.GetTaskID()),
+			taskAttemptKey.Int(executable.Attempt()),
+		)
+	}
+
+	tags, isActive, err := e.executor.Execute(ctx, executable)
+	span.SetAttributes(taskActiveKey.Bool(isActive))
+	return tags, isActive, err
+}
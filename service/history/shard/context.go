@@ -63,6 +63,7 @@ type (
 		GetLogger() log.Logger
 		GetThrottledLogger() log.Logger
 		GetMetricsHandler() metrics.Handler
+		GetSLOMetrics() *metrics.SLOMetrics
 		GetTimeSource() clock.TimeSource
 
 		GetEngine(ctx context.Context) (Engine, error)
@@ -96,6 +96,7 @@ type (
 		stringRepr          string
 		executionManager    persistence.ExecutionManager
 		metricsHandler      metrics.Handler
+		sloMetrics          *metrics.SLOMetrics
 		eventsCache         events.Cache
 		closeCallback       func(*ContextImpl)
 		config              *configs.Config
@@ -115,6 +116,7 @@ type (
 		clusterMetadata         cluster.Metadata
 		archivalMetadata        archiver.ArchivalMetadata
 		hostInfoProvider        membership.HostInfoProvider
+		hostLevelEventsCache    *events.HostLevelCache
 
 		// Context that lives for the lifetime of the shard context
 		lifecycleCtx    context.Context
@@ -1465,6 +1467,11 @@ func (s *ContextImpl) finishStop() {
 		engine.Stop()
 		s.contextTaggedLogger.Info("", tag.LifeCycleStopped, tag.ComponentShardEngine)
 	}
+
+	// events of the shard are not read on this host anymore
+	if s.hostLevelEventsCache != nil {
+		s.hostLevelEventsCache.PurgeShard(s.shardID)
+	}
 }
 
 func (s *ContextImpl) isValid() bool {
@@ -1983,6 +1990,8 @@ func newContext(
 	clusterMetadata cluster.Metadata,
 	archivalMetadata archiver.ArchivalMetadata,
 	hostInfoProvider membership.HostInfoProvider,
+	hostLevelEventsCache *events.HostLevelCache,
+	sloMetrics *metrics.SLOMetrics,
 ) (*ContextImpl, error) {
 	hostIdentity := hostInfoProvider.HostInfo().Identity()
 
@@ -1994,6 +2003,7 @@ func newContext(
 		stringRepr:              fmt.Sprintf("Shard(%d)", shardID),
 		executionManager:        persistenceExecutionManager,
 		metricsHandler:          metricsHandler,
+		sloMetrics:              sloMetrics,
 		closeCallback:           closeCallback,
 		config:                  config,
 		contextTaggedLogger:     log.With(logger, tag.ShardID(shardID), tag.Address(hostIdentity)),
@@ -2015,16 +2025,28 @@ func newContext(
 		lifecycleCancel:         lifecycleCancel,
 		engineFuture:            future.NewFuture[Engine](),
 	}
-	shardContext.eventsCache = events.NewEventsCache(
-		shardContext.GetShardID(),
-		shardContext.GetConfig().EventsCacheInitialSize(),
-		shardContext.GetConfig().EventsCacheMaxSize(),
-		shardContext.GetConfig().EventsCacheTTL(),
-		shardContext.GetExecutionManager(),
-		false,
-		shardContext.GetLogger(),
-		shardContext.GetMetricsHandler(),
-	)
+	if hostLevelEventsCache != nil && config.EnableHostLevelEventsCache() {
+		shardContext.hostLevelEventsCache = hostLevelEventsCache
+		shardContext.eventsCache = events.NewShardLevelEventsCache(
+			shardContext.GetShardID(),
+			hostLevelEventsCache,
+			shardContext.GetExecutionManager(),
+			false,
+			shardContext.GetLogger(),
+			shardContext.GetMetricsHandler(),
+		)
+	} else {
+		shardContext.eventsCache = events.NewEventsCache(
+			shardContext.GetShardID(),
+			shardContext.GetConfig().EventsCacheInitialSize(),
+			shardContext.GetConfig().EventsCacheMaxSize(),
+			shardContext.GetConfig().EventsCacheTTL(),
+			shardContext.GetExecutionManager(),
+			false,
+			shardContext.GetLogger(),
+			shardContext.GetMetricsHandler(),
+		)
+	}
 
 	return shardContext, nil
 }
@@ -2068,6 +2090,10 @@ func (s *ContextImpl) GetMetricsHandler() metrics.Handler {
 	return s.metricsHandler
 }
 
+func (s *ContextImpl) GetSLOMetrics() *metrics.SLOMetrics {
+	return s.sloMetrics
+}
+
 func (s *ContextImpl) GetTimeSource() cclock.TimeSource {
 	return s.timeSource
 }
@@ -522,6 +522,20 @@ func (mr *MockContextMockRecorder) GetReplicatorDLQAckLevel(sourceCluster interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicatorDLQAckLevel", reflect.TypeOf((*MockContext)(nil).GetReplicatorDLQAckLevel), sourceCluster)
 }
 
+// GetSLOMetrics mocks base method.
+func (m *MockContext) GetSLOMetrics() *metrics.SLOMetrics {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSLOMetrics")
+	ret0, _ := ret[0].(*metrics.SLOMetrics)
+	return ret0
+}
+
+// GetSLOMetrics indicates an expected call of GetSLOMetrics.
+func (mr *MockContextMockRecorder) GetSLOMetrics() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLOMetrics", reflect.TypeOf((*MockContext)(nil).GetSLOMetrics))
+}
+
 // GetSearchAttributesMapperProvider mocks base method.
 func (m *MockContext) GetSearchAttributesMapperProvider() searchattribute.MapperProvider {
 	m.ctrl.T.Helper()
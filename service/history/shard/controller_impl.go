@@ -53,6 +53,7 @@ import (
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/events"
 )
 
 const (
@@ -94,6 +95,8 @@ type (
 		archivalMetadata            archiver.ArchivalMetadata
 		hostInfoProvider            membership.HostInfoProvider
 		tracer                      trace.Tracer
+		hostLevelEventsCache        *events.HostLevelCache
+		sloMetrics                  *metrics.SLOMetrics
 	}
 )
 
@@ -309,6 +312,8 @@ func (c *ControllerImpl) getOrCreateShardContext(shardID int32) (*ContextImpl, e
 		c.clusterMetadata,
 		c.archivalMetadata,
 		c.hostInfoProvider,
+		c.hostLevelEventsCache,
+		c.sloMetrics,
 	)
 	if err != nil {
 		return nil, err
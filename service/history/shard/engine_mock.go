@@ -299,6 +299,20 @@ func (mr *MockEngineMockRecorder) ReapplyEvents(ctx, namespaceUUID, workflowID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockEngine)(nil).ReapplyEvents), ctx, namespaceUUID, workflowID, runID, events)
 }
 
+// RearchiveWorkflowExecution mocks base method.
+func (m *MockEngine) RearchiveWorkflowExecution(ctx context.Context, namespaceUUID namespace.ID, execution common.WorkflowExecution) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RearchiveWorkflowExecution", ctx, namespaceUUID, execution)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RearchiveWorkflowExecution indicates an expected call of RearchiveWorkflowExecution.
+func (mr *MockEngineMockRecorder) RearchiveWorkflowExecution(ctx, namespaceUUID, execution interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RearchiveWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).RearchiveWorkflowExecution), ctx, namespaceUUID, execution)
+}
+
 // RebuildMutableState mocks base method.
 func (m *MockEngine) RebuildMutableState(ctx context.Context, namespaceUUID namespace.ID, execution common.WorkflowExecution) error {
 	m.ctrl.T.Helper()
@@ -43,9 +43,11 @@ import (
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/events"
 )
 
 var Module = fx.Options(
+	fx.Provide(SLOMetricsProvider),
 	fx.Provide(ControllerProvider),
 	fx.Provide(fx.Annotate(
 		func(p Controller) common.Pingable { return p },
@@ -73,6 +75,8 @@ func ControllerProvider(
 	hostInfoProvider membership.HostInfoProvider,
 	engineFactory EngineFactory,
 	tracerProvider trace.TracerProvider,
+	hostLevelEventsCache *events.HostLevelCache,
+	sloMetrics *metrics.SLOMetrics,
 ) Controller {
 	return &ControllerImpl{
 		status:                      common.DaemonStatusInitialized,
@@ -100,5 +104,16 @@ func ControllerProvider(
 		hostInfoProvider:            hostInfoProvider,
 		engineFactory:               engineFactory,
 		tracer:                      tracerProvider.Tracer(consts.LibraryName),
+		hostLevelEventsCache:        hostLevelEventsCache,
+		sloMetrics:                  sloMetrics,
 	}
 }
+
+// SLOMetricsProvider provides the per workflow type and per task queue SLO metrics shared by all shards on the host.
+func SLOMetricsProvider(config *configs.Config) *metrics.SLOMetrics {
+	return metrics.NewSLOMetrics(
+		config.EnableSLOMetrics,
+		metrics.NewSLOTagLimiter(config.SLOMetricsWorkflowTypeAllowlist, config.SLOMetricsMaxTagValues),
+		metrics.NewSLOTagLimiter(config.SLOMetricsTaskQueueAllowlist, config.SLOMetricsMaxTagValues),
+	)
+}
@@ -357,7 +357,20 @@ func (t *timerQueueActiveTaskExecutor) executeWorkflowTaskTimeoutTask(
 		scheduleWorkflowTask = true
 	}
 
-	return t.updateWorkflowExecution(ctx, weContext, mutableState, scheduleWorkflowTask)
+	if err := t.updateWorkflowExecution(ctx, weContext, mutableState, scheduleWorkflowTask); err != nil {
+		return err
+	}
+
+	if scheduleWorkflowTask {
+		t.shard.GetSLOMetrics().RecordWorkflowTaskFailed(
+			t.metricHandler.WithTags(metrics.OperationTag(metrics.TimerActiveTaskWorkflowTaskTimeoutScope)),
+			mutableState.GetNamespaceEntry().Name().String(),
+			mutableState.GetExecutionInfo().WorkflowTypeName,
+			mutableState.GetExecutionInfo().TaskQueue,
+			task.TimeoutType.String(),
+		)
+	}
+	return nil
 }
 
 func (t *timerQueueActiveTaskExecutor) executeWorkflowBackoffTimerTask(
@@ -391,6 +404,11 @@ func (t *timerQueueActiveTaskExecutor) executeWorkflowBackoffTimerTask(
 			1,
 			metrics.OperationTag(metrics.TimerActiveTaskWorkflowBackoffTimerScope),
 		)
+	} else if task.WorkflowBackoffType == enumsspb.WORKFLOW_BACKOFF_TYPE_DELAY_START {
+		t.metricHandler.Counter(metrics.WorkflowDelayedStartBackoffTimerCount.GetMetricName()).Record(
+			1,
+			metrics.OperationTag(metrics.TimerActiveTaskWorkflowBackoffTimerScope),
+		)
 	}
 
 	if mutableState.HasProcessedOrPendingWorkflowTask() {
@@ -175,7 +175,7 @@ func (f *timerQueueFactory) CreateQueue(
 		f.HostScheduler,
 		rescheduler,
 		f.HostPriorityAssigner,
-		executor,
+		queues.NewTracingExecutor(shard.GetShardID(), f.TracerProvider, executor),
 		&queues.Options{
 			ReaderOptions: queues.ReaderOptions{
 				BatchSize:            f.Config.TimerTaskBatchSize,
@@ -165,7 +165,7 @@ func (f *transferQueueFactory) CreateQueue(
 		f.HostScheduler,
 		rescheduler,
 		f.HostPriorityAssigner,
-		executor,
+		queues.NewTracingExecutor(shard.GetShardID(), f.TracerProvider, executor),
 		&queues.Options{
 			ReaderOptions: queues.ReaderOptions{
 				BatchSize:            f.Config.TransferTaskBatchSize,
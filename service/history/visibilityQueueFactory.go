@@ -27,6 +27,7 @@ package history
 import (
 	"go.uber.org/fx"
 
+	"go.temporal.io/server/common/cdc"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -48,6 +49,7 @@ type (
 		QueueFactoryBaseParams
 
 		VisibilityMgr manager.VisibilityManager
+		CDCSink       cdc.Sink
 	}
 
 	visibilityQueueFactory struct {
@@ -112,6 +114,8 @@ func (f *visibilityQueueFactory) CreateQueue(
 		f.MetricsHandler,
 		f.Config.VisibilityProcessorEnsureCloseBeforeDelete,
 		f.Config.VisibilityProcessorEnableCloseWorkflowCleanup,
+		f.CDCSink,
+		f.Config.EnableCDC,
 	)
 
 	return queues.NewImmediateQueue(
@@ -120,7 +124,7 @@ func (f *visibilityQueueFactory) CreateQueue(
 		f.HostScheduler,
 		rescheduler,
 		f.HostPriorityAssigner,
-		executor,
+		queues.NewTracingExecutor(shard.GetShardID(), f.TracerProvider, executor),
 		&queues.Options{
 			ReaderOptions: queues.ReaderOptions{
 				BatchSize:            f.Config.VisibilityTaskBatchSize,
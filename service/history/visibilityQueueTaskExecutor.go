@@ -33,6 +33,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cdc"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -40,6 +41,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/queues"
 	"go.temporal.io/server/service/history/shard"
@@ -57,6 +59,9 @@ type (
 
 		ensureCloseBeforeDelete    dynamicconfig.BoolPropertyFn
 		enableCloseWorkflowCleanup dynamicconfig.BoolPropertyFnWithNamespaceFilter
+
+		cdcSink   cdc.Sink
+		enableCDC dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	}
 )
 
@@ -70,6 +75,8 @@ func newVisibilityQueueTaskExecutor(
 	metricProvider metrics.Handler,
 	ensureCloseBeforeDelete dynamicconfig.BoolPropertyFn,
 	enableCloseWorkflowCleanup dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	cdcSink cdc.Sink,
+	enableCDC dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 ) *visibilityQueueTaskExecutor {
 	return &visibilityQueueTaskExecutor{
 		shard:          shard,
@@ -80,6 +87,9 @@ func newVisibilityQueueTaskExecutor(
 
 		ensureCloseBeforeDelete:    ensureCloseBeforeDelete,
 		enableCloseWorkflowCleanup: enableCloseWorkflowCleanup,
+
+		cdcSink:   cdcSink,
+		enableCDC: enableCDC,
 	}
 }
 
@@ -287,7 +297,10 @@ func (t *visibilityQueueTaskExecutor) recordStartExecution(
 			SearchAttributes: searchAttributes,
 		},
 	}
-	return t.visibilityMgr.RecordWorkflowExecutionStarted(ctx, request)
+	if err := t.visibilityMgr.RecordWorkflowExecutionStarted(ctx, request); err != nil {
+		return err
+	}
+	return t.publishCDCEvents(ctx, request.VisibilityRequestBase, nil, cdc.EventTypeStarted)
 }
 
 func (t *visibilityQueueTaskExecutor) upsertExecution(
@@ -330,7 +343,10 @@ func (t *visibilityQueueTaskExecutor) upsertExecution(
 		},
 	}
 
-	return t.visibilityMgr.UpsertWorkflowExecution(ctx, request)
+	if err := t.visibilityMgr.UpsertWorkflowExecution(ctx, request); err != nil {
+		return err
+	}
+	return t.publishCDCEvents(ctx, request.VisibilityRequestBase, nil, cdc.EventTypeSearchAttributesUpserted)
 }
 
 func (t *visibilityQueueTaskExecutor) processCloseExecution(
@@ -440,7 +456,7 @@ func (t *visibilityQueueTaskExecutor) recordCloseExecution(
 	searchAttributes *commonpb.SearchAttributes,
 	historySizeBytes int64,
 ) error {
-	return t.visibilityMgr.RecordWorkflowExecutionClosed(ctx, &manager.RecordWorkflowExecutionClosedRequest{
+	request := &manager.RecordWorkflowExecutionClosedRequest{
 		VisibilityRequestBase: &manager.VisibilityRequestBase{
 			NamespaceID: namespaceEntry.ID(),
 			Namespace:   namespaceEntry.Name(),
@@ -462,7 +478,57 @@ func (t *visibilityQueueTaskExecutor) recordCloseExecution(
 		CloseTime:        endTime,
 		HistoryLength:    historyLength,
 		HistorySizeBytes: historySizeBytes,
-	})
+	}
+	if err := t.visibilityMgr.RecordWorkflowExecutionClosed(ctx, request); err != nil {
+		return err
+	}
+	return t.publishCDCEvents(
+		ctx,
+		request.VisibilityRequestBase,
+		&endTime,
+		cdc.EventTypeStatusChanged,
+		cdc.EventTypeClosed,
+	)
+}
+
+// publishCDCEvents sends the given lifecycle events to the cdc sink once the corresponding
+// visibility record is written. The sink retries and dead letters the events itself. If an event
+// is neither delivered nor dead lettered, the visibility task fails and is retried together with
+// the (idempotent) visibility record write, so events are delivered at least once.
+func (t *visibilityQueueTaskExecutor) publishCDCEvents(
+	ctx context.Context,
+	request *manager.VisibilityRequestBase,
+	closeTime *time.Time,
+	eventTypes ...cdc.EventType,
+) error {
+	if !t.enableCDC(request.Namespace.String()) {
+		return nil
+	}
+
+	// Stringify falls back to the raw value for attributes it can't decode,
+	// which is good enough for the feed.
+	searchAttributes, _ := searchattribute.Stringify(request.SearchAttributes, nil)
+	for _, eventType := range eventTypes {
+		event := &cdc.Event{
+			Type:                 eventType,
+			NamespaceID:          request.NamespaceID.String(),
+			Namespace:            request.Namespace.String(),
+			WorkflowID:           request.Execution.GetWorkflowId(),
+			RunID:                request.Execution.GetRunId(),
+			WorkflowType:         request.WorkflowTypeName,
+			Status:               request.Status,
+			StartTime:            request.StartTime,
+			CloseTime:            closeTime,
+			StateTransitionCount: request.StateTransitionCount,
+			TaskID:               request.TaskID,
+			ShardID:              request.ShardID,
+			SearchAttributes:     searchAttributes,
+		}
+		if err := t.cdcSink.Send(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (t *visibilityQueueTaskExecutor) processDeleteExecution(
@@ -26,6 +26,7 @@ package history
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -44,6 +45,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cdc"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
@@ -74,6 +76,7 @@ type (
 
 		mockVisibilityMgr *manager.MockVisibilityManager
 		mockExecutionMgr  *persistence.MockExecutionManager
+		mockCDCSink       *cdc.MockSink
 
 		workflowCache               wcache.Cache
 		logger                      log.Logger
@@ -85,6 +88,7 @@ type (
 		visibilityQueueTaskExecutor *visibilityQueueTaskExecutor
 
 		enableCloseWorkflowCleanup bool
+		enableCDC                  bool
 	}
 )
 
@@ -133,6 +137,7 @@ func (s *visibilityQueueTaskExecutorSuite) SetupTest() {
 
 	s.mockExecutionMgr = s.mockShard.Resource.ExecutionMgr
 	s.mockVisibilityMgr = manager.NewMockVisibilityManager(s.controller)
+	s.mockCDCSink = cdc.NewMockSink(s.controller)
 
 	mockNamespaceCache := s.mockShard.Resource.NamespaceCache
 	mockNamespaceCache.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.GlobalNamespaceEntry, nil).AnyTimes()
@@ -161,6 +166,7 @@ func (s *visibilityQueueTaskExecutorSuite) SetupTest() {
 	s.mockShard.SetEngineForTesting(h)
 
 	s.enableCloseWorkflowCleanup = false
+	s.enableCDC = false
 	s.visibilityQueueTaskExecutor = newVisibilityQueueTaskExecutor(
 		s.mockShard,
 		s.workflowCache,
@@ -169,6 +175,8 @@ func (s *visibilityQueueTaskExecutorSuite) SetupTest() {
 		metrics.NoopMetricsHandler,
 		config.VisibilityProcessorEnsureCloseBeforeDelete,
 		func(_ string) bool { return s.enableCloseWorkflowCleanup },
+		s.mockCDCSink,
+		func(_ string) bool { return s.enableCDC },
 	)
 }
 
@@ -368,6 +376,74 @@ func (s *visibilityQueueTaskExecutorSuite) TestProcessRecordWorkflowStartedTask(
 	s.Nil(err)
 }
 
+func (s *visibilityQueueTaskExecutorSuite) TestProcessRecordWorkflowStartedTask_CDC() {
+	s.enableCDC = true
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+
+	mutableState := workflow.TestGlobalMutableState(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID.String(),
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: timestamp.DurationPtr(2 * time.Second),
+				WorkflowTaskTimeout:      timestamp.DurationPtr(1 * time.Second),
+			},
+		},
+	)
+	s.Nil(err)
+
+	taskID := int64(59)
+	wt := addWorkflowTaskScheduledEvent(mutableState)
+
+	visibilityTask := &tasks.StartExecutionVisibilityTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			s.namespaceID.String(),
+			execution.GetWorkflowId(),
+			execution.GetRunId(),
+		),
+		VisibilityTimestamp: time.Now().UTC(),
+		Version:             s.version,
+		TaskID:              taskID,
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, wt.ScheduledEventID, wt.Version)
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockVisibilityMgr.EXPECT().RecordWorkflowExecutionStarted(gomock.Any(), gomock.Any()).Return(nil)
+
+	var events []*cdc.Event
+	s.mockCDCSink.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, event *cdc.Event) error {
+			events = append(events, event)
+			return errors.New("sink unavailable")
+		},
+	)
+
+	// an event which is neither delivered nor dead lettered fails the visibility task, so it is retried
+	_, _, err = s.visibilityQueueTaskExecutor.Execute(context.Background(), s.newTaskExecutable(visibilityTask))
+	s.Error(err)
+
+	s.Len(events, 1)
+	s.Equal(cdc.EventTypeStarted, events[0].Type)
+	s.Equal(s.namespaceID.String(), events[0].NamespaceID)
+	s.Equal(s.namespace.String(), events[0].Namespace)
+	s.Equal(execution.GetWorkflowId(), events[0].WorkflowID)
+	s.Equal(execution.GetRunId(), events[0].RunID)
+	s.Equal(workflowType, events[0].WorkflowType)
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, events[0].Status)
+	s.Equal(taskID, events[0].TaskID)
+	s.Nil(events[0].CloseTime)
+}
+
 func (s *visibilityQueueTaskExecutorSuite) TestProcessUpsertWorkflowSearchAttributes() {
 	execution := commonpb.WorkflowExecution{
 		WorkflowId: "some random workflow ID",
@@ -57,6 +57,10 @@ type (
 			execution commonpb.WorkflowExecution,
 			caller workflow.CallerType,
 		) (workflow.Context, ReleaseCacheFunc, error)
+
+		// Purge drops all cached workflows. It is called when the shard is closed on this host,
+		// so that the cached mutable states stop counting against the host level budget.
+		Purge()
 	}
 
 	CacheImpl struct {
@@ -78,17 +82,27 @@ const (
 )
 
 func NewCache(shard shard.Context) Cache {
-	opts := &cache.Options{}
+	return NewCacheWithBudget(shard, nil)
+}
+
+// NewCacheWithBudget creates a workflow cache whose entries are additionally bounded
+// by the given byte budget, which is typically shared by all shards on the host.
+func NewCacheWithBudget(shard shard.Context, budget *cache.Budget) Cache {
 	config := shard.GetConfig()
+	metricsHandler := shard.GetMetricsHandler().WithTags(metrics.CacheTypeTag(metrics.MutableStateCacheTypeTagValue))
+
+	opts := &cache.Options{}
 	opts.InitialCapacity = config.HistoryCacheInitialSize()
 	opts.TTL = config.HistoryCacheTTL()
 	opts.Pin = true
+	opts.Budget = budget
+	opts.MetricsHandler = metricsHandler
 
 	return &CacheImpl{
 		Cache:          cache.New(config.HistoryCacheMaxSize(), opts),
 		shard:          shard,
 		logger:         log.With(shard.GetLogger(), tag.ComponentHistoryCache),
-		metricsHandler: shard.GetMetricsHandler().WithTags(metrics.CacheTypeTag(metrics.MutableStateCacheTypeTagValue)),
+		metricsHandler: metricsHandler,
 		config:         config,
 	}
 }
@@ -27,6 +27,8 @@ package cache
 import (
 	"go.uber.org/fx"
 
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/shard"
 )
 
@@ -35,8 +37,10 @@ var Module = fx.Options(
 )
 
 // NewCacheFnProvider provide a NewCacheFn that can be used to create new workflow cache.
-func NewCacheFnProvider() NewCacheFn {
+// All caches created by the returned function share a single host-level byte budget.
+func NewCacheFnProvider(config *configs.Config) NewCacheFn {
+	budget := cache.NewBudget(config.HistoryCacheHostLevelMaxSizeBytes)
 	return func(shard shard.Context) Cache {
-		return NewCache(shard)
+		return NewCacheWithBudget(shard, budget)
 	}
 }
@@ -29,6 +29,7 @@ package workflow
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
@@ -58,6 +59,10 @@ import (
 
 const (
 	defaultRemoteCallTimeout = 30 * time.Second
+
+	// emptyContextCacheSize is the approximate size of a workflow context
+	// without mutable state loaded
+	emptyContextCacheSize = 1024
 )
 
 const (
@@ -161,6 +166,10 @@ type (
 		mutex        locks.PriorityMutex
 		MutableState MutableState
 		stats        *persistencespb.ExecutionStats
+
+		// mutableStateSize is the approximate size of the persisted mutable
+		// state, read by the workflow cache without holding the context lock
+		mutableStateSize atomic.Int64
 	}
 )
 
@@ -223,6 +232,12 @@ func (c *ContextImpl) Clear() {
 	c.stats = &persistencespb.ExecutionStats{
 		HistorySize: 0,
 	}
+	c.mutableStateSize.Store(0)
+}
+
+// CacheSize returns the approximate size in bytes of the workflow context
+func (c *ContextImpl) CacheSize() int {
+	return emptyContextCacheSize + int(c.mutableStateSize.Load())
 }
 
 func (c *ContextImpl) GetWorkflowKey() definition.WorkflowKey {
@@ -287,6 +302,7 @@ func (c *ContextImpl) LoadMutableState(ctx context.Context) (MutableState, error
 		}
 
 		c.stats = response.State.ExecutionInfo.ExecutionStats
+		c.mutableStateSize.Store(int64(response.MutableStateStats.TotalSize))
 	}
 
 	flushBeforeReady, err := c.MutableState.StartTransaction(namespaceEntry)
@@ -360,6 +376,7 @@ func (c *ContextImpl) CreateWorkflowExecution(
 		return err
 	}
 	c.SetHistorySize(int64(resp.NewMutableStateStats.HistoryStatistics.SizeDiff))
+	c.mutableStateSize.Store(int64(resp.NewMutableStateStats.TotalSize))
 
 	engine, err := c.shard.GetEngine(ctx)
 	if err != nil {
@@ -476,6 +493,9 @@ func (c *ContextImpl) ConflictResolveWorkflowExecution(
 			currentContext.SetHistorySize(currentContext.GetHistorySize() + currentWorkflowSizeDiff)
 		}
 	}
+	updateMutableStateSize(c, resetMutableState)
+	updateMutableStateSize(newContext, newMutableState)
+	updateMutableStateSize(currentContext, currentMutableState)
 
 	emitStateTransitionCount(c.metricsHandler, c.clusterMetadata, resetMutableState)
 	emitStateTransitionCount(c.metricsHandler, c.clusterMetadata, newMutableState)
@@ -650,6 +670,8 @@ func (c *ContextImpl) UpdateWorkflowExecutionWithNew(
 			newContext.SetHistorySize(newContext.GetHistorySize() + newWorkflowSizeDiff)
 		}
 	}
+	updateMutableStateSize(c, c.MutableState)
+	updateMutableStateSize(newContext, newMutableState)
 
 	emitStateTransitionCount(c.metricsHandler, c.clusterMetadata, c.MutableState)
 	emitStateTransitionCount(c.metricsHandler, c.clusterMetadata, newMutableState)
@@ -689,10 +711,26 @@ func (c *ContextImpl) SetWorkflowExecution(ctx context.Context, now time.Time) (
 		HistorySize: c.GetHistorySize(),
 	}
 
-	return c.transaction.SetWorkflowExecution(
+	if err := c.transaction.SetWorkflowExecution(
 		ctx,
 		resetWorkflowSnapshot,
-	)
+	); err != nil {
+		return err
+	}
+	updateMutableStateSize(c, c.MutableState)
+	return nil
+}
+
+// updateMutableStateSize refreshes the cache size of a workflow context after its mutable state is persisted
+func updateMutableStateSize(
+	wfContext Context,
+	mutableState MutableState,
+) {
+	contextImpl, ok := wfContext.(*ContextImpl)
+	if !ok || mutableState == nil {
+		return
+	}
+	contextImpl.mutableStateSize.Store(int64(mutableState.GetApproximatePersistedSize()))
 }
 
 func (c *ContextImpl) mergeContinueAsNewReplicationTasks(
@@ -918,13 +956,11 @@ func (c *ContextImpl) enforceSizeCheck(
 		return true, nil
 	}
 
-	if historySize > historySizeLimitWarn || historyCount > historyCountLimitWarn {
-		c.logger.Warn("history size exceeds warn limit.",
-			tag.WorkflowNamespaceID(c.MutableState.GetExecutionInfo().NamespaceId),
-			tag.WorkflowID(c.MutableState.GetExecutionInfo().WorkflowId),
-			tag.WorkflowRunID(c.MutableState.GetExecutionState().RunId),
-			tag.WorkflowHistorySize(historySize),
-			tag.WorkflowEventCount(historyCount))
+	if historySize > historySizeLimitWarn {
+		RecordLimitWarning(c.shard, c.MutableState, LimitHistorySize, historySize, historySizeLimitWarn, historySizeLimitError)
+	}
+	if historyCount > historyCountLimitWarn {
+		RecordLimitWarning(c.shard, c.MutableState, LimitHistoryCount, historyCount, historyCountLimitWarn, historyCountLimitError)
 	}
 
 	return false, nil
@@ -0,0 +1,203 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workflow
+
+import (
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"golang.org/x/exp/slices"
+
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+// Names of the per workflow limits that have a warning level. They are used as the value of
+// the limit metrics tag and of the limit warning search attribute.
+const (
+	LimitPendingChildExecutions = "PendingChildExecutions"
+	LimitPendingActivities      = "PendingActivities"
+	LimitPendingSignals         = "PendingSignals"
+	LimitPendingCancelRequests  = "PendingCancelRequests"
+	LimitHistorySize            = "HistorySize"
+	LimitHistoryCount           = "HistoryCount"
+)
+
+// RecordLimitWarning reports a workflow that crossed the warning level of a per workflow limit.
+// It emits a metric and a throttled warning log.
+func RecordLimitWarning(
+	shard shard.Context,
+	mutableState MutableState,
+	limit string,
+	value int,
+	warnLimit int,
+	errorLimit int,
+) {
+	namespaceName := mutableState.GetNamespaceEntry().Name().String()
+	shard.GetMetricsHandler().Counter(metrics.WorkflowLimitWarning.GetMetricName()).Record(
+		1,
+		metrics.NamespaceTag(namespaceName),
+		metrics.LimitTag(limit),
+	)
+
+	key := mutableState.GetWorkflowKey()
+	shard.GetThrottledLogger().Warn("Workflow exceeds the warning level of a limit.",
+		tag.WorkflowNamespace(namespaceName),
+		tag.WorkflowID(key.WorkflowID),
+		tag.WorkflowRunID(key.RunID),
+		tag.NewStringTag("limit", limit),
+		tag.NewInt("value", value),
+		tag.NewInt("warn-limit", warnLimit),
+		tag.NewInt("error-limit", errorLimit),
+	)
+}
+
+// HistoryLimitWarnings returns the history limits whose warning level the workflow crossed.
+func HistoryLimitWarnings(
+	shard shard.Context,
+	mutableState MutableState,
+	historySize int64,
+) []string {
+	config := shard.GetConfig()
+	namespaceName := mutableState.GetNamespaceEntry().Name().String()
+
+	var limits []string
+	if historySize > int64(config.HistorySizeLimitWarn(namespaceName)) {
+		limits = append(limits, LimitHistorySize)
+	}
+	if mutableState.GetNextEventID()-1 > int64(config.HistoryCountLimitWarn(namespaceName)) {
+		limits = append(limits, LimitHistoryCount)
+	}
+	return limits
+}
+
+// AddLimitWarningSearchAttribute adds the limits to the search attribute configured by LimitWarningSearchAttribute
+// of the namespace, if any. The search attribute is set in mutable state and an upsert visibility task is generated
+// (same as for BinaryChecksums): no history event is written, because an event which wasn't produced by a workflow
+// command would break the replay of the workflow by SDK workers.
+// The search attribute is best effort: if it is misconfigured, a warning is logged and nothing is added.
+func AddLimitWarningSearchAttribute(
+	shard shard.Context,
+	mutableState MutableState,
+	limits []string,
+) {
+	if len(limits) == 0 || !mutableState.IsWorkflowExecutionRunning() {
+		return
+	}
+
+	fieldName, limitsPayload, err := limitWarningSearchAttribute(shard, mutableState, limits)
+	if err != nil {
+		key := mutableState.GetWorkflowKey()
+		shard.GetThrottledLogger().Warn("Unable to record limit warning search attribute.",
+			tag.WorkflowNamespace(mutableState.GetNamespaceEntry().Name().String()),
+			tag.WorkflowID(key.WorkflowID),
+			tag.WorkflowRunID(key.RunID),
+			tag.Error(err),
+		)
+		return
+	}
+	if limitsPayload == nil {
+		return
+	}
+
+	executionInfo := mutableState.GetExecutionInfo()
+	if executionInfo.SearchAttributes == nil {
+		executionInfo.SearchAttributes = make(map[string]*commonpb.Payload, 1)
+	}
+	executionInfo.SearchAttributes[fieldName] = limitsPayload
+	mutableState.AddTasks(&tasks.UpsertExecutionVisibilityTask{
+		// TaskID, VisibilityTimestamp is set by shard
+		WorkflowKey: mutableState.GetWorkflowKey(),
+		Version:     mutableState.GetCurrentVersion(), // task processing does not check this version
+	})
+}
+
+// limitWarningSearchAttribute returns the field name of the limit warning search attribute and its new value,
+// or nil value if the search attribute is not configured or already has all the limits.
+func limitWarningSearchAttribute(
+	shard shard.Context,
+	mutableState MutableState,
+	newLimits []string,
+) (string, *commonpb.Payload, error) {
+	config := shard.GetConfig()
+	namespaceName := mutableState.GetNamespaceEntry().Name()
+	alias := config.LimitWarningSearchAttribute(namespaceName.String())
+	if alias == "" || config.AdvancedVisibilityWritingMode() == visibility.AdvancedVisibilityWritingModeOff {
+		return "", nil, nil
+	}
+
+	mapper, err := shard.GetSearchAttributesMapperProvider().GetMapper(namespaceName)
+	if err != nil {
+		return "", nil, err
+	}
+	fieldName, err := mapper.GetFieldName(alias, namespaceName.String())
+	if err != nil {
+		return "", nil, err
+	}
+	saTypeMap, err := shard.GetSearchAttributesProvider().GetSearchAttributes(config.DefaultVisibilityIndexName, false)
+	if err != nil {
+		return "", nil, err
+	}
+	saType, err := saTypeMap.GetType(fieldName)
+	if err != nil {
+		return "", nil, err
+	}
+	if saType != enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST {
+		return "", nil, searchattribute.ErrInvalidType
+	}
+
+	var limits []string
+	if value, ok := mutableState.GetExecutionInfo().SearchAttributes[fieldName]; ok {
+		decoded, err := searchattribute.DecodeValue(value, saType)
+		if err != nil {
+			return "", nil, err
+		}
+		switch decoded := decoded.(type) {
+		case string:
+			limits = []string{decoded}
+		case []string:
+			limits = decoded
+		}
+	}
+	changed := false
+	for _, limit := range newLimits {
+		if !slices.Contains(limits, limit) {
+			limits = append(limits, limit)
+			changed = true
+		}
+	}
+	if !changed {
+		return "", nil, nil
+	}
+
+	limitsPayload, err := searchattribute.EncodeValue(limits, saType)
+	if err != nil {
+		return "", nil, err
+	}
+	return fieldName, limitsPayload, nil
+}
@@ -0,0 +1,170 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workflow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	commandpb "go.temporal.io/api/command/v1"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/worker"
+	sdkworkflow "go.temporal.io/sdk/workflow"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/history/events"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/history/tests"
+)
+
+func TestAddLimitWarningSearchAttribute(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	config := tests.NewDynamicConfig()
+	// any KeywordList search attribute works, TemporalChangeVersion is the one available in tests
+	config.LimitWarningSearchAttribute = func(string) string { return searchattribute.TemporalChangeVersion }
+	config.AdvancedVisibilityWritingMode = func() string { return visibility.AdvancedVisibilityWritingModeOn }
+	mockShard := shard.NewTestContext(
+		controller,
+		&persistencespb.ShardInfo{ShardId: 1, RangeId: 1},
+		config,
+	)
+	defer mockShard.StopForTest()
+	mockShard.Resource.SearchAttributesMapperProvider.EXPECT().GetMapper(gomock.Any()).
+		DoAndReturn(searchattribute.NewTestMapperProvider(nil).GetMapper).AnyTimes()
+	mockShard.Resource.SearchAttributesProvider.EXPECT().GetSearchAttributes(gomock.Any(), false).
+		Return(searchattribute.TestNameTypeMap, nil).AnyTimes()
+
+	ms := TestLocalMutableState(mockShard, events.NewMockCache(controller), tests.LocalNamespaceEntry, log.NewTestLogger(), uuid.New())
+	ms.executionState.State = enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING
+
+	nextEventID := ms.GetNextEventID()
+
+	AddLimitWarningSearchAttribute(mockShard, ms, []string{LimitPendingActivities})
+	AddLimitWarningSearchAttribute(mockShard, ms, []string{LimitPendingActivities, LimitHistoryCount})
+	// no new limits, no visibility task
+	AddLimitWarningSearchAttribute(mockShard, ms, []string{LimitHistoryCount})
+
+	value, err := searchattribute.DecodeValue(
+		ms.GetExecutionInfo().SearchAttributes[searchattribute.TemporalChangeVersion],
+		enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{LimitPendingActivities, LimitHistoryCount}, value)
+	require.Len(t, ms.InsertTasks[tasks.CategoryVisibility], 2)
+
+	// no history events
+	require.Equal(t, nextEventID, ms.GetNextEventID())
+}
+
+func TestAddLimitWarningSearchAttribute_Replay(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	config := tests.NewDynamicConfig()
+	config.LimitWarningSearchAttribute = func(string) string { return searchattribute.TemporalChangeVersion }
+	config.AdvancedVisibilityWritingMode = func() string { return visibility.AdvancedVisibilityWritingModeOn }
+	mockShard := shard.NewTestContext(
+		controller,
+		&persistencespb.ShardInfo{ShardId: 1, RangeId: 1},
+		config,
+	)
+	defer mockShard.StopForTest()
+	mockShard.Resource.SearchAttributesMapperProvider.EXPECT().GetMapper(gomock.Any()).
+		DoAndReturn(searchattribute.NewTestMapperProvider(nil).GetMapper).AnyTimes()
+	mockShard.Resource.SearchAttributesProvider.EXPECT().GetSearchAttributes(gomock.Any(), false).
+		Return(searchattribute.TestNameTypeMap, nil).AnyTimes()
+	mockShard.Resource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	mockShard.Resource.NamespaceCache.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.LocalNamespaceEntry, nil).AnyTimes()
+	eventsCache := events.NewMockCache(controller)
+	eventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+
+	const (
+		workflowType = "limit-warning-workflow"
+		activityType = "limit-warning-activity"
+		taskQueue    = "limit-warning-task-queue"
+	)
+	execution := commonpb.WorkflowExecution{WorkflowId: "limit-warning-workflow-id", RunId: uuid.New()}
+	ms := TestLocalMutableState(mockShard, eventsCache, tests.LocalNamespaceEntry, log.NewTestLogger(), execution.GetRunId())
+
+	// Workflow schedules an activity in its first workflow task and crosses the warning level of PendingActivities.
+	_, err := ms.AddWorkflowExecutionStartedEvent(execution, &historyservice.StartWorkflowExecutionRequest{
+		Attempt:     1,
+		NamespaceId: tests.NamespaceID.String(),
+		StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+			WorkflowId:          execution.GetWorkflowId(),
+			WorkflowType:        &commonpb.WorkflowType{Name: workflowType},
+			TaskQueue:           &taskqueuepb.TaskQueue{Name: taskQueue},
+			WorkflowRunTimeout:  timestamp.DurationPtr(time.Hour),
+			WorkflowTaskTimeout: timestamp.DurationPtr(10 * time.Second),
+		},
+	})
+	require.NoError(t, err)
+	workflowTask, err := ms.AddWorkflowTaskScheduledEvent(false, enumsspb.WORKFLOW_TASK_TYPE_NORMAL)
+	require.NoError(t, err)
+	_, workflowTask, err = ms.AddWorkflowTaskStartedEvent(workflowTask.ScheduledEventID, uuid.New(), &taskqueuepb.TaskQueue{Name: taskQueue}, "identity")
+	require.NoError(t, err)
+	completedEvent, err := ms.AddWorkflowTaskCompletedEvent(workflowTask.ScheduledEventID, workflowTask.StartedEventID, &workflowservice.RespondWorkflowTaskCompletedRequest{Identity: "identity"}, 0)
+	require.NoError(t, err)
+	_, _, err = ms.AddActivityTaskScheduledEvent(completedEvent.GetEventId(), &commandpb.ScheduleActivityTaskCommandAttributes{
+		ActivityId:          "1",
+		ActivityType:        &commonpb.ActivityType{Name: activityType},
+		TaskQueue:           &taskqueuepb.TaskQueue{Name: taskQueue},
+		StartToCloseTimeout: timestamp.DurationPtr(time.Minute),
+	}, false)
+	require.NoError(t, err)
+	AddLimitWarningSearchAttribute(mockShard, ms, []string{LimitPendingActivities})
+	require.Contains(t, ms.GetExecutionInfo().SearchAttributes, searchattribute.TemporalChangeVersion)
+
+	_, workflowEvents, err := ms.CloseTransactionAsMutation(time.Now().UTC(), TransactionPolicyActive)
+	require.NoError(t, err)
+	history := &historypb.History{}
+	for _, batch := range workflowEvents {
+		history.Events = append(history.Events, batch.Events...)
+	}
+
+	replayer := worker.NewWorkflowReplayer()
+	replayer.RegisterWorkflowWithOptions(func(ctx sdkworkflow.Context) error {
+		ctx = sdkworkflow.WithActivityOptions(ctx, sdkworkflow.ActivityOptions{ActivityID: "1", StartToCloseTimeout: time.Minute})
+		return sdkworkflow.ExecuteActivity(ctx, activityType).Get(ctx, nil)
+	}, sdkworkflow.RegisterOptions{Name: workflowType})
+	require.NoError(t, replayer.ReplayWorkflowHistory(nil, history))
+}
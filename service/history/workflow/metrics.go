@@ -109,3 +109,25 @@ func emitWorkflowCompletionStats(
 		handler.Counter(metrics.WorkflowContinuedAsNewCount.GetMetricName()).Record(1)
 	}
 }
+
+func emitWorkflowEndToEndLatency(
+	metricsHandler metrics.Handler,
+	sloMetrics *metrics.SLOMetrics,
+	namespace namespace.Name,
+	completionMetric completionMetric,
+) {
+	if completionMetric.status == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING ||
+		completionMetric.startTime == nil ||
+		completionMetric.closeTime == nil {
+		return
+	}
+
+	sloMetrics.RecordWorkflowEndToEndLatency(
+		metricsHandler.WithTags(metrics.OperationTag(metrics.WorkflowCompletionStatsScope)),
+		namespace.String(),
+		completionMetric.workflowType,
+		completionMetric.taskQueue,
+		completionMetric.status,
+		completionMetric.closeTime.Sub(*completionMetric.startTime),
+	)
+}
@@ -137,6 +137,7 @@ type (
 		AddWorkflowExecutionStartedEvent(commonpb.WorkflowExecution, *historyservice.StartWorkflowExecutionRequest) (*historypb.HistoryEvent, error)
 		AddWorkflowExecutionStartedEventWithOptions(commonpb.WorkflowExecution, *historyservice.StartWorkflowExecutionRequest, *workflowpb.ResetPoints, string, string) (*historypb.HistoryEvent, error)
 		AddWorkflowExecutionTerminatedEvent(firstEventID int64, reason string, details *commonpb.Payloads, identity string, deleteAfterTerminate bool) (*historypb.HistoryEvent, error)
+		AttachRequestID(requestID string)
 		ClearStickyness()
 		CheckResettable() error
 		CloneToProto() *persistencespb.WorkflowMutableState
@@ -146,6 +147,7 @@ type (
 		DeleteSignalRequested(requestID string)
 		FlushBufferedEvents()
 		GetWorkflowKey() definition.WorkflowKey
+		GetApproximatePersistedSize() int
 		GetActivityByActivityID(string) (*persistencespb.ActivityInfo, bool)
 		GetActivityInfo(int64) (*persistencespb.ActivityInfo, bool)
 		GetActivityInfoWithTimerHeartbeat(scheduledEventID int64) (*persistencespb.ActivityInfo, time.Time, bool)
@@ -194,6 +196,7 @@ type (
 		HasProcessedOrPendingWorkflowTask() bool
 		IsCancelRequested() bool
 		IsCurrentWorkflowGuaranteed() bool
+		IsRequestIDAttached(requestID string) bool
 		IsSignalRequested(requestID string) bool
 		IsStickyTaskQueueEnabled() bool
 		TaskQueue() *taskqueuepb.TaskQueue
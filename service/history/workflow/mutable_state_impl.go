@@ -40,6 +40,7 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"golang.org/x/exp/slices"
 
 	clockspb "go.temporal.io/server/api/clock/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
@@ -405,6 +406,35 @@ func (ms *MutableStateImpl) GetWorkflowKey() definition.WorkflowKey {
 	)
 }
 
+// GetApproximatePersistedSize returns the approximate size of the mutable state as persisted,
+// i.e. not including in-memory only attributes and pending changes of the current transaction
+func (ms *MutableStateImpl) GetApproximatePersistedSize() int {
+	size := ms.executionInfo.Size()
+	size += ms.executionState.Size()
+	for _, ai := range ms.pendingActivityInfoIDs {
+		size += ai.Size()
+	}
+	for _, ti := range ms.pendingTimerInfoIDs {
+		size += ti.Size()
+	}
+	for _, ci := range ms.pendingChildExecutionInfoIDs {
+		size += ci.Size()
+	}
+	for _, ri := range ms.pendingRequestCancelInfoIDs {
+		size += ri.Size()
+	}
+	for _, si := range ms.pendingSignalInfoIDs {
+		size += si.Size()
+	}
+	for requestID := range ms.pendingSignalRequestedIDs {
+		size += len(requestID)
+	}
+	for _, event := range ms.bufferEventsInDB {
+		size += event.Size()
+	}
+	return size
+}
+
 func (ms *MutableStateImpl) GetCurrentBranchToken() ([]byte, error) {
 	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(ms.executionInfo.VersionHistories)
 	if err != nil {
@@ -1389,6 +1419,20 @@ func (ms *MutableStateImpl) IsSignalRequested(
 	return false
 }
 
+func (ms *MutableStateImpl) IsRequestIDAttached(
+	requestID string,
+) bool {
+
+	return slices.Contains(ms.executionInfo.AttachedRequestIds, requestID)
+}
+
+func (ms *MutableStateImpl) AttachRequestID(
+	requestID string,
+) {
+
+	ms.executionInfo.AttachedRequestIds = append(ms.executionInfo.AttachedRequestIds, requestID)
+}
+
 func (ms *MutableStateImpl) IsWorkflowPendingOnWorkflowTaskBackoff() bool {
 
 	workflowTaskBackoff := timestamp.TimeValue(ms.executionInfo.GetExecutionTime()).After(timestamp.TimeValue(ms.executionInfo.GetStartTime()))
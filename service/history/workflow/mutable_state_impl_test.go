@@ -787,6 +787,41 @@ func (s *mutableStateSuite) buildWorkflowMutableState() *persistencespb.Workflow
 	}
 }
 
+func (s *mutableStateSuite) TestGetApproximatePersistedSize() {
+	dbState := s.buildWorkflowMutableState()
+
+	var err error
+	s.mutableState, err = newMutableStateFromDB(s.mockShard, s.mockEventsCache, s.logger, tests.LocalNamespaceEntry, dbState, 123)
+	s.NoError(err)
+
+	expectedSize := dbState.ExecutionInfo.Size() + dbState.ExecutionState.Size()
+	for _, ai := range dbState.ActivityInfos {
+		expectedSize += ai.Size()
+	}
+	for _, ti := range dbState.TimerInfos {
+		expectedSize += ti.Size()
+	}
+	for _, ci := range dbState.ChildExecutionInfos {
+		expectedSize += ci.Size()
+	}
+	for _, ri := range dbState.RequestCancelInfos {
+		expectedSize += ri.Size()
+	}
+	for _, si := range dbState.SignalInfos {
+		expectedSize += si.Size()
+	}
+	for _, requestID := range dbState.SignalRequestedIds {
+		expectedSize += len(requestID)
+	}
+	for _, event := range dbState.BufferedEvents {
+		expectedSize += event.Size()
+	}
+	s.Equal(expectedSize, s.mutableState.GetApproximatePersistedSize())
+
+	s.mutableState.pendingSignalRequestedIDs["new-request-id"] = struct{}{}
+	s.Equal(expectedSize+len("new-request-id"), s.mutableState.GetApproximatePersistedSize())
+}
+
 func (s *mutableStateSuite) TestReplicateActivityTaskStartedEvent() {
 	state := s.buildWorkflowMutableState()
 
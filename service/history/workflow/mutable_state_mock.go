@@ -786,6 +786,18 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowTaskTimedOutEvent(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowTaskTimedOutEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowTaskTimedOutEvent), arg0, arg1)
 }
 
+// AttachRequestID mocks base method.
+func (m *MockMutableState) AttachRequestID(requestID string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AttachRequestID", requestID)
+}
+
+// AttachRequestID indicates an expected call of AttachRequestID.
+func (mr *MockMutableStateMockRecorder) AttachRequestID(requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachRequestID", reflect.TypeOf((*MockMutableState)(nil).AttachRequestID), requestID)
+}
+
 // CheckResettable mocks base method.
 func (m *MockMutableState) CheckResettable() error {
 	m.ctrl.T.Helper()
@@ -1072,6 +1084,20 @@ func (mr *MockMutableStateMockRecorder) GetCurrentBranchToken() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentBranchToken", reflect.TypeOf((*MockMutableState)(nil).GetCurrentBranchToken))
 }
 
+// GetApproximatePersistedSize mocks base method.
+func (m *MockMutableState) GetApproximatePersistedSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApproximatePersistedSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// GetApproximatePersistedSize indicates an expected call of GetApproximatePersistedSize.
+func (mr *MockMutableStateMockRecorder) GetApproximatePersistedSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApproximatePersistedSize", reflect.TypeOf((*MockMutableState)(nil).GetApproximatePersistedSize))
+}
+
 // GetCurrentVersion mocks base method.
 func (m *MockMutableState) GetCurrentVersion() int64 {
 	m.ctrl.T.Helper()
@@ -1650,6 +1676,20 @@ func (mr *MockMutableStateMockRecorder) IsCurrentWorkflowGuaranteed() *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCurrentWorkflowGuaranteed", reflect.TypeOf((*MockMutableState)(nil).IsCurrentWorkflowGuaranteed))
 }
 
+// IsRequestIDAttached mocks base method.
+func (m *MockMutableState) IsRequestIDAttached(requestID string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRequestIDAttached", requestID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsRequestIDAttached indicates an expected call of IsRequestIDAttached.
+func (mr *MockMutableStateMockRecorder) IsRequestIDAttached(requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRequestIDAttached", reflect.TypeOf((*MockMutableState)(nil).IsRequestIDAttached), requestID)
+}
+
 // IsResourceDuplicated mocks base method.
 func (m *MockMutableState) IsResourceDuplicated(resourceDedupKey definition.DeduplicationID) bool {
 	m.ctrl.T.Helper()
@@ -58,6 +58,7 @@ import (
 	"go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/cluster"
@@ -362,3 +363,37 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskGeneratorImpl_GenerateDelayedWorkflowTasks_StartDelay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mutableState := NewMockMutableState(ctrl)
+	mutableState.EXPECT().GetWorkflowKey().Return(definition.NewWorkflowKey(
+		tests.NamespaceID.String(), tests.WorkflowID, tests.RunID,
+	)).AnyTimes()
+	var allTasks []tasks.Task
+	mutableState.EXPECT().AddTasks(gomock.Any()).Do(func(ts ...tasks.Task) {
+		allTasks = append(allTasks, ts...)
+	}).AnyTimes()
+
+	startTime := time.Unix(0, 0).UTC()
+	startDelay := 10 * time.Minute
+	taskGenerator := NewTaskGenerator(nil, mutableState, &configs.Config{}, nil)
+	err := taskGenerator.GenerateDelayedWorkflowTasks(&historypb.HistoryEvent{
+		EventTime: timestamp.TimePtr(startTime),
+		Version:   tests.Version,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+			WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+				Initiator:                enums.CONTINUE_AS_NEW_INITIATOR_UNSPECIFIED,
+				FirstWorkflowTaskBackoff: timestamp.DurationPtr(startDelay),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, allTasks, 1)
+	backoffTask, ok := allTasks[0].(*tasks.WorkflowBackoffTimerTask)
+	require.True(t, ok)
+	assert.Equal(t, enumsspb.WORKFLOW_BACKOFF_TYPE_DELAY_START, backoffTask.WorkflowBackoffType)
+	assert.Equal(t, startTime.Add(startDelay), backoffTask.VisibilityTimestamp)
+	assert.Equal(t, tests.Version, backoffTask.Version)
+}
@@ -26,6 +26,7 @@ package workflow
 
 import (
 	"context"
+	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -48,6 +49,9 @@ type (
 		taskQueue      string
 		namespaceState string
 		status         enumspb.WorkflowExecutionStatus
+		workflowType   string
+		startTime      *time.Time
+		closeTime      *time.Time
 	}
 	TransactionImpl struct {
 		shard  shard.Context
@@ -704,6 +708,9 @@ func snapshotToCompletionMetric(
 		taskQueue:      workflowSnapshot.ExecutionInfo.TaskQueue,
 		namespaceState: namespaceState,
 		status:         workflowSnapshot.ExecutionState.Status,
+		workflowType:   workflowSnapshot.ExecutionInfo.WorkflowTypeName,
+		startTime:      workflowSnapshot.ExecutionInfo.StartTime,
+		closeTime:      workflowSnapshot.ExecutionInfo.CloseTime,
 	}
 }
 
@@ -719,6 +726,9 @@ func mutationToCompletionMetric(
 		taskQueue:      workflowMutation.ExecutionInfo.TaskQueue,
 		namespaceState: namespaceState,
 		status:         workflowMutation.ExecutionState.Status,
+		workflowType:   workflowMutation.ExecutionInfo.WorkflowTypeName,
+		startTime:      workflowMutation.ExecutionInfo.StartTime,
+		closeTime:      workflowMutation.ExecutionInfo.CloseTime,
 	}
 }
 
@@ -741,5 +751,11 @@ func emitCompletionMetrics(
 			completionMetric.taskQueue,
 			completionMetric.status,
 		)
+		emitWorkflowEndToEndLatency(
+			metricsHandler,
+			shard.GetSLOMetrics(),
+			namespaceName,
+			completionMetric,
+		)
 	}
 }
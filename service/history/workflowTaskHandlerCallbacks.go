@@ -250,6 +250,15 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskStarted(
 				workflowScheduleToStartLatency,
 				metrics.TaskQueueTypeTag(enumspb.TASK_QUEUE_TYPE_WORKFLOW),
 			)
+			executionInfo := mutableState.GetExecutionInfo()
+			handler.shard.GetSLOMetrics().RecordScheduleToStartLatency(
+				metricsScope,
+				namespaceName.String(),
+				executionInfo.WorkflowTypeName,
+				executionInfo.TaskQueue,
+				enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+				workflowScheduleToStartLatency,
+			)
 
 			resp, err = handler.createRecordWorkflowTaskStartedResponse(mutableState, workflowTask, req.PollRequest.GetIdentity())
 			if err != nil {
@@ -273,7 +282,7 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskFailed(
 	req *historyservice.RespondWorkflowTaskFailedRequest,
 ) (retError error) {
 
-	_, err := api.GetActiveNamespace(handler.shard, namespace.ID(req.GetNamespaceId()))
+	namespaceEntry, err := api.GetActiveNamespace(handler.shard, namespace.ID(req.GetNamespaceId()))
 	if err != nil {
 		return err
 	}
@@ -284,7 +293,8 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskFailed(
 		return consts.ErrDeserializingToken
 	}
 
-	return api.GetAndUpdateWorkflowWithNew(
+	var workflowTypeName, taskQueue string
+	err = api.GetAndUpdateWorkflowWithNew(
 		ctx,
 		token.Clock,
 		api.BypassMutableStateConsistencyPredicate,
@@ -310,6 +320,8 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskFailed(
 			if err != nil {
 				return nil, err
 			}
+			workflowTypeName = mutableState.GetExecutionInfo().WorkflowTypeName
+			taskQueue = mutableState.GetExecutionInfo().TaskQueue
 			return &api.UpdateWorkflowAction{
 				Noop:               false,
 				CreateWorkflowTask: true,
@@ -319,6 +331,18 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskFailed(
 		handler.shard,
 		handler.workflowConsistencyChecker,
 	)
+	if err != nil {
+		return err
+	}
+
+	handler.shard.GetSLOMetrics().RecordWorkflowTaskFailed(
+		handler.metricsHandler.WithTags(metrics.OperationTag(metrics.HistoryRespondWorkflowTaskFailedScope)),
+		namespaceEntry.Name().String(),
+		workflowTypeName,
+		taskQueue,
+		request.GetCause().String(),
+	)
+	return nil
 }
 
 func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskCompleted(
@@ -449,15 +473,20 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskCompleted(
 		namespace := namespaceEntry.Name()
 		workflowSizeChecker := newWorkflowSizeChecker(
 			workflowSizeLimits{
-				blobSizeLimitWarn:              handler.config.BlobSizeLimitWarn(namespace.String()),
-				blobSizeLimitError:             handler.config.BlobSizeLimitError(namespace.String()),
-				memoSizeLimitWarn:              handler.config.MemoSizeLimitWarn(namespace.String()),
-				memoSizeLimitError:             handler.config.MemoSizeLimitError(namespace.String()),
-				numPendingChildExecutionsLimit: handler.config.NumPendingChildExecutionsLimit(namespace.String()),
-				numPendingActivitiesLimit:      handler.config.NumPendingActivitiesLimit(namespace.String()),
-				numPendingSignalsLimit:         handler.config.NumPendingSignalsLimit(namespace.String()),
-				numPendingCancelsRequestLimit:  handler.config.NumPendingCancelsRequestLimit(namespace.String()),
+				blobSizeLimitWarn:                  handler.config.BlobSizeLimitWarn(namespace.String()),
+				blobSizeLimitError:                 handler.config.BlobSizeLimitError(namespace.String()),
+				memoSizeLimitWarn:                  handler.config.MemoSizeLimitWarn(namespace.String()),
+				memoSizeLimitError:                 handler.config.MemoSizeLimitError(namespace.String()),
+				numPendingChildExecutionsLimit:     handler.config.NumPendingChildExecutionsLimit(namespace.String()),
+				numPendingActivitiesLimit:          handler.config.NumPendingActivitiesLimit(namespace.String()),
+				numPendingSignalsLimit:             handler.config.NumPendingSignalsLimit(namespace.String()),
+				numPendingCancelsRequestLimit:      handler.config.NumPendingCancelsRequestLimit(namespace.String()),
+				numPendingChildExecutionsLimitWarn: handler.config.NumPendingChildExecutionsLimitWarn(namespace.String()),
+				numPendingActivitiesLimitWarn:      handler.config.NumPendingActivitiesLimitWarn(namespace.String()),
+				numPendingSignalsLimitWarn:         handler.config.NumPendingSignalsLimitWarn(namespace.String()),
+				numPendingCancelsRequestLimitWarn:  handler.config.NumPendingCancelsRequestLimitWarn(namespace.String()),
 			},
+			handler.shard,
 			ms,
 			handler.searchAttributesValidator,
 			executionStats,
@@ -497,6 +526,14 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskCompleted(
 			return nil, err
 		}
 
+		if workflowTaskHandler.workflowTaskFailedCause == nil {
+			limitWarnings := append(
+				workflowSizeChecker.limitWarnings,
+				workflow.HistoryLimitWarnings(handler.shard, ms, executionStats.HistorySize)...,
+			)
+			workflow.AddLimitWarningSearchAttribute(handler.shard, ms, limitWarnings)
+		}
+
 		// set the vars used by following logic
 		// further refactor should also clean up the vars used below
 		wtFailedCause = workflowTaskHandler.workflowTaskFailedCause
@@ -654,6 +691,33 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskCompleted(
 
 	handler.handleBufferedQueries(ms, req.GetCompleteRequest().GetQueryResults(), createNewWorkflowTask, namespaceEntry, workflowTaskHeartbeating)
 
+	sloMetricsScope := handler.metricsHandler.WithTags(metrics.OperationTag(metrics.HistoryRespondWorkflowTaskCompletedScope))
+	switch {
+	case workflowTaskHeartbeatTimeout:
+		handler.shard.GetSLOMetrics().RecordWorkflowTaskFailed(
+			sloMetricsScope,
+			namespaceEntry.Name().String(),
+			executionInfo.WorkflowTypeName,
+			executionInfo.TaskQueue,
+			enumspb.TIMEOUT_TYPE_HEARTBEAT.String(),
+		)
+	case wtFailedCause != nil:
+		handler.shard.GetSLOMetrics().RecordWorkflowTaskFailed(
+			sloMetricsScope,
+			namespaceEntry.Name().String(),
+			executionInfo.WorkflowTypeName,
+			executionInfo.TaskQueue,
+			wtFailedCause.failedCause.String(),
+		)
+	default:
+		handler.shard.GetSLOMetrics().RecordWorkflowTaskCompleted(
+			sloMetricsScope,
+			namespaceEntry.Name().String(),
+			executionInfo.WorkflowTypeName,
+			executionInfo.TaskQueue,
+		)
+	}
+
 	if workflowTaskHeartbeatTimeout {
 		// at this point, update is successful, but we still return an error to client so that the worker will give up this workflow
 		return nil, serviceerror.NewNotFound("workflow task heartbeat timeout")
@@ -27,6 +27,7 @@ package matching
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/fx"
 
 	"go.temporal.io/server/api/historyservice/v1"
@@ -121,6 +122,7 @@ func HandlerProvider(
 	metricsHandler metrics.Handler,
 	namespaceRegistry namespace.Registry,
 	clusterMetadata cluster.Metadata,
+	tracerProvider trace.TracerProvider,
 ) *Handler {
 	return NewHandler(
 		config,
@@ -133,6 +135,7 @@ func HandlerProvider(
 		metricsHandler,
 		namespaceRegistry,
 		clusterMetadata,
+		tracerProvider,
 	)
 }
 
@@ -29,6 +29,7 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 
@@ -76,6 +77,7 @@ func NewHandler(
 	metricsHandler metrics.Handler,
 	namespaceRegistry namespace.Registry,
 	clusterMetadata cluster.Metadata,
+	tracerProvider trace.TracerProvider,
 ) *Handler {
 	handler := &Handler{
 		config:          config,
@@ -92,6 +94,7 @@ func NewHandler(
 			namespaceRegistry,
 			matchingServiceResolver,
 			clusterMetadata,
+			tracerProvider,
 		),
 		namespaceRegistry: namespaceRegistry,
 	}
@@ -35,6 +35,7 @@ import (
 	"time"
 
 	"github.com/pborman/uuid"
+	"go.opentelemetry.io/otel/trace"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
@@ -103,6 +104,7 @@ type (
 		namespaceRegistry    namespace.Registry
 		keyResolver          membership.ServiceResolver
 		clusterMeta          cluster.Metadata
+		tracer               trace.Tracer
 	}
 )
 
@@ -133,6 +135,7 @@ func NewEngine(
 	namespaceRegistry namespace.Registry,
 	resolver membership.ServiceResolver,
 	clusterMeta cluster.Metadata,
+	tracerProvider trace.TracerProvider,
 ) Engine {
 
 	return &matchingEngineImpl{
@@ -150,6 +153,7 @@ func NewEngine(
 		namespaceRegistry:    namespaceRegistry,
 		keyResolver:          resolver,
 		clusterMeta:          clusterMeta,
+		tracer:               tracerProvider.Tracer(tracerName),
 	}
 }
 
@@ -33,6 +33,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	uberatomic "go.uber.org/atomic"
 
 	commonpb "go.temporal.io/api/common/v1"
@@ -56,6 +57,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/telemetry"
 	"go.temporal.io/server/common/util"
 )
 
@@ -160,6 +162,7 @@ type (
 		outstandingPollsMap  map[string]context.CancelFunc
 		signalFatalProblem   func(taskQueueManager)
 		clusterMeta          cluster.Metadata
+		tracer               trace.Tracer
 		initializedError     *future.FutureImpl[struct{}]
 		// metadataInitialFetch is fulfilled once versioning data is fetched from the root partition. If this TQ is
 		// the root partition, it is fulfilled as soon as it is fetched from db.
@@ -229,6 +232,7 @@ func newTaskQueueManager(
 		outstandingPollsMap:  make(map[string]context.CancelFunc),
 		signalFatalProblem:   e.unloadTaskQueue,
 		clusterMeta:          clusterMeta,
+		tracer:               e.tracer,
 		namespace:            nsName,
 		taggedMetricsHandler: taggedMetricsHandler,
 		initializedError:     future.NewFuture[struct{}](),
@@ -339,7 +343,20 @@ func (c *taskQueueManagerImpl) WaitUntilInitialized(ctx context.Context) error {
 func (c *taskQueueManagerImpl) AddTask(
 	ctx context.Context,
 	params addTaskParams,
-) (bool, error) {
+) (syncMatch bool, retErr error) {
+	ctx, span := c.tracer.Start(ctx, "matching.AddTask")
+	defer func() {
+		span.SetAttributes(syncMatchKey.Bool(syncMatch))
+		telemetry.EndSpan(span, retErr)
+	}()
+	if span.IsRecording() {
+		span.SetAttributes(taskAttributes(c.taskQueueID, params.taskInfo)...)
+		span.SetAttributes(
+			taskSourceKey.String(params.source.String()),
+			forwardedFromKey.String(params.forwardedFrom),
+		)
+	}
+
 	if params.forwardedFrom == "" {
 		// request sent by history service
 		c.liveness.markAlive(time.Now())
@@ -450,7 +467,17 @@ func (c *taskQueueManagerImpl) GetTask(
 func (c *taskQueueManagerImpl) DispatchTask(
 	ctx context.Context,
 	task *internalTask,
-) error {
+) (retErr error) {
+	ctx, span := c.tracer.Start(ctx, "matching.DispatchBacklogTask")
+	defer func() { telemetry.EndSpan(span, retErr) }()
+	if span.IsRecording() && task.event != nil {
+		span.SetAttributes(taskAttributes(c.taskQueueID, task.event.Data)...)
+		span.SetAttributes(
+			taskSourceKey.String(task.source.String()),
+			taskIDKey.Int64(task.event.GetTaskId()),
+		)
+	}
+
 	return c.matcher.MustOffer(ctx, task)
 }
 
@@ -662,7 +689,13 @@ func executeWithRetry(
 	return err
 }
 
-func (c *taskQueueManagerImpl) trySyncMatch(ctx context.Context, params addTaskParams) (bool, error) {
+func (c *taskQueueManagerImpl) trySyncMatch(ctx context.Context, params addTaskParams) (matched bool, retErr error) {
+	ctx, span := c.tracer.Start(ctx, "matching.TrySyncMatch")
+	defer func() {
+		span.SetAttributes(syncMatchKey.Bool(matched))
+		telemetry.EndSpan(span, retErr)
+	}()
+
 	childCtx, cancel := c.newChildContext(ctx, c.config.SyncMatchWaitDuration(), time.Second)
 
 	// Mocking out TaskId for syncmatch as it hasn't been allocated yet
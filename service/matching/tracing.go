@@ -0,0 +1,62 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/telemetry"
+)
+
+const (
+	tracerName = "go.temporal.io/server/service/matching"
+
+	taskQueueNameKey = attribute.Key("io.temporal.task_queue.name")
+	taskQueueTypeKey = attribute.Key("io.temporal.task_queue.type")
+	taskIDKey        = attribute.Key("io.temporal.task.id")
+	// syncMatchKey records whether a task was matched with a poller without being persisted
+	syncMatchKey = attribute.Key("io.temporal.task.sync_match")
+	// taskSourceKey records where a task came from: history, or the db backlog
+	taskSourceKey = attribute.Key("io.temporal.task.source")
+	// forwardedFromKey records the child partition a task was forwarded from
+	forwardedFromKey = attribute.Key("io.temporal.task.forwarded_from")
+)
+
+// taskAttributes returns the span attributes identifying a task of the task queue
+func taskAttributes(
+	taskQueue *taskQueueID,
+	taskInfo *persistencespb.TaskInfo,
+) []attribute.KeyValue {
+	attrs := telemetry.WorkflowAttributes(
+		taskQueue.namespaceID.String(),
+		taskInfo.GetWorkflowId(),
+		taskInfo.GetRunId(),
+	)
+	return append(attrs,
+		taskQueueNameKey.String(taskQueue.FullName()),
+		taskQueueTypeKey.String(taskQueue.taskType.String()),
+	)
+}
@@ -0,0 +1,260 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package changesearchattributetype
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/olivere/elastic/v7"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+	"golang.org/x/exp/slices"
+
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	// backfillScript copies the value of the old field as is. Elasticsearch converts it to the type of the
+	// new field when the document is indexed, e.g. a single Keyword value becomes a KeywordList of one item.
+	backfillScript = "ctx._source[params.newField] = ctx._source[params.oldField]"
+)
+
+type (
+	activities struct {
+		esClient          esclient.Client
+		indexName         string
+		saProvider        searchattribute.Provider
+		saMapperProvider  searchattribute.MapperProvider
+		metadataManager   persistence.MetadataManager
+		namespaceRegistry namespace.Registry
+		metricsHandler    metrics.Handler
+		logger            log.Logger
+	}
+)
+
+// GetFieldsActivity validates the change and resolves the old and the new field of the search attribute.
+func (a *activities) GetFieldsActivity(ctx context.Context, params WorkflowParams) (Fields, error) {
+	if a.esClient == nil {
+		return Fields{}, temporal.NewNonRetryableApplicationError("type of search attribute can be changed only with Elasticsearch visibility", "", nil)
+	}
+
+	ns, err := a.namespaceRegistry.GetNamespace(namespace.Name(params.Namespace))
+	if err != nil {
+		return Fields{}, err
+	}
+
+	saTypeMap, err := a.saProvider.GetSearchAttributes(a.indexName, true)
+	if err != nil {
+		return Fields{}, err
+	}
+	saMapper, err := a.saMapperProvider.GetMapper(ns.Name())
+	if err != nil {
+		return Fields{}, err
+	}
+	currentFieldName, err := saMapper.GetFieldName(params.SearchAttribute, params.Namespace)
+	if err != nil {
+		return Fields{}, temporal.NewNonRetryableApplicationError(err.Error(), "", nil)
+	}
+	currentType, err := saTypeMap.GetType(currentFieldName)
+	if err != nil {
+		return Fields{}, temporal.NewNonRetryableApplicationError(fmt.Sprintf("search attribute %s doesn't exist", params.SearchAttribute), "", nil)
+	}
+	if _, isCustom := saTypeMap.Custom()[currentFieldName]; !isCustom {
+		return Fields{}, temporal.NewNonRetryableApplicationError(fmt.Sprintf("search attribute %s is not a custom search attribute", params.SearchAttribute), "", nil)
+	}
+
+	fields := Fields{
+		IndexName:    a.indexName,
+		NamespaceID:  ns.ID().String(),
+		OldFieldName: currentFieldName,
+		OldType:      currentType,
+		NewFieldName: params.NewFieldName,
+	}
+
+	if currentFieldName == params.NewFieldName {
+		// The alias was swapped by a previous run, which is resumed now. The old field is the one
+		// hidden behind the alias.
+		oldType, isCustom := saTypeMap.Custom()[params.SearchAttribute]
+		if !isCustom {
+			return Fields{}, temporal.NewNonRetryableApplicationError(fmt.Sprintf("search attribute %s is already of type %s", params.SearchAttribute, currentType), "", nil)
+		}
+		fields.OldFieldName = params.SearchAttribute
+		fields.OldType = oldType
+		fields.NewFieldExists = true
+		fields.AliasSwapped = true
+		return fields, nil
+	}
+
+	if currentType == params.NewType {
+		return Fields{}, temporal.NewNonRetryableApplicationError(fmt.Sprintf("search attribute %s is already of type %s", params.SearchAttribute, currentType), "", nil)
+	}
+	if searchattribute.IsReserved(params.NewFieldName) {
+		return Fields{}, temporal.NewNonRetryableApplicationError(fmt.Sprintf("field name %s is reserved by system", params.NewFieldName), "", nil)
+	}
+	if newFieldType, err := saTypeMap.GetType(params.NewFieldName); err == nil {
+		if newFieldType != params.NewType {
+			return Fields{}, temporal.NewNonRetryableApplicationError(fmt.Sprintf("field %s already exists with type %s", params.NewFieldName, newFieldType), "", nil)
+		}
+		fields.NewFieldExists = true
+	}
+	return fields, nil
+}
+
+// BackfillActivity copies values of the old field to the new one in at most BatchSize documents of
+// the namespace which don't have the new field yet. It returns the number of updated documents.
+func (a *activities) BackfillActivity(ctx context.Context, request backfillRequest) (int64, error) {
+	fields := request.Fields
+	query := elastic.NewBoolQuery().
+		Filter(
+			elastic.NewTermQuery(searchattribute.NamespaceID, fields.NamespaceID),
+			elastic.NewExistsQuery(fields.OldFieldName),
+		).
+		MustNot(elastic.NewExistsQuery(fields.NewFieldName))
+	script := elastic.NewScript(backfillScript).
+		Lang("painless").
+		Params(map[string]interface{}{
+			"oldField": fields.OldFieldName,
+			"newField": fields.NewFieldName,
+		})
+
+	// Update by query can't update documents of version 0, which is why migrated visibility records
+	// (see visibilitymigration.migrationTaskID) are written with version 1.
+	resp, err := a.esClient.UpdateByQuery(ctx, fields.IndexName, query, script, request.BatchSize)
+	if err != nil {
+		a.metricsHandler.Counter(metrics.ChangeSearchAttributeTypeFailuresCount.GetMetricName()).Record(1)
+		if a.isRetryableError(err) {
+			a.logger.Error("Unable to backfill search attribute (retryable error).", tag.ESIndex(fields.IndexName), tag.ESField(fields.NewFieldName), tag.Error(err))
+			return 0, err
+		}
+		a.logger.Error("Unable to backfill search attribute (non-retryable error).", tag.ESIndex(fields.IndexName), tag.ESField(fields.NewFieldName), tag.Error(err))
+		return 0, temporal.NewNonRetryableApplicationError(err.Error(), "", nil)
+	}
+	if len(resp.Failures) > 0 {
+		a.metricsHandler.Counter(metrics.ChangeSearchAttributeTypeFailuresCount.GetMetricName()).Record(1)
+		// Failures are caused by values which can't be converted to the new type, retry won't help.
+		return 0, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("unable to copy %s to %s in %d documents, e.g. %s", fields.OldFieldName, fields.NewFieldName, len(resp.Failures), resp.Failures[0].Id), "", nil)
+	}
+
+	a.metricsHandler.Counter(metrics.ChangeSearchAttributeTypeBackfilledCount.GetMetricName()).Record(resp.Updated)
+	return resp.Updated, nil
+}
+
+// SwapAliasActivity makes the search attribute an alias of the new field in the namespace config.
+// A previous alias of the search attribute is removed.
+func (a *activities) SwapAliasActivity(ctx context.Context, fields Fields, searchAttribute string) error {
+	ctx = headers.SetCallerInfo(ctx, headers.SystemBackgroundCallerInfo)
+
+	metadata, err := a.metadataManager.GetMetadata(ctx)
+	if err != nil {
+		a.metricsHandler.Counter(metrics.ReadNamespaceFailuresCount.GetMetricName()).Record(1)
+		return err
+	}
+	ns, err := a.metadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{
+		ID: fields.NamespaceID,
+	})
+	if err != nil {
+		a.metricsHandler.Counter(metrics.ReadNamespaceFailuresCount.GetMetricName()).Record(1)
+		return err
+	}
+
+	config := ns.Namespace.Config
+	info := ns.Namespace.Info
+	changedSearchAttributes := namespace.ParseChangedSearchAttributes(info.GetData()[namespace.ChangedSearchAttributesDataKey])
+	if config.CustomSearchAttributeAliases[fields.NewFieldName] == searchAttribute &&
+		slices.Contains(changedSearchAttributes, searchAttribute) {
+		// already swapped by a previous attempt
+		return nil
+	}
+	aliases := make(map[string]string, len(config.CustomSearchAttributeAliases)+1)
+	for fieldName, alias := range config.CustomSearchAttributeAliases {
+		if alias != searchAttribute {
+			aliases[fieldName] = alias
+		}
+	}
+	aliases[fields.NewFieldName] = searchAttribute
+	config.CustomSearchAttributeAliases = aliases
+	// Elasticsearch namespaces resolve only the search attributes listed in the namespace data through aliases.
+	if !slices.Contains(changedSearchAttributes, searchAttribute) {
+		if info.Data == nil {
+			info.Data = make(map[string]string)
+		}
+		info.Data[namespace.ChangedSearchAttributesDataKey] = strings.Join(append(changedSearchAttributes, searchAttribute), ",")
+	}
+	ns.Namespace.ConfigVersion++
+
+	err = a.metadataManager.UpdateNamespace(ctx, &persistence.UpdateNamespaceRequest{
+		Namespace:           ns.Namespace,
+		IsGlobalNamespace:   ns.IsGlobalNamespace,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+	if err != nil {
+		a.metricsHandler.Counter(metrics.UpdateNamespaceFailuresCount.GetMetricName()).Record(1)
+		a.logger.Error("Unable to update search attribute aliases.", tag.WorkflowNamespaceID(fields.NamespaceID), tag.Error(err))
+		return err
+	}
+	a.logger.Info("Search attribute alias swapped.", tag.WorkflowNamespaceID(fields.NamespaceID), tag.ESField(fields.NewFieldName))
+	return nil
+}
+
+// CountRunningActivity returns the number of running executions of the namespace which have the old field
+// but not the new one, i.e. which are left to backfill. Their documents lose the new field when they are
+// updated from mutable state. The query matches the one of BackfillActivity, so the count drops to 0 once
+// every running execution is backfilled.
+func (a *activities) CountRunningActivity(ctx context.Context, fields Fields) (int64, error) {
+	query := elastic.NewBoolQuery().
+		Filter(
+			elastic.NewTermQuery(searchattribute.NamespaceID, fields.NamespaceID),
+			elastic.NewTermQuery(searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String()),
+			elastic.NewExistsQuery(fields.OldFieldName),
+		).
+		MustNot(elastic.NewExistsQuery(fields.NewFieldName))
+	return a.esClient.Count(ctx, fields.IndexName, query)
+}
+
+func (a *activities) isRetryableError(err error) bool {
+	var esErr *elastic.Error
+	if !errors.As(err, &esErr) {
+		return true
+	}
+
+	switch esErr.Status {
+	case http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound:
+		return false
+	default:
+		return true
+	}
+}
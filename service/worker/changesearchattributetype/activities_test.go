@@ -0,0 +1,257 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package changesearchattributetype
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+func Test_GetFieldsActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	nsRegistry := namespace.NewMockRegistry(ctrl)
+	nsRegistry.EXPECT().GetNamespace(namespace.Name("namespace")).Return(namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: "namespace-id", Name: "namespace"}, nil, ""), nil).AnyTimes()
+
+	a := &activities{
+		esClient:          esclient.NewMockClient(ctrl),
+		indexName:         "index",
+		saProvider:        searchattribute.NewTestProvider(),
+		saMapperProvider:  searchattribute.NewTestMapperProvider(nil),
+		namespaceRegistry: nsRegistry,
+		metricsHandler:    metrics.NoopMetricsHandler,
+		logger:            log.NewNoopLogger(),
+	}
+
+	params := WorkflowParams{
+		Namespace:       "namespace",
+		SearchAttribute: "CustomKeywordField",
+		NewType:         enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+		NewFieldName:    "CustomKeywordFieldKeywordList",
+	}
+	fields, err := a.GetFieldsActivity(context.Background(), params)
+	require.NoError(t, err)
+	require.Equal(t, Fields{
+		IndexName:    "index",
+		NamespaceID:  "namespace-id",
+		OldFieldName: "CustomKeywordField",
+		OldType:      enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		NewFieldName: "CustomKeywordFieldKeywordList",
+	}, fields)
+
+	// New field already exists.
+	params.NewType = enumspb.INDEXED_VALUE_TYPE_TEXT
+	params.NewFieldName = "CustomTextField"
+	fields, err = a.GetFieldsActivity(context.Background(), params)
+	require.NoError(t, err)
+	require.True(t, fields.NewFieldExists)
+	require.False(t, fields.AliasSwapped)
+
+	var appErr *temporal.ApplicationError
+
+	// New field already exists with different type.
+	params.NewFieldName = "CustomIntField"
+	_, err = a.GetFieldsActivity(context.Background(), params)
+	require.ErrorAs(t, err, &appErr)
+	require.True(t, appErr.NonRetryable())
+
+	// Type is not changed.
+	params.NewType = enumspb.INDEXED_VALUE_TYPE_KEYWORD
+	params.NewFieldName = "CustomKeywordFieldKeyword"
+	_, err = a.GetFieldsActivity(context.Background(), params)
+	require.ErrorAs(t, err, &appErr)
+	require.True(t, appErr.NonRetryable())
+
+	// System search attribute.
+	params.SearchAttribute = "WorkflowType"
+	params.NewType = enumspb.INDEXED_VALUE_TYPE_TEXT
+	_, err = a.GetFieldsActivity(context.Background(), params)
+	require.ErrorAs(t, err, &appErr)
+	require.True(t, appErr.NonRetryable())
+
+	// Elasticsearch is not configured.
+	a.esClient = nil
+	_, err = a.GetFieldsActivity(context.Background(), params)
+	require.ErrorAs(t, err, &appErr)
+	require.True(t, appErr.NonRetryable())
+}
+
+func Test_BackfillActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	esClient := esclient.NewMockClient(ctrl)
+
+	a := &activities{
+		esClient:       esClient,
+		metricsHandler: metrics.NoopMetricsHandler,
+		logger:         log.NewNoopLogger(),
+	}
+	request := backfillRequest{
+		Fields: Fields{
+			IndexName:    "index",
+			NamespaceID:  "namespace-id",
+			OldFieldName: "CustomKeywordField",
+			NewFieldName: "CustomKeywordFieldKeywordList",
+		},
+		BatchSize: 100,
+	}
+
+	esClient.EXPECT().UpdateByQuery(gomock.Any(), "index", gomock.Any(), gomock.Any(), 100).DoAndReturn(
+		func(_ context.Context, _ string, query elastic.Query, script *elastic.Script, _ int) (*elastic.BulkIndexByScrollResponse, error) {
+			querySource, err := query.Source()
+			require.NoError(t, err)
+			queryJSON, err := json.Marshal(querySource)
+			require.NoError(t, err)
+			require.JSONEq(t, `{"bool":{
+				"filter":[{"term":{"NamespaceId":"namespace-id"}},{"exists":{"field":"CustomKeywordField"}}],
+				"must_not":{"exists":{"field":"CustomKeywordFieldKeywordList"}}}}`, string(queryJSON))
+
+			scriptSource, err := script.Source()
+			require.NoError(t, err)
+			scriptJSON, err := json.Marshal(scriptSource)
+			require.NoError(t, err)
+			require.JSONEq(t, `{"lang":"painless","source":"ctx._source[params.newField] = ctx._source[params.oldField]",
+				"params":{"oldField":"CustomKeywordField","newField":"CustomKeywordFieldKeywordList"}}`, string(scriptJSON))
+			return &elastic.BulkIndexByScrollResponse{Updated: 42}, nil
+		})
+	updatedCount, err := a.BackfillActivity(context.Background(), request)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), updatedCount)
+
+	var appErr *temporal.ApplicationError
+
+	// Documents which can't be updated fail the activity.
+	var failedResponse elastic.BulkIndexByScrollResponse
+	require.NoError(t, json.Unmarshal([]byte(`{"updated":1,"failures":[{"id":"doc-id","status":400}]}`), &failedResponse))
+	esClient.EXPECT().UpdateByQuery(gomock.Any(), "index", gomock.Any(), gomock.Any(), 100).Return(&failedResponse, nil)
+	_, err = a.BackfillActivity(context.Background(), request)
+	require.ErrorAs(t, err, &appErr)
+	require.True(t, appErr.NonRetryable())
+	require.Contains(t, err.Error(), "doc-id")
+
+	esClient.EXPECT().UpdateByQuery(gomock.Any(), "index", gomock.Any(), gomock.Any(), 100).Return(
+		nil, &elastic.Error{Status: 400})
+	_, err = a.BackfillActivity(context.Background(), request)
+	require.ErrorAs(t, err, &appErr)
+	require.True(t, appErr.NonRetryable())
+
+	esClient.EXPECT().UpdateByQuery(gomock.Any(), "index", gomock.Any(), gomock.Any(), 100).Return(
+		nil, &elastic.Error{Status: 503})
+	_, err = a.BackfillActivity(context.Background(), request)
+	require.Error(t, err)
+	require.False(t, errors.As(err, &appErr))
+}
+
+func Test_CountRunningActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	esClient := esclient.NewMockClient(ctrl)
+
+	a := &activities{
+		esClient:       esClient,
+		metricsHandler: metrics.NoopMetricsHandler,
+		logger:         log.NewNoopLogger(),
+	}
+
+	esClient.EXPECT().Count(gomock.Any(), "index", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, query elastic.Query) (int64, error) {
+			querySource, err := query.Source()
+			require.NoError(t, err)
+			queryJSON, err := json.Marshal(querySource)
+			require.NoError(t, err)
+			// backfilled documents are not counted, otherwise the count never drops to 0
+			require.JSONEq(t, `{"bool":{
+				"filter":[{"term":{"NamespaceId":"namespace-id"}},{"term":{"ExecutionStatus":"Running"}},{"exists":{"field":"CustomKeywordField"}}],
+				"must_not":{"exists":{"field":"CustomKeywordFieldKeywordList"}}}}`, string(queryJSON))
+			return 3, nil
+		})
+	count, err := a.CountRunningActivity(context.Background(), Fields{
+		IndexName:    "index",
+		NamespaceID:  "namespace-id",
+		OldFieldName: "CustomKeywordField",
+		NewFieldName: "CustomKeywordFieldKeywordList",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+}
+
+func Test_SwapAliasActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metadataManager := persistence.NewMockMetadataManager(ctrl)
+
+	a := &activities{
+		metadataManager: metadataManager,
+		metricsHandler:  metrics.NoopMetricsHandler,
+		logger:          log.NewNoopLogger(),
+	}
+
+	metadataManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil)
+	metadataManager.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		ID: "namespace-id",
+	}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{Id: "namespace-id", Name: "namespace"},
+			Config: &persistencespb.NamespaceConfig{
+				CustomSearchAttributeAliases: map[string]string{
+					"CustomIntField":        "Amount",
+					"CustomerIdKeywordList": "CustomerId",
+				},
+			},
+			ConfigVersion: 3,
+		},
+	}, nil)
+	metadataManager.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			require.Equal(t, int64(7), request.NotificationVersion)
+			require.Equal(t, map[string]string{
+				"CustomIntField": "Amount",
+				"CustomerIdText": "CustomerId",
+			}, request.Namespace.Config.CustomSearchAttributeAliases)
+			require.Equal(t, "CustomerId", request.Namespace.Info.Data[namespace.ChangedSearchAttributesDataKey])
+			require.Equal(t, int64(4), request.Namespace.ConfigVersion)
+			return nil
+		})
+
+	err := a.SwapAliasActivity(context.Background(), Fields{
+		NamespaceID:  "namespace-id",
+		OldFieldName: "CustomerIdKeywordList",
+		NewFieldName: "CustomerIdText",
+	}, "CustomerId")
+	require.NoError(t, err)
+}
@@ -0,0 +1,270 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package changesearchattributetype
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/service/worker/addsearchattributes"
+)
+
+const (
+	// WorkflowName is the workflow name.
+	WorkflowName = "temporal-sys-change-search-attribute-type-workflow"
+
+	defaultBackfillBatchSize = 1000
+	defaultCatchUpInterval   = time.Hour
+
+	// backfillBatchesPerExecution and catchUpIterationsPerExecution limit history size before continue as new.
+	backfillBatchesPerExecution   = 1000
+	catchUpIterationsPerExecution = 100
+)
+
+type (
+	// WorkflowParams are the input of ChangeSearchAttributeTypeWorkflow.
+	WorkflowParams struct {
+		Namespace string
+		// SearchAttribute is the name of the search attribute as it is used in queries. It stays the same.
+		SearchAttribute string
+		NewType         enumspb.IndexedValueType
+		// NewFieldName is the name of the new Elasticsearch field. Default is SearchAttribute followed by
+		// NewType, e.g. CustomerIdKeywordList.
+		NewFieldName string
+		// BackfillBatchSize is max number of documents updated by one backfill activity.
+		BackfillBatchSize int
+		// CatchUpInterval is the interval between backfills after the alias is swapped.
+		CatchUpInterval time.Duration
+
+		// Fields is set on the first run and is carried over by continue as new.
+		Fields   *Fields
+		Progress Progress
+	}
+
+	// Fields describes the Elasticsearch fields of the search attribute before and after the change.
+	Fields struct {
+		IndexName    string
+		NamespaceID  string
+		OldFieldName string
+		OldType      enumspb.IndexedValueType
+		NewFieldName string
+
+		// NewFieldExists is true if the new field is already added to the index.
+		NewFieldExists bool
+		// AliasSwapped is true if the search attribute is already an alias of the new field.
+		AliasSwapped bool
+	}
+
+	// Progress is the result of ChangeSearchAttributeTypeWorkflow.
+	Progress struct {
+		BackfilledCount int64 // documents updated with the value of the new field
+		CatchUpCount    int   // backfills after the alias swap
+	}
+
+	backfillRequest struct {
+		Fields    Fields
+		BatchSize int
+	}
+)
+
+var (
+	localActivityOptions = workflow.LocalActivityOptions{
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: 1 * time.Second,
+			MaximumInterval: 10 * time.Second,
+		},
+		StartToCloseTimeout:    30 * time.Second,
+		ScheduleToCloseTimeout: 5 * time.Minute,
+	}
+
+	backfillActivityOptions = workflow.ActivityOptions{
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: 1 * time.Second,
+			MaximumInterval: 1 * time.Minute,
+		},
+		StartToCloseTimeout:    5 * time.Minute,
+		ScheduleToCloseTimeout: 1 * time.Hour,
+	}
+
+	countActivityOptions = workflow.ActivityOptions{
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: 1 * time.Second,
+			MaximumInterval: 10 * time.Second,
+		},
+		StartToCloseTimeout:    30 * time.Second,
+		ScheduleToCloseTimeout: 5 * time.Minute,
+	}
+
+	ErrUnableToExecuteActivity      = errors.New("unable to execute activity")
+	ErrUnableToExecuteChildWorkflow = errors.New("unable to execute child workflow")
+)
+
+func validateParams(params *WorkflowParams) error {
+	if params.Namespace == "" || params.SearchAttribute == "" {
+		return temporal.NewNonRetryableApplicationError("namespace and search attribute are required", "", nil)
+	}
+	if _, ok := enumspb.IndexedValueType_name[int32(params.NewType)]; !ok || params.NewType == enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED {
+		return temporal.NewNonRetryableApplicationError(fmt.Sprintf("invalid search attribute type: %v", params.NewType), "", nil)
+	}
+	if params.NewFieldName == "" {
+		params.NewFieldName = params.SearchAttribute + params.NewType.String()
+	}
+	if params.BackfillBatchSize <= 0 {
+		params.BackfillBatchSize = defaultBackfillBatchSize
+	}
+	if params.CatchUpInterval <= 0 {
+		params.CatchUpInterval = defaultCatchUpInterval
+	}
+	return nil
+}
+
+// ChangeSearchAttributeTypeWorkflow changes the type of a custom search attribute of the namespace.
+// Elasticsearch can't change the type of an existing field, therefore the workflow:
+//  1. adds a new field of the new type to the index,
+//  2. copies values from the old field to the new one in all documents of the namespace,
+//  3. makes the search attribute an alias of the new field in the namespace config, so both queries
+//     and new values use the new field under the same name,
+//  4. copies values again until no running execution is left with the old field.
+//
+// Step 4 is needed because running executions keep the old field in their mutable state and
+// overwrite their documents without the new field until they are closed. The old field stays
+// in the index and can still be used by other namespaces.
+func ChangeSearchAttributeTypeWorkflow(ctx workflow.Context, params WorkflowParams) (Progress, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Workflow started.", tag.WorkflowType(WorkflowName))
+
+	if err := validateParams(&params); err != nil {
+		return params.Progress, err
+	}
+
+	var a *activities
+
+	// Step 0. Resolve fields of the search attribute.
+	if params.Fields == nil {
+		ctx0 := workflow.WithLocalActivityOptions(ctx, localActivityOptions)
+		var fields Fields
+		err := workflow.ExecuteLocalActivity(ctx0, a.GetFieldsActivity, params).Get(ctx, &fields)
+		if err != nil {
+			return params.Progress, fmt.Errorf("%w: GetFieldsActivity: %v", ErrUnableToExecuteActivity, err)
+		}
+		params.Fields = &fields
+	}
+	fields := params.Fields
+
+	// Step 1. Add the new field.
+	if !fields.NewFieldExists {
+		ctx1 := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+			WorkflowID: addsearchattributes.WorkflowName,
+		})
+		err := workflow.ExecuteChildWorkflow(ctx1, addsearchattributes.WorkflowName, addsearchattributes.WorkflowParams{
+			IndexName:             fields.IndexName,
+			CustomAttributesToAdd: map[string]enumspb.IndexedValueType{fields.NewFieldName: params.NewType},
+		}).Get(ctx, nil)
+		if err != nil {
+			return params.Progress, fmt.Errorf("%w: %s: %v", ErrUnableToExecuteChildWorkflow, addsearchattributes.WorkflowName, err)
+		}
+		fields.NewFieldExists = true
+	}
+
+	batchesLeft := backfillBatchesPerExecution
+
+	// Steps 2 and 3. Backfill the new field and swap the alias.
+	if !fields.AliasSwapped {
+		done, err := backfill(ctx, &params, &batchesLeft)
+		if err != nil || !done {
+			return continueAsNewOrFail(ctx, params, err)
+		}
+
+		ctx3 := workflow.WithLocalActivityOptions(ctx, localActivityOptions)
+		err = workflow.ExecuteLocalActivity(ctx3, a.SwapAliasActivity, *fields, params.SearchAttribute).Get(ctx, nil)
+		if err != nil {
+			return params.Progress, fmt.Errorf("%w: SwapAliasActivity: %v", ErrUnableToExecuteActivity, err)
+		}
+		fields.AliasSwapped = true
+	}
+
+	// Step 4. Catch up with running executions.
+	for i := 0; i < catchUpIterationsPerExecution; i++ {
+		// Count before backfill: executions closed in between are backfilled too.
+		ctx4 := workflow.WithActivityOptions(ctx, countActivityOptions)
+		var runningCount int64
+		err := workflow.ExecuteActivity(ctx4, a.CountRunningActivity, *fields).Get(ctx, &runningCount)
+		if err != nil {
+			return params.Progress, fmt.Errorf("%w: CountRunningActivity: %v", ErrUnableToExecuteActivity, err)
+		}
+
+		done, err := backfill(ctx, &params, &batchesLeft)
+		if err != nil || !done {
+			return continueAsNewOrFail(ctx, params, err)
+		}
+		params.Progress.CatchUpCount++
+
+		if runningCount == 0 {
+			logger.Info("Workflow finished successfully.", tag.WorkflowType(WorkflowName))
+			return params.Progress, nil
+		}
+
+		if err := workflow.Sleep(ctx, params.CatchUpInterval); err != nil {
+			return params.Progress, err
+		}
+	}
+
+	return continueAsNewOrFail(ctx, params, nil)
+}
+
+// backfill copies values of the old field to the new one until all documents are updated (done is true)
+// or batchesLeft reaches zero.
+func backfill(ctx workflow.Context, params *WorkflowParams, batchesLeft *int) (bool, error) {
+	var a *activities
+	ctx = workflow.WithActivityOptions(ctx, backfillActivityOptions)
+	for ; *batchesLeft > 0; *batchesLeft-- {
+		var updatedCount int64
+		err := workflow.ExecuteActivity(ctx, a.BackfillActivity, backfillRequest{
+			Fields:    *params.Fields,
+			BatchSize: params.BackfillBatchSize,
+		}).Get(ctx, &updatedCount)
+		if err != nil {
+			return false, fmt.Errorf("%w: BackfillActivity: %v", ErrUnableToExecuteActivity, err)
+		}
+		params.Progress.BackfilledCount += updatedCount
+		if updatedCount == 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func continueAsNewOrFail(ctx workflow.Context, params WorkflowParams, err error) (Progress, error) {
+	if err != nil {
+		return params.Progress, err
+	}
+	return params.Progress, workflow.NewContinueAsNewError(ctx, WorkflowName, params)
+}
@@ -0,0 +1,148 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package changesearchattributetype
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/service/worker/addsearchattributes"
+)
+
+func Test_ChangeSearchAttributeTypeWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(addsearchattributes.AddSearchAttributesWorkflow, workflow.RegisterOptions{Name: addsearchattributes.WorkflowName})
+
+	var a *activities
+
+	params := WorkflowParams{
+		Namespace:       "namespace",
+		SearchAttribute: "CustomKeywordField",
+		NewType:         enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+	}
+	fields := Fields{
+		IndexName:    "index",
+		NamespaceID:  "namespace-id",
+		OldFieldName: "CustomKeywordField",
+		OldType:      enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		NewFieldName: "CustomKeywordFieldKeywordList",
+	}
+	fieldsWithNewField := fields
+	fieldsWithNewField.NewFieldExists = true
+	fieldsSwapped := fieldsWithNewField
+	fieldsSwapped.AliasSwapped = true
+
+	env.OnActivity(a.GetFieldsActivity, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, p WorkflowParams) (Fields, error) {
+			require.Equal(t, "CustomKeywordFieldKeywordList", p.NewFieldName)
+			return fields, nil
+		}).Once()
+	env.OnWorkflow(addsearchattributes.WorkflowName, mock.Anything, addsearchattributes.WorkflowParams{
+		IndexName:             "index",
+		CustomAttributesToAdd: map[string]enumspb.IndexedValueType{"CustomKeywordFieldKeywordList": enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST},
+	}).Return(nil).Once()
+	env.OnActivity(a.BackfillActivity, mock.Anything, backfillRequest{Fields: fieldsWithNewField, BatchSize: defaultBackfillBatchSize}).Return(int64(1000), nil).Once()
+	env.OnActivity(a.BackfillActivity, mock.Anything, backfillRequest{Fields: fieldsWithNewField, BatchSize: defaultBackfillBatchSize}).Return(int64(0), nil).Once()
+	env.OnActivity(a.SwapAliasActivity, mock.Anything, fieldsWithNewField, "CustomKeywordField").Return(nil).Once()
+	// First catch-up: one execution is still running.
+	env.OnActivity(a.CountRunningActivity, mock.Anything, fieldsSwapped).Return(int64(1), nil).Once()
+	env.OnActivity(a.BackfillActivity, mock.Anything, backfillRequest{Fields: fieldsSwapped, BatchSize: defaultBackfillBatchSize}).Return(int64(1), nil).Once()
+	env.OnActivity(a.BackfillActivity, mock.Anything, backfillRequest{Fields: fieldsSwapped, BatchSize: defaultBackfillBatchSize}).Return(int64(0), nil).Once()
+	// Second catch-up: the execution was closed in the meantime.
+	env.OnActivity(a.CountRunningActivity, mock.Anything, fieldsSwapped).Return(int64(0), nil).Once()
+	env.OnActivity(a.BackfillActivity, mock.Anything, backfillRequest{Fields: fieldsSwapped, BatchSize: defaultBackfillBatchSize}).Return(int64(1), nil).Once()
+	env.OnActivity(a.BackfillActivity, mock.Anything, backfillRequest{Fields: fieldsSwapped, BatchSize: defaultBackfillBatchSize}).Return(int64(0), nil).Once()
+
+	startTime := env.Now()
+	env.ExecuteWorkflow(ChangeSearchAttributeTypeWorkflow, params)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var progress Progress
+	require.NoError(t, env.GetWorkflowResult(&progress))
+	require.Equal(t, Progress{BackfilledCount: 1002, CatchUpCount: 2}, progress)
+	require.GreaterOrEqual(t, env.Now().Sub(startTime), defaultCatchUpInterval)
+	env.AssertExpectations(t)
+}
+
+func Test_ChangeSearchAttributeTypeWorkflow_Resume(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+
+	fields := Fields{
+		IndexName:      "index",
+		NamespaceID:    "namespace-id",
+		OldFieldName:   "CustomKeywordField",
+		OldType:        enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		NewFieldName:   "CustomKeywordFieldKeywordList",
+		NewFieldExists: true,
+		AliasSwapped:   true,
+	}
+	env.OnActivity(a.GetFieldsActivity, mock.Anything, mock.Anything).Return(fields, nil).Once()
+	env.OnActivity(a.CountRunningActivity, mock.Anything, fields).Return(int64(0), nil).Once()
+	env.OnActivity(a.BackfillActivity, mock.Anything, mock.Anything).Return(int64(0), nil).Once()
+
+	env.ExecuteWorkflow(ChangeSearchAttributeTypeWorkflow, WorkflowParams{
+		Namespace:       "namespace",
+		SearchAttribute: "CustomKeywordField",
+		NewType:         enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+		CatchUpInterval: time.Minute,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var progress Progress
+	require.NoError(t, env.GetWorkflowResult(&progress))
+	require.Equal(t, Progress{CatchUpCount: 1}, progress)
+	env.AssertExpectations(t)
+}
+
+func Test_ChangeSearchAttributeTypeWorkflow_InvalidParams(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(ChangeSearchAttributeTypeWorkflow, WorkflowParams{
+		Namespace:       "namespace",
+		SearchAttribute: "CustomKeywordField",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	var appErr *temporal.ApplicationError
+	require.ErrorAs(t, err, &appErr)
+	require.True(t, appErr.NonRetryable())
+}
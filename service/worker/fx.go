@@ -43,18 +43,24 @@ import (
 	"go.temporal.io/server/service"
 	"go.temporal.io/server/service/worker/addsearchattributes"
 	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/changesearchattributetype"
 	"go.temporal.io/server/service/worker/deletenamespace"
 	"go.temporal.io/server/service/worker/migration"
+	"go.temporal.io/server/service/worker/renamenamespace"
 	"go.temporal.io/server/service/worker/scheduler"
+	"go.temporal.io/server/service/worker/visibilitymigration"
 )
 
 var Module = fx.Options(
 	migration.Module,
 	addsearchattributes.Module,
+	changesearchattributetype.Module,
 	resource.Module,
 	deletenamespace.Module,
+	renamenamespace.Module,
 	scheduler.Module,
 	batcher.Module,
+	visibilitymigration.Module,
 	fx.Provide(VisibilityManagerProvider),
 	fx.Provide(dynamicconfig.NewCollection),
 	fx.Provide(ThrottledLoggerRpsFnProvider),
@@ -36,14 +36,29 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/activity"
+	"go.temporal.io/server/api/adminservice/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/quotas"
 )
 
+type verifyResult int
+
+const (
+	verifyResultVerified verifyResult = iota
+	verifyResultSkipped
+	verifyResultMissing
+	verifyResultDiverged
+)
+
+const verifyRetryInterval = 5 * time.Second
+
 // GetMetadata returns history shard count and namespaceID for requested namespace.
 func (a *activities) GetMetadata(ctx context.Context, request metadataRequest) (*metadataResponse, error) {
 	nsEntry, err := a.namespaceRegistry.GetNamespace(namespace.Name(request.Namespace))
@@ -346,3 +361,110 @@ func (a *activities) GenerateReplicationTasks(ctx context.Context, request *gene
 
 	return nil
 }
+
+// VerifyReplicationTasks checks that every execution exists on the target cluster and has caught up
+// with the last event of its current branch on this cluster. Replication is asynchronous, so
+// executions which are missing or behind are re-checked until request.VerifyTimeout expires.
+func (a *activities) VerifyReplicationTasks(ctx context.Context, request *verifyReplicationTasksRequest) (*verifyReplicationTasksResponse, error) {
+	remoteAdminClient, err := a.clientBean.GetRemoteAdminClient(request.TargetClusterName)
+	if err != nil {
+		return nil, err
+	}
+	rateLimiter := quotas.NewRateLimiter(request.RPS, int(math.Ceil(request.RPS)))
+	deadline := time.Now().Add(request.VerifyTimeout)
+
+	response := &verifyReplicationTasksResponse{}
+	pending := request.Executions
+	for {
+		var missing, diverged []commonpb.WorkflowExecution
+		for i := range pending {
+			if err := rateLimiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+			we := pending[i]
+			result, err := a.verifyWorkflowReplication(ctx, remoteAdminClient, request, &we)
+			if err != nil {
+				a.logger.Info("Force replication verification failed", tag.WorkflowNamespaceID(request.NamespaceID), tag.WorkflowID(we.WorkflowId), tag.WorkflowRunID(we.RunId), tag.Error(err))
+				return nil, err
+			}
+			switch result {
+			case verifyResultVerified:
+				response.VerifiedCount++
+			case verifyResultSkipped:
+				response.SkippedCount++
+			case verifyResultMissing:
+				missing = append(missing, we)
+			case verifyResultDiverged:
+				diverged = append(diverged, we)
+			}
+			activity.RecordHeartbeat(ctx, i)
+		}
+
+		if len(missing) == 0 && len(diverged) == 0 || !time.Now().Before(deadline) {
+			response.Missing = missing
+			response.Diverged = diverged
+			return response, nil
+		}
+
+		pending = append(missing, diverged...)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(verifyRetryInterval):
+		}
+	}
+}
+
+func (a *activities) verifyWorkflowReplication(
+	ctx context.Context,
+	remoteAdminClient adminservice.AdminServiceClient,
+	request *verifyReplicationTasksRequest,
+	execution *commonpb.WorkflowExecution,
+) (verifyResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	localResp, err := a.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: request.NamespaceID,
+		Execution:   execution,
+	})
+	if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
+		// execution is deleted on the source cluster, nothing to verify
+		return verifyResultSkipped, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	remoteResp, err := remoteAdminClient.DescribeMutableState(ctx, &adminservice.DescribeMutableStateRequest{
+		Namespace: request.Namespace,
+		Execution: execution,
+	})
+	if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
+		return verifyResultMissing, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	localItem, err := lastVersionHistoryItem(localResp.GetDatabaseMutableState())
+	if err != nil {
+		return 0, err
+	}
+	remoteItem, err := lastVersionHistoryItem(remoteResp.GetDatabaseMutableState())
+	if err != nil {
+		return 0, err
+	}
+	if !versionhistory.IsEqualVersionHistoryItem(localItem, remoteItem) {
+		return verifyResultDiverged, nil
+	}
+	return verifyResultVerified, nil
+}
+
+func lastVersionHistoryItem(mutableState *persistencespb.WorkflowMutableState) (*historyspb.VersionHistoryItem, error) {
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(mutableState.GetExecutionInfo().GetVersionHistories())
+	if err != nil {
+		return nil, err
+	}
+	return versionhistory.GetLastVersionHistoryItem(currentVersionHistory)
+}
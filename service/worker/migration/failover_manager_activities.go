@@ -0,0 +1,169 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const clusterHealthCheckTimeout = 5 * time.Second
+
+// GetFailoverPolicies returns the failover policies of the managed global namespaces, sorted by
+// descending priority. Namespaces without a preferred cluster are not managed.
+func (a *activities) GetFailoverPolicies(ctx context.Context, request *getFailoverPoliciesRequest) (*getFailoverPoliciesResponse, error) {
+	var namespaces []*workflowservice.DescribeNamespaceResponse
+	if len(request.Namespaces) == 0 {
+		var pageToken []byte
+		for {
+			resp, err := a.frontendClient.ListNamespaces(ctx, &workflowservice.ListNamespacesRequest{
+				PageSize:      100,
+				NextPageToken: pageToken,
+			})
+			if err != nil {
+				return nil, err
+			}
+			namespaces = append(namespaces, resp.Namespaces...)
+			pageToken = resp.NextPageToken
+			if len(pageToken) == 0 {
+				break
+			}
+		}
+	} else {
+		for _, ns := range request.Namespaces {
+			resp, err := a.frontendClient.DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+				Namespace: ns,
+			})
+			if err != nil {
+				return nil, err
+			}
+			namespaces = append(namespaces, resp)
+		}
+	}
+
+	response := &getFailoverPoliciesResponse{
+		CurrentCluster: a.clusterMetadata.GetCurrentClusterName(),
+	}
+	for _, ns := range namespaces {
+		if policy, ok := a.newFailoverPolicy(ns); ok {
+			response.Policies = append(response.Policies, policy)
+		}
+	}
+	sort.SliceStable(response.Policies, func(i, j int) bool {
+		if response.Policies[i].Priority != response.Policies[j].Priority {
+			return response.Policies[i].Priority > response.Policies[j].Priority
+		}
+		return response.Policies[i].Namespace < response.Policies[j].Namespace
+	})
+	return response, nil
+}
+
+func (a *activities) newFailoverPolicy(ns *workflowservice.DescribeNamespaceResponse) (NamespaceFailoverPolicy, bool) {
+	data := ns.GetNamespaceInfo().GetData()
+	preferredCluster := data[FailoverPreferredClusterDataKey]
+	if !ns.GetIsGlobalNamespace() || len(preferredCluster) == 0 {
+		return NamespaceFailoverPolicy{}, false
+	}
+
+	policy := NamespaceFailoverPolicy{
+		Namespace:        ns.GetNamespaceInfo().GetName(),
+		ActiveCluster:    ns.GetReplicationConfig().GetActiveClusterName(),
+		PreferredCluster: preferredCluster,
+	}
+	for _, cluster := range ns.GetReplicationConfig().GetClusters() {
+		policy.Clusters = append(policy.Clusters, cluster.GetClusterName())
+	}
+	if priority, ok := data[FailoverPriorityDataKey]; ok {
+		p, err := strconv.Atoi(priority)
+		if err != nil {
+			a.logger.Warn("Invalid namespace failover priority, using default",
+				tag.WorkflowNamespace(policy.Namespace),
+				tag.Value(priority),
+				tag.Error(err),
+			)
+		}
+		policy.Priority = p
+	}
+	return policy, true
+}
+
+// CheckClustersHealth checks that every cluster is reachable with DescribeCluster, and reports
+// the replication lag of this cluster towards it, which is the max over all shards.
+func (a *activities) CheckClustersHealth(ctx context.Context, request *checkClustersHealthRequest) (*checkClustersHealthResponse, error) {
+	response := &checkClustersHealthResponse{
+		Clusters: make(map[string]clusterHealthCheck, len(request.Clusters)),
+	}
+	if len(request.Clusters) == 0 {
+		return response, nil
+	}
+
+	replicationStatus, err := a.historyClient.GetReplicationStatus(ctx, &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: request.Clusters,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cluster := range request.Clusters {
+		check := clusterHealthCheck{Reachable: true}
+		if err := a.describeCluster(ctx, cluster); err != nil {
+			check.Reachable = false
+			check.Error = err.Error()
+		}
+		for _, shard := range replicationStatus.Shards {
+			clusterInfo, ok := shard.RemoteClusters[cluster]
+			if !ok || clusterInfo.AckedTaskId >= shard.MaxReplicationTaskId {
+				continue
+			}
+			lag := timestamp.TimeValue(shard.MaxReplicationTaskVisibilityTime).Sub(timestamp.TimeValue(clusterInfo.AckedTaskVisibilityTime))
+			if lag > check.ReplicationLag {
+				check.ReplicationLag = lag
+			}
+		}
+		response.Clusters[cluster] = check
+	}
+	return response, nil
+}
+
+func (a *activities) describeCluster(ctx context.Context, cluster string) error {
+	ctx, cancel := context.WithTimeout(ctx, clusterHealthCheckTimeout)
+	defer cancel()
+
+	adminClient, err := a.clientBean.GetRemoteAdminClient(cluster)
+	if err != nil {
+		return err
+	}
+	_, err = adminClient.DescribeCluster(ctx, &adminservice.DescribeClusterRequest{})
+	return err
+}
@@ -0,0 +1,420 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	failoverManagerWorkflowName = "failover-manager"
+
+	failoverManagerStatusQueryType = "failover-manager-status"
+
+	// FailoverPreferredClusterDataKey is the namespace data key holding the cluster a namespace should
+	// be active on. Namespaces without it are not managed by the failover manager.
+	FailoverPreferredClusterDataKey = "temporal.failover.preferred-cluster"
+	// FailoverPriorityDataKey is the namespace data key holding the failover priority of a namespace.
+	// Namespaces with higher priority are failed over first. The default priority is 0.
+	FailoverPriorityDataKey = "temporal.failover.priority"
+
+	defaultFailoverCheckInterval     = 30 * time.Second
+	defaultFailoverUnhealthyChecks   = 3
+	defaultFailoverRecoveryChecks    = 10
+	defaultFailoverMaxReplicationLag = time.Minute
+	defaultFailoverChecksPerRun      = 100
+	maxRecentFailoverActions         = 20
+)
+
+type (
+	// FailoverManagerParams configures FailoverManagerWorkflow. The workflow is meant to run in every
+	// cluster: a namespace is failed over by the manager of its failover coordinator, and failed back
+	// by the manager of the cluster it is active on, so managers never act on the same namespace.
+	FailoverManagerParams struct {
+		// Namespaces to manage. All global namespaces with a failover policy are managed if empty.
+		Namespaces []string
+		// CheckInterval is the interval between two health checks.
+		CheckInterval time.Duration
+		// UnhealthyThreshold is the number of consecutive checks a cluster has to be unreachable
+		// before the namespaces active on it are failed over.
+		UnhealthyThreshold int
+		// RecoveryThreshold is the number of consecutive successful health checks after which
+		// a cluster is considered recovered and namespaces preferring it are failed back.
+		RecoveryThreshold int
+		// MaxReplicationLag is the replication lag from this cluster above which a reachable remote
+		// cluster is neither picked as failover target nor failed back to. A lagging cluster is never
+		// failed over from, as that would leave two active clusters.
+		MaxReplicationLag time.Duration
+		// AllowedLaggingSeconds and HandoverTimeoutSeconds are passed to the handover used for failback.
+		AllowedLaggingSeconds  int
+		HandoverTimeoutSeconds int
+		// ChecksPerRun is the number of health checks before continue as new.
+		ChecksPerRun int
+
+		// Carried over by continue as new
+		ClusterHealth       map[string]*ClusterHealth
+		RecentActions       []FailoverAction
+		ContinuedAsNewCount int
+	}
+
+	ClusterHealth struct {
+		ConsecutiveFailures  int
+		ConsecutiveSuccesses int
+		ReplicationLag       time.Duration
+		LastError            string
+		LastCheckTime        time.Time
+	}
+
+	FailoverAction struct {
+		Time        time.Time
+		Namespace   string
+		FromCluster string
+		ToCluster   string
+		Failback    bool
+		Error       string
+	}
+
+	FailoverManagerStatus struct {
+		CurrentCluster      string
+		ClusterHealth       map[string]*ClusterHealth
+		RecentActions       []FailoverAction
+		ContinuedAsNewCount int
+	}
+
+	NamespaceFailoverPolicy struct {
+		Namespace        string
+		ActiveCluster    string
+		Clusters         []string
+		PreferredCluster string
+		Priority         int
+	}
+
+	getFailoverPoliciesRequest struct {
+		Namespaces []string
+	}
+
+	getFailoverPoliciesResponse struct {
+		CurrentCluster string
+		// Policies sorted by descending priority
+		Policies []NamespaceFailoverPolicy
+	}
+
+	checkClustersHealthRequest struct {
+		Clusters []string
+	}
+
+	checkClustersHealthResponse struct {
+		Clusters map[string]clusterHealthCheck
+	}
+
+	clusterHealthCheck struct {
+		Reachable      bool
+		ReplicationLag time.Duration
+		Error          string
+	}
+
+	failoverDecision struct {
+		Namespace   string
+		FromCluster string
+		ToCluster   string
+		Failback    bool
+	}
+)
+
+func FailoverManagerWorkflow(ctx workflow.Context, params FailoverManagerParams) error {
+	var currentCluster string
+	workflow.SetQueryHandler(ctx, failoverManagerStatusQueryType, func() (FailoverManagerStatus, error) {
+		return FailoverManagerStatus{
+			CurrentCluster:      currentCluster,
+			ClusterHealth:       params.ClusterHealth,
+			RecentActions:       params.RecentActions,
+			ContinuedAsNewCount: params.ContinuedAsNewCount,
+		}, nil
+	})
+
+	if err := validateAndSetFailoverManagerParams(&params); err != nil {
+		return err
+	}
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			MaximumInterval:    time.Second * 10,
+			MaximumAttempts:    3,
+			BackoffCoefficient: 2,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+	var a *activities
+
+	for i := 0; i < params.ChecksPerRun; i++ {
+		if i > 0 {
+			if err := workflow.Sleep(ctx, params.CheckInterval); err != nil {
+				return err
+			}
+		}
+
+		var policiesResp getFailoverPoliciesResponse
+		err := workflow.ExecuteActivity(ctx, a.GetFailoverPolicies, &getFailoverPoliciesRequest{
+			Namespaces: params.Namespaces,
+		}).Get(ctx, &policiesResp)
+		if err != nil {
+			// policies are re-read on the next check
+			workflow.GetLogger(ctx).Warn("Failover manager failed to get failover policies", "error", err)
+			continue
+		}
+		currentCluster = policiesResp.CurrentCluster
+
+		var healthResp checkClustersHealthResponse
+		err = workflow.ExecuteActivity(ctx, a.CheckClustersHealth, &checkClustersHealthRequest{
+			Clusters: remoteClusters(currentCluster, policiesResp.Policies),
+		}).Get(ctx, &healthResp)
+		if err != nil {
+			workflow.GetLogger(ctx).Warn("Failover manager failed to check cluster health", "error", err)
+			continue
+		}
+		updateClusterHealth(&params, healthResp, workflow.Now(ctx))
+
+		for _, decision := range planFailovers(currentCluster, policiesResp.Policies, &params) {
+			executeFailover(ctx, decision, &params)
+		}
+	}
+
+	params.ContinuedAsNewCount++
+	return workflow.NewContinueAsNewError(ctx, FailoverManagerWorkflow, params)
+}
+
+func validateAndSetFailoverManagerParams(params *FailoverManagerParams) error {
+	if params.CheckInterval <= 0 {
+		params.CheckInterval = defaultFailoverCheckInterval
+	}
+	if params.UnhealthyThreshold <= 0 {
+		params.UnhealthyThreshold = defaultFailoverUnhealthyChecks
+	}
+	if params.RecoveryThreshold <= 0 {
+		params.RecoveryThreshold = defaultFailoverRecoveryChecks
+	}
+	if params.MaxReplicationLag <= 0 {
+		params.MaxReplicationLag = defaultFailoverMaxReplicationLag
+	}
+	if params.ChecksPerRun <= 0 {
+		params.ChecksPerRun = defaultFailoverChecksPerRun
+	}
+	if params.ClusterHealth == nil {
+		params.ClusterHealth = make(map[string]*ClusterHealth)
+	}
+	if params.CheckInterval < time.Second {
+		return errors.New("InvalidArgument: CheckInterval must be at least one second")
+	}
+	return nil
+}
+
+func remoteClusters(currentCluster string, policies []NamespaceFailoverPolicy) []string {
+	clusterSet := make(map[string]struct{})
+	for _, policy := range policies {
+		for _, cluster := range policy.Clusters {
+			if cluster != currentCluster {
+				clusterSet[cluster] = struct{}{}
+			}
+		}
+	}
+	clusters := make([]string, 0, len(clusterSet))
+	for cluster := range clusterSet {
+		clusters = append(clusters, cluster)
+	}
+	sort.Strings(clusters)
+	return clusters
+}
+
+func updateClusterHealth(params *FailoverManagerParams, resp checkClustersHealthResponse, now time.Time) {
+	for cluster, check := range resp.Clusters {
+		health, ok := params.ClusterHealth[cluster]
+		if !ok {
+			health = &ClusterHealth{}
+			params.ClusterHealth[cluster] = health
+		}
+		health.LastCheckTime = now
+		health.ReplicationLag = check.ReplicationLag
+		health.LastError = check.Error
+
+		switch {
+		case !check.Reachable:
+			health.ConsecutiveFailures++
+			health.ConsecutiveSuccesses = 0
+		case check.ReplicationLag > params.MaxReplicationLag:
+			// reachable but behind: not a failover trigger, but not recovered either
+			health.ConsecutiveFailures = 0
+			health.ConsecutiveSuccesses = 0
+		default:
+			health.ConsecutiveFailures = 0
+			health.ConsecutiveSuccesses++
+		}
+	}
+}
+
+// planFailovers returns the failovers this cluster has to perform, in policy order.
+//   - A namespace active on an unreachable remote cluster is failed over by the manager of its failover
+//     coordinator only. The target is the first candidate, preferred cluster first and the others by name,
+//     which is this cluster or a reachable cluster within MaxReplicationLag of it.
+//   - A namespace active on this cluster is handed over to its preferred cluster once it has recovered.
+func planFailovers(currentCluster string, policies []NamespaceFailoverPolicy, params *FailoverManagerParams) []failoverDecision {
+	isCurrent := func(cluster string) bool { return cluster == currentCluster }
+	isUnreachable := func(cluster string) bool {
+		health, ok := params.ClusterHealth[cluster]
+		return !isCurrent(cluster) && ok && health.ConsecutiveFailures >= params.UnhealthyThreshold
+	}
+	isCaughtUp := func(cluster string) bool {
+		health, ok := params.ClusterHealth[cluster]
+		return isCurrent(cluster) ||
+			ok && health.ConsecutiveFailures == 0 && health.ReplicationLag <= params.MaxReplicationLag
+	}
+	isRecovered := func(cluster string) bool {
+		health, ok := params.ClusterHealth[cluster]
+		return isCurrent(cluster) || ok && health.ConsecutiveSuccesses >= params.RecoveryThreshold
+	}
+
+	var decisions []failoverDecision
+	for _, policy := range policies {
+		switch {
+		case !isCurrent(policy.ActiveCluster) && isUnreachable(policy.ActiveCluster):
+			if !isCurrent(failoverCoordinator(policy)) {
+				continue
+			}
+			for _, candidate := range failoverCandidates(policy) {
+				if isCaughtUp(candidate) {
+					decisions = append(decisions, failoverDecision{
+						Namespace:   policy.Namespace,
+						FromCluster: policy.ActiveCluster,
+						ToCluster:   candidate,
+					})
+					break
+				}
+			}
+		case isCurrent(policy.ActiveCluster) &&
+			!isCurrent(policy.PreferredCluster) &&
+			containsCluster(policy.Clusters, policy.PreferredCluster) &&
+			isRecovered(policy.PreferredCluster):
+			decisions = append(decisions, failoverDecision{
+				Namespace:   policy.Namespace,
+				FromCluster: policy.ActiveCluster,
+				ToCluster:   policy.PreferredCluster,
+				Failback:    true,
+			})
+		}
+	}
+	return decisions
+}
+
+// failoverCoordinator returns the only cluster whose manager may fail the namespace over from its
+// active cluster, the first of the other clusters by name. The managers' health views are not
+// coordinated, so letting any cluster act could fail a namespace over twice, e.g. when the remaining
+// clusters can't reach each other. If the coordinator is down as well the namespace is not failed over.
+func failoverCoordinator(policy NamespaceFailoverPolicy) string {
+	var coordinator string
+	for _, cluster := range policy.Clusters {
+		if cluster != policy.ActiveCluster && (coordinator == "" || cluster < coordinator) {
+			coordinator = cluster
+		}
+	}
+	return coordinator
+}
+
+func failoverCandidates(policy NamespaceFailoverPolicy) []string {
+	var candidates []string
+	if policy.PreferredCluster != policy.ActiveCluster && containsCluster(policy.Clusters, policy.PreferredCluster) {
+		candidates = append(candidates, policy.PreferredCluster)
+	}
+	others := make([]string, 0, len(policy.Clusters))
+	for _, cluster := range policy.Clusters {
+		if cluster != policy.ActiveCluster && cluster != policy.PreferredCluster {
+			others = append(others, cluster)
+		}
+	}
+	sort.Strings(others)
+	return append(candidates, others...)
+}
+
+func containsCluster(clusters []string, cluster string) bool {
+	for _, c := range clusters {
+		if c == cluster {
+			return true
+		}
+	}
+	return false
+}
+
+// executeFailover performs a single decision and records it. Failures are recorded but not
+// returned, the next check retries if the decision still holds.
+func executeFailover(ctx workflow.Context, decision failoverDecision, params *FailoverManagerParams) {
+	var a *activities
+	var err error
+	if decision.Failback {
+		// graceful: wait for replication to catch up before switching the active cluster
+		cctx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+			WorkflowID:            failoverManagerWorkflowName + "-failback-" + decision.Namespace,
+			WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		})
+		err = workflow.ExecuteChildWorkflow(cctx, namespaceHandoverWorkflowName, NamespaceHandoverParams{
+			Namespace:              decision.Namespace,
+			RemoteCluster:          decision.ToCluster,
+			AllowedLaggingSeconds:  params.AllowedLaggingSeconds,
+			HandoverTimeoutSeconds: params.HandoverTimeoutSeconds,
+		}).Get(ctx, nil)
+	} else {
+		// the active cluster is down, so the failover can't wait for replication
+		err = workflow.ExecuteActivity(ctx, a.UpdateActiveCluster, updateActiveClusterRequest{
+			Namespace:     decision.Namespace,
+			ActiveCluster: decision.ToCluster,
+		}).Get(ctx, nil)
+	}
+
+	action := FailoverAction{
+		Time:        workflow.Now(ctx),
+		Namespace:   decision.Namespace,
+		FromCluster: decision.FromCluster,
+		ToCluster:   decision.ToCluster,
+		Failback:    decision.Failback,
+	}
+	if err != nil {
+		action.Error = err.Error()
+		workflow.GetLogger(ctx).Error("Failover manager failed to fail over namespace",
+			"namespace", decision.Namespace, "to-cluster", decision.ToCluster, "failback", decision.Failback, "error", err)
+	} else {
+		workflow.GetLogger(ctx).Info("Failover manager failed over namespace",
+			"namespace", decision.Namespace, "from-cluster", decision.FromCluster, "to-cluster", decision.ToCluster, "failback", decision.Failback)
+	}
+
+	params.RecentActions = append(params.RecentActions, action)
+	if len(params.RecentActions) > maxRecentFailoverActions {
+		params.RecentActions = params.RecentActions[len(params.RecentActions)-maxRecentFailoverActions:]
+	}
+}
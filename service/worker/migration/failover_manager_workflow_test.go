@@ -0,0 +1,166 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestFailoverManagerWorkflow_FailoverOnUnhealthyCluster(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.GetFailoverPolicies, mock.Anything, mock.Anything).Return(&getFailoverPoliciesResponse{
+		CurrentCluster: "standby",
+		Policies: []NamespaceFailoverPolicy{
+			{
+				Namespace:        "test-ns",
+				ActiveCluster:    "active",
+				Clusters:         []string{"active", "standby"},
+				PreferredCluster: "active",
+			},
+		},
+	}, nil).Times(3)
+
+	env.OnActivity(a.CheckClustersHealth, mock.Anything, mock.Anything).Return(func(ctx context.Context, request *checkClustersHealthRequest) (*checkClustersHealthResponse, error) {
+		assert.Equal(t, []string{"active"}, request.Clusters)
+		return &checkClustersHealthResponse{
+			Clusters: map[string]clusterHealthCheck{
+				"active": {Reachable: false, Error: "unavailable"},
+			},
+		}, nil
+	}).Times(3)
+
+	// the namespace is failed over once the threshold is reached, and on every following check
+	// as long as the mocked policy still reports it active on the unhealthy cluster
+	env.OnActivity(a.UpdateActiveCluster, mock.Anything, updateActiveClusterRequest{
+		Namespace:     "test-ns",
+		ActiveCluster: "standby",
+	}).Return(nil).Times(2)
+
+	env.ExecuteWorkflow(FailoverManagerWorkflow, FailoverManagerParams{
+		CheckInterval:      time.Minute,
+		UnhealthyThreshold: 2,
+		ChecksPerRun:       3,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "continue as new")
+	env.AssertExpectations(t)
+
+	envValue, err := env.QueryWorkflow(failoverManagerStatusQueryType)
+	require.NoError(t, err)
+
+	var status FailoverManagerStatus
+	require.NoError(t, envValue.Get(&status))
+	assert.Equal(t, "standby", status.CurrentCluster)
+	assert.Equal(t, 3, status.ClusterHealth["active"].ConsecutiveFailures)
+	assert.Equal(t, "unavailable", status.ClusterHealth["active"].LastError)
+	require.Len(t, status.RecentActions, 2)
+	assert.Equal(t, "test-ns", status.RecentActions[0].Namespace)
+	assert.Equal(t, "active", status.RecentActions[0].FromCluster)
+	assert.Equal(t, "standby", status.RecentActions[0].ToCluster)
+	assert.False(t, status.RecentActions[0].Failback)
+	assert.Empty(t, status.RecentActions[0].Error)
+}
+
+func TestPlanFailovers(t *testing.T) {
+	params := &FailoverManagerParams{
+		UnhealthyThreshold: 2,
+		RecoveryThreshold:  3,
+		MaxReplicationLag:  time.Minute,
+		ClusterHealth: map[string]*ClusterHealth{
+			"c0": {ConsecutiveSuccesses: 5},
+			"c1": {ConsecutiveFailures: 2},
+			"c3": {ConsecutiveSuccesses: 5},
+			"c4": {ReplicationLag: 2 * time.Minute},
+		},
+	}
+	policies := []NamespaceFailoverPolicy{
+		// c1 is down, c2 (the current cluster) coordinates and is the first candidate
+		{Namespace: "ns1", ActiveCluster: "c1", Clusters: []string{"c1", "c2", "c3"}, PreferredCluster: "c1"},
+		// c1 is down, c2 coordinates and fails it over to its preferred cluster c3
+		{Namespace: "ns2", ActiveCluster: "c1", Clusters: []string{"c1", "c2", "c3"}, PreferredCluster: "c3"},
+		// active here, preferred c3 has recovered: fail back
+		{Namespace: "ns3", ActiveCluster: "c2", Clusters: []string{"c2", "c3"}, PreferredCluster: "c3"},
+		// active here, preferred c1 is down: stay
+		{Namespace: "ns4", ActiveCluster: "c2", Clusters: []string{"c1", "c2"}, PreferredCluster: "c1"},
+		// active on its preferred healthy cluster: nothing to do
+		{Namespace: "ns5", ActiveCluster: "c3", Clusters: []string{"c2", "c3"}, PreferredCluster: "c3"},
+		// c4 is reachable but lagging: it is not failed over from
+		{Namespace: "ns6", ActiveCluster: "c4", Clusters: []string{"c2", "c4"}, PreferredCluster: "c2"},
+		// c1 is down, preferred c4 is lagging: fail over to c2 instead
+		{Namespace: "ns7", ActiveCluster: "c1", Clusters: []string{"c1", "c2", "c4"}, PreferredCluster: "c4"},
+		// c1 is down, but c0 coordinates the failover
+		{Namespace: "ns8", ActiveCluster: "c1", Clusters: []string{"c0", "c1", "c2"}, PreferredCluster: "c2"},
+		// active here, preferred c4 is lagging: don't fail back
+		{Namespace: "ns9", ActiveCluster: "c2", Clusters: []string{"c2", "c4"}, PreferredCluster: "c4"},
+	}
+
+	decisions := planFailovers("c2", policies, params)
+	require.Equal(t, []failoverDecision{
+		{Namespace: "ns1", FromCluster: "c1", ToCluster: "c2"},
+		{Namespace: "ns2", FromCluster: "c1", ToCluster: "c3"},
+		{Namespace: "ns3", FromCluster: "c2", ToCluster: "c3", Failback: true},
+		{Namespace: "ns7", FromCluster: "c1", ToCluster: "c2"},
+	}, decisions)
+
+	// c3 has not recovered long enough yet
+	params.ClusterHealth["c3"].ConsecutiveSuccesses = 2
+	decisions = planFailovers("c2", policies[2:3], params)
+	require.Empty(t, decisions)
+}
+
+func TestUpdateClusterHealth(t *testing.T) {
+	params := &FailoverManagerParams{
+		MaxReplicationLag: time.Minute,
+		ClusterHealth: map[string]*ClusterHealth{
+			"lagging": {ConsecutiveSuccesses: 3},
+		},
+	}
+	updateClusterHealth(params, checkClustersHealthResponse{
+		Clusters: map[string]clusterHealthCheck{
+			"down":    {Reachable: false, Error: "unavailable"},
+			"lagging": {Reachable: true, ReplicationLag: 2 * time.Minute},
+			"healthy": {Reachable: true},
+		},
+	}, time.Now())
+
+	assert.Equal(t, 1, params.ClusterHealth["down"].ConsecutiveFailures)
+	assert.Equal(t, 0, params.ClusterHealth["lagging"].ConsecutiveFailures)
+	assert.Equal(t, 0, params.ClusterHealth["lagging"].ConsecutiveSuccesses)
+	assert.Equal(t, 0, params.ClusterHealth["healthy"].ConsecutiveFailures)
+	assert.Equal(t, 1, params.ClusterHealth["healthy"].ConsecutiveSuccesses)
+}
@@ -26,6 +26,7 @@ package migration
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
@@ -42,18 +43,41 @@ type (
 		OverallRps              float64 // RPS for enqueuing of replication tasks
 		ListWorkflowsPageSize   int     // PageSize of ListWorkflow, will paginate through results.
 		PageCountPerExecution   int     // number of pages to be processed before continue as new, max is 1000.
-		NextPageToken           []byte  // used by continue as new
+		NextPageToken           []byte  // used by continue as new, or to resume from a previous ResumeToken
+
+		// TargetClusterName enables the verification phase: once replication tasks of a page are generated,
+		// every execution is checked on the target cluster with DescribeMutableState.
+		TargetClusterName string
+		// VerifyTimeout is how long to keep re-checking executions which are missing or behind on the
+		// target cluster before reporting them.
+		VerifyTimeout time.Duration
 
 		// Used by query handler to indicate overall progress of replication
 		LastCloseTime       time.Time
 		LastStartTime       time.Time
 		ContinuedAsNewCount int
+		Progress            ForceReplicationProgress
+	}
+
+	ForceReplicationProgress struct {
+		ReplicatedCount    int64
+		VerifiedCount      int64
+		SkippedCount       int64 // executions deleted on the source cluster before they could be verified
+		MissingCount       int64
+		DivergedCount      int64
+		MissingExecutions  []commonpb.WorkflowExecution // capped at maxReportedExecutions
+		DivergedExecutions []commonpb.WorkflowExecution // capped at maxReportedExecutions
 	}
 
 	ForceReplicationStatus struct {
 		LastCloseTime       time.Time
 		LastStartTime       time.Time
 		ContinuedAsNewCount int
+		OverallRps          float64
+		Progress            ForceReplicationProgress
+		// ResumeToken can be passed as NextPageToken to a new ForceReplicationWorkflow to resume the
+		// replication. All executions listed before it have been replicated (and verified).
+		ResumeToken []byte
 	}
 
 	listWorkflowsResponse struct {
@@ -72,6 +96,22 @@ type (
 		RPS         float64
 	}
 
+	verifyReplicationTasksRequest struct {
+		Namespace         string
+		NamespaceID       string
+		TargetClusterName string
+		Executions        []commonpb.WorkflowExecution
+		RPS               float64
+		VerifyTimeout     time.Duration
+	}
+
+	verifyReplicationTasksResponse struct {
+		VerifiedCount int64
+		SkippedCount  int64
+		Missing       []commonpb.WorkflowExecution
+		Diverged      []commonpb.WorkflowExecution
+	}
+
 	metadataRequest struct {
 		Namespace string
 	}
@@ -91,14 +131,28 @@ var (
 
 const (
 	forceReplicationStatusQueryType = "force-replication-status"
+	// forceReplicationUpdateRpsSignal changes OverallRps of a running force replication. The new rate
+	// applies to pages processed after the signal is received.
+	forceReplicationUpdateRpsSignal = "force-replication-update-rps"
+
+	forceReplicationVerificationFailedErrorType = "ForceReplicationVerificationFailed"
+
+	defaultVerifyTimeout  = 5 * time.Minute
+	maxReportedExecutions = 100
 )
 
 func ForceReplicationWorkflow(ctx workflow.Context, params ForceReplicationParams) error {
+	// pages before resumeToken are fully processed by previous runs
+	resumeToken := params.NextPageToken
+
 	workflow.SetQueryHandler(ctx, forceReplicationStatusQueryType, func() (ForceReplicationStatus, error) {
 		return ForceReplicationStatus{
 			LastCloseTime:       params.LastCloseTime,
 			LastStartTime:       params.LastStartTime,
 			ContinuedAsNewCount: params.ContinuedAsNewCount,
+			OverallRps:          params.OverallRps,
+			Progress:            params.Progress,
+			ResumeToken:         resumeToken,
 		}, nil
 	})
 
@@ -106,6 +160,17 @@ func ForceReplicationWorkflow(ctx workflow.Context, params ForceReplicationParam
 		return err
 	}
 
+	rpsCh := workflow.GetSignalChannel(ctx, forceReplicationUpdateRpsSignal)
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		for {
+			var rps float64
+			rpsCh.Receive(ctx, &rps)
+			if rps > 0 {
+				params.OverallRps = rps
+			}
+		}
+	})
+
 	metadataResp, err := getClusterMetadata(ctx, params)
 	if err != nil {
 		return err
@@ -122,7 +187,7 @@ func ForceReplicationWorkflow(ctx workflow.Context, params ForceReplicationParam
 		workflowExecutionsCh.Close()
 	})
 
-	if err := enqueueReplicationTasks(ctx, workflowExecutionsCh, metadataResp.NamespaceID, params); err != nil {
+	if err := enqueueReplicationTasks(ctx, workflowExecutionsCh, metadataResp.NamespaceID, &params); err != nil {
 		return err
 	}
 
@@ -131,7 +196,7 @@ func ForceReplicationWorkflow(ctx workflow.Context, params ForceReplicationParam
 	}
 
 	if params.NextPageToken == nil {
-		return nil
+		return verificationResult(params)
 	}
 
 	params.ContinuedAsNewCount++
@@ -160,6 +225,9 @@ func validateAndSetForceReplicationParams(params *ForceReplicationParams) error
 	if params.PageCountPerExecution > maxPageCountPerExecution {
 		params.PageCountPerExecution = maxPageCountPerExecution
 	}
+	if params.VerifyTimeout <= 0 {
+		params.VerifyTimeout = defaultVerifyTimeout
+	}
 
 	return nil
 }
@@ -219,45 +287,114 @@ func listWorkflowsForReplication(ctx workflow.Context, workflowExecutionsCh work
 	return nil
 }
 
-func enqueueReplicationTasks(ctx workflow.Context, workflowExecutionsCh workflow.Channel, namespaceID string, params ForceReplicationParams) error {
-	selector := workflow.NewSelector(ctx)
-	pendingActivities := 0
-
+func enqueueReplicationTasks(ctx workflow.Context, workflowExecutionsCh workflow.Channel, namespaceID string, params *ForceReplicationParams) error {
 	ao := workflow.ActivityOptions{
 		StartToCloseTimeout: time.Hour,
 		HeartbeatTimeout:    time.Second * 30,
 		RetryPolicy:         forceReplicationActivityRetryPolicy,
 	}
 
-	actx := workflow.WithActivityOptions(ctx, ao)
-	var a *activities
-	var futures []workflow.Future
+	pendingPages := 0
+	var pageErr error
 	var workflowExecutions []commonpb.WorkflowExecution
 
 	for workflowExecutionsCh.Receive(ctx, &workflowExecutions) {
-		replicationTaskFuture := workflow.ExecuteActivity(actx, a.GenerateReplicationTasks, &generateReplicationTasksRequest{
-			NamespaceID: namespaceID,
-			Executions:  workflowExecutions,
-			RPS:         params.OverallRps / float64(params.ConcurrentActivityCount),
-		})
-
-		pendingActivities++
-		selector.AddFuture(replicationTaskFuture, func(f workflow.Future) {
-			pendingActivities--
+		executions := workflowExecutions
+		// rps is read per page, so that it follows forceReplicationUpdateRpsSignal
+		rps := params.OverallRps / float64(params.ConcurrentActivityCount)
+
+		pendingPages++
+		workflow.Go(ctx, func(ctx workflow.Context) {
+			defer func() { pendingPages-- }()
+
+			// activities must be awaited with the context of the coroutine they run in
+			actx := workflow.WithActivityOptions(ctx, ao)
+			if err := replicatePage(actx, namespaceID, executions, rps, params); err != nil && pageErr == nil {
+				pageErr = err
+			}
 		})
 
-		if pendingActivities == params.ConcurrentActivityCount {
-			selector.Select(ctx) // this will block until one of the in-flight activities completes
+		// block until one of the in-flight pages completes
+		if err := workflow.Await(ctx, func() bool {
+			return pendingPages < params.ConcurrentActivityCount
+		}); err != nil {
+			return err
 		}
+	}
 
-		futures = append(futures, replicationTaskFuture)
+	if err := workflow.Await(ctx, func() bool { return pendingPages == 0 }); err != nil {
+		return err
 	}
+	return pageErr
+}
 
-	for _, future := range futures {
-		if err := future.Get(ctx, nil); err != nil {
-			return err
-		}
+func replicatePage(
+	ctx workflow.Context,
+	namespaceID string,
+	executions []commonpb.WorkflowExecution,
+	rps float64,
+	params *ForceReplicationParams,
+) error {
+	var a *activities
+
+	err := workflow.ExecuteActivity(ctx, a.GenerateReplicationTasks, &generateReplicationTasksRequest{
+		NamespaceID: namespaceID,
+		Executions:  executions,
+		RPS:         rps,
+	}).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+	params.Progress.ReplicatedCount += int64(len(executions))
+
+	if len(params.TargetClusterName) == 0 || len(executions) == 0 {
+		return nil
 	}
 
+	var verifyResp verifyReplicationTasksResponse
+	err = workflow.ExecuteActivity(ctx, a.VerifyReplicationTasks, &verifyReplicationTasksRequest{
+		Namespace:         params.Namespace,
+		NamespaceID:       namespaceID,
+		TargetClusterName: params.TargetClusterName,
+		Executions:        executions,
+		RPS:               rps,
+		VerifyTimeout:     params.VerifyTimeout,
+	}).Get(ctx, &verifyResp)
+	if err != nil {
+		return err
+	}
+
+	progress := &params.Progress
+	progress.VerifiedCount += verifyResp.VerifiedCount
+	progress.SkippedCount += verifyResp.SkippedCount
+	progress.MissingCount += int64(len(verifyResp.Missing))
+	progress.DivergedCount += int64(len(verifyResp.Diverged))
+	progress.MissingExecutions = appendCapped(progress.MissingExecutions, verifyResp.Missing)
+	progress.DivergedExecutions = appendCapped(progress.DivergedExecutions, verifyResp.Diverged)
 	return nil
 }
+
+func appendCapped(reported []commonpb.WorkflowExecution, executions []commonpb.WorkflowExecution) []commonpb.WorkflowExecution {
+	if room := maxReportedExecutions - len(reported); room < len(executions) {
+		executions = executions[:room]
+	}
+	return append(reported, executions...)
+}
+
+// verificationResult fails the workflow if the verification phase found executions which
+// are missing or diverged on the target cluster. Details are available through the status query.
+func verificationResult(params ForceReplicationParams) error {
+	if params.Progress.MissingCount == 0 && params.Progress.DivergedCount == 0 {
+		return nil
+	}
+	return temporal.NewNonRetryableApplicationError(
+		fmt.Sprintf("force replication verification failed: %d executions missing and %d diverged on cluster %s",
+			params.Progress.MissingCount,
+			params.Progress.DivergedCount,
+			params.TargetClusterName,
+		),
+		forceReplicationVerificationFailedErrorType,
+		nil,
+		params.Progress,
+	)
+}
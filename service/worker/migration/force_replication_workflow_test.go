@@ -36,6 +36,7 @@ import (
 	"github.com/stretchr/testify/require"
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/testsuite"
 )
 
@@ -232,3 +233,113 @@ func TestForceReplicationWorkflow_GenerateReplicationTaskError(t *testing.T) {
 	require.Contains(t, err.Error(), "mock generate replication tasks error")
 	env.AssertExpectations(t)
 }
+
+func TestForceReplicationWorkflow_Verification(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	namespaceID := uuid.New()
+
+	var a *activities
+	env.OnActivity(a.GetMetadata, mock.Anything, metadataRequest{Namespace: "test-ns"}).Return(&metadataResponse{ShardCount: 4, NamespaceID: namespaceID}, nil)
+
+	verified := commonpb.WorkflowExecution{WorkflowId: "wf-verified", RunId: uuid.New()}
+	missing := commonpb.WorkflowExecution{WorkflowId: "wf-missing", RunId: uuid.New()}
+	env.OnActivity(a.ListWorkflows, mock.Anything, mock.Anything).Return(func(ctx context.Context, request *workflowservice.ListWorkflowExecutionsRequest) (*listWorkflowsResponse, error) {
+		if request.NextPageToken == nil {
+			return &listWorkflowsResponse{
+				Executions:    []commonpb.WorkflowExecution{verified},
+				NextPageToken: []byte("fake-page-token"),
+			}, nil
+		}
+		return &listWorkflowsResponse{
+			Executions:    []commonpb.WorkflowExecution{missing},
+			NextPageToken: nil, // last page
+		}, nil
+	}).Times(2)
+
+	env.OnActivity(a.GenerateReplicationTasks, mock.Anything, mock.Anything).Return(nil).Times(2)
+	env.OnActivity(a.VerifyReplicationTasks, mock.Anything, mock.Anything).Return(func(ctx context.Context, request *verifyReplicationTasksRequest) (*verifyReplicationTasksResponse, error) {
+		assert.Equal(t, "test-ns", request.Namespace)
+		assert.Equal(t, namespaceID, request.NamespaceID)
+		assert.Equal(t, "target-cluster", request.TargetClusterName)
+		assert.Equal(t, defaultVerifyTimeout, request.VerifyTimeout)
+		require.Len(t, request.Executions, 1)
+		if request.Executions[0] == missing {
+			return &verifyReplicationTasksResponse{Missing: request.Executions}, nil
+		}
+		return &verifyReplicationTasksResponse{VerifiedCount: 1}, nil
+	}).Times(2)
+
+	env.ExecuteWorkflow(ForceReplicationWorkflow, ForceReplicationParams{
+		Namespace:               "test-ns",
+		ConcurrentActivityCount: 2,
+		OverallRps:              10,
+		ListWorkflowsPageSize:   1,
+		PageCountPerExecution:   4,
+		TargetClusterName:       "target-cluster",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	var appErr *temporal.ApplicationError
+	require.ErrorAs(t, err, &appErr)
+	require.Equal(t, forceReplicationVerificationFailedErrorType, appErr.Type())
+	env.AssertExpectations(t)
+
+	envValue, err := env.QueryWorkflow(forceReplicationStatusQueryType)
+	require.NoError(t, err)
+
+	var status ForceReplicationStatus
+	require.NoError(t, envValue.Get(&status))
+	assert.Equal(t, int64(2), status.Progress.ReplicatedCount)
+	assert.Equal(t, int64(1), status.Progress.VerifiedCount)
+	assert.Equal(t, int64(1), status.Progress.MissingCount)
+	assert.Equal(t, int64(0), status.Progress.DivergedCount)
+	assert.Equal(t, []commonpb.WorkflowExecution{missing}, status.Progress.MissingExecutions)
+}
+
+func TestForceReplicationWorkflow_UpdateRps(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	namespaceID := uuid.New()
+
+	var a *activities
+	env.OnActivity(a.GetMetadata, mock.Anything, metadataRequest{Namespace: "test-ns"}).Return(&metadataResponse{ShardCount: 4, NamespaceID: namespaceID}, nil)
+
+	env.OnActivity(a.ListWorkflows, mock.Anything, mock.Anything).Return(&listWorkflowsResponse{
+		Executions:    []commonpb.WorkflowExecution{},
+		NextPageToken: nil, // last page
+	}, nil).After(time.Minute).Once()
+
+	env.OnActivity(a.GenerateReplicationTasks, mock.Anything, mock.Anything).Return(func(ctx context.Context, request *generateReplicationTasksRequest) error {
+		assert.Equal(t, float64(20), request.RPS)
+		return nil
+	}).Once()
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(forceReplicationUpdateRpsSignal, float64(40))
+	}, time.Second)
+
+	env.ExecuteWorkflow(ForceReplicationWorkflow, ForceReplicationParams{
+		Namespace:               "test-ns",
+		ConcurrentActivityCount: 2,
+		OverallRps:              10,
+		ListWorkflowsPageSize:   1,
+		PageCountPerExecution:   4,
+		NextPageToken:           []byte("resume-token"),
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+
+	envValue, err := env.QueryWorkflow(forceReplicationStatusQueryType)
+	require.NoError(t, err)
+
+	var status ForceReplicationStatus
+	require.NoError(t, envValue.Get(&status))
+	assert.Equal(t, float64(40), status.OverallRps)
+	assert.Equal(t, []byte("resume-token"), status.ResumeToken)
+}
@@ -31,6 +31,8 @@ import (
 	"go.uber.org/fx"
 
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -47,6 +49,8 @@ type (
 		NamespaceRegistry namespace.Registry
 		HistoryClient     historyservice.HistoryServiceClient
 		FrontendClient    workflowservice.WorkflowServiceClient
+		ClientBean        client.Bean
+		ClusterMetadata   cluster.Metadata
 		Logger            log.Logger
 		MetricsHandler    metrics.Handler
 	}
@@ -77,6 +81,7 @@ func NewResult(params initParams) fxResult {
 func (wc *replicationWorkerComponent) Register(worker sdkworker.Worker) {
 	worker.RegisterWorkflowWithOptions(ForceReplicationWorkflow, workflow.RegisterOptions{Name: forceReplicationWorkflowName})
 	worker.RegisterWorkflowWithOptions(NamespaceHandoverWorkflow, workflow.RegisterOptions{Name: namespaceHandoverWorkflowName})
+	worker.RegisterWorkflowWithOptions(FailoverManagerWorkflow, workflow.RegisterOptions{Name: failoverManagerWorkflowName})
 	worker.RegisterActivity(wc.activities())
 }
 
@@ -92,6 +97,8 @@ func (wc *replicationWorkerComponent) activities() *activities {
 		namespaceRegistry: wc.NamespaceRegistry,
 		historyClient:     wc.HistoryClient,
 		frontendClient:    wc.FrontendClient,
+		clientBean:        wc.ClientBean,
+		clusterMetadata:   wc.ClusterMetadata,
 		logger:            wc.Logger,
 		metricsHandler:    wc.MetricsHandler,
 	}
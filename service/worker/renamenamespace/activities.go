@@ -0,0 +1,223 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package renamenamespace
+
+import (
+	"context"
+	"fmt"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/temporal"
+
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	activities struct {
+		metadataManager   persistence.MetadataManager
+		namespaceRegistry namespace.Registry
+		metricsHandler    metrics.Handler
+		logger            log.Logger
+	}
+)
+
+func NewActivities(
+	metadataManager persistence.MetadataManager,
+	namespaceRegistry namespace.Registry,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *activities {
+	return &activities{
+		metadataManager:   metadataManager,
+		namespaceRegistry: namespaceRegistry,
+		metricsHandler:    metricsHandler.WithTags(metrics.OperationTag(metrics.RenameNamespaceWorkflowScope)),
+		logger:            logger,
+	}
+}
+
+// ValidateRenameActivity checks that the namespace exists and is not global, as renaming is not replicated
+// to other clusters, and that the new name is neither used by nor an alias of another namespace.
+// It returns the ID of the namespace to rename.
+func (a *activities) ValidateRenameActivity(ctx context.Context, nsName namespace.Name, newName namespace.Name) (namespace.ID, error) {
+	ctx = headers.SetCallerName(ctx, nsName.String())
+
+	ns, err := a.metadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{
+		Name: nsName.String(),
+	})
+	if err != nil {
+		a.metricsHandler.Counter(metrics.ReadNamespaceFailuresCount.GetMetricName()).Record(1)
+		a.logger.Error("Unable to get namespace details.", tag.WorkflowNamespace(nsName.String()), tag.Error(err))
+		return namespace.EmptyID, err
+	}
+	if ns.Namespace.Info.State == enumspb.NAMESPACE_STATE_DELETED {
+		return namespace.EmptyID, temporal.NewNonRetryableApplicationError(fmt.Sprintf("namespace %s is deleted", nsName), "", nil)
+	}
+	if ns.IsGlobalNamespace {
+		return namespace.EmptyID, temporal.NewNonRetryableApplicationError(fmt.Sprintf("namespace %s is a global namespace", nsName), "", nil)
+	}
+	nsID := namespace.ID(ns.Namespace.Info.Id)
+
+	_, err = a.metadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{
+		Name: newName.String(),
+	})
+	switch err.(type) {
+	case nil:
+		return namespace.EmptyID, temporal.NewNonRetryableApplicationError(fmt.Sprintf("namespace %s already exists", newName), "", nil)
+	case *serviceerror.NamespaceNotFound:
+	default:
+		a.metricsHandler.Counter(metrics.ReadNamespaceFailuresCount.GetMetricName()).Record(1)
+		a.logger.Error("Unable to get namespace details.", tag.WorkflowNamespace(newName.String()), tag.Error(err))
+		return namespace.EmptyID, err
+	}
+
+	// the new name can still be an alias of another namespace
+	aliased, err := a.namespaceRegistry.GetNamespace(newName)
+	switch err.(type) {
+	case nil:
+		if aliased.ID() != nsID {
+			return namespace.EmptyID, temporal.NewNonRetryableApplicationError(
+				fmt.Sprintf("%s is an alias of namespace %s", newName, aliased.Name()), "", nil)
+		}
+	case *serviceerror.NamespaceNotFound:
+	default:
+		return namespace.EmptyID, err
+	}
+
+	return nsID, nil
+}
+
+// UpdateNamespaceActivity updates the aliases, previous names and archival URIs of the namespace before it is renamed.
+// It returns the aliases of the namespace after the update.
+func (a *activities) UpdateNamespaceActivity(ctx context.Context, nsID namespace.ID, params RenameNamespaceWorkflowParams) ([]namespace.Name, error) {
+	ctx = headers.SetCallerName(ctx, params.Namespace.String())
+
+	metadata, err := a.metadataManager.GetMetadata(ctx)
+	if err != nil {
+		a.metricsHandler.Counter(metrics.ReadNamespaceFailuresCount.GetMetricName()).Record(1)
+		a.logger.Error("Unable to get cluster metadata.", tag.WorkflowNamespace(params.Namespace.String()), tag.Error(err))
+		return nil, err
+	}
+
+	ns, err := a.metadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{
+		ID: nsID.String(),
+	})
+	if err != nil {
+		a.metricsHandler.Counter(metrics.ReadNamespaceFailuresCount.GetMetricName()).Record(1)
+		a.logger.Error("Unable to get namespace details.", tag.WorkflowNamespace(params.Namespace.String()), tag.Error(err))
+		return nil, err
+	}
+
+	var aliases []namespace.Name
+	for _, alias := range namespace.ParseAliases(ns.Namespace.Info.Data[namespace.AliasesDataKey]) {
+		if alias != params.NewName && alias != params.Namespace {
+			aliases = append(aliases, alias)
+		}
+	}
+	if params.KeepAlias {
+		aliases = append(aliases, params.Namespace)
+	}
+	if ns.Namespace.Info.Data == nil {
+		ns.Namespace.Info.Data = make(map[string]string)
+	}
+	if len(aliases) == 0 {
+		delete(ns.Namespace.Info.Data, namespace.AliasesDataKey)
+	} else {
+		ns.Namespace.Info.Data[namespace.AliasesDataKey] = namespace.FormatAliases(aliases)
+	}
+
+	// Previous names are kept even without alias, so that a custom search attributes mapper,
+	// which knows the namespace by its previous name, still maps search attributes of the namespace.
+	previousNames := []namespace.Name{params.Namespace}
+	for _, previousName := range namespace.ParseAliases(ns.Namespace.Info.Data[namespace.PreviousNamesDataKey]) {
+		if previousName != params.NewName && previousName != params.Namespace {
+			previousNames = append(previousNames, previousName)
+		}
+	}
+	ns.Namespace.Info.Data[namespace.PreviousNamesDataKey] = namespace.FormatAliases(previousNames)
+
+	config := ns.Namespace.Config
+	configChanged := false
+	if params.HistoryArchivalURI != "" && params.HistoryArchivalURI != config.HistoryArchivalUri {
+		config.HistoryArchivalUri = params.HistoryArchivalURI
+		configChanged = true
+	}
+	if params.VisibilityArchivalURI != "" && params.VisibilityArchivalURI != config.VisibilityArchivalUri {
+		config.VisibilityArchivalUri = params.VisibilityArchivalURI
+		configChanged = true
+	}
+	if configChanged {
+		ns.Namespace.ConfigVersion++
+	}
+
+	err = a.metadataManager.UpdateNamespace(ctx, &persistence.UpdateNamespaceRequest{
+		Namespace:           ns.Namespace,
+		IsGlobalNamespace:   ns.IsGlobalNamespace,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+	if err != nil {
+		a.metricsHandler.Counter(metrics.UpdateNamespaceFailuresCount.GetMetricName()).Record(1)
+		a.logger.Error("Unable to update namespace aliases.", tag.WorkflowNamespace(params.Namespace.String()), tag.Error(err))
+		return nil, err
+	}
+	return aliases, nil
+}
+
+// RenameNamespaceActivity renames the namespace. It is a no-op if the namespace already has the new name.
+func (a *activities) RenameNamespaceActivity(ctx context.Context, nsID namespace.ID, previousName namespace.Name, newName namespace.Name) error {
+	ctx = headers.SetCallerName(ctx, previousName.String())
+
+	ns, err := a.metadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{
+		ID: nsID.String(),
+	})
+	if err != nil {
+		a.metricsHandler.Counter(metrics.ReadNamespaceFailuresCount.GetMetricName()).Record(1)
+		a.logger.Error("Unable to get namespace details.", tag.WorkflowNamespace(previousName.String()), tag.Error(err))
+		return err
+	}
+	if ns.Namespace.Info.Name == newName.String() {
+		// already renamed by a previous attempt
+		return nil
+	}
+
+	err = a.metadataManager.RenameNamespace(ctx, &persistence.RenameNamespaceRequest{
+		PreviousName: previousName.String(),
+		NewName:      newName.String(),
+	})
+	if err != nil {
+		a.metricsHandler.Counter(metrics.RenameNamespaceFailuresCount.GetMetricName()).Record(1)
+		a.logger.Error("Unable to rename namespace.", tag.WorkflowNamespace(previousName.String()), tag.Error(err))
+		return err
+	}
+
+	a.metricsHandler.Counter(metrics.RenameNamespaceSuccessCount.GetMetricName()).Record(1)
+	a.logger.Info("Namespace renamed successfully.", tag.WorkflowNamespace(previousName.String()), tag.WorkflowNamespace(newName.String()))
+	return nil
+}
@@ -0,0 +1,148 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package renamenamespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/temporal"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+)
+
+func Test_UpdateNamespaceActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metadataManager := persistence.NewMockMetadataManager(ctrl)
+
+	a := &activities{
+		metadataManager: metadataManager,
+		metricsHandler:  metrics.NoopMetricsHandler,
+		logger:          log.NewNoopLogger(),
+	}
+
+	metadataManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil)
+	metadataManager.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		ID: "namespace-id",
+	}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   "namespace-id",
+				Name: "namespace",
+				Data: map[string]string{
+					namespace.AliasesDataKey:       "oldest-namespace,new-namespace",
+					namespace.PreviousNamesDataKey: "oldest-namespace,new-namespace",
+				},
+			},
+			Config: &persistencespb.NamespaceConfig{
+				HistoryArchivalUri: "file:///tmp/namespace",
+			},
+			ConfigVersion: 3,
+		},
+	}, nil)
+	metadataManager.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			require.Equal(t, int64(7), request.NotificationVersion)
+			require.Equal(t, "oldest-namespace,namespace", request.Namespace.Info.Data[namespace.AliasesDataKey])
+			require.Equal(t, "namespace,oldest-namespace", request.Namespace.Info.Data[namespace.PreviousNamesDataKey])
+			require.Equal(t, "file:///tmp/new-namespace", request.Namespace.Config.HistoryArchivalUri)
+			require.Equal(t, int64(4), request.Namespace.ConfigVersion)
+			return nil
+		})
+
+	aliases, err := a.UpdateNamespaceActivity(context.Background(), "namespace-id", RenameNamespaceWorkflowParams{
+		Namespace:          "namespace",
+		NewName:            "new-namespace",
+		KeepAlias:          true,
+		HistoryArchivalURI: "file:///tmp/new-namespace",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []namespace.Name{"oldest-namespace", "namespace"}, aliases)
+
+	ctrl.Finish()
+}
+
+func Test_RenameNamespaceActivity_AlreadyRenamed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metadataManager := persistence.NewMockMetadataManager(ctrl)
+
+	a := &activities{
+		metadataManager: metadataManager,
+		metricsHandler:  metrics.NoopMetricsHandler,
+		logger:          log.NewNoopLogger(),
+	}
+
+	metadataManager.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		ID: "namespace-id",
+	}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   "namespace-id",
+				Name: "new-namespace",
+			},
+		},
+	}, nil)
+
+	err := a.RenameNamespaceActivity(context.Background(), "namespace-id", "namespace", "new-namespace")
+	require.NoError(t, err)
+
+	ctrl.Finish()
+}
+
+func Test_ValidateRenameActivity_GlobalNamespace(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metadataManager := persistence.NewMockMetadataManager(ctrl)
+
+	a := &activities{
+		metadataManager: metadataManager,
+		metricsHandler:  metrics.NoopMetricsHandler,
+		logger:          log.NewNoopLogger(),
+	}
+
+	metadataManager.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: "namespace",
+	}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   "namespace-id",
+				Name: "namespace",
+			},
+		},
+		IsGlobalNamespace: true,
+	}, nil)
+
+	_, err := a.ValidateRenameActivity(context.Background(), "namespace", "new-namespace")
+	var applicationErr *temporal.ApplicationError
+	require.ErrorAs(t, err, &applicationErr)
+	require.True(t, applicationErr.NonRetryable())
+
+	ctrl.Finish()
+}
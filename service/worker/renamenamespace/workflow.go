@@ -0,0 +1,124 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package renamenamespace
+
+import (
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+)
+
+const (
+	WorkflowName = "temporal-sys-rename-namespace-workflow"
+)
+
+type (
+	RenameNamespaceWorkflowParams struct {
+		Namespace namespace.Name
+		NewName   namespace.Name
+
+		// KeepAlias keeps the previous name as an alias of the namespace,
+		// so it still resolves to the renamed namespace while clients migrate.
+		KeepAlias bool
+
+		// HistoryArchivalURI and VisibilityArchivalURI optionally replace the archival URIs of the namespace,
+		// e.g. when they are derived from the namespace name. Empty means the URI is kept as is.
+		HistoryArchivalURI    string
+		VisibilityArchivalURI string
+	}
+
+	RenameNamespaceWorkflowResult struct {
+		NamespaceID namespace.ID
+		Aliases     []namespace.Name
+	}
+)
+
+var (
+	localRetryPolicy = &temporal.RetryPolicy{
+		InitialInterval: 1 * time.Second,
+		MaximumInterval: 10 * time.Second,
+	}
+
+	localActivityOptions = workflow.LocalActivityOptions{
+		RetryPolicy:            localRetryPolicy,
+		StartToCloseTimeout:    30 * time.Second,
+		ScheduleToCloseTimeout: 5 * time.Minute,
+	}
+)
+
+func validateParams(params *RenameNamespaceWorkflowParams) error {
+	if params.Namespace.IsEmpty() || params.NewName.IsEmpty() {
+		return temporal.NewNonRetryableApplicationError("namespace and new name are required", "", nil)
+	}
+	if params.Namespace == params.NewName {
+		return temporal.NewNonRetryableApplicationError("new name must be different from the current name", "", nil)
+	}
+	return nil
+}
+
+// RenameNamespaceWorkflow renames a namespace while keeping its ID. Because workflow executions, search attribute
+// aliases (stored in the namespace config) and archived data are keyed by namespace ID, they are carried over as is.
+// The namespace registries of all hosts pick up the new name with the next namespace refresh.
+func RenameNamespaceWorkflow(ctx workflow.Context, params RenameNamespaceWorkflowParams) (RenameNamespaceWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Workflow started.", tag.WorkflowType(WorkflowName))
+
+	var result RenameNamespaceWorkflowResult
+
+	if err := validateParams(&params); err != nil {
+		return result, err
+	}
+
+	var a *activities
+
+	// Step 1. Validate the namespace and the new name.
+	ctx1 := workflow.WithLocalActivityOptions(ctx, localActivityOptions)
+	err := workflow.ExecuteLocalActivity(ctx1, a.ValidateRenameActivity, params.Namespace, params.NewName).Get(ctx, &result.NamespaceID)
+	if err != nil {
+		return result, temporal.NewNonRetryableApplicationError(fmt.Sprintf("unable to rename namespace %s to %s", params.Namespace, params.NewName), "", err)
+	}
+
+	// Step 2. Update aliases and archival URIs.
+	ctx2 := workflow.WithLocalActivityOptions(ctx, localActivityOptions)
+	err = workflow.ExecuteLocalActivity(ctx2, a.UpdateNamespaceActivity, result.NamespaceID, params).Get(ctx, &result.Aliases)
+	if err != nil {
+		return result, fmt.Errorf("%w: UpdateNamespaceActivity: %v", ErrUnableToExecuteActivity, err)
+	}
+
+	// Step 3. Rename namespace.
+	ctx3 := workflow.WithLocalActivityOptions(ctx, localActivityOptions)
+	err = workflow.ExecuteLocalActivity(ctx3, a.RenameNamespaceActivity, result.NamespaceID, params.Namespace, params.NewName).Get(ctx, nil)
+	if err != nil {
+		return result, fmt.Errorf("%w: RenameNamespaceActivity: %v", ErrUnableToExecuteActivity, err)
+	}
+
+	logger.Info("Workflow finished successfully.", tag.WorkflowType(WorkflowName))
+	return result, nil
+}
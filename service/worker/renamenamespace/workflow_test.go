@@ -0,0 +1,78 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package renamenamespace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+
+	"go.temporal.io/server/common/namespace"
+)
+
+func Test_RenameNamespaceWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+
+	params := RenameNamespaceWorkflowParams{
+		Namespace: "namespace",
+		NewName:   "new-namespace",
+		KeepAlias: true,
+	}
+	env.OnActivity(a.ValidateRenameActivity, mock.Anything, namespace.Name("namespace"), namespace.Name("new-namespace")).Return(namespace.ID("namespace-id"), nil).Once()
+	env.OnActivity(a.UpdateNamespaceActivity, mock.Anything, namespace.ID("namespace-id"), params).Return([]namespace.Name{"namespace"}, nil).Once()
+	env.OnActivity(a.RenameNamespaceActivity, mock.Anything, namespace.ID("namespace-id"), namespace.Name("namespace"), namespace.Name("new-namespace")).Return(nil).Once()
+
+	env.ExecuteWorkflow(RenameNamespaceWorkflow, params)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var result RenameNamespaceWorkflowResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	require.Equal(t, namespace.ID("namespace-id"), result.NamespaceID)
+	require.Equal(t, []namespace.Name{"namespace"}, result.Aliases)
+}
+
+func Test_RenameNamespaceWorkflow_InvalidParams(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(RenameNamespaceWorkflow, RenameNamespaceWorkflowParams{
+		Namespace: "namespace",
+		NewName:   "namespace",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	var appErr *temporal.ApplicationError
+	require.ErrorAs(t, err, &appErr)
+	require.True(t, appErr.NonRetryable())
+}
@@ -0,0 +1,575 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archival
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/activity"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	carchiver "go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/checksum"
+	"go.temporal.io/server/common/collection"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	// MismatchReasonMissing means no archived history could be found for a closed workflow
+	MismatchReasonMissing = "missing"
+	// MismatchReasonTruncated means the archived history has fewer events than the workflow
+	MismatchReasonTruncated = "truncated"
+	// MismatchReasonEventCount means the archived history has a different number of events than the workflow
+	MismatchReasonEventCount = "event_count"
+	// MismatchReasonChecksum means the archived events differ from the events in the primary store
+	MismatchReasonChecksum = "checksum"
+)
+
+type (
+	// VerifierHeartbeatDetails is the heartbeat detail for ArchivalVerifierActivity
+	VerifierHeartbeatDetails struct {
+		VerifiedCount int
+		MismatchCount int
+		ErrorCount    int
+		SkipCount     int
+		CurrentPage   int
+
+		NextPageToken []byte
+
+		// Mismatches holds the most recent mismatches found, capped at maxReportedMismatches
+		Mismatches []Mismatch
+	}
+
+	// Mismatch describes an archived history which does not agree with the primary store
+	Mismatch struct {
+		NamespaceID string
+		WorkflowID  string
+		RunID       string
+		Reason      string
+
+		ExpectedEventCount int64
+		ArchivedEventCount int64
+	}
+
+	// Verifier is the type that holds the state for the archival verifier daemon
+	Verifier struct {
+		numShards        int32
+		db               persistence.ExecutionManager
+		client           historyservice.HistoryServiceClient
+		registry         namespace.Registry
+		archiverProvider provider.ArchiverProvider
+		rateLimiter      quotas.RateLimiter
+		metricsHandler   metrics.Handler
+		logger           log.Logger
+		isInTest         bool
+		// only verify workflows closed longer than this age, so that archival has had the chance to complete
+		dataMinAge dynamicconfig.DurationPropertyFn
+		// fraction of history branches to verify, 1.0 walks every branch
+		sampleRate dynamicconfig.FloatPropertyFn
+
+		sync.WaitGroup
+		sync.Mutex
+		hbd VerifierHeartbeatDetails
+	}
+
+	verifyTask struct {
+		shardID     int32
+		namespaceID string
+		workflowID  string
+		runID       string
+	}
+)
+
+const (
+	pageSize  = 100
+	numWorker = 10
+
+	readHistoryPageSize   = 1000
+	maxReportedMismatches = 100
+)
+
+// errTaskSkipped is returned by verifyTask when the workflow is not eligible for verification
+var errTaskSkipped = errors.New("archival verification skipped")
+
+// NewVerifier returns an instance of archival verifier daemon
+// The Verifier can be started by calling the Run() method on the
+// returned object. Calling the Run() method will result in one
+// complete iteration over (a sample of) the history branches in the system.
+// For each closed workflow in a namespace with history archival enabled, the verifier will
+//   - read the archived history through HistoryArchiver.Get
+//   - compare the archived event count against the mutable state
+//   - compare the checksum of the archived events against the events in the primary store
+func NewVerifier(
+	numShards int32,
+	db persistence.ExecutionManager,
+	rps int,
+	client historyservice.HistoryServiceClient,
+	registry namespace.Registry,
+	archiverProvider provider.ArchiverProvider,
+	hbd VerifierHeartbeatDetails,
+	dataMinAge dynamicconfig.DurationPropertyFn,
+	sampleRate dynamicconfig.FloatPropertyFn,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *Verifier {
+
+	return &Verifier{
+		numShards:        numShards,
+		db:               db,
+		client:           client,
+		registry:         registry,
+		archiverProvider: archiverProvider,
+		rateLimiter: quotas.NewDefaultOutgoingRateLimiter(
+			func() float64 { return float64(rps) },
+		),
+		dataMinAge:     dataMinAge,
+		sampleRate:     sampleRate,
+		metricsHandler: metricsHandler.WithTags(metrics.OperationTag(metrics.ArchivalVerifierScope)),
+		logger:         logger,
+
+		hbd: hbd,
+	}
+}
+
+// Run runs the verifier
+func (v *Verifier) Run(ctx context.Context) (VerifierHeartbeatDetails, error) {
+	reqCh := make(chan verifyTask, pageSize)
+	loadErrCh := make(chan error, 1)
+
+	go func() {
+		loadErrCh <- v.loadTasks(ctx, reqCh)
+	}()
+	for i := 0; i < numWorker; i++ {
+		v.WaitGroup.Add(1)
+		go v.taskWorker(ctx, reqCh)
+	}
+
+	v.WaitGroup.Wait()
+	loadErr := <-loadErrCh
+
+	v.Lock()
+	defer v.Unlock()
+	return v.hbd, loadErr
+}
+
+func (v *Verifier) loadTasks(
+	ctx context.Context,
+	reqCh chan verifyTask,
+) error {
+
+	defer close(reqCh)
+
+	iter := collection.NewPagingIteratorWithToken(v.getPaginationFn(ctx), v.hbd.NextPageToken)
+	for iter.HasNext() {
+		item, err := iter.Next()
+		if err != nil {
+			return err
+		}
+
+		// Heartbeat to prevent heartbeat timeout.
+		v.heartbeat(ctx)
+
+		task := v.filterTask(item)
+		if task == nil {
+			continue
+		}
+
+		if err := v.rateLimiter.Wait(ctx); err != nil {
+			// context done
+			return err
+		}
+
+		select {
+		case reqCh <- *task:
+			// noop
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+func (v *Verifier) taskWorker(
+	ctx context.Context,
+	taskCh chan verifyTask,
+) {
+
+	defer v.WaitGroup.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case task, ok := <-taskCh:
+			if !ok {
+				return
+			}
+
+			v.heartbeat(ctx)
+			mismatch, err := v.verifyTask(ctx, task)
+			v.handleResult(task, mismatch, err)
+		}
+	}
+}
+
+func (v *Verifier) heartbeat(ctx context.Context) {
+	v.Lock()
+	defer v.Unlock()
+
+	if !v.isInTest {
+		activity.RecordHeartbeat(ctx, v.hbd)
+	}
+}
+
+func (v *Verifier) filterTask(
+	branch persistence.HistoryBranchDetail,
+) *verifyTask {
+
+	if rand.Float64() >= v.sampleRate() {
+		return nil
+	}
+
+	namespaceID, workflowID, runID, err := persistence.SplitHistoryGarbageCleanupInfo(branch.Info)
+	if err != nil {
+		v.logger.Error("unable to parse the history branch info", tag.DetailInfo(branch.Info))
+		v.handleResult(verifyTask{}, nil, err)
+		return nil
+	}
+
+	return &verifyTask{
+		shardID:     common.WorkflowIDToHistoryShard(namespaceID, workflowID, v.numShards),
+		namespaceID: namespaceID,
+		workflowID:  workflowID,
+		runID:       runID,
+	}
+}
+
+// verifyTask returns a non-nil mismatch if the archived history of the given workflow does not match
+// the primary store, errTaskSkipped if the workflow is not eligible for verification, or nil mismatch
+// and nil error if the workflow was verified.
+func (v *Verifier) verifyTask(
+	ctx context.Context,
+	task verifyTask,
+) (*Mismatch, error) {
+
+	resp, err := v.client.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: task.namespaceID,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: task.workflowID,
+			RunId:      task.runID,
+		},
+	})
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		// history garbage, will be handled by history scavenger
+		return nil, errTaskSkipped
+	default:
+		return nil, err
+	}
+
+	mutableState := resp.GetDatabaseMutableState()
+	if !v.shouldVerify(mutableState) {
+		return nil, errTaskSkipped
+	}
+
+	ns, err := v.registry.GetNamespaceByID(namespace.ID(task.namespaceID))
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NamespaceNotFound:
+		return nil, errTaskSkipped
+	default:
+		return nil, err
+	}
+	archivalState := ns.HistoryArchivalState()
+	if archivalState.State != enumspb.ARCHIVAL_STATE_ENABLED || archivalState.URI == "" {
+		return nil, errTaskSkipped
+	}
+
+	URI, err := carchiver.NewURI(archivalState.URI)
+	if err != nil {
+		return nil, err
+	}
+	historyArchiver, err := v.archiverProvider.GetHistoryArchiver(URI.Scheme(), string(primitives.WorkerService))
+	if err != nil {
+		return nil, err
+	}
+
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(mutableState.GetExecutionInfo().GetVersionHistories())
+	if err != nil {
+		return nil, err
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(currentVersionHistory)
+	if err != nil {
+		return nil, err
+	}
+
+	archivedEvents, err := readArchivedHistory(ctx, historyArchiver, URI, task, lastItem.GetVersion())
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		return newMismatch(task, MismatchReasonMissing, lastItem.GetEventId(), 0), nil
+	default:
+		return nil, err
+	}
+
+	archivedCount := int64(len(archivedEvents))
+	if archivedCount == 0 || archivedEvents[archivedCount-1].GetEventId() < lastItem.GetEventId() {
+		return newMismatch(task, MismatchReasonTruncated, lastItem.GetEventId(), archivedCount), nil
+	}
+	if archivedCount != lastItem.GetEventId() {
+		return newMismatch(task, MismatchReasonEventCount, lastItem.GetEventId(), archivedCount), nil
+	}
+
+	primaryEvents, err := v.readPrimaryHistory(ctx, task, currentVersionHistory.GetBranchToken(), lastItem.GetEventId()+1)
+	if err != nil {
+		return nil, err
+	}
+	equal, err := checksumEqual(archivedEvents, primaryEvents)
+	if err != nil {
+		return nil, err
+	}
+	if !equal {
+		return newMismatch(task, MismatchReasonChecksum, lastItem.GetEventId(), archivedCount), nil
+	}
+	return nil, nil
+}
+
+func (v *Verifier) shouldVerify(
+	mutableState *persistencespb.WorkflowMutableState,
+) bool {
+	if mutableState.GetExecutionState().GetState() != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+		return false
+	}
+	closeTime := mutableState.GetExecutionInfo().GetCloseTime()
+	if closeTime == nil {
+		closeTime = mutableState.GetExecutionInfo().GetLastUpdateTime()
+	}
+	return time.Now().UTC().Add(-v.dataMinAge()).After(timestamp.TimeValue(closeTime))
+}
+
+func (v *Verifier) readPrimaryHistory(
+	ctx context.Context,
+	task verifyTask,
+	branchToken []byte,
+	nextEventID int64,
+) ([]*historypb.HistoryEvent, error) {
+	var events []*historypb.HistoryEvent
+	var pageToken []byte
+	for {
+		resp, err := v.db.ReadHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+			ShardID:       task.shardID,
+			BranchToken:   branchToken,
+			MinEventID:    common.FirstEventID,
+			MaxEventID:    nextEventID,
+			PageSize:      readHistoryPageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, resp.HistoryEvents...)
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return events, nil
+		}
+	}
+}
+
+func readArchivedHistory(
+	ctx context.Context,
+	historyArchiver carchiver.HistoryArchiver,
+	URI carchiver.URI,
+	task verifyTask,
+	closeFailoverVersion int64,
+) ([]*historypb.HistoryEvent, error) {
+	var events []*historypb.HistoryEvent
+	request := &carchiver.GetHistoryRequest{
+		NamespaceID:          task.namespaceID,
+		WorkflowID:           task.workflowID,
+		RunID:                task.runID,
+		CloseFailoverVersion: &closeFailoverVersion,
+		PageSize:             readHistoryPageSize,
+	}
+	for {
+		resp, err := historyArchiver.Get(ctx, URI, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, batch := range resp.HistoryBatches {
+			events = append(events, batch.Events...)
+		}
+		if len(resp.NextPageToken) == 0 {
+			return events, nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
+func checksumEqual(
+	archivedEvents []*historypb.HistoryEvent,
+	primaryEvents []*historypb.HistoryEvent,
+) (bool, error) {
+	archivedChecksum, err := checksum.GenerateCRC32(&historypb.History{Events: archivedEvents}, 0)
+	if err != nil {
+		return false, err
+	}
+	primaryChecksum, err := checksum.GenerateCRC32(&historypb.History{Events: primaryEvents}, 0)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(archivedChecksum.GetValue(), primaryChecksum.GetValue()), nil
+}
+
+func newMismatch(
+	task verifyTask,
+	reason string,
+	expectedEventCount int64,
+	archivedEventCount int64,
+) *Mismatch {
+	return &Mismatch{
+		NamespaceID:        task.namespaceID,
+		WorkflowID:         task.workflowID,
+		RunID:              task.runID,
+		Reason:             reason,
+		ExpectedEventCount: expectedEventCount,
+		ArchivedEventCount: archivedEventCount,
+	}
+}
+
+func (v *Verifier) skip() {
+	v.metricsHandler.Counter(metrics.ArchivalVerifierSkipCount.GetMetricName()).Record(1)
+
+	v.Lock()
+	defer v.Unlock()
+	v.hbd.SkipCount++
+}
+
+func (v *Verifier) handleResult(
+	task verifyTask,
+	mismatch *Mismatch,
+	err error,
+) {
+	if err == errTaskSkipped {
+		v.skip()
+		return
+	}
+	if err != nil {
+		v.logger.Error("encountered error when verifying archived history", getTaskLoggingTags(err, task)...)
+		v.metricsHandler.Counter(metrics.ArchivalVerifierErrorCount.GetMetricName()).Record(1)
+
+		v.Lock()
+		defer v.Unlock()
+		v.hbd.ErrorCount++
+		return
+	}
+
+	if mismatch != nil {
+		v.logger.Warn("archived history does not match primary store",
+			append(getTaskLoggingTags(nil, task),
+				tag.ArchivalBlobIntegrityCheckFailReason(mismatch.Reason),
+				tag.WorkflowNextEventID(mismatch.ExpectedEventCount+1),
+				tag.NewInt64("archived-event-count", mismatch.ArchivedEventCount),
+			)...,
+		)
+		v.metricsHandler.Counter(
+			metrics.ArchivalVerifierMismatchCount.GetMetricName(),
+		).Record(1, metrics.FailureTag(mismatch.Reason))
+
+		v.Lock()
+		defer v.Unlock()
+		v.hbd.MismatchCount++
+		v.hbd.Mismatches = append(v.hbd.Mismatches, *mismatch)
+		if len(v.hbd.Mismatches) > maxReportedMismatches {
+			v.hbd.Mismatches = v.hbd.Mismatches[len(v.hbd.Mismatches)-maxReportedMismatches:]
+		}
+		return
+	}
+
+	v.metricsHandler.Counter(metrics.ArchivalVerifierSuccessCount.GetMetricName()).Record(1)
+
+	v.Lock()
+	defer v.Unlock()
+	v.hbd.VerifiedCount++
+}
+
+func (v *Verifier) getPaginationFn(
+	ctx context.Context,
+) collection.PaginationFn[persistence.HistoryBranchDetail] {
+	return func(paginationToken []byte) ([]persistence.HistoryBranchDetail, []byte, error) {
+		req := &persistence.GetAllHistoryTreeBranchesRequest{
+			PageSize:      pageSize,
+			NextPageToken: paginationToken,
+		}
+		resp, err := v.db.GetAllHistoryTreeBranches(ctx, req)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		v.Lock()
+		v.hbd.CurrentPage++
+		v.hbd.NextPageToken = resp.NextPageToken
+		v.Unlock()
+
+		return resp.Branches, resp.NextPageToken, nil
+	}
+}
+
+func getTaskLoggingTags(err error, task verifyTask) []tag.Tag {
+	tags := []tag.Tag{
+		tag.WorkflowNamespaceID(task.namespaceID),
+		tag.WorkflowID(task.workflowID),
+		tag.WorkflowRunID(task.runID),
+	}
+	if err != nil {
+		tags = append(tags, tag.Error(err))
+	}
+	return tags
+}
@@ -0,0 +1,281 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archival
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	carchiver "go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	testNamespaceID = "deadbeef-0123-4567-890a-bcdef0123456"
+	testWorkflowID  = "test-workflow-id"
+	testRunID       = "test-run-id"
+	testArchivalURI = "test:///archival/path"
+	testVersion     = int64(100)
+)
+
+type (
+	verifierSuite struct {
+		suite.Suite
+		controller *gomock.Controller
+
+		mockExecutionManager *persistence.MockExecutionManager
+		mockHistoryClient    *historyservicemock.MockHistoryServiceClient
+		mockRegistry         *namespace.MockRegistry
+		mockArchiverProvider *provider.MockArchiverProvider
+		mockHistoryArchiver  *carchiver.MockHistoryArchiver
+
+		verifier *Verifier
+	}
+)
+
+func TestVerifierSuite(t *testing.T) {
+	suite.Run(t, new(verifierSuite))
+}
+
+func (s *verifierSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockExecutionManager = persistence.NewMockExecutionManager(s.controller)
+	s.mockHistoryClient = historyservicemock.NewMockHistoryServiceClient(s.controller)
+	s.mockRegistry = namespace.NewMockRegistry(s.controller)
+	s.mockArchiverProvider = provider.NewMockArchiverProvider(s.controller)
+	s.mockHistoryArchiver = carchiver.NewMockHistoryArchiver(s.controller)
+
+	s.verifier = NewVerifier(
+		1,
+		s.mockExecutionManager,
+		100,
+		s.mockHistoryClient,
+		s.mockRegistry,
+		s.mockArchiverProvider,
+		VerifierHeartbeatDetails{},
+		dynamicconfig.GetDurationPropertyFn(time.Hour),
+		dynamicconfig.GetFloatPropertyFn(1.0),
+		metrics.NoopMetricsHandler,
+		log.NewTestLogger(),
+	)
+	s.verifier.isInTest = true
+}
+
+func (s *verifierSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *verifierSuite) TestRun_Verified() {
+	s.mockBranches()
+	s.mockMutableState(enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, 3)
+	s.mockNamespace(enumspb.ARCHIVAL_STATE_ENABLED)
+	s.mockArchivedHistory(testEvents(3), nil)
+	s.mockPrimaryHistory(testEvents(3))
+
+	hbd, err := s.verifier.Run(context.Background())
+	s.NoError(err)
+	s.Equal(1, hbd.VerifiedCount)
+	s.Equal(0, hbd.MismatchCount)
+	s.Empty(hbd.Mismatches)
+}
+
+func (s *verifierSuite) TestRun_Missing() {
+	s.mockBranches()
+	s.mockMutableState(enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, 3)
+	s.mockNamespace(enumspb.ARCHIVAL_STATE_ENABLED)
+	s.mockArchivedHistory(nil, serviceerror.NewNotFound("history not found"))
+
+	hbd, err := s.verifier.Run(context.Background())
+	s.NoError(err)
+	s.Equal(1, hbd.MismatchCount)
+	s.Equal(MismatchReasonMissing, hbd.Mismatches[0].Reason)
+}
+
+func (s *verifierSuite) TestRun_Truncated() {
+	s.mockBranches()
+	s.mockMutableState(enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, 3)
+	s.mockNamespace(enumspb.ARCHIVAL_STATE_ENABLED)
+	s.mockArchivedHistory(testEvents(2), nil)
+
+	hbd, err := s.verifier.Run(context.Background())
+	s.NoError(err)
+	s.Equal(1, hbd.MismatchCount)
+	s.Equal(MismatchReasonTruncated, hbd.Mismatches[0].Reason)
+	s.Equal(int64(3), hbd.Mismatches[0].ExpectedEventCount)
+	s.Equal(int64(2), hbd.Mismatches[0].ArchivedEventCount)
+}
+
+func (s *verifierSuite) TestRun_ChecksumMismatch() {
+	s.mockBranches()
+	s.mockMutableState(enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, 3)
+	s.mockNamespace(enumspb.ARCHIVAL_STATE_ENABLED)
+	archivedEvents := testEvents(3)
+	archivedEvents[1].TaskId = 12345
+	s.mockArchivedHistory(archivedEvents, nil)
+	s.mockPrimaryHistory(testEvents(3))
+
+	hbd, err := s.verifier.Run(context.Background())
+	s.NoError(err)
+	s.Equal(1, hbd.MismatchCount)
+	s.Equal(MismatchReasonChecksum, hbd.Mismatches[0].Reason)
+}
+
+func (s *verifierSuite) TestRun_SkipRunningWorkflow() {
+	s.mockBranches()
+	s.mockMutableState(enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, 3)
+
+	hbd, err := s.verifier.Run(context.Background())
+	s.NoError(err)
+	s.Equal(1, hbd.SkipCount)
+	s.Equal(0, hbd.VerifiedCount)
+}
+
+func (s *verifierSuite) TestRun_SkipArchivalDisabled() {
+	s.mockBranches()
+	s.mockMutableState(enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, 3)
+	s.mockNamespace(enumspb.ARCHIVAL_STATE_DISABLED)
+
+	hbd, err := s.verifier.Run(context.Background())
+	s.NoError(err)
+	s.Equal(1, hbd.SkipCount)
+	s.Equal(0, hbd.VerifiedCount)
+}
+
+func (s *verifierSuite) TestRun_LoadTasksError() {
+	s.mockExecutionManager.EXPECT().GetAllHistoryTreeBranches(gomock.Any(), gomock.Any()).Return(
+		nil, serviceerror.NewUnavailable("persistence unavailable"))
+
+	hbd, err := s.verifier.Run(context.Background())
+	s.Error(err)
+	s.Equal(0, hbd.VerifiedCount)
+}
+
+func (s *verifierSuite) mockBranches() {
+	s.mockExecutionManager.EXPECT().GetAllHistoryTreeBranches(gomock.Any(), gomock.Any()).Return(
+		&persistence.GetAllHistoryTreeBranchesResponse{
+			Branches: []persistence.HistoryBranchDetail{
+				{
+					BranchToken: []byte("branch-token"),
+					Info:        persistence.BuildHistoryGarbageCleanupInfo(testNamespaceID, testWorkflowID, testRunID),
+				},
+			},
+		}, nil)
+}
+
+func (s *verifierSuite) mockMutableState(state enumsspb.WorkflowExecutionState, lastEventID int64) {
+	closeTime := time.Now().UTC().Add(-2 * time.Hour)
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(
+		&historyservice.DescribeMutableStateResponse{
+			DatabaseMutableState: &persistencespb.WorkflowMutableState{
+				ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+					NamespaceId: testNamespaceID,
+					WorkflowId:  testWorkflowID,
+					CloseTime:   &closeTime,
+					VersionHistories: &historyspb.VersionHistories{
+						CurrentVersionHistoryIndex: 0,
+						Histories: []*historyspb.VersionHistory{
+							{
+								BranchToken: []byte("branch-token"),
+								Items: []*historyspb.VersionHistoryItem{
+									{EventId: lastEventID, Version: testVersion},
+								},
+							},
+						},
+					},
+				},
+				ExecutionState: &persistencespb.WorkflowExecutionState{
+					RunId: testRunID,
+					State: state,
+				},
+				NextEventId: lastEventID + 1,
+			},
+		}, nil)
+}
+
+func (s *verifierSuite) mockNamespace(state enumspb.ArchivalState) {
+	s.mockRegistry.EXPECT().GetNamespaceByID(namespace.ID(testNamespaceID)).Return(
+		namespace.NewLocalNamespaceForTest(
+			&persistencespb.NamespaceInfo{Id: testNamespaceID, Name: "test-namespace"},
+			&persistencespb.NamespaceConfig{
+				Retention:            timestamp.DurationFromDays(1),
+				HistoryArchivalState: state,
+				HistoryArchivalUri:   testArchivalURI,
+			},
+			cluster.TestCurrentClusterName,
+		), nil)
+}
+
+func (s *verifierSuite) mockArchivedHistory(events []*historypb.HistoryEvent, err error) {
+	s.mockArchiverProvider.EXPECT().GetHistoryArchiver("test", string(primitives.WorkerService)).Return(s.mockHistoryArchiver, nil)
+	if err != nil {
+		s.mockHistoryArchiver.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, err)
+		return
+	}
+	s.mockHistoryArchiver.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ carchiver.URI, request *carchiver.GetHistoryRequest) (*carchiver.GetHistoryResponse, error) {
+			s.Equal(testVersion, *request.CloseFailoverVersion)
+			return &carchiver.GetHistoryResponse{
+				HistoryBatches: []*historypb.History{{Events: events}},
+			}, nil
+		})
+}
+
+func (s *verifierSuite) mockPrimaryHistory(events []*historypb.HistoryEvent) {
+	s.mockExecutionManager.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).Return(
+		&persistence.ReadHistoryBranchResponse{
+			HistoryEvents: events,
+		}, nil)
+}
+
+func testEvents(count int) []*historypb.HistoryEvent {
+	events := make([]*historypb.HistoryEvent, 0, count)
+	for i := 1; i <= count; i++ {
+		events = append(events, &historypb.HistoryEvent{
+			EventId: int64(i),
+			Version: testVersion,
+		})
+	}
+	return events
+}
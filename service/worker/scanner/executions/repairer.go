@@ -0,0 +1,419 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
+)
+
+const (
+	// RepairModeOff only reports validation failures
+	RepairModeOff = "off"
+	// RepairModeDryRun reports the fixes repair rules would apply without applying them
+	RepairModeDryRun = "dryrun"
+	// RepairModeRepair applies the fixes of repair rules
+	RepairModeRepair = "repair"
+
+	repairRefreshTasksAction           = "refresh_tasks"
+	repairRebuildMutableStateAction    = "rebuild_mutable_state"
+	repairDeleteCurrentExecutionAction = "delete_current_execution"
+
+	// same as workflow.TimerTaskStatusNone, a timer whose task was never created
+	timerTaskStatusNone = 0
+)
+
+type (
+	// RepairAction is a fix proposed by a repair rule for a single execution
+	RepairAction struct {
+		Action      string
+		NamespaceID string
+		WorkflowID  string
+		RunID       string
+		Details     string
+	}
+
+	// RepairReport counts the fixes proposed, and applied unless dry run, per action
+	RepairReport struct {
+		sync.Mutex
+		Proposed map[string]int
+		Applied  map[string]int
+	}
+
+	// repairRule proposes and applies a single kind of fix
+	repairRule interface {
+		Check(ctx context.Context, mutableState *MutableState, results []MutableStateValidationResult) (*RepairAction, error)
+		Apply(ctx context.Context, action *RepairAction) error
+	}
+
+	// repairer runs the repair rules against an execution, according to the repair mode of its namespace
+	repairer struct {
+		registry       namespace.Registry
+		repairMode     dynamicconfig.StringPropertyFnWithNamespaceFilter
+		rules          []repairRule
+		report         *RepairReport
+		metricsHandler metrics.Handler
+		logger         log.Logger
+	}
+
+	missingTimerTasksRule struct {
+		adminClient adminservice.AdminServiceClient
+	}
+
+	mutableStateCountersRule struct {
+		registry    namespace.Registry
+		adminClient adminservice.AdminServiceClient
+	}
+
+	danglingCurrentExecutionRule struct {
+		shardID          int32
+		executionManager persistence.ExecutionManager
+	}
+)
+
+var _ repairRule = (*missingTimerTasksRule)(nil)
+var _ repairRule = (*mutableStateCountersRule)(nil)
+var _ repairRule = (*danglingCurrentExecutionRule)(nil)
+
+// NewRepairReport returns an empty repair report
+func NewRepairReport() *RepairReport {
+	return &RepairReport{
+		Proposed: make(map[string]int),
+		Applied:  make(map[string]int),
+	}
+}
+
+func (r *RepairReport) record(action string, applied bool) {
+	r.Lock()
+	defer r.Unlock()
+	r.Proposed[action]++
+	if applied {
+		r.Applied[action]++
+	}
+}
+
+// String returns a summary of the report
+func (r *RepairReport) String() string {
+	r.Lock()
+	defer r.Unlock()
+	return fmt.Sprintf("proposed: %v, applied: %v", r.Proposed, r.Applied)
+}
+
+func newRepairer(
+	shardID int32,
+	executionManager persistence.ExecutionManager,
+	registry namespace.Registry,
+	adminClient adminservice.AdminServiceClient,
+	repairMode dynamicconfig.StringPropertyFnWithNamespaceFilter,
+	report *RepairReport,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *repairer {
+	return &repairer{
+		registry:   registry,
+		repairMode: repairMode,
+		// rules are tried in order, and at most one fix is applied per execution per scan:
+		// rebuilding mutable state also regenerates its tasks.
+		rules: []repairRule{
+			&mutableStateCountersRule{registry: registry, adminClient: adminClient},
+			&missingTimerTasksRule{adminClient: adminClient},
+			&danglingCurrentExecutionRule{shardID: shardID, executionManager: executionManager},
+		},
+		report:         report,
+		metricsHandler: metricsHandler,
+		logger:         logger,
+	}
+}
+
+// repair finds the first repair rule that applies to the execution and, unless the namespace
+// is in dry run mode, applies its fix.
+func (r *repairer) repair(
+	ctx context.Context,
+	mutableState *MutableState,
+	results []MutableStateValidationResult,
+) error {
+	for _, result := range results {
+		if result.failureType == mutableStateRetentionFailureType {
+			// expired executions are deleted instead
+			return nil
+		}
+	}
+
+	ns, err := r.registry.GetNamespaceByID(namespace.ID(mutableState.GetExecutionInfo().GetNamespaceId()))
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
+		return nil
+	default:
+		return err
+	}
+
+	mode := r.repairMode(ns.Name().String())
+	if mode != RepairModeDryRun && mode != RepairModeRepair {
+		return nil
+	}
+
+	for _, rule := range r.rules {
+		action, err := rule.Check(ctx, mutableState, results)
+		if err != nil {
+			return err
+		}
+		if action == nil {
+			continue
+		}
+
+		dryRun := mode == RepairModeDryRun
+		metricsTags := []metrics.Tag{
+			metrics.RepairActionTag(action.Action),
+			metrics.NamespaceTag(ns.Name().String()),
+		}
+		r.metricsHandler.Counter(metrics.ScavengerRepairProposedCount.GetMetricName()).Record(1, metricsTags...)
+		r.logger.Info("executions scanner repair",
+			tag.WorkflowNamespace(ns.Name().String()),
+			tag.WorkflowID(action.WorkflowID),
+			tag.WorkflowRunID(action.RunID),
+			tag.Key(action.Action),
+			tag.Value(action.Details),
+			tag.NewBoolTag("dry-run", dryRun),
+		)
+		if !dryRun {
+			if err := rule.Apply(ctx, action); err != nil {
+				return err
+			}
+			r.metricsHandler.Counter(metrics.ScavengerRepairAppliedCount.GetMetricName()).Record(1, metricsTags...)
+		}
+		r.report.record(action.Action, !dryRun)
+		return nil
+	}
+	return nil
+}
+
+// Check proposes to refresh the tasks of a running workflow which has pending
+// timers or activities but none of them has a timer task.
+func (m *missingTimerTasksRule) Check(
+	_ context.Context,
+	mutableState *MutableState,
+	_ []MutableStateValidationResult,
+) (*RepairAction, error) {
+	if mutableState.GetExecutionState().GetState() != enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING {
+		return nil, nil
+	}
+
+	var details string
+	if len(mutableState.TimerInfos) > 0 {
+		created := false
+		for _, timer := range mutableState.TimerInfos {
+			if timer.GetTaskStatus() != timerTaskStatusNone {
+				created = true
+				break
+			}
+		}
+		if !created {
+			details = fmt.Sprintf("none of %d pending timers has a timer task", len(mutableState.TimerInfos))
+		}
+	}
+	if details == "" && len(mutableState.ActivityInfos) > 0 {
+		created := false
+		for _, activity := range mutableState.ActivityInfos {
+			if activity.GetTimerTaskStatus() != timerTaskStatusNone {
+				created = true
+				break
+			}
+		}
+		if !created {
+			details = fmt.Sprintf("none of %d pending activities has a timeout timer task", len(mutableState.ActivityInfos))
+		}
+	}
+	if details == "" {
+		return nil, nil
+	}
+	return newRepairAction(repairRefreshTasksAction, mutableState, details), nil
+}
+
+func (m *missingTimerTasksRule) Apply(
+	ctx context.Context,
+	action *RepairAction,
+) error {
+	_, err := m.adminClient.RefreshWorkflowTasks(ctx, &adminservice.RefreshWorkflowTasksRequest{
+		NamespaceId: action.NamespaceID,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: action.WorkflowID,
+			RunId:      action.RunID,
+		},
+	})
+	return ignoreNotFound(err)
+}
+
+// Check proposes to rebuild mutable state from history when its event ID counters
+// disagree with its version history.
+func (m *mutableStateCountersRule) Check(
+	_ context.Context,
+	mutableState *MutableState,
+	results []MutableStateValidationResult,
+) (*RepairAction, error) {
+	for _, result := range results {
+		switch result.failureType {
+		case mutableStateActivityIDFailureType,
+			mutableStateTimerIDFailureType,
+			mutableStateChildWorkflowIDFailureType,
+			mutableStateRequestCancelIDFailureType,
+			mutableStateSignalIDFailureType:
+			return newRepairAction(repairRebuildMutableStateAction, mutableState, result.failureDetails), nil
+		}
+	}
+
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(
+		mutableState.GetExecutionInfo().GetVersionHistories(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(currentVersionHistory)
+	if err != nil {
+		return nil, err
+	}
+	if mutableState.GetNextEventId() != lastItem.GetEventId()+1 {
+		return newRepairAction(repairRebuildMutableStateAction, mutableState, fmt.Sprintf(
+			"NextEventID: %d does not follow last event ID: %d",
+			mutableState.GetNextEventId(),
+			lastItem.GetEventId(),
+		)), nil
+	}
+	return nil, nil
+}
+
+func (m *mutableStateCountersRule) Apply(
+	ctx context.Context,
+	action *RepairAction,
+) error {
+	ns, err := m.registry.GetNamespaceByID(namespace.ID(action.NamespaceID))
+	if err != nil {
+		return ignoreNotFound(err)
+	}
+	_, err = m.adminClient.RebuildMutableState(ctx, &adminservice.RebuildMutableStateRequest{
+		Namespace: ns.Name().String(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: action.WorkflowID,
+			RunId:      action.RunID,
+		},
+	})
+	return ignoreNotFound(err)
+}
+
+// Check proposes to delete the current execution record of the workflow ID when
+// it points to a run which no longer exists.
+func (m *danglingCurrentExecutionRule) Check(
+	ctx context.Context,
+	mutableState *MutableState,
+	_ []MutableStateValidationResult,
+) (*RepairAction, error) {
+	executionInfo := mutableState.GetExecutionInfo()
+	resp, err := m.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     m.shardID,
+		NamespaceID: executionInfo.GetNamespaceId(),
+		WorkflowID:  executionInfo.GetWorkflowId(),
+	})
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		return nil, nil
+	default:
+		return nil, err
+	}
+	if resp.RunID == mutableState.GetExecutionState().GetRunId() {
+		return nil, nil
+	}
+
+	_, err = m.executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     m.shardID,
+		NamespaceID: executionInfo.GetNamespaceId(),
+		WorkflowID:  executionInfo.GetWorkflowId(),
+		RunID:       resp.RunID,
+	})
+	switch err.(type) {
+	case nil:
+		return nil, nil
+	case *serviceerror.NotFound:
+		return &RepairAction{
+			Action:      repairDeleteCurrentExecutionAction,
+			NamespaceID: executionInfo.GetNamespaceId(),
+			WorkflowID:  executionInfo.GetWorkflowId(),
+			RunID:       resp.RunID,
+			Details:     fmt.Sprintf("current execution points to missing run: %s", resp.RunID),
+		}, nil
+	default:
+		return nil, err
+	}
+}
+
+func (m *danglingCurrentExecutionRule) Apply(
+	ctx context.Context,
+	action *RepairAction,
+) error {
+	// conditional on RunID, so a current record updated since the check is left untouched
+	return m.executionManager.DeleteCurrentWorkflowExecution(ctx, &persistence.DeleteCurrentWorkflowExecutionRequest{
+		ShardID:     m.shardID,
+		NamespaceID: action.NamespaceID,
+		WorkflowID:  action.WorkflowID,
+		RunID:       action.RunID,
+	})
+}
+
+func newRepairAction(
+	action string,
+	mutableState *MutableState,
+	details string,
+) *RepairAction {
+	return &RepairAction{
+		Action:      action,
+		NamespaceID: mutableState.GetExecutionInfo().GetNamespaceId(),
+		WorkflowID:  mutableState.GetExecutionInfo().GetWorkflowId(),
+		RunID:       mutableState.GetExecutionState().GetRunId(),
+		Details:     details,
+	}
+}
+
+func ignoreNotFound(err error) error {
+	switch err.(type) {
+	case *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
+		return nil
+	default:
+		return err
+	}
+}
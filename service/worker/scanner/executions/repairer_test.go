@@ -0,0 +1,261 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/adminservicemock/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
+)
+
+const (
+	testShardID       = int32(1)
+	testNamespaceID   = "deadbeef-0123-4567-890a-bcdef0123456"
+	testNamespaceName = "test-namespace"
+	testWorkflowID    = "test-workflow-id"
+	testRunID         = "test-run-id"
+)
+
+type (
+	repairerSuite struct {
+		suite.Suite
+		controller *gomock.Controller
+
+		mockExecutionManager *persistence.MockExecutionManager
+		mockAdminClient      *adminservicemock.MockAdminServiceClient
+		mockRegistry         *namespace.MockRegistry
+
+		repairMode     string
+		report         *RepairReport
+		metricsHandler *metricstest.Handler
+		repairer       *repairer
+	}
+)
+
+func TestRepairerSuite(t *testing.T) {
+	suite.Run(t, new(repairerSuite))
+}
+
+func (s *repairerSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockExecutionManager = persistence.NewMockExecutionManager(s.controller)
+	s.mockAdminClient = adminservicemock.NewMockAdminServiceClient(s.controller)
+	s.mockRegistry = namespace.NewMockRegistry(s.controller)
+	s.mockRegistry.EXPECT().GetNamespaceByID(namespace.ID(testNamespaceID)).Return(
+		namespace.NewLocalNamespaceForTest(
+			&persistencespb.NamespaceInfo{Id: testNamespaceID, Name: testNamespaceName},
+			nil,
+			"active",
+		), nil,
+	).AnyTimes()
+
+	s.repairMode = RepairModeRepair
+	s.report = NewRepairReport()
+	s.metricsHandler = metricstest.MustNewHandler(log.NewNoopLogger())
+	s.repairer = newRepairer(
+		testShardID,
+		s.mockExecutionManager,
+		s.mockRegistry,
+		s.mockAdminClient,
+		func(namespace string) string {
+			s.Equal(testNamespaceName, namespace)
+			return s.repairMode
+		},
+		s.report,
+		s.metricsHandler,
+		log.NewNoopLogger(),
+	)
+}
+
+func (s *repairerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *repairerSuite) TestRepair_Off() {
+	s.repairMode = RepairModeOff
+	mutableState := s.newMutableState(10)
+	mutableState.NextEventId = 5
+
+	s.NoError(s.repairer.repair(context.Background(), mutableState, nil))
+	s.Empty(s.report.Proposed)
+}
+
+func (s *repairerSuite) TestRepair_DryRun() {
+	s.repairMode = RepairModeDryRun
+	mutableState := s.newMutableState(10)
+	mutableState.NextEventId = 5
+
+	s.NoError(s.repairer.repair(context.Background(), mutableState, nil))
+	s.Equal(map[string]int{repairRebuildMutableStateAction: 1}, s.report.Proposed)
+	s.Empty(s.report.Applied)
+
+	snapshot := s.metricsHandler.MustSnapshot()
+	s.Equal(float64(1), snapshot.MustCounter(metrics.ScavengerRepairProposedCount.GetMetricName(), s.repairMetricsTags(repairRebuildMutableStateAction)...))
+	_, err := snapshot.Counter(metrics.ScavengerRepairAppliedCount.GetMetricName(), s.repairMetricsTags(repairRebuildMutableStateAction)...)
+	s.Error(err)
+}
+
+func (s *repairerSuite) TestRepair_SkipExpired() {
+	mutableState := s.newMutableState(10)
+	mutableState.NextEventId = 5
+
+	s.NoError(s.repairer.repair(context.Background(), mutableState, []MutableStateValidationResult{{
+		failureType: mutableStateRetentionFailureType,
+	}}))
+	s.Empty(s.report.Proposed)
+}
+
+func (s *repairerSuite) TestRepair_RebuildMutableState() {
+	mutableState := s.newMutableState(10)
+
+	s.mockAdminClient.EXPECT().RebuildMutableState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *adminservice.RebuildMutableStateRequest, _ ...interface{}) (*adminservice.RebuildMutableStateResponse, error) {
+			s.Equal(testNamespaceName, request.Namespace)
+			s.Equal(testWorkflowID, request.Execution.WorkflowId)
+			s.Equal(testRunID, request.Execution.RunId)
+			return &adminservice.RebuildMutableStateResponse{}, nil
+		},
+	)
+
+	s.NoError(s.repairer.repair(context.Background(), mutableState, []MutableStateValidationResult{{
+		failureType:    mutableStateActivityIDFailureType,
+		failureDetails: "ActivityEventID: 12 is not less than last event ID: 10",
+	}}))
+	s.Equal(map[string]int{repairRebuildMutableStateAction: 1}, s.report.Applied)
+
+	snapshot := s.metricsHandler.MustSnapshot()
+	s.Equal(float64(1), snapshot.MustCounter(metrics.ScavengerRepairProposedCount.GetMetricName(), s.repairMetricsTags(repairRebuildMutableStateAction)...))
+	s.Equal(float64(1), snapshot.MustCounter(metrics.ScavengerRepairAppliedCount.GetMetricName(), s.repairMetricsTags(repairRebuildMutableStateAction)...))
+}
+
+func (s *repairerSuite) TestRepair_RefreshMissingTimerTasks() {
+	mutableState := s.newMutableState(10)
+	mutableState.TimerInfos = map[string]*persistencespb.TimerInfo{
+		"timer-1": {TimerId: "timer-1", StartedEventId: 5, TaskStatus: timerTaskStatusNone},
+		"timer-2": {TimerId: "timer-2", StartedEventId: 6, TaskStatus: timerTaskStatusNone},
+	}
+
+	s.mockAdminClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *adminservice.RefreshWorkflowTasksRequest, _ ...interface{}) (*adminservice.RefreshWorkflowTasksResponse, error) {
+			s.Equal(testNamespaceID, request.NamespaceId)
+			s.Equal(testRunID, request.Execution.RunId)
+			return &adminservice.RefreshWorkflowTasksResponse{}, nil
+		},
+	)
+
+	s.NoError(s.repairer.repair(context.Background(), mutableState, nil))
+	s.Equal(map[string]int{repairRefreshTasksAction: 1}, s.report.Applied)
+}
+
+func (s *repairerSuite) TestRepair_TimerTaskCreated() {
+	mutableState := s.newMutableState(10)
+	mutableState.TimerInfos = map[string]*persistencespb.TimerInfo{
+		"timer-1": {TimerId: "timer-1", StartedEventId: 5, TaskStatus: 1},
+		"timer-2": {TimerId: "timer-2", StartedEventId: 6, TaskStatus: timerTaskStatusNone},
+	}
+	s.expectCurrentExecution(testRunID)
+
+	s.NoError(s.repairer.repair(context.Background(), mutableState, nil))
+	s.Empty(s.report.Proposed)
+}
+
+func (s *repairerSuite) TestRepair_DanglingCurrentExecution() {
+	mutableState := s.newMutableState(10)
+	mutableState.ExecutionState.State = enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED
+	s.expectCurrentExecution("missing-run-id")
+	s.mockExecutionManager.EXPECT().GetWorkflowExecution(gomock.Any(), &persistence.GetWorkflowExecutionRequest{
+		ShardID:     testShardID,
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       "missing-run-id",
+	}).Return(nil, serviceerror.NewNotFound(""))
+	s.mockExecutionManager.EXPECT().DeleteCurrentWorkflowExecution(gomock.Any(), &persistence.DeleteCurrentWorkflowExecutionRequest{
+		ShardID:     testShardID,
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       "missing-run-id",
+	}).Return(nil)
+
+	s.NoError(s.repairer.repair(context.Background(), mutableState, nil))
+	s.Equal(map[string]int{repairDeleteCurrentExecutionAction: 1}, s.report.Applied)
+}
+
+func (s *repairerSuite) TestRepair_CurrentExecutionExists() {
+	mutableState := s.newMutableState(10)
+	mutableState.ExecutionState.State = enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED
+	s.expectCurrentExecution("newer-run-id")
+	s.mockExecutionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{}, nil)
+
+	s.NoError(s.repairer.repair(context.Background(), mutableState, nil))
+	s.Empty(s.report.Proposed)
+}
+
+func (s *repairerSuite) newMutableState(lastEventID int64) *MutableState {
+	return &MutableState{WorkflowMutableState: &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId: testNamespaceID,
+			WorkflowId:  testWorkflowID,
+			VersionHistories: versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(
+				[]byte("branch-token"),
+				[]*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(lastEventID, 1)},
+			)),
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId: testRunID,
+			State: enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		},
+		NextEventId: lastEventID + 1,
+	}}
+}
+
+func (s *repairerSuite) repairMetricsTags(action string) []metrics.Tag {
+	return []metrics.Tag{
+		metrics.RepairActionTag(action),
+		metrics.NamespaceTag(testNamespaceName),
+	}
+}
+
+func (s *repairerSuite) expectCurrentExecution(runID string) {
+	s.mockExecutionManager.EXPECT().GetCurrentExecution(gomock.Any(), &persistence.GetCurrentExecutionRequest{
+		ShardID:     testShardID,
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+	}).Return(&persistence.GetCurrentExecutionResponse{RunID: runID}, nil)
+}
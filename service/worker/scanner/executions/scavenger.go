@@ -64,6 +64,8 @@ type (
 		rateLimiter                 quotas.RateLimiter
 		perShardQPS                 dynamicconfig.IntPropertyFn
 		executionDataDurationBuffer dynamicconfig.DurationPropertyFn
+		repairMode                  dynamicconfig.StringPropertyFnWithNamespaceFilter
+		repairReport                *RepairReport
 		metricsHandler              metrics.Handler
 		logger                      log.Logger
 
@@ -77,6 +79,7 @@ type (
 // returned object. Calling the Start() method will result in one
 // complete iteration over all of the open workflow executions in the system. For
 // each executions, will attempt to validate the workflow execution and emit metrics/logs on validation failures.
+// Depending on the repair mode of the namespace, the fixes of repair rules are also reported or applied.
 //
 // The scavenger will retry on all persistence errors infinitely and will only stop under
 // two conditions
@@ -89,6 +92,7 @@ func NewScavenger(
 	perShardQPS dynamicconfig.IntPropertyFn,
 	executionDataDurationBuffer dynamicconfig.DurationPropertyFn,
 	executionTaskWorker dynamicconfig.IntPropertyFn,
+	repairMode dynamicconfig.StringPropertyFnWithNamespaceFilter,
 	executionManager persistence.ExecutionManager,
 	registry namespace.Registry,
 	historyClient historyservice.HistoryServiceClient,
@@ -114,6 +118,8 @@ func NewScavenger(
 		),
 		perShardQPS:                 perShardQPS,
 		executionDataDurationBuffer: executionDataDurationBuffer,
+		repairMode:                  repairMode,
+		repairReport:                NewRepairReport(),
 		metricsHandler:              metricsHandler.WithTags(metrics.OperationTag(metrics.ExecutionsScavengerScope)),
 		logger:                      logger,
 
@@ -185,6 +191,8 @@ func (s *Scavenger) run() {
 				s.rateLimiter,
 			}),
 			s.executionDataDurationBuffer,
+			s.repairMode,
+			s.repairReport,
 		))
 		if !submitted {
 			s.logger.Error("unable to submit task to executor", tag.ShardID(shardID))
@@ -192,6 +200,7 @@ func (s *Scavenger) run() {
 	}
 
 	s.awaitExecutor()
+	s.logger.Info("Executions scavenger repair report", tag.Value(s.repairReport.String()))
 }
 
 func (s *Scavenger) awaitExecutor() {
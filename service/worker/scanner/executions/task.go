@@ -69,6 +69,7 @@ type (
 		ctx                         context.Context
 		rateLimiter                 quotas.RateLimiter
 		executionDataDurationBuffer dynamicconfig.DurationPropertyFn
+		repairer                    *repairer
 		paginationToken             []byte
 	}
 )
@@ -86,7 +87,10 @@ func newTask(
 	scavenger *Scavenger,
 	rateLimiter quotas.RateLimiter,
 	executionDataDurationBuffer dynamicconfig.DurationPropertyFn,
+	repairMode dynamicconfig.StringPropertyFnWithNamespaceFilter,
+	repairReport *RepairReport,
 ) executor.Task {
+	metricsHandler = metricsHandler.WithTags(metrics.OperationTag(metrics.ExecutionsScavengerScope))
 	return &task{
 		shardID:          shardID,
 		executionManager: executionManager,
@@ -94,13 +98,23 @@ func newTask(
 		historyClient:    historyClient,
 		adminClient:      adminClient,
 
-		metricsHandler: metricsHandler.WithTags(metrics.OperationTag(metrics.ExecutionsScavengerScope)),
+		metricsHandler: metricsHandler,
 		logger:         logger,
 		scavenger:      scavenger,
 
 		ctx:                         ctx,
 		rateLimiter:                 rateLimiter,
 		executionDataDurationBuffer: executionDataDurationBuffer,
+		repairer: newRepairer(
+			shardID,
+			executionManager,
+			registry,
+			adminClient,
+			repairMode,
+			repairReport,
+			metricsHandler,
+			logger,
+		),
 	}
 }
 
@@ -144,6 +158,19 @@ func (t *task) Run() executor.TaskStatus {
 				tag.WorkflowRunID(mutableState.GetExecutionState().GetRunId()))
 			retryTask = true
 		}
+		err = t.repairer.repair(t.ctx, mutableState, results)
+		if err != nil {
+			// continue validation process and retry after all workflow records has been iterated.
+			executionInfo := mutableState.GetExecutionInfo()
+			t.metricsHandler.Counter(metrics.ScavengerRepairFailuresCount.GetMetricName()).Record(1)
+			t.logger.Error("unable to repair execution",
+				tag.ShardID(t.shardID),
+				tag.Error(err),
+				tag.WorkflowNamespaceID(executionInfo.GetNamespaceId()),
+				tag.WorkflowID(executionInfo.GetWorkflowId()),
+				tag.WorkflowRunID(mutableState.GetExecutionState().GetRunId()))
+			retryTask = true
+		}
 	}
 	if retryTask {
 		return executor.TaskStatusDefer
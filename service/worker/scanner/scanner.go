@@ -38,6 +38,7 @@ import (
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
@@ -82,6 +83,14 @@ type (
 		ExecutionDataDurationBuffer dynamicconfig.DurationPropertyFn
 		// ExecutionScannerWorkerCount is the execution scavenger task worker number
 		ExecutionScannerWorkerCount dynamicconfig.IntPropertyFn
+		// ExecutionScannerRepairMode is the executions scavenger repair mode per namespace
+		ExecutionScannerRepairMode dynamicconfig.StringPropertyFnWithNamespaceFilter
+		// ArchivalScannerEnabled indicates if archival scanner should be started as part of scanner
+		ArchivalScannerEnabled dynamicconfig.BoolPropertyFn
+		// ArchivalScannerDataMinAge indicates the minimum time since close before archived history is verified
+		ArchivalScannerDataMinAge dynamicconfig.DurationPropertyFn
+		// ArchivalScannerSampleRate is the fraction of closed workflows verified by the archival scanner
+		ArchivalScannerSampleRate dynamicconfig.FloatPropertyFn
 	}
 
 	// scannerContext is the context object that get's
@@ -96,6 +105,7 @@ type (
 		historyClient     historyservice.HistoryServiceClient
 		adminClient       adminservice.AdminServiceClient
 		namespaceRegistry namespace.Registry
+		archiverProvider  provider.ArchiverProvider
 	}
 
 	// Scanner is the background sub-system that does full scans
@@ -123,6 +133,7 @@ func New(
 	historyClient historyservice.HistoryServiceClient,
 	adminClient adminservice.AdminServiceClient,
 	registry namespace.Registry,
+	archiverProvider provider.ArchiverProvider,
 ) *Scanner {
 	return &Scanner{
 		context: scannerContext{
@@ -135,6 +146,7 @@ func New(
 			historyClient:     historyClient,
 			adminClient:       adminClient,
 			namespaceRegistry: registry,
+			archiverProvider:  archiverProvider,
 		},
 	}
 }
@@ -173,15 +185,23 @@ func (s *Scanner) Start() error {
 		workerTaskQueueNames = append(workerTaskQueueNames, historyScannerTaskQueueName)
 	}
 
+	if s.context.cfg.ArchivalScannerEnabled() {
+		s.wg.Add(1)
+		go s.startWorkflowWithRetry(ctx, archivalScannerWFStartOptions, archivalScannerWFTypeName)
+		workerTaskQueueNames = append(workerTaskQueueNames, archivalScannerTaskQueueName)
+	}
+
 	for _, tl := range workerTaskQueueNames {
 		work := s.context.sdkClientFactory.NewWorker(s.context.sdkClientFactory.GetSystemClient(), tl, workerOpts)
 
 		work.RegisterWorkflowWithOptions(TaskQueueScannerWorkflow, workflow.RegisterOptions{Name: tqScannerWFTypeName})
 		work.RegisterWorkflowWithOptions(HistoryScannerWorkflow, workflow.RegisterOptions{Name: historyScannerWFTypeName})
 		work.RegisterWorkflowWithOptions(ExecutionsScannerWorkflow, workflow.RegisterOptions{Name: executionsScannerWFTypeName})
+		work.RegisterWorkflowWithOptions(ArchivalScannerWorkflow, workflow.RegisterOptions{Name: archivalScannerWFTypeName})
 		work.RegisterActivityWithOptions(TaskQueueScavengerActivity, activity.RegisterOptions{Name: taskQueueScavengerActivityName})
 		work.RegisterActivityWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
 		work.RegisterActivityWithOptions(ExecutionsScavengerActivity, activity.RegisterOptions{Name: executionsScavengerActivityName})
+		work.RegisterActivityWithOptions(ArchivalVerifierActivity, activity.RegisterOptions{Name: archivalVerifierActivityName})
 
 		if err := work.Start(); err != nil {
 			return err
@@ -35,6 +35,7 @@ import (
 
 	"go.temporal.io/server/api/adminservicemock/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -71,12 +72,17 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 		WFTypeName:    historyScannerWFTypeName,
 		TaskQueueName: historyScannerTaskQueueName,
 	}
+	archivalScanner := expectedScanner{
+		WFTypeName:    archivalScannerWFTypeName,
+		TaskQueueName: archivalScannerTaskQueueName,
+	}
 
 	type testCase struct {
 		Name                     string
 		ExecutionsScannerEnabled bool
 		TaskQueueScannerEnabled  bool
 		HistoryScannerEnabled    bool
+		ArchivalScannerEnabled   bool
 		DefaultStore             string
 		ExpectedScanners         []expectedScanner
 	}
@@ -146,13 +152,23 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 			DefaultStore:             config.StoreTypeSQL,
 			ExpectedScanners:         []expectedScanner{executionScanner},
 		},
+		{
+			Name:                     "ArchivalScannerNoSQL",
+			ExecutionsScannerEnabled: false,
+			TaskQueueScannerEnabled:  false,
+			HistoryScannerEnabled:    false,
+			ArchivalScannerEnabled:   true,
+			DefaultStore:             config.StoreTypeNoSQL,
+			ExpectedScanners:         []expectedScanner{archivalScanner},
+		},
 		{
 			Name:                     "AllScannersSQL",
 			ExecutionsScannerEnabled: true,
 			TaskQueueScannerEnabled:  true,
 			HistoryScannerEnabled:    true,
+			ArchivalScannerEnabled:   true,
 			DefaultStore:             config.StoreTypeSQL,
-			ExpectedScanners:         []expectedScanner{historyScanner, taskQueueScanner, executionScanner},
+			ExpectedScanners:         []expectedScanner{historyScanner, taskQueueScanner, executionScanner, archivalScanner},
 		},
 	} {
 		s.Run(c.Name, func() {
@@ -171,6 +187,7 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 					HistoryScannerEnabled:                  dynamicconfig.GetBoolPropertyFn(c.HistoryScannerEnabled),
 					ExecutionsScannerEnabled:               dynamicconfig.GetBoolPropertyFn(c.ExecutionsScannerEnabled),
 					TaskQueueScannerEnabled:                dynamicconfig.GetBoolPropertyFn(c.TaskQueueScannerEnabled),
+					ArchivalScannerEnabled:                 dynamicconfig.GetBoolPropertyFn(c.ArchivalScannerEnabled),
 					Persistence: &config.Persistence{
 						DefaultStore: c.DefaultStore,
 						DataStores: map[string]config.DataStore{
@@ -188,6 +205,7 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 				historyservicemock.NewMockHistoryServiceClient(ctrl),
 				mockAdminClient,
 				mockNamespaceRegistry,
+				provider.NewMockArchiverProvider(ctrl),
 			)
 			var wg sync.WaitGroup
 			for _, sc := range c.ExpectedScanners {
@@ -243,6 +261,7 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 			HistoryScannerEnabled:                  dynamicconfig.GetBoolPropertyFn(true),
 			ExecutionsScannerEnabled:               dynamicconfig.GetBoolPropertyFn(false),
 			TaskQueueScannerEnabled:                dynamicconfig.GetBoolPropertyFn(false),
+			ArchivalScannerEnabled:                 dynamicconfig.GetBoolPropertyFn(false),
 			Persistence: &config.Persistence{
 				DefaultStore: config.StoreTypeNoSQL,
 				DataStores: map[string]config.DataStore{
@@ -257,6 +276,7 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 		historyservicemock.NewMockHistoryServiceClient(ctrl),
 		mockAdminClient,
 		mockNamespaceRegistry,
+		provider.NewMockArchiverProvider(ctrl),
 	)
 	mockSdkClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient).AnyTimes()
 	worker.EXPECT().RegisterActivityWithOptions(gomock.Any(), gomock.Any()).AnyTimes()
@@ -35,6 +35,7 @@ import (
 	"go.temporal.io/sdk/workflow"
 
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/service/worker/scanner/archival"
 	"go.temporal.io/server/service/worker/scanner/executions"
 	"go.temporal.io/server/service/worker/scanner/history"
 	"go.temporal.io/server/service/worker/scanner/taskqueue"
@@ -57,6 +58,11 @@ const (
 	executionsScannerWFTypeName     = "temporal-sys-executions-scanner-workflow"
 	executionsScannerTaskQueueName  = "temporal-sys-executions-scanner-taskqueue-0"
 	executionsScavengerActivityName = "temporal-sys-executions-scanner-scvg-activity"
+
+	archivalScannerWFID          = "temporal-sys-archival-scanner"
+	archivalScannerWFTypeName    = "temporal-sys-archival-scanner-workflow"
+	archivalScannerTaskQueueName = "temporal-sys-archival-scanner-taskqueue-0"
+	archivalVerifierActivityName = "temporal-sys-archival-scanner-verify-activity"
 )
 
 type (
@@ -98,6 +104,12 @@ var (
 		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
 		CronSchedule:          "0 */12 * * *",
 	}
+	archivalScannerWFStartOptions = client.StartWorkflowOptions{
+		ID:                    archivalScannerWFID,
+		TaskQueue:             archivalScannerTaskQueueName,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		CronSchedule:          "0 0 * * *",
+	}
 )
 
 // TaskQueueScannerWorkflow is the workflow that runs the task queue scanner background daemon
@@ -129,6 +141,20 @@ func ExecutionsScannerWorkflow(
 	return future.Get(ctx, nil)
 }
 
+// ArchivalScannerWorkflow is the workflow that runs the archival scanner background daemon
+func ArchivalScannerWorkflow(
+	ctx workflow.Context,
+) (archival.VerifierHeartbeatDetails, error) {
+
+	var result archival.VerifierHeartbeatDetails
+	future := workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, activityOptions),
+		archivalVerifierActivityName,
+	)
+	err := future.Get(ctx, &result)
+	return result, err
+}
+
 // HistoryScavengerActivity is the activity that runs history scavenger
 func HistoryScavengerActivity(
 	activityCtx context.Context,
@@ -162,6 +188,38 @@ func HistoryScavengerActivity(
 	return scavenger.Run(activityCtx)
 }
 
+// ArchivalVerifierActivity is the activity that verifies archived histories against the primary store
+func ArchivalVerifierActivity(
+	activityCtx context.Context,
+) (archival.VerifierHeartbeatDetails, error) {
+
+	ctx := activityCtx.Value(scannerContextKey).(scannerContext)
+	rps := ctx.cfg.PersistenceMaxQPS()
+	numShards := ctx.cfg.Persistence.NumHistoryShards
+
+	hbd := archival.VerifierHeartbeatDetails{}
+	if activity.HasHeartbeatDetails(activityCtx) {
+		if err := activity.GetHeartbeatDetails(activityCtx, &hbd); err != nil {
+			ctx.logger.Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+		}
+	}
+
+	verifier := archival.NewVerifier(
+		numShards,
+		ctx.executionManager,
+		rps,
+		ctx.historyClient,
+		ctx.namespaceRegistry,
+		ctx.archiverProvider,
+		hbd,
+		ctx.cfg.ArchivalScannerDataMinAge,
+		ctx.cfg.ArchivalScannerSampleRate,
+		ctx.metricsHandler,
+		ctx.logger,
+	)
+	return verifier.Run(activityCtx)
+}
+
 // TaskQueueScavengerActivity is the activity that runs task queue scavenger
 func TaskQueueScavengerActivity(
 	activityCtx context.Context,
@@ -196,6 +254,7 @@ func ExecutionsScavengerActivity(
 		ctx.cfg.ExecutionScannerPerShardQPS,
 		ctx.cfg.ExecutionDataDurationBuffer,
 		ctx.cfg.ExecutionScannerWorkerCount,
+		ctx.cfg.ExecutionScannerRepairMode,
 		ctx.executionManager,
 		ctx.namespaceRegistry,
 		ctx.historyClient,
@@ -56,6 +56,7 @@ import (
 	"go.temporal.io/server/service/worker/parentclosepolicy"
 	"go.temporal.io/server/service/worker/replicator"
 	"go.temporal.io/server/service/worker/scanner"
+	"go.temporal.io/server/service/worker/scanner/executions"
 )
 
 type (
@@ -286,6 +287,18 @@ func NewConfig(dc *dynamicconfig.Collection, persistenceConfig *config.Persisten
 				dynamicconfig.HistoryScannerVerifyRetention,
 				true,
 			),
+			ArchivalScannerEnabled: dc.GetBoolProperty(
+				dynamicconfig.ArchivalScannerEnabled,
+				false,
+			),
+			ArchivalScannerDataMinAge: dc.GetDurationProperty(
+				dynamicconfig.ArchivalScannerDataMinAge,
+				24*time.Hour,
+			),
+			ArchivalScannerSampleRate: dc.GetFloat64Property(
+				dynamicconfig.ArchivalScannerSampleRate,
+				0.1,
+			),
 			ExecutionScannerPerHostQPS: dc.GetIntProperty(
 				dynamicconfig.ExecutionScannerPerHostQPS,
 				10,
@@ -302,6 +315,10 @@ func NewConfig(dc *dynamicconfig.Collection, persistenceConfig *config.Persisten
 				dynamicconfig.ExecutionScannerWorkerCount,
 				8,
 			),
+			ExecutionScannerRepairMode: dc.GetStringPropertyFnWithNamespaceFilter(
+				dynamicconfig.ExecutionScannerRepairMode,
+				executions.RepairModeOff,
+			),
 		},
 		EnableBatcher:      dc.GetBoolProperty(dynamicconfig.EnableBatcher, true),
 		BatcherRPS:         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BatcherRPS, batcher.DefaultRPS),
@@ -492,6 +509,7 @@ func (s *Service) initScanner() error {
 		s.historyClient,
 		adminClient,
 		s.namespaceRegistry,
+		s.archiverProvider,
 	)
 	return nil
 }
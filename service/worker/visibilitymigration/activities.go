@@ -0,0 +1,444 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/activity"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	activities struct {
+		historyShardCount int32
+		stores            func() (*stores, error)
+		executionManager  persistence.ExecutionManager
+		namespaceRegistry namespace.Registry
+		saMapperProvider  searchattribute.MapperProvider
+		metricsHandler    metrics.Handler
+		logger            log.Logger
+	}
+
+	// visibilityRecord is either *manager.RecordWorkflowExecutionStartedRequest (for open executions)
+	// or *manager.RecordWorkflowExecutionClosedRequest (for closed ones).
+	visibilityRecord interface{}
+)
+
+const (
+	// migrationTaskID is used as TaskID of migrated records. Elasticsearch uses TaskID as external document
+	// version, so the smallest version guarantees that a migrated record never overwrites a record which
+	// was written to target store by dual visibility. It is not 0 because Elasticsearch can't update
+	// documents of version 0 by query.
+	migrationTaskID = 1
+
+	migratePagesDuration     = 10 * time.Minute
+	countTargetRetryInterval = 5 * time.Second
+	countTargetTimeout       = time.Minute
+)
+
+// GetMetadata returns namespaceID for requested namespace and history shard count.
+func (a *activities) GetMetadata(_ context.Context, request metadataRequest) (*metadataResponse, error) {
+	nsEntry, err := a.namespaceRegistry.GetNamespace(namespace.Name(request.Namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	return &metadataResponse{
+		NamespaceID: string(nsEntry.ID()),
+		ShardCount:  a.historyShardCount,
+	}, nil
+}
+
+// MigratePages migrates pages of executions from source at request.Checkpoint to target store, until
+// request.MaxPageCount pages are migrated or migratePagesDuration elapses, and returns the checkpoint of the
+// next page. Progress is recorded in heartbeat details, and a retry of the activity continues from the
+// last migrated page.
+func (a *activities) MigratePages(ctx context.Context, request *migratePagesRequest) (*migratePagesResponse, error) {
+	s, err := a.stores()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &migratePagesResponse{
+		Checkpoint: request.Checkpoint,
+	}
+	if activity.HasHeartbeatDetails(ctx) {
+		var details migratePagesResponse
+		if err := activity.GetHeartbeatDetails(ctx, &details); err == nil {
+			response = &details
+		}
+	}
+
+	// The rate limiter is shared by all pages of the attempt, so that a new page doesn't start with a full burst.
+	rateLimiter := quotas.NewRateLimiter(request.RPS, 1)
+	deadline := time.Now().Add(migratePagesDuration)
+	for response.PageCount < request.MaxPageCount && !response.Checkpoint.Done {
+		if response.PageCount > 0 && !time.Now().Before(deadline) {
+			break
+		}
+
+		var (
+			records []visibilityRecord
+			page    *migratePagesResponse
+		)
+		switch request.Source {
+		case SourceExecutions:
+			records, page, err = a.readExecutionsPage(ctx, request, response.Checkpoint)
+		default:
+			records, page, err = a.readVisibilityPage(ctx, s.source, request, response.Checkpoint)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, record := range records {
+			if err := rateLimiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+			if err := writeRecord(ctx, s.target, record); err != nil {
+				a.metricsHandler.Counter(metrics.VisibilityMigrationFailuresCount.GetMetricName()).Record(1)
+				a.logger.Warn("Visibility migration failed to write execution.", tag.WorkflowNamespace(request.Namespace), tag.Error(err))
+				return nil, err
+			}
+			page.MigratedCount++
+			activity.RecordHeartbeat(ctx, *response)
+		}
+		a.metricsHandler.Counter(metrics.VisibilityMigrationMigratedCount.GetMetricName()).Record(page.MigratedCount)
+
+		response.Checkpoint = page.Checkpoint
+		response.PageCount++
+		response.ListedCount += page.ListedCount
+		response.MigratedCount += page.MigratedCount
+		activity.RecordHeartbeat(ctx, *response)
+	}
+
+	return response, nil
+}
+
+func (a *activities) readVisibilityPage(
+	ctx context.Context,
+	source manager.VisibilityManager,
+	request *migratePagesRequest,
+	checkpoint Checkpoint,
+) ([]visibilityRecord, *migratePagesResponse, error) {
+	listRequest := &manager.ListWorkflowExecutionsRequest{
+		NamespaceID:       namespace.ID(request.NamespaceID),
+		Namespace:         namespace.Name(request.Namespace),
+		EarliestStartTime: time.Unix(0, 0),
+		LatestStartTime:   request.LatestStartTime,
+		PageSize:          request.PageSize,
+		NextPageToken:     checkpoint.NextPageToken,
+	}
+
+	var (
+		listResponse *manager.ListWorkflowExecutionsResponse
+		err          error
+	)
+	if checkpoint.Closed {
+		listResponse, err = source.ListClosedWorkflowExecutions(ctx, listRequest)
+	} else {
+		listResponse, err = source.ListOpenWorkflowExecutions(ctx, listRequest)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := &migratePagesResponse{
+		Checkpoint: Checkpoint{
+			Closed:        checkpoint.Closed,
+			NextPageToken: listResponse.NextPageToken,
+		},
+		ListedCount: int64(len(listResponse.Executions)),
+	}
+	if len(listResponse.NextPageToken) == 0 {
+		// Closed executions are migrated after open ones.
+		response.Checkpoint.Done = checkpoint.Closed
+		response.Checkpoint.Closed = true
+	}
+
+	records := make([]visibilityRecord, 0, len(listResponse.Executions))
+	for _, executionInfo := range listResponse.Executions {
+		// Visibility stores return search attributes with aliases, but write requests must have field names.
+		searchAttributes, err := searchattribute.UnaliasFields(a.saMapperProvider, executionInfo.GetSearchAttributes(), request.Namespace)
+		if err != nil {
+			return nil, nil, err
+		}
+		if searchAttributes == nil {
+			searchAttributes = executionInfo.GetSearchAttributes()
+		}
+		records = append(records, recordFromExecutionInfo(request, executionInfo, searchAttributes))
+	}
+	return records, response, nil
+}
+
+func (a *activities) readExecutionsPage(
+	ctx context.Context,
+	request *migratePagesRequest,
+	checkpoint Checkpoint,
+) ([]visibilityRecord, *migratePagesResponse, error) {
+	shardID := checkpoint.ShardID
+	if shardID == 0 {
+		shardID = 1
+	}
+
+	listResponse, err := a.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+		ShardID:   shardID,
+		PageSize:  request.PageSize,
+		PageToken: checkpoint.NextPageToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := &migratePagesResponse{
+		Checkpoint: Checkpoint{
+			ShardID:       shardID,
+			NextPageToken: listResponse.PageToken,
+		},
+	}
+	if len(listResponse.PageToken) == 0 {
+		response.Checkpoint.ShardID = shardID + 1
+		response.Checkpoint.Done = shardID >= request.ShardCount
+	}
+
+	var records []visibilityRecord
+	for _, mutableState := range listResponse.States {
+		if mutableState.GetExecutionInfo().GetNamespaceId() != request.NamespaceID {
+			continue
+		}
+		response.ListedCount++
+		if mutableState.GetExecutionState().GetState() == enumsspb.WORKFLOW_EXECUTION_STATE_VOID ||
+			timestamp.TimeValue(mutableState.GetExecutionInfo().GetStartTime()).After(request.LatestStartTime) {
+			continue
+		}
+		records = append(records, recordFromMutableState(request, mutableState))
+	}
+	return records, response, nil
+}
+
+// CountStores returns numbers of executions of the namespace, which were started before the migration, in source
+// and target stores, or -1 for a store which doesn't support counting. Source store is counted by listing if it
+// doesn't support counting. Target store is eventually consistent, so it is counted until its count is within
+// the tolerance of source count or countTargetTimeout expires.
+func (a *activities) CountStores(ctx context.Context, request *countStoresRequest) (*countStoresResponse, error) {
+	s, err := a.stores()
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf("%s <= '%s'", searchattribute.StartTime, request.LatestStartTime.UTC().Format(time.RFC3339Nano))
+	sourceCount, err := countStore(ctx, s.source, request, query)
+	if errors.Is(err, store.OperationNotSupportedErr) {
+		sourceCount, err = countStoreByListing(ctx, s.source, request)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(countTargetTimeout)
+	for {
+		targetCount, err := countStore(ctx, s.target, request, query)
+		if errors.Is(err, store.OperationNotSupportedErr) {
+			return &countStoresResponse{SourceCount: sourceCount, TargetCount: -1}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if withinCountTolerance(sourceCount, targetCount, request.CountTolerance) || !time.Now().Before(deadline) {
+			return &countStoresResponse{SourceCount: sourceCount, TargetCount: targetCount}, nil
+		}
+
+		activity.RecordHeartbeat(ctx, targetCount)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(countTargetRetryInterval):
+		}
+	}
+}
+
+func countStore(
+	ctx context.Context,
+	visibilityManager manager.VisibilityManager,
+	request *countStoresRequest,
+	query string,
+) (int64, error) {
+	resp, err := visibilityManager.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: namespace.ID(request.NamespaceID),
+		Namespace:   namespace.Name(request.Namespace),
+		Query:       query,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.Count, nil
+}
+
+// countStoreByListing counts open and closed executions which were started before the migration. Closed executions
+// are listed by close time, so all of them are listed and filtered by start time.
+func countStoreByListing(
+	ctx context.Context,
+	visibilityManager manager.VisibilityManager,
+	request *countStoresRequest,
+) (int64, error) {
+	var count int64
+	for _, closed := range []bool{false, true} {
+		listRequest := &manager.ListWorkflowExecutionsRequest{
+			NamespaceID:       namespace.ID(request.NamespaceID),
+			Namespace:         namespace.Name(request.Namespace),
+			EarliestStartTime: time.Unix(0, 0),
+			LatestStartTime:   request.LatestStartTime,
+			PageSize:          request.PageSize,
+		}
+		if closed {
+			listRequest.LatestStartTime = time.Now()
+		}
+		for {
+			var (
+				listResponse *manager.ListWorkflowExecutionsResponse
+				err          error
+			)
+			if closed {
+				listResponse, err = visibilityManager.ListClosedWorkflowExecutions(ctx, listRequest)
+			} else {
+				listResponse, err = visibilityManager.ListOpenWorkflowExecutions(ctx, listRequest)
+			}
+			if err != nil {
+				return 0, err
+			}
+			for _, executionInfo := range listResponse.Executions {
+				if !timestamp.TimeValue(executionInfo.GetStartTime()).After(request.LatestStartTime) {
+					count++
+				}
+			}
+			activity.RecordHeartbeat(ctx, count)
+			if len(listResponse.NextPageToken) == 0 {
+				break
+			}
+			listRequest.NextPageToken = listResponse.NextPageToken
+		}
+	}
+	return count, nil
+}
+
+func recordFromExecutionInfo(
+	request *migratePagesRequest,
+	executionInfo *workflowpb.WorkflowExecutionInfo,
+	searchAttributes *commonpb.SearchAttributes,
+) visibilityRecord {
+	base := &manager.VisibilityRequestBase{
+		NamespaceID:          namespace.ID(request.NamespaceID),
+		Namespace:            namespace.Name(request.Namespace),
+		Execution:            *executionInfo.GetExecution(),
+		WorkflowTypeName:     executionInfo.GetType().GetName(),
+		StartTime:            timestamp.TimeValue(executionInfo.GetStartTime()),
+		Status:               executionInfo.GetStatus(),
+		ExecutionTime:        timestamp.TimeValue(executionInfo.GetExecutionTime()),
+		StateTransitionCount: executionInfo.GetStateTransitionCount(),
+		TaskID:               migrationTaskID,
+		Memo:                 executionInfo.GetMemo(),
+		TaskQueue:            executionInfo.GetTaskQueue(),
+		SearchAttributes:     searchAttributes,
+	}
+	if executionInfo.GetStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		return &manager.RecordWorkflowExecutionStartedRequest{VisibilityRequestBase: base}
+	}
+	// History size isn't returned by visibility stores and is migrated only from executions source.
+	return &manager.RecordWorkflowExecutionClosedRequest{
+		VisibilityRequestBase: base,
+		CloseTime:             timestamp.TimeValue(executionInfo.GetCloseTime()),
+		HistoryLength:         executionInfo.GetHistoryLength(),
+	}
+}
+
+func recordFromMutableState(
+	request *migratePagesRequest,
+	mutableState *persistencespb.WorkflowMutableState,
+) visibilityRecord {
+	executionInfo := mutableState.GetExecutionInfo()
+	executionState := mutableState.GetExecutionState()
+
+	base := &manager.VisibilityRequestBase{
+		NamespaceID: namespace.ID(request.NamespaceID),
+		Namespace:   namespace.Name(request.Namespace),
+		Execution: commonpb.WorkflowExecution{
+			WorkflowId: executionInfo.GetWorkflowId(),
+			RunId:      executionState.GetRunId(),
+		},
+		WorkflowTypeName:     executionInfo.GetWorkflowTypeName(),
+		StartTime:            timestamp.TimeValue(executionInfo.GetStartTime()),
+		Status:               executionState.GetStatus(),
+		ExecutionTime:        timestamp.TimeValue(executionInfo.GetExecutionTime()),
+		StateTransitionCount: executionInfo.GetStateTransitionCount(),
+		TaskID:               migrationTaskID,
+		Memo:                 &commonpb.Memo{Fields: executionInfo.GetMemo()},
+		TaskQueue:            executionInfo.GetTaskQueue(),
+		SearchAttributes:     &commonpb.SearchAttributes{IndexedFields: executionInfo.GetSearchAttributes()},
+	}
+	if executionState.GetState() != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+		return &manager.RecordWorkflowExecutionStartedRequest{VisibilityRequestBase: base}
+	}
+
+	closeTime := executionInfo.GetCloseTime()
+	if closeTime == nil {
+		closeTime = executionInfo.GetLastUpdateTime()
+	}
+	return &manager.RecordWorkflowExecutionClosedRequest{
+		VisibilityRequestBase: base,
+		CloseTime:             timestamp.TimeValue(closeTime),
+		HistoryLength:         mutableState.GetNextEventId() - 1,
+		HistorySizeBytes:      executionInfo.GetExecutionStats().GetHistorySize(),
+	}
+}
+
+func writeRecord(ctx context.Context, target manager.VisibilityManager, record visibilityRecord) error {
+	switch r := record.(type) {
+	case *manager.RecordWorkflowExecutionStartedRequest:
+		return target.RecordWorkflowExecutionStarted(ctx, r)
+	case *manager.RecordWorkflowExecutionClosedRequest:
+		return target.RecordWorkflowExecutionClosed(ctx, r)
+	default:
+		return errors.New("unknown visibility record type")
+	}
+}
@@ -0,0 +1,197 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/testsuite"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+func Test_ReadExecutionsPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+
+	a := &activities{
+		executionManager: executionManager,
+		metricsHandler:   metrics.NoopMetricsHandler,
+		logger:           log.NewNoopLogger(),
+	}
+
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	closeTime := startTime.Add(time.Hour)
+	latestStartTime := startTime.Add(24 * time.Hour)
+	newMutableState := func(namespaceID string, runID string, startTime time.Time, state enumsspb.WorkflowExecutionState) *persistencespb.WorkflowMutableState {
+		return &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				NamespaceId:      namespaceID,
+				WorkflowId:       "workflow-id",
+				WorkflowTypeName: "workflow-type",
+				TaskQueue:        "task-queue",
+				StartTime:        timestamp.TimePtr(startTime),
+				CloseTime:        timestamp.TimePtr(closeTime),
+				ExecutionStats:   &persistencespb.ExecutionStats{HistorySize: 1024},
+			},
+			ExecutionState: &persistencespb.WorkflowExecutionState{
+				RunId:  runID,
+				State:  state,
+				Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			},
+			NextEventId: 11,
+		}
+	}
+
+	request := &migratePagesRequest{
+		Namespace:       "test-ns",
+		NamespaceID:     "namespace-id",
+		Source:          SourceExecutions,
+		ShardCount:      2,
+		PageSize:        10,
+		LatestStartTime: latestStartTime,
+	}
+
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:  1,
+		PageSize: 10,
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			newMutableState("namespace-id", "run-1", startTime, enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED),
+			newMutableState("other-namespace-id", "run-2", startTime, enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED),
+			newMutableState("namespace-id", "run-3", startTime, enumsspb.WORKFLOW_EXECUTION_STATE_VOID),
+			newMutableState("namespace-id", "run-4", latestStartTime.Add(time.Second), enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING),
+		},
+	}, nil)
+
+	records, response, err := a.readExecutionsPage(context.Background(), request, Checkpoint{})
+	require.NoError(t, err)
+	require.Equal(t, Checkpoint{ShardID: 2}, response.Checkpoint)
+	require.Equal(t, int64(3), response.ListedCount)
+	require.Len(t, records, 1)
+
+	closedRequest, ok := records[0].(*manager.RecordWorkflowExecutionClosedRequest)
+	require.True(t, ok)
+	require.Equal(t, "run-1", closedRequest.Execution.GetRunId())
+	require.Equal(t, "workflow-type", closedRequest.WorkflowTypeName)
+	require.Equal(t, closeTime, closedRequest.CloseTime)
+	require.Equal(t, int64(10), closedRequest.HistoryLength)
+	require.Equal(t, int64(1024), closedRequest.HistorySizeBytes)
+	require.Equal(t, int64(migrationTaskID), closedRequest.TaskID)
+
+	// Last page of last shard completes the migration.
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:  2,
+		PageSize: 10,
+	}).Return(&persistence.ListConcreteExecutionsResponse{}, nil)
+
+	records, response, err = a.readExecutionsPage(context.Background(), request, Checkpoint{ShardID: 2})
+	require.NoError(t, err)
+	require.Empty(t, records)
+	require.True(t, response.Checkpoint.Done)
+
+	ctrl.Finish()
+}
+
+func Test_CountStores(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	source := manager.NewMockVisibilityManager(ctrl)
+	target := manager.NewMockVisibilityManager(ctrl)
+
+	a := &activities{
+		stores: func() (*stores, error) {
+			return &stores{source: source, target: target}, nil
+		},
+		metricsHandler: metrics.NoopMetricsHandler,
+		logger:         log.NewNoopLogger(),
+	}
+
+	latestStartTime := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	request := &countStoresRequest{
+		Namespace:       "test-ns",
+		NamespaceID:     "namespace-id",
+		PageSize:        10,
+		LatestStartTime: latestStartTime,
+		CountTolerance:  0.01,
+	}
+	countRequest := &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: "namespace-id",
+		Namespace:   "test-ns",
+		Query:       "StartTime <= '2020-01-02T00:00:00Z'",
+	}
+	newExecution := func(startTime time.Time) *workflowpb.WorkflowExecutionInfo {
+		return &workflowpb.WorkflowExecutionInfo{StartTime: timestamp.TimePtr(startTime)}
+	}
+
+	// Source store doesn't support counting and is counted by listing.
+	source.EXPECT().CountWorkflowExecutions(gomock.Any(), countRequest).Return(nil, store.OperationNotSupportedErr)
+	source.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{newExecution(latestStartTime.Add(-time.Hour))},
+	}, nil)
+	source.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			newExecution(latestStartTime.Add(-time.Hour)),
+			newExecution(latestStartTime.Add(time.Hour)),
+		},
+		NextPageToken: []byte("next-page-token"),
+	}, nil)
+	source.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{newExecution(latestStartTime)},
+	}, nil)
+	target.EXPECT().CountWorkflowExecutions(gomock.Any(), countRequest).Return(&manager.CountWorkflowExecutionsResponse{Count: 3}, nil)
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	env.RegisterActivity(a)
+	value, err := env.ExecuteActivity(a.CountStores, request)
+	require.NoError(t, err)
+
+	var response countStoresResponse
+	require.NoError(t, value.Get(&response))
+	require.Equal(t, countStoresResponse{SourceCount: 3, TargetCount: 3}, response)
+
+	ctrl.Finish()
+}
+
+func Test_WithinCountTolerance(t *testing.T) {
+	require.True(t, withinCountTolerance(0, 0, 0.01))
+	require.True(t, withinCountTolerance(1000, 990, 0.01))
+	require.True(t, withinCountTolerance(1000, 1010, 0.01))
+	require.False(t, withinCountTolerance(1000, 989, 0.01))
+	require.False(t, withinCountTolerance(0, 1, 0.01))
+}
@@ -0,0 +1,119 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"sync"
+
+	sdkworker "go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/fx"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/common/searchattribute"
+	workercommon "go.temporal.io/server/service/worker/common"
+)
+
+type (
+	initParams struct {
+		fx.In
+		PersistenceConfig              *config.Persistence
+		PersistenceServiceResolver     resolver.ServiceResolver
+		ESConfig                       *esclient.Config
+		ESClient                       esclient.Client
+		SearchAttributesProvider       searchattribute.Provider
+		SearchAttributesMapperProvider searchattribute.MapperProvider
+		DynamicCollection              *dynamicconfig.Collection
+		ExecutionManager               persistence.ExecutionManager
+		NamespaceRegistry              namespace.Registry
+		MetricsHandler                 metrics.Handler
+		Logger                         log.Logger
+	}
+
+	fxResult struct {
+		fx.Out
+		Component workercommon.WorkerComponent `group:"workerComponent"`
+	}
+
+	// visibilityMigrationComponent backfills the secondary visibility store from the primary one.
+	visibilityMigrationComponent struct {
+		initParams
+
+		storesOnce sync.Once
+		stores     *stores
+		storesErr  error
+	}
+)
+
+var Module = fx.Options(
+	fx.Provide(NewResult),
+)
+
+func NewResult(params initParams) fxResult {
+	component := &visibilityMigrationComponent{
+		initParams: params,
+	}
+	return fxResult{
+		Component: component,
+	}
+}
+
+func (wc *visibilityMigrationComponent) Register(worker sdkworker.Worker) {
+	worker.RegisterWorkflowWithOptions(VisibilityMigrationWorkflow, workflow.RegisterOptions{Name: WorkflowName})
+	worker.RegisterActivity(wc.activities())
+}
+
+func (wc *visibilityMigrationComponent) DedicatedWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// use default worker
+	return nil
+}
+
+func (wc *visibilityMigrationComponent) activities() *activities {
+	return &activities{
+		historyShardCount: wc.PersistenceConfig.NumHistoryShards,
+		stores:            wc.getStores,
+		executionManager:  wc.ExecutionManager,
+		namespaceRegistry: wc.NamespaceRegistry,
+		saMapperProvider:  wc.SearchAttributesMapperProvider,
+		metricsHandler:    wc.MetricsHandler.WithTags(metrics.OperationTag(metrics.VisibilityMigrationWorkflowScope)),
+		logger:            wc.Logger,
+	}
+}
+
+// getStores creates source and target visibility managers on first use, so that worker doesn't
+// start an Elasticsearch bulk processor unless visibility migration is actually running.
+func (wc *visibilityMigrationComponent) getStores() (*stores, error) {
+	wc.storesOnce.Do(func() {
+		wc.stores, wc.storesErr = newStores(wc.initParams)
+	})
+	return wc.stores, wc.storesErr
+}
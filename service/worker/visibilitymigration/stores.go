@@ -0,0 +1,130 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"errors"
+	"time"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+)
+
+type (
+	// stores are the visibility managers which executions are migrated between.
+	stores struct {
+		source manager.VisibilityManager
+		target manager.VisibilityManager
+	}
+)
+
+var (
+	errNoSecondaryVisibilityStore = errors.New("visibility migration requires secondary visibility store: configure both standard and advanced visibility, or secondary Elasticsearch index")
+)
+
+// newStores creates visibility managers for the same store pairs which are supported by dual visibility:
+// standard visibility to Elasticsearch, and Elasticsearch index to secondary Elasticsearch index.
+// Unlike worker's visibility manager, target manager is able to write.
+func newStores(params initParams) (*stores, error) {
+	dc := params.DynamicCollection
+	esIndexName := params.ESConfig.GetVisibilityIndex()
+	esSecondaryIndexName := params.ESConfig.GetSecondaryVisibilityIndex()
+
+	esProcessorConfig := &elasticsearch.ProcessorConfig{
+		IndexerConcurrency:       dc.GetIntProperty(dynamicconfig.WorkerIndexerConcurrency, 100),
+		ESProcessorNumOfWorkers:  dc.GetIntProperty(dynamicconfig.WorkerESProcessorNumOfWorkers, 1),
+		ESProcessorBulkActions:   dc.GetIntProperty(dynamicconfig.WorkerESProcessorBulkActions, 500),
+		ESProcessorBulkSize:      dc.GetIntProperty(dynamicconfig.WorkerESProcessorBulkSize, 16*1024*1024),
+		ESProcessorFlushInterval: dc.GetDurationProperty(dynamicconfig.WorkerESProcessorFlushInterval, 1*time.Second),
+		ESProcessorAckTimeout:    dc.GetDurationProperty(dynamicconfig.WorkerESProcessorAckTimeout, 30*time.Second),
+	}
+
+	newAdvancedManager := func(indexName string, processorConfig *elasticsearch.ProcessorConfig) (manager.VisibilityManager, error) {
+		return visibility.NewAdvancedManager(
+			indexName,
+			params.ESClient,
+			processorConfig,
+			params.SearchAttributesProvider,
+			params.SearchAttributesMapperProvider,
+			dc.GetIntProperty(dynamicconfig.AdvancedVisibilityPersistenceMaxReadQPS, 9000),
+			dc.GetIntProperty(dynamicconfig.AdvancedVisibilityPersistenceMaxWriteQPS, 9000),
+			dc.GetBoolProperty(dynamicconfig.VisibilityDisableOrderByClause, false),
+			params.MetricsHandler,
+			params.Logger,
+		)
+	}
+
+	if esIndexName == "" {
+		return nil, errNoSecondaryVisibilityStore
+	}
+
+	var (
+		source manager.VisibilityManager
+		err    error
+	)
+	if esSecondaryIndexName != "" {
+		source, err = newAdvancedManager(esIndexName, nil)
+	} else {
+		source, err = visibility.NewStandardManager(
+			*params.PersistenceConfig,
+			params.PersistenceServiceResolver,
+			dc.GetIntProperty(dynamicconfig.StandardVisibilityPersistenceMaxReadQPS, 9000),
+			dc.GetIntProperty(dynamicconfig.StandardVisibilityPersistenceMaxWriteQPS, 9000),
+			params.MetricsHandler,
+			params.Logger,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if source == nil {
+		return nil, errNoSecondaryVisibilityStore
+	}
+
+	targetIndexName := esIndexName
+	if esSecondaryIndexName != "" {
+		targetIndexName = esSecondaryIndexName
+	}
+	target, err := newAdvancedManager(targetIndexName, esProcessorConfig)
+	if err != nil || target == nil {
+		source.Close()
+		if err == nil {
+			err = errNoSecondaryVisibilityStore
+		}
+		return nil, err
+	}
+
+	params.Logger.Info("Created visibility managers for visibility migration.",
+		tag.NewStringTag("source", source.GetName()+":"+source.GetIndexName()),
+		tag.NewStringTag("target", target.GetName()+":"+target.GetIndexName()),
+	)
+	return &stores{
+		source: source,
+		target: target,
+	}, nil
+}
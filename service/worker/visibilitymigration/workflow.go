@@ -0,0 +1,332 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	// WorkflowName is the workflow name.
+	WorkflowName = "temporal-sys-visibility-migration-workflow"
+
+	// SourceVisibility enumerates open and then closed executions of the namespace from the primary visibility store.
+	SourceVisibility = "visibility"
+	// SourceExecutions enumerates executions of the namespace from the executions table of every history shard.
+	// Executions which were already deleted from the executions table (i.e. past retention) are not migrated.
+	SourceExecutions = "executions"
+
+	// StatusQueryType returns VisibilityMigrationStatus of a running migration.
+	StatusQueryType = "visibility-migration-status"
+	// UpdateRpsSignal changes OverallRps of a running migration. The new rate applies to pages
+	// processed after the signal is received.
+	UpdateRpsSignal = "visibility-migration-update-rps"
+
+	countMismatchErrorType = "VisibilityMigrationCountMismatch"
+
+	defaultPageSize              = 1000
+	defaultOverallRps            = 100
+	defaultPageCountPerExecution = 100
+	maxPageCountPerExecution     = 1000
+	defaultCountTolerance        = 0.01
+)
+
+type (
+	// VisibilityMigrationParams are the input of VisibilityMigrationWorkflow.
+	//
+	// The workflow copies visibility records of all executions of the namespace, which were started before
+	// the migration, from the primary visibility store into the secondary one. Executions started later,
+	// as well as any change to migrated executions, are expected to be written to both stores by dual
+	// visibility, therefore writes to the secondary store must be enabled before the migration is started.
+	VisibilityMigrationParams struct {
+		Namespace  string
+		Source     string  // SourceVisibility (default) or SourceExecutions
+		PageSize   int     // number of executions read from source store at once
+		OverallRps float64 // RPS of writes to target store
+
+		// PageCountPerExecution is number of pages to be processed before continue as new, max is 1000.
+		PageCountPerExecution int
+		// CountTolerance is the allowed difference between the number of executions in source and target stores
+		// after the migration, relative to the source count. Retention and executions closing while the stores
+		// are counted make the counts differ slightly even if nothing was lost. Default is 0.01.
+		CountTolerance float64
+
+		// Checkpoint is used by continue as new, or to resume from the Checkpoint returned by StatusQueryType.
+		Checkpoint Checkpoint
+		// LatestStartTime is set on the first run: executions started later are not migrated.
+		LatestStartTime     time.Time
+		ContinuedAsNewCount int
+		Progress            Progress
+	}
+
+	// Checkpoint is the position in the source store. All executions before it have been migrated.
+	Checkpoint struct {
+		Closed        bool  // SourceVisibility: open executions are migrated first, then closed ones
+		ShardID       int32 // SourceExecutions: shard which is being migrated
+		NextPageToken []byte
+		Done          bool
+	}
+
+	// Progress is informational only: an execution which closes during the migration is read and written twice,
+	// and executions deleted by retention are never read.
+	Progress struct {
+		ListedCount   int64 // executions read from source store
+		MigratedCount int64 // executions written to target store
+	}
+
+	VisibilityMigrationStatus struct {
+		Checkpoint          Checkpoint
+		ContinuedAsNewCount int
+		OverallRps          float64
+		Progress            Progress
+	}
+
+	// VisibilityMigrationResult is the result of VisibilityMigrationWorkflow.
+	VisibilityMigrationResult struct {
+		Progress Progress
+		// SourceCount and TargetCount are numbers of executions of the namespace, which were started before
+		// the migration, in source and target stores after the migration, or -1 if the store doesn't support
+		// counting.
+		SourceCount int64
+		TargetCount int64
+	}
+
+	metadataRequest struct {
+		Namespace string
+	}
+
+	metadataResponse struct {
+		NamespaceID string
+		ShardCount  int32
+	}
+
+	migratePagesRequest struct {
+		Namespace       string
+		NamespaceID     string
+		Source          string
+		ShardCount      int32
+		PageSize        int
+		LatestStartTime time.Time
+		RPS             float64
+		Checkpoint      Checkpoint
+		MaxPageCount    int
+	}
+
+	migratePagesResponse struct {
+		Checkpoint    Checkpoint
+		PageCount     int
+		ListedCount   int64
+		MigratedCount int64
+	}
+
+	countStoresRequest struct {
+		Namespace       string
+		NamespaceID     string
+		PageSize        int
+		LatestStartTime time.Time
+		CountTolerance  float64
+	}
+
+	countStoresResponse struct {
+		SourceCount int64
+		TargetCount int64
+	}
+)
+
+var (
+	activityRetryPolicy = &temporal.RetryPolicy{
+		InitialInterval: time.Second,
+		MaximumInterval: time.Second * 10,
+	}
+)
+
+// VisibilityMigrationWorkflow backfills secondary visibility store with executions from the source store.
+// It is started by operator in the system namespace (see WorkflowName and VisibilityMigrationParams).
+func VisibilityMigrationWorkflow(ctx workflow.Context, params VisibilityMigrationParams) (VisibilityMigrationResult, error) {
+	workflow.SetQueryHandler(ctx, StatusQueryType, func() (VisibilityMigrationStatus, error) {
+		return VisibilityMigrationStatus{
+			Checkpoint:          params.Checkpoint,
+			ContinuedAsNewCount: params.ContinuedAsNewCount,
+			OverallRps:          params.OverallRps,
+			Progress:            params.Progress,
+		}, nil
+	})
+
+	if err := validateAndSetParams(&params); err != nil {
+		return VisibilityMigrationResult{}, err
+	}
+	if params.LatestStartTime.IsZero() {
+		params.LatestStartTime = workflow.Now(ctx)
+	}
+
+	rpsCh := workflow.GetSignalChannel(ctx, UpdateRpsSignal)
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		for {
+			var rps float64
+			rpsCh.Receive(ctx, &rps)
+			if rps > 0 {
+				params.OverallRps = rps
+			}
+		}
+	})
+
+	metadataResp, err := getMetadata(ctx, params)
+	if err != nil {
+		return VisibilityMigrationResult{}, err
+	}
+
+	var a *activities
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour,
+		HeartbeatTimeout:    time.Second * 30,
+		RetryPolicy:         activityRetryPolicy,
+	}
+	actx := workflow.WithActivityOptions(ctx, ao)
+
+	for pageCount := 0; pageCount < params.PageCountPerExecution && !params.Checkpoint.Done; {
+		var resp migratePagesResponse
+		err := workflow.ExecuteActivity(actx, a.MigratePages, &migratePagesRequest{
+			Namespace:       params.Namespace,
+			NamespaceID:     metadataResp.NamespaceID,
+			Source:          params.Source,
+			ShardCount:      metadataResp.ShardCount,
+			PageSize:        params.PageSize,
+			LatestStartTime: params.LatestStartTime,
+			RPS:             params.OverallRps,
+			Checkpoint:      params.Checkpoint,
+			MaxPageCount:    params.PageCountPerExecution - pageCount,
+		}).Get(ctx, &resp)
+		if err != nil {
+			return VisibilityMigrationResult{}, err
+		}
+
+		pageCount += resp.PageCount
+		params.Checkpoint = resp.Checkpoint
+		params.Progress.ListedCount += resp.ListedCount
+		params.Progress.MigratedCount += resp.MigratedCount
+	}
+
+	if !params.Checkpoint.Done {
+		params.ContinuedAsNewCount++
+
+		// There are still more executions to migrate. Continue-as-new to process on a new run.
+		// This prevents history size from exceeding the server-defined limit
+		return VisibilityMigrationResult{}, workflow.NewContinueAsNewError(ctx, VisibilityMigrationWorkflow, params)
+	}
+
+	var countResp countStoresResponse
+	err = workflow.ExecuteActivity(actx, a.CountStores, &countStoresRequest{
+		Namespace:       params.Namespace,
+		NamespaceID:     metadataResp.NamespaceID,
+		PageSize:        params.PageSize,
+		LatestStartTime: params.LatestStartTime,
+		CountTolerance:  params.CountTolerance,
+	}).Get(ctx, &countResp)
+	if err != nil {
+		return VisibilityMigrationResult{}, err
+	}
+
+	return migrationResult(params, countResp)
+}
+
+func validateAndSetParams(params *VisibilityMigrationParams) error {
+	if len(params.Namespace) == 0 {
+		return errors.New("InvalidArgument: Namespace is required")
+	}
+	switch params.Source {
+	case "":
+		params.Source = SourceVisibility
+	case SourceVisibility, SourceExecutions:
+	default:
+		return fmt.Errorf("InvalidArgument: unknown Source %q, must be %q or %q", params.Source, SourceVisibility, SourceExecutions)
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = defaultPageSize
+	}
+	if params.OverallRps <= 0 {
+		params.OverallRps = defaultOverallRps
+	}
+	if params.PageCountPerExecution <= 0 {
+		params.PageCountPerExecution = defaultPageCountPerExecution
+	}
+	if params.PageCountPerExecution > maxPageCountPerExecution {
+		params.PageCountPerExecution = maxPageCountPerExecution
+	}
+	if params.CountTolerance <= 0 {
+		params.CountTolerance = defaultCountTolerance
+	}
+
+	return nil
+}
+
+func getMetadata(ctx workflow.Context, params VisibilityMigrationParams) (metadataResponse, error) {
+	var a *activities
+
+	lao := workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Second * 10,
+		RetryPolicy:         activityRetryPolicy,
+	}
+
+	actx := workflow.WithLocalActivityOptions(ctx, lao)
+	var metadataResp metadataResponse
+	err := workflow.ExecuteLocalActivity(actx, a.GetMetadata, metadataRequest{Namespace: params.Namespace}).Get(ctx, &metadataResp)
+	return metadataResp, err
+}
+
+// migrationResult fails the workflow if the number of executions, which were started before the migration, differs
+// between source and target stores by more than CountTolerance. The check is skipped if a store can't be counted.
+func migrationResult(params VisibilityMigrationParams, counts countStoresResponse) (VisibilityMigrationResult, error) {
+	result := VisibilityMigrationResult{
+		Progress:    params.Progress,
+		SourceCount: counts.SourceCount,
+		TargetCount: counts.TargetCount,
+	}
+	if counts.SourceCount < 0 || counts.TargetCount < 0 ||
+		withinCountTolerance(counts.SourceCount, counts.TargetCount, params.CountTolerance) {
+		return result, nil
+	}
+	return result, temporal.NewNonRetryableApplicationError(
+		fmt.Sprintf("visibility migration count mismatch: source store has %d executions, but target store has %d",
+			counts.SourceCount,
+			counts.TargetCount,
+		),
+		countMismatchErrorType,
+		nil,
+		result,
+	)
+}
+
+func withinCountTolerance(sourceCount int64, targetCount int64, tolerance float64) bool {
+	diff := sourceCount - targetCount
+	if diff < 0 {
+		diff = -diff
+	}
+	return float64(diff) <= float64(sourceCount)*tolerance
+}
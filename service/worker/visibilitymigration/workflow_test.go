@@ -0,0 +1,179 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestVisibilityMigrationWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	namespaceID := uuid.New()
+
+	var a *activities
+	env.OnActivity(a.GetMetadata, mock.Anything, metadataRequest{Namespace: "test-ns"}).Return(&metadataResponse{NamespaceID: namespaceID, ShardCount: 4}, nil)
+
+	pageCount := 0
+	env.OnActivity(a.MigratePages, mock.Anything, mock.Anything).Return(func(ctx context.Context, request *migratePagesRequest) (*migratePagesResponse, error) {
+		assert.Equal(t, namespaceID, request.NamespaceID)
+		assert.Equal(t, SourceVisibility, request.Source)
+		assert.False(t, request.LatestStartTime.IsZero())
+		assert.Equal(t, defaultPageCountPerExecution-pageCount, request.MaxPageCount)
+		pageCount++
+		return &migratePagesResponse{
+			Checkpoint: Checkpoint{
+				Closed: pageCount > 1,
+				Done:   pageCount == 3,
+			},
+			PageCount:     1,
+			ListedCount:   10,
+			MigratedCount: 10,
+		}, nil
+	}).Times(3)
+
+	env.OnActivity(a.CountStores, mock.Anything, mock.Anything).Return(func(ctx context.Context, request *countStoresRequest) (*countStoresResponse, error) {
+		assert.Equal(t, namespaceID, request.NamespaceID)
+		assert.False(t, request.LatestStartTime.IsZero())
+		assert.Equal(t, defaultCountTolerance, request.CountTolerance)
+		return &countStoresResponse{SourceCount: 1000, TargetCount: 995}, nil
+	}).Once()
+
+	env.ExecuteWorkflow(VisibilityMigrationWorkflow, VisibilityMigrationParams{
+		Namespace: "test-ns",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+
+	var result VisibilityMigrationResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	assert.Equal(t, Progress{ListedCount: 30, MigratedCount: 30}, result.Progress)
+	assert.Equal(t, int64(1000), result.SourceCount)
+	assert.Equal(t, int64(995), result.TargetCount)
+
+	envValue, err := env.QueryWorkflow(StatusQueryType)
+	require.NoError(t, err)
+
+	var status VisibilityMigrationStatus
+	require.NoError(t, envValue.Get(&status))
+	assert.True(t, status.Checkpoint.Done)
+	assert.Equal(t, float64(defaultOverallRps), status.OverallRps)
+}
+
+func TestVisibilityMigrationWorkflow_ContinueAsNew(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.GetMetadata, mock.Anything, mock.Anything).Return(&metadataResponse{NamespaceID: uuid.New(), ShardCount: 4}, nil)
+	env.OnActivity(a.MigratePages, mock.Anything, mock.Anything).Return(func(ctx context.Context, request *migratePagesRequest) (*migratePagesResponse, error) {
+		return &migratePagesResponse{
+			Checkpoint: Checkpoint{
+				ShardID:       request.Checkpoint.ShardID + 1,
+				NextPageToken: []byte("fake-page-token"),
+			},
+			PageCount:     1,
+			ListedCount:   5,
+			MigratedCount: 4,
+		}, nil
+	}).Times(2)
+
+	env.ExecuteWorkflow(VisibilityMigrationWorkflow, VisibilityMigrationParams{
+		Namespace:             "test-ns",
+		Source:                SourceExecutions,
+		PageCountPerExecution: 2,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	env.AssertExpectations(t)
+
+	require.Contains(t, err.Error(), "continue as new")
+
+	envValue, err := env.QueryWorkflow(StatusQueryType)
+	require.NoError(t, err)
+
+	var status VisibilityMigrationStatus
+	require.NoError(t, envValue.Get(&status))
+	assert.Equal(t, 1, status.ContinuedAsNewCount)
+	assert.Equal(t, int32(2), status.Checkpoint.ShardID)
+	assert.Equal(t, []byte("fake-page-token"), status.Checkpoint.NextPageToken)
+	assert.False(t, status.Checkpoint.Done)
+	assert.Equal(t, Progress{ListedCount: 10, MigratedCount: 8}, status.Progress)
+}
+
+func TestVisibilityMigrationWorkflow_CountMismatch(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.GetMetadata, mock.Anything, mock.Anything).Return(&metadataResponse{NamespaceID: uuid.New(), ShardCount: 4}, nil)
+	env.OnActivity(a.MigratePages, mock.Anything, mock.Anything).Return(&migratePagesResponse{
+		Checkpoint:    Checkpoint{Closed: true, Done: true},
+		PageCount:     1,
+		ListedCount:   10,
+		MigratedCount: 10,
+	}, nil).Once()
+	// Migrated count is not compared: executions which closed during the migration were migrated twice.
+	env.OnActivity(a.CountStores, mock.Anything, mock.Anything).Return(&countStoresResponse{SourceCount: 8, TargetCount: 7}, nil).Once()
+
+	env.ExecuteWorkflow(VisibilityMigrationWorkflow, VisibilityMigrationParams{
+		Namespace: "test-ns",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	env.AssertExpectations(t)
+
+	var applicationErr *temporal.ApplicationError
+	require.ErrorAs(t, err, &applicationErr)
+	assert.Equal(t, countMismatchErrorType, applicationErr.Type())
+}
+
+func TestVisibilityMigrationWorkflow_InvalidSource(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(VisibilityMigrationWorkflow, VisibilityMigrationParams{
+		Namespace: "test-ns",
+		Source:    "unknown",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.Error(t, env.GetWorkflowError())
+}
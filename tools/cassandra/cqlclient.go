@@ -73,6 +73,8 @@ const (
 	readSchemaVersionCQL        = `SELECT curr_version from schema_version where keyspace_name=?`
 	listTablesCQL               = `SELECT table_name from system_schema.tables where keyspace_name=?`
 	listTypesCQL                = `SELECT type_name from system_schema.types where keyspace_name=?`
+	listColumnsCQL              = `SELECT column_name from system_schema.columns where keyspace_name=? and table_name=?`
+	listIndexesCQL              = `SELECT index_name from system_schema.indexes where keyspace_name=? and table_name=?`
 	writeSchemaVersionCQL       = `INSERT into schema_version(keyspace_name, creation_time, curr_version, min_compatible_version) VALUES (?,?,?,?)`
 	writeSchemaUpdateHistoryCQL = `INSERT into schema_update_history(year, month, update_time, old_version, new_version, manifest_md5, description) VALUES(?,?,?,?,?,?,?)`
 
@@ -229,8 +231,21 @@ func (client *cqlClient) Close() {
 
 // ListTables lists the table names in a Keyspace
 func (client *cqlClient) ListTables() ([]string, error) {
-	query := client.session.Query(listTablesCQL, client.keyspace)
-	iter := query.Iter()
+	return client.listNames(listTablesCQL, client.keyspace)
+}
+
+// ListColumns lists the column names of a table
+func (client *cqlClient) ListColumns(table string) ([]string, error) {
+	return client.listNames(listColumnsCQL, client.keyspace, table)
+}
+
+// ListIndexes lists the secondary index names of a table
+func (client *cqlClient) ListIndexes(table string) ([]string, error) {
+	return client.listNames(listIndexesCQL, client.keyspace, table)
+}
+
+func (client *cqlClient) listNames(cql string, values ...interface{}) ([]string, error) {
+	iter := client.session.Query(cql, values...).Iter()
 	var names []string
 	var name string
 	for iter.Scan(&name) {
@@ -244,17 +259,7 @@ func (client *cqlClient) ListTables() ([]string, error) {
 
 // listTypes lists the User defined types in a Keyspace
 func (client *cqlClient) listTypes() ([]string, error) {
-	qry := client.session.Query(listTypesCQL, client.keyspace)
-	iter := qry.Iter()
-	var names []string
-	var name string
-	for iter.Scan(&name) {
-		names = append(names, name)
-	}
-	if err := iter.Close(); err != nil {
-		return nil, err
-	}
-	return names, nil
+	return client.listNames(listTypesCQL, client.keyspace)
 }
 
 // dropTable drops a given table from the Keyspace
@@ -45,6 +45,14 @@ type SetupSchemaConfig struct {
 	schema.SetupConfig
 }
 
+// scratchKeyspaces creates the scratch keyspaces used by schema validation
+type scratchKeyspaces struct {
+	config *CQLClientConfig
+	logger log.Logger
+}
+
+var _ schema.ScratchDBProvider = (*scratchKeyspaces)(nil)
+
 // setupSchema executes the setupSchemaTask
 // using the given command line arguments
 // as input
@@ -88,6 +96,28 @@ func updateSchema(cli *cli.Context, logger log.Logger) error {
 	return nil
 }
 
+// validateSchema executes the validateSchemaTask
+// using the given command line args as input
+func validateSchema(cli *cli.Context, logger log.Logger) error {
+	config, err := newCQLClientConfig(cli)
+	if err != nil {
+		logger.Error("Unable to read config.", tag.Error(schema.NewConfigError(err.Error())))
+		return err
+	}
+	client, err := newCQLClient(config, logger)
+	if err != nil {
+		logger.Error("Unable to establish CQL session.", tag.Error(err))
+		return err
+	}
+	defer client.Close()
+	scratch := &scratchKeyspaces{config: config, logger: logger}
+	if err := schema.Validate(cli, client, scratch, logger); err != nil {
+		logger.Error("CQL schema validation failed.", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
 func createKeyspace(cli *cli.Context, logger log.Logger) error {
 	config, err := newCQLClientConfig(cli)
 	if err != nil {
@@ -258,3 +288,23 @@ func validateCQLClientConfig(config *CQLClientConfig) error {
 func flag(opt string) string {
 	return "(-" + opt + ")"
 }
+
+// CreateScratchDB creates a keyspace with the given name and connects to it
+func (s *scratchKeyspaces) CreateScratchDB(name string) (schema.DB, error) {
+	cfg := *s.config
+	if err := doCreateKeyspace(&cfg, name, s.logger); err != nil {
+		return nil, err
+	}
+	cfg.Keyspace = name
+	client, err := newCQLClient(&cfg, s.logger)
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// DropScratchDB drops the keyspace with the given name
+func (s *scratchKeyspaces) DropScratchDB(name string) error {
+	cfg := *s.config
+	return doDropKeyspace(&cfg, name, s.logger)
+}
@@ -195,11 +195,33 @@ func buildCLIOptions() *cli.App {
 					Name:  schema.CLIFlagSchemaDir,
 					Usage: "path to directory containing versioned schema",
 				},
+				cli.BoolFlag{
+					Name:  schema.CLIOptPrintOnly,
+					Usage: "print the statements of the schema update without executing them",
+				},
 			},
 			Action: func(c *cli.Context) {
 				cliHandler(c, updateSchema, logger)
 			},
 		},
+		{
+			Name:    "validate-schema",
+			Aliases: []string{"validate", "diff"},
+			Usage:   "compare the keyspace schema with the versioned schema applied to a scratch keyspace; creates and drops a temporal_validate_* keyspace on the cluster, which needs CREATE and DROP permissions on keyspaces",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagTargetVersion,
+					Usage: "schema version to compare against, defaults to the current version of the keyspace",
+				},
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, validateSchema, logger)
+			},
+		},
 		{
 			Name:    "create-keyspace",
 			Aliases: []string{"create", "create-Keyspace"},
@@ -48,10 +48,22 @@ func Update(cli *cli.Context, db DB, logger log.Logger) error {
 	return newUpdateSchemaTask(db, cfg, logger).Run()
 }
 
+// Validate compares the schema of the specified database with the
+// versioned schema applied to a scratch database, at the current
+// (or the given) version of the specified database
+func Validate(cli *cli.Context, db DB, scratch ScratchDBProvider, logger log.Logger) error {
+	cfg, err := newValidateConfig(cli)
+	if err != nil {
+		return err
+	}
+	return newValidateSchemaTask(db, scratch, cfg, logger).Run()
+}
+
 func newUpdateConfig(cli *cli.Context) (*UpdateConfig, error) {
 	config := new(UpdateConfig)
 	config.SchemaDir = cli.String(CLIOptSchemaDir)
 	config.TargetVersion = cli.String(CLIOptTargetVersion)
+	config.IsPrintOnly = cli.Bool(CLIOptPrintOnly)
 
 	if err := validateUpdateConfig(config); err != nil {
 		return nil, err
@@ -59,6 +71,17 @@ func newUpdateConfig(cli *cli.Context) (*UpdateConfig, error) {
 	return config, nil
 }
 
+func newValidateConfig(cli *cli.Context) (*ValidateConfig, error) {
+	config := new(ValidateConfig)
+	config.SchemaDir = cli.String(CLIOptSchemaDir)
+	config.TargetVersion = cli.String(CLIOptTargetVersion)
+
+	if err := validateValidateConfig(config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
 func newSetupConfig(cli *cli.Context) (*SetupConfig, error) {
 	config := new(SetupConfig)
 	config.SchemaFilePath = cli.String(CLIOptSchemaFile)
@@ -105,6 +128,20 @@ func validateUpdateConfig(config *UpdateConfig) error {
 	return nil
 }
 
+func validateValidateConfig(config *ValidateConfig) error {
+	if len(config.SchemaDir) == 0 {
+		return NewConfigError("missing " + flag(CLIOptSchemaDir) + " argument ")
+	}
+	if len(config.TargetVersion) > 0 {
+		ver, err := normalizeVersionString(config.TargetVersion)
+		if err != nil {
+			return NewConfigError("invalid " + flag(CLIOptTargetVersion) + " argument:" + err.Error())
+		}
+		config.TargetVersion = ver
+	}
+	return nil
+}
+
 func flag(opt string) string {
 	return "(-" + opt + ")"
 }
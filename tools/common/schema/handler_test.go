@@ -109,6 +109,25 @@ func (s *HandlerTestSuite) TestValidateUpdateConfig() {
 	s.Equal("1.2", config.TargetVersion)
 }
 
+func (s *HandlerTestSuite) TestValidateValidateConfig() {
+
+	config := new(ValidateConfig)
+	s.Error(validateValidateConfig(config))
+
+	config.SchemaDir = "/tmp"
+	config.TargetVersion = "abc"
+	s.Error(validateValidateConfig(config))
+
+	config.SchemaDir = "/tmp"
+	config.TargetVersion = ""
+	s.NoError(validateValidateConfig(config))
+
+	config.SchemaDir = "/tmp"
+	config.TargetVersion = "v1.2"
+	s.NoError(validateValidateConfig(config))
+	s.Equal("1.2", config.TargetVersion)
+}
+
 func (s *HandlerTestSuite) assertValidateSetupSucceeds(input *SetupConfig) {
 	err := validateSetupConfig(input)
 	s.Nil(err)
@@ -71,7 +71,7 @@ func (tb *UpdateSchemaTestBase) TearDownSuiteBase() {
 	tb.db.Close()
 }
 
-// RunDryrunTest tests a dryrun schema setup & update
+// RunDryrunTest tests a dryrun schema setup, update & validation
 func (tb *UpdateSchemaTestBase) RunDryrunTest(app *cli.App, db DB, dbNameFlag string, dir string, endVersion string) {
 	command := append(tb.getCommandBase(), []string{
 		dbNameFlag, tb.DBName,
@@ -86,13 +86,34 @@ func (tb *UpdateSchemaTestBase) RunDryrunTest(app *cli.App, db DB, dbNameFlag st
 		"-q",
 		"update-schema",
 		"-d", dir,
+		"--print-only",
 	}...)
 	tb.NoError(app.Run(command))
 	ver, err := db.ReadSchemaVersion()
 	tb.Nil(err)
+	// print only must not change the schema
+	tb.Equal("0.0", ver)
+
+	command = append(tb.getCommandBase(), []string{
+		dbNameFlag, tb.DBName,
+		"-q",
+		"update-schema",
+		"-d", dir,
+	}...)
+	tb.NoError(app.Run(command))
+	ver, err = db.ReadSchemaVersion()
+	tb.Nil(err)
 	// update the version to the latest
 	tb.Logger.Info(ver)
 	tb.Equal(endVersion, ver)
+
+	command = append(tb.getCommandBase(), []string{
+		dbNameFlag, tb.DBName,
+		"-q",
+		"validate-schema",
+		"-d", dir,
+	}...)
+	tb.NoError(app.Run(command))
 	tb.NoError(db.DropAllTables())
 }
 
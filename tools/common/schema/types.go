@@ -42,6 +42,21 @@ type (
 		TargetVersion string
 		SchemaDir     string
 		IsDryRun      bool
+		IsPrintOnly   bool
+	}
+	// ValidateConfig holds the config
+	// params for executing a ValidateTask
+	ValidateConfig struct {
+		TargetVersion string
+		SchemaDir     string
+	}
+	// ScratchDBProvider creates and drops the scratch databases
+	// that a ValidateTask applies the versioned schema to
+	ScratchDBProvider interface {
+		// CreateScratchDB creates an empty database with the given name and connects to it
+		CreateScratchDB(name string) (DB, error)
+		// DropScratchDB drops the database with the given name
+		DropScratchDB(name string) error
 	}
 	// SetupConfig holds the config
 	// params need by the SetupTask
@@ -67,6 +82,12 @@ type (
 		UpdateSchemaVersion(newVersion string, minCompatibleVersion string) error
 		// WriteSchemaUpdateLog adds an entry to the schema update history table
 		WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error
+		// ListTables returns the names of the tables in the keyspace
+		ListTables() ([]string, error)
+		// ListColumns returns the names of the columns of the given table
+		ListColumns(table string) ([]string, error)
+		// ListIndexes returns the names of the indexes on the given table
+		ListIndexes(table string) ([]string, error)
 		// Close gracefully closes the client object
 		Close()
 	}
@@ -119,6 +140,8 @@ const (
 	CLIOptQuiet = "quiet"
 	// CLIOptForce is the cli option for force mode
 	CLIOptForce = "force"
+	// CLIOptPrintOnly is the cli option for printing schema updates without applying them
+	CLIOptPrintOnly = "print-only"
 
 	// CLIFlagEndpoint is the cli flag for endpoint
 	CLIFlagEndpoint = CLIOptEndpoint + ", ep"
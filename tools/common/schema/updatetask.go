@@ -32,6 +32,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"regexp"
 	"sort"
@@ -51,6 +52,7 @@ type (
 		db     DB
 		config *UpdateConfig
 		logger log.Logger
+		out    io.Writer
 	}
 
 	// manifest is a value type that represents
@@ -88,6 +90,7 @@ func newUpdateSchemaTask(db DB, config *UpdateConfig, logger log.Logger) *Update
 		db:     db,
 		config: config,
 		logger: logger,
+		out:    os.Stdout,
 	}
 }
 
@@ -126,11 +129,20 @@ func (task *UpdateTask) Run() error {
 func (task *UpdateTask) executeUpdates(currVer string, updates []changeSet) error {
 	if len(updates) == 0 {
 		task.logger.Debug(fmt.Sprintf("found zero updates from current version %v", currVer))
+		if task.config.IsPrintOnly {
+			_, _ = fmt.Fprintf(task.out, "-- schema is up to date at version %v\n", currVer)
+		}
 		return nil
 	}
 
 	for _, cs := range updates {
 
+		if task.config.IsPrintOnly {
+			task.printStmts(currVer, &cs)
+			currVer = cs.version
+			continue
+		}
+
 		err := task.execStmts(cs.version, cs.cqlStmts)
 		if err != nil {
 			return err
@@ -160,6 +172,16 @@ func (task *UpdateTask) execStmts(ver string, stmts []string) error {
 	return nil
 }
 
+// printStmts writes the statements of a change set to the task output
+// instead of executing them, it is used in print only mode
+func (task *UpdateTask) printStmts(oldVer string, cs *changeSet) {
+	_, _ = fmt.Fprintf(task.out, "-- update schema from %v to %v: %v\n", oldVer, cs.version, cs.manifest.Description)
+	for _, stmt := range cs.cqlStmts {
+		_, _ = fmt.Fprintln(task.out, stmt)
+	}
+	_, _ = fmt.Fprintf(task.out, "-- set schema version to %v, min compatible version %v\n\n", cs.version, cs.manifest.MinCompatibleVersion)
+}
+
 func (task *UpdateTask) updateSchemaVersion(oldVer string, cs *changeSet) error {
 
 	err := task.db.UpdateSchemaVersion(cs.version, cs.manifest.MinCompatibleVersion)
@@ -0,0 +1,217 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// ValidateTask represents a task that compares the
+	// live database schema with the versioned schema
+	// applied to a scratch database up to a given version
+	ValidateTask struct {
+		db      DB
+		scratch ScratchDBProvider
+		config  *ValidateConfig
+		logger  log.Logger
+		out     io.Writer
+	}
+
+	// tableDef is the set of columns and
+	// named indexes that make up a table
+	tableDef struct {
+		columns map[string]struct{}
+		indexes map[string]struct{}
+	}
+
+	// schemaDef maps table names to their definition
+	schemaDef map[string]*tableDef
+)
+
+const scratchDBNamePrefix = "temporal_validate_"
+
+// newValidateSchemaTask returns a new instance of ValidateTask
+func newValidateSchemaTask(db DB, scratch ScratchDBProvider, config *ValidateConfig, logger log.Logger) *ValidateTask {
+	return &ValidateTask{
+		db:      db,
+		scratch: scratch,
+		config:  config,
+		logger:  logger,
+		out:     os.Stdout,
+	}
+}
+
+// Run executes the task
+func (task *ValidateTask) Run() error {
+	config := task.config
+
+	task.logger.Info("ValidateSchemaTask started", tag.NewAnyTag("config", config))
+
+	version := config.TargetVersion
+	if len(version) == 0 {
+		currVer, err := task.db.ReadSchemaVersion()
+		if err != nil {
+			return fmt.Errorf("error reading current schema version:%v", err.Error())
+		}
+		version = currVer
+	}
+
+	expected, err := task.buildExpectedSchema(version)
+	if err != nil {
+		return err
+	}
+
+	actual, err := readSchema(task.db)
+	if err != nil {
+		return err
+	}
+
+	diffs := diffSchemas(expected, actual)
+	if len(diffs) == 0 {
+		_, _ = fmt.Fprintf(task.out, "schema matches version %v\n", version)
+		task.logger.Info("ValidateSchemaTask done")
+		return nil
+	}
+
+	_, _ = fmt.Fprintf(task.out, "schema does not match version %v:\n", version)
+	for _, d := range diffs {
+		_, _ = fmt.Fprintf(task.out, "  %v\n", d)
+	}
+	return fmt.Errorf("found %v difference(s) between database schema and schema version %v", len(diffs), version)
+}
+
+// buildExpectedSchema applies the versioned schema up to the given
+// version to a new scratch database and reads back its schema
+func (task *ValidateTask) buildExpectedSchema(version string) (schemaDef, error) {
+	name := scratchDBNamePrefix + strconv.FormatInt(time.Now().UnixNano(), 36)
+	scratchDB, err := task.scratch.CreateScratchDB(name)
+	if err != nil {
+		return nil, fmt.Errorf("error creating scratch database:%v", err.Error())
+	}
+	defer func() {
+		scratchDB.Close()
+		if err := task.scratch.DropScratchDB(name); err != nil {
+			task.logger.Warn("Unable to drop scratch database.", tag.NewStringTag("name", name), tag.Error(err))
+		}
+	}()
+
+	setupTask := newSetupSchemaTask(scratchDB, &SetupConfig{InitialVersion: "0.0"}, task.logger)
+	if err := setupTask.Run(); err != nil {
+		return nil, fmt.Errorf("error setting up scratch database:%v", err.Error())
+	}
+	updateTask := newUpdateSchemaTask(scratchDB, &UpdateConfig{
+		SchemaDir:     task.config.SchemaDir,
+		TargetVersion: version,
+	}, task.logger)
+	if err := updateTask.Run(); err != nil {
+		return nil, fmt.Errorf("error updating scratch database to version %v:%v", version, err.Error())
+	}
+
+	return readSchema(scratchDB)
+}
+
+// readSchema reads the tables, columns and indexes of a database
+func readSchema(db DB) (schemaDef, error) {
+	tables, err := db.ListTables()
+	if err != nil {
+		return nil, fmt.Errorf("error listing tables:%v", err.Error())
+	}
+
+	result := make(schemaDef, len(tables))
+	for _, table := range tables {
+		columns, err := db.ListColumns(table)
+		if err != nil {
+			return nil, fmt.Errorf("error listing columns of table %v:%v", table, err.Error())
+		}
+		indexes, err := db.ListIndexes(table)
+		if err != nil {
+			return nil, fmt.Errorf("error listing indexes of table %v:%v", table, err.Error())
+		}
+
+		def := &tableDef{
+			columns: make(map[string]struct{}, len(columns)),
+			indexes: make(map[string]struct{}, len(indexes)),
+		}
+		for _, c := range columns {
+			def.columns[strings.ToLower(c)] = struct{}{}
+		}
+		for _, i := range indexes {
+			def.indexes[strings.ToLower(i)] = struct{}{}
+		}
+		result[strings.ToLower(table)] = def
+	}
+	return result, nil
+}
+
+// diffSchemas returns the sorted list of differences between the
+// expected and the actual schema: missing and unexpected tables,
+// columns and indexes
+func diffSchemas(expected schemaDef, actual schemaDef) []string {
+	var diffs []string
+
+	for name, want := range expected {
+		got, ok := actual[name]
+		if !ok {
+			diffs = append(diffs, "missing table: "+name)
+			continue
+		}
+		diffs = append(diffs, diffNames("column", name, want.columns, got.columns)...)
+		diffs = append(diffs, diffNames("index", name, want.indexes, got.indexes)...)
+	}
+
+	for name := range actual {
+		if _, ok := expected[name]; !ok {
+			diffs = append(diffs, "unexpected table: "+name)
+		}
+	}
+
+	sort.Strings(diffs)
+	return diffs
+}
+
+func diffNames(kind string, table string, want map[string]struct{}, got map[string]struct{}) []string {
+	var diffs []string
+	for n := range want {
+		if _, ok := got[n]; !ok {
+			diffs = append(diffs, "missing "+kind+": "+table+"."+n)
+		}
+	}
+	for n := range got {
+		if _, ok := want[n]; !ok {
+			diffs = append(diffs, "unexpected "+kind+": "+table+"."+n)
+		}
+	}
+	return diffs
+}
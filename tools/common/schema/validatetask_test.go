@@ -0,0 +1,299 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap/zaptest"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/tests/testutils"
+)
+
+type (
+	ValidateTaskTestSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(n) will stop the test, not merely log an error
+		suite.Suite
+		schemaDir string
+		logger    log.Logger
+	}
+
+	// fakeDB is an in-memory DB which records the executed statements.
+	// If schemas is set, the tables are the ones of the current version.
+	fakeDB struct {
+		version string
+		tables  schemaDef
+		schemas map[string]schemaDef
+		stmts   []string
+	}
+
+	// fakeScratchDBProvider creates fakeDBs which follow the given schemas
+	fakeScratchDBProvider struct {
+		schemas map[string]schemaDef
+		created map[string]*fakeDB
+		dropped []string
+	}
+)
+
+const (
+	validateTestSchemaV1 = `CREATE TABLE executions (shard_id INT NOT NULL, workflow_id VARCHAR(255) NOT NULL, PRIMARY KEY (shard_id, workflow_id));
+CREATE INDEX by_workflow_id ON executions (workflow_id);
+CREATE TABLE tasks (task_id BIGINT, task_data BLOB, PRIMARY KEY (task_id));`
+
+	validateTestSchemaV2 = `ALTER TABLE executions ADD COLUMN search_attributes JSON NULL;
+ALTER TABLE tasks DROP task_data;
+DROP INDEX by_workflow_id ON executions;
+CREATE TABLE queue (queue_type INT, message_id BIGINT, PRIMARY KEY (queue_type, message_id));`
+)
+
+func TestValidateTaskTestSuite(t *testing.T) {
+	suite.Run(t, new(ValidateTaskTestSuite))
+}
+
+func (s *ValidateTaskTestSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.logger = log.NewZapLogger(zaptest.NewLogger(s.T()))
+	s.schemaDir = testutils.MkdirTemp(s.T(), "", "validate_schema_test")
+	s.writeVersion("1.0", validateTestSchemaV1)
+	s.writeVersion("2.0", validateTestSchemaV2)
+}
+
+func (s *ValidateTaskTestSuite) TestRun_SchemaMatches() {
+	db := &fakeDB{version: "2.0", tables: s.schemas()["2.0"]}
+	scratch := newFakeScratchDBProvider(s.schemas())
+
+	out, err := s.runValidate(db, scratch, "")
+	s.NoError(err)
+	s.Equal("schema matches version 2.0\n", out)
+
+	// the versioned schema is applied to the scratch database, which is dropped afterwards
+	s.Len(scratch.created, 1)
+	for name, scratchDB := range scratch.created {
+		s.True(strings.HasPrefix(name, scratchDBNamePrefix))
+		s.Equal("2.0", scratchDB.version)
+		s.Len(scratchDB.stmts, 7)
+		s.Equal([]string{name}, scratch.dropped)
+	}
+	s.Empty(db.stmts)
+}
+
+func (s *ValidateTaskTestSuite) TestRun_SchemaDrift() {
+	db := &fakeDB{version: "2.0", tables: s.schemas()["2.0"]}
+	delete(db.tables, "queue")
+	delete(db.tables["executions"].columns, "search_attributes")
+	db.tables["executions"].indexes["by_workflow_id"] = struct{}{}
+	delete(db.tables["tasks"].indexes, "primary")
+	db.tables["tasks"].columns["hotfix"] = struct{}{}
+	db.tables["tasks_backup"] = newTableDef([]string{"task_id"}, nil)
+
+	out, err := s.runValidate(db, newFakeScratchDBProvider(s.schemas()), "")
+	s.Error(err)
+	s.Equal(`schema does not match version 2.0:
+  missing column: executions.search_attributes
+  missing index: tasks.primary
+  missing table: queue
+  unexpected column: tasks.hotfix
+  unexpected index: executions.by_workflow_id
+  unexpected table: tasks_backup
+`, out)
+}
+
+func (s *ValidateTaskTestSuite) TestRun_TargetVersion() {
+	db := &fakeDB{version: "2.0", tables: s.schemas()["2.0"]}
+	scratch := newFakeScratchDBProvider(s.schemas())
+
+	out, err := s.runValidate(db, scratch, "1.0")
+	s.Error(err)
+	s.Equal(`schema does not match version 1.0:
+  missing column: tasks.task_data
+  missing index: executions.by_workflow_id
+  unexpected column: executions.search_attributes
+  unexpected table: queue
+`, out)
+
+	_, err = s.runValidate(db, scratch, "3.0")
+	s.Error(err)
+	s.Len(scratch.dropped, 2)
+}
+
+func (s *ValidateTaskTestSuite) TestUpdatePrintOnly() {
+	db := &fakeDB{version: "1.0", tables: make(schemaDef)}
+	out := &bytes.Buffer{}
+	task := newUpdateSchemaTask(db, &UpdateConfig{SchemaDir: s.schemaDir, IsPrintOnly: true}, s.logger)
+	task.out = out
+
+	s.NoError(task.Run())
+	s.Empty(db.stmts)
+	s.Equal("1.0", db.version)
+	s.Contains(out.String(), "-- update schema from 1.0 to 2.0: v2.0 of schema\n")
+	s.Contains(out.String(), "ALTER TABLE tasks DROP task_data;\n")
+	s.Contains(out.String(), "-- set schema version to 2.0, min compatible version 1.0\n")
+
+	db.version = "2.0"
+	out.Reset()
+	s.NoError(task.Run())
+	s.Equal("-- schema is up to date at version 2.0\n", out.String())
+}
+
+func (s *ValidateTaskTestSuite) runValidate(db DB, scratch ScratchDBProvider, version string) (string, error) {
+	config := &ValidateConfig{SchemaDir: s.schemaDir, TargetVersion: version}
+	out := &bytes.Buffer{}
+	task := newValidateSchemaTask(db, scratch, config, s.logger)
+	task.out = out
+	err := task.Run()
+	return out.String(), err
+}
+
+// schemas returns the tables of each schema version, as the database would list them
+func (s *ValidateTaskTestSuite) schemas() map[string]schemaDef {
+	return map[string]schemaDef{
+		"1.0": {
+			"schema_version": newTableDef([]string{"db_name", "curr_version"}, []string{"primary"}),
+			"executions":     newTableDef([]string{"shard_id", "workflow_id"}, []string{"primary", "by_workflow_id"}),
+			"tasks":          newTableDef([]string{"task_id", "task_data"}, []string{"primary"}),
+		},
+		"2.0": {
+			"schema_version": newTableDef([]string{"db_name", "curr_version"}, []string{"primary"}),
+			"executions":     newTableDef([]string{"shard_id", "workflow_id", "search_attributes"}, []string{"primary"}),
+			"tasks":          newTableDef([]string{"task_id"}, []string{"primary"}),
+			"queue":          newTableDef([]string{"queue_type", "message_id"}, []string{"primary"}),
+		},
+	}
+}
+
+func (s *ValidateTaskTestSuite) writeVersion(version string, content string) {
+	dir := s.schemaDir + "/v" + version
+	s.NoError(os.Mkdir(dir, os.FileMode(0700)))
+	m := `{
+		"CurrVersion": "` + version + `",
+		"MinCompatibleVersion": "1.0",
+		"Description": "v` + version + ` of schema",
+		"SchemaUpdateCqlFiles": ["schema.sql"]
+	}`
+	s.NoError(os.WriteFile(dir+"/manifest.json", []byte(m), os.FileMode(0600)))
+	s.NoError(os.WriteFile(dir+"/schema.sql", []byte(content), os.FileMode(0600)))
+}
+
+func newTableDef(columns []string, indexes []string) *tableDef {
+	def := &tableDef{
+		columns: make(map[string]struct{}),
+		indexes: make(map[string]struct{}),
+	}
+	for _, c := range columns {
+		def.columns[c] = struct{}{}
+	}
+	for _, i := range indexes {
+		def.indexes[i] = struct{}{}
+	}
+	return def
+}
+
+func newFakeScratchDBProvider(schemas map[string]schemaDef) *fakeScratchDBProvider {
+	return &fakeScratchDBProvider{
+		schemas: schemas,
+		created: make(map[string]*fakeDB),
+	}
+}
+
+func (p *fakeScratchDBProvider) CreateScratchDB(name string) (DB, error) {
+	db := &fakeDB{schemas: p.schemas}
+	p.created[name] = db
+	return db, nil
+}
+
+func (p *fakeScratchDBProvider) DropScratchDB(name string) error {
+	p.dropped = append(p.dropped, name)
+	return nil
+}
+
+func (db *fakeDB) currentTables() schemaDef {
+	if db.schemas != nil {
+		return db.schemas[db.version]
+	}
+	return db.tables
+}
+
+func (db *fakeDB) Exec(stmt string, _ ...interface{}) error {
+	db.stmts = append(db.stmts, stmt)
+	return nil
+}
+
+func (db *fakeDB) DropAllTables() error {
+	db.tables = make(schemaDef)
+	return nil
+}
+
+func (db *fakeDB) CreateSchemaVersionTables() error {
+	return nil
+}
+
+func (db *fakeDB) ReadSchemaVersion() (string, error) {
+	if db.version == "" {
+		return "", errors.New("schema version not found")
+	}
+	return db.version, nil
+}
+
+func (db *fakeDB) UpdateSchemaVersion(newVersion string, _ string) error {
+	db.version = newVersion
+	return nil
+}
+
+func (db *fakeDB) WriteSchemaUpdateLog(_ string, _ string, _ string, _ string) error {
+	return nil
+}
+
+func (db *fakeDB) ListTables() ([]string, error) {
+	var tables []string
+	for t := range db.currentTables() {
+		tables = append(tables, t)
+	}
+	return tables, nil
+}
+
+func (db *fakeDB) ListColumns(table string) ([]string, error) {
+	var columns []string
+	for c := range db.currentTables()[table].columns {
+		columns = append(columns, c)
+	}
+	return columns, nil
+}
+
+func (db *fakeDB) ListIndexes(table string) ([]string, error) {
+	var indexes []string
+	for i := range db.currentTables()[table].indexes {
+		indexes = append(indexes, i)
+	}
+	return indexes, nil
+}
+
+func (db *fakeDB) Close() {}
@@ -86,6 +86,16 @@ func (c *Connection) ListTables() ([]string, error) {
 	return c.adminDb.ListTables(c.dbName)
 }
 
+// ListColumns returns a list of columns of the given table
+func (c *Connection) ListColumns(table string) ([]string, error) {
+	return c.adminDb.ListColumns(c.dbName, table)
+}
+
+// ListIndexes returns a list of indexes on the given table
+func (c *Connection) ListIndexes(table string) ([]string, error) {
+	return c.adminDb.ListIndexes(c.dbName, table)
+}
+
 // DropTable drops a given table from the database
 func (c *Connection) DropTable(name string) error {
 	return c.adminDb.DropTable(name)
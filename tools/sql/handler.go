@@ -38,6 +38,14 @@ import (
 	"go.temporal.io/server/tools/common/schema"
 )
 
+// scratchDatabases creates the scratch databases used by schema validation
+type scratchDatabases struct {
+	config    *config.SQL
+	defaultDb string
+}
+
+var _ schema.ScratchDBProvider = (*scratchDatabases)(nil)
+
 // setupSchema executes the setupSchemaTask
 // using the given command line arguments
 // as input
@@ -81,6 +89,28 @@ func updateSchema(cli *cli.Context, logger log.Logger) error {
 	return nil
 }
 
+// validateSchema executes the validateSchemaTask
+// using the given command line args as input
+func validateSchema(cli *cli.Context, logger log.Logger) error {
+	cfg, err := parseConnectConfig(cli)
+	if err != nil {
+		logger.Error("Unable to read config.", tag.Error(schema.NewConfigError(err.Error())))
+		return err
+	}
+	conn, err := NewConnection(cfg)
+	if err != nil {
+		logger.Error("Unable to connect to SQL database.", tag.Error(err))
+		return err
+	}
+	defer conn.Close()
+	scratch := &scratchDatabases{config: cfg, defaultDb: cli.String(schema.CLIOptDefaultDb)}
+	if err := schema.Validate(cli, conn, scratch, logger); err != nil {
+		logger.Error("SQL schema validation failed.", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
 // createDatabase creates a sql database
 func createDatabase(cli *cli.Context, logger log.Logger) error {
 	cfg, err := parseConnectConfig(cli)
@@ -139,6 +169,28 @@ func DoDropDatabase(cfg *config.SQL, defaultDb string) error {
 	return nil
 }
 
+// CreateScratchDB creates a database with the given name and connects to it
+func (s *scratchDatabases) CreateScratchDB(name string) (schema.DB, error) {
+	cfg := *s.config
+	cfg.DatabaseName = name
+	if err := DoCreateDatabase(&cfg, s.defaultDb); err != nil {
+		return nil, err
+	}
+	cfg.DatabaseName = name
+	conn, err := NewConnection(&cfg)
+	if err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+// DropScratchDB drops the database with the given name
+func (s *scratchDatabases) DropScratchDB(name string) error {
+	cfg := *s.config
+	cfg.DatabaseName = name
+	return DoDropDatabase(&cfg, s.defaultDb)
+}
+
 func parseConnectConfig(cli *cli.Context) (*config.SQL, error) {
 	cfg := new(config.SQL)
 
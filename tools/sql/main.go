@@ -180,11 +180,37 @@ func BuildCLIOptions() *cli.App {
 					Name:  schema.CLIFlagSchemaDir,
 					Usage: "path to directory containing versioned schema",
 				},
+				cli.BoolFlag{
+					Name:  schema.CLIOptPrintOnly,
+					Usage: "print the statements of the schema update without executing them",
+				},
 			},
 			Action: func(c *cli.Context) {
 				cliHandler(c, updateSchema, logger)
 			},
 		},
+		{
+			Name:    "validate-schema",
+			Aliases: []string{"validate", "diff"},
+			Usage:   "compare the database schema with the versioned schema applied to a scratch database; creates and drops a temporal_validate_* database on the cluster, which needs permission to create and drop databases",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagTargetVersion,
+					Usage: "schema version to compare against, defaults to the current version of the database",
+				},
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+				cli.StringFlag{
+					Name:  schema.CLIOptDefaultDb,
+					Usage: "optional default db to connect to when creating the scratch database",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, validateSchema, logger)
+			},
+		},
 		{
 			Name:    "create-database",
 			Aliases: []string{"create"},
@@ -193,6 +193,28 @@ func describeMutableState(c *cli.Context) (*adminservice.DescribeMutableStateRes
 	return resp, nil
 }
 
+// AdminCountWorkflows counts workflow executions, per group if the query has a GROUP BY clause
+func AdminCountWorkflows(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.CountWorkflowExecutions(ctx, &adminservice.CountWorkflowExecutionsRequest{
+		Namespace: nsName,
+		Query:     c.String(FlagQuery),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to count workflow executions: %s", err)
+	}
+	prettyPrintJSONObject(resp)
+	return nil
+}
+
 // AdminDeleteWorkflow force deletes a workflow's mutable state (both concrete and current), history, and visibility
 // records as long as it's possible.
 // It should only be used as a troubleshooting tool since no additional check will be done before the deletion.
@@ -572,3 +594,53 @@ func AdminRebuildMutableState(c *cli.Context) error {
 	}
 	return nil
 }
+
+// AdminRearchiveWorkflow re-archives the history of a closed workflow execution. The workflow's mutable state and
+// history must still be in the primary store, i.e. the workflow must not have passed its retention period.
+// History archival must be enabled for the namespace.
+func AdminRearchiveWorkflow(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid := c.String(FlagRunID)
+
+	resp, err := describeMutableState(c)
+	if err != nil {
+		return err
+	}
+	executionState := resp.GetDatabaseMutableState().GetExecutionState()
+	if executionState.GetState() != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+		return fmt.Errorf("workflow execution is not closed, current state: %v", executionState.GetState())
+	}
+
+	msg := fmt.Sprintf("Namespace: %s WorkflowID: %s RunID: %s\nRe-archive above workflow execution[Yes/No]?", nsName, wid, executionState.GetRunId())
+	prompt(msg, c.Bool(FlagYes))
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	nsID, err := getNamespaceID(c, namespace.Name(nsName))
+	if err != nil {
+		return err
+	}
+
+	_, err = adminClient.RearchiveWorkflowExecution(ctx, &adminservice.RearchiveWorkflowExecutionRequest{
+		NamespaceId: nsID.String(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to re-archive workflow execution: %s", err)
+	}
+	fmt.Println("Archival task scheduled, the workflow history will be re-archived by the archival queue.")
+	return nil
+}
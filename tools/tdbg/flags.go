@@ -78,4 +78,12 @@ var (
 	FlagBinaryFile                 = "binary-file"
 	FlagBase64Data                 = "base64-data"
 	FlagBase64File                 = "base64-file"
+	FlagNewName                    = "new-name"
+	FlagKeepAlias                  = "keep-alias"
+	FlagHistoryArchivalURI         = "history-uri"
+	FlagVisibilityArchivalURI      = "visibility-uri"
+	FlagShowShards                 = "show-shards"
+	FlagMaxDLQSize                 = "max-dlq-size"
+	FlagQuery                      = "query"
+	FlagQueryAlias                 = []string{"q"}
 )
@@ -0,0 +1,66 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"go.temporal.io/server/api/adminservice/v1"
+)
+
+// AdminRenameNamespace renames a namespace. The rename is run by the rename namespace system workflow.
+func AdminRenameNamespace(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	newName, err := getRequiredOption(c, FlagNewName)
+	if err != nil {
+		return err
+	}
+
+	msg := fmt.Sprintf("Rename namespace %s to %s[Yes/No]?", nsName, newName)
+	prompt(msg, c.Bool(FlagYes))
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.RenameNamespace(ctx, &adminservice.RenameNamespaceRequest{
+		Namespace:             nsName,
+		NewName:               newName,
+		KeepAlias:             c.Bool(FlagKeepAlias),
+		HistoryArchivalUri:    c.String(FlagHistoryArchivalURI),
+		VisibilityArchivalUri: c.String(FlagVisibilityArchivalURI),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to rename namespace: %s", err)
+	}
+	fmt.Printf("Namespace %s renamed to %s, NamespaceID: %s\n", nsName, newName, resp.GetNamespaceId())
+	return nil
+}
@@ -0,0 +1,114 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+// AdminGetReplicationStatus shows the replication status towards remote clusters
+func AdminGetReplicationStatus(c *cli.Context) error {
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	adminClient := cFactory.AdminClient(c)
+	resp, err := adminClient.GetReplicationStatus(ctx, &adminservice.GetReplicationStatusRequest{
+		RemoteClusters: c.StringSlice(FlagCluster),
+		IncludeShards:  c.Bool(FlagShowShards),
+		MaxDlqSize:     int32(c.Int(FlagMaxDLQSize)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get replication status: %w", err)
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(resp)
+		return nil
+	}
+
+	table := newReplicationStatusTable([]string{"Cluster", "Shards", "Missing", "Max Lag (tasks)", "P99 Lag (tasks)", "Max Lag", "P99 Lag", "DLQ Size"})
+	for _, cluster := range resp.Clusters {
+		table.Append([]string{
+			cluster.ClusterName,
+			strconv.Itoa(int(cluster.ShardCount)),
+			strconv.Itoa(int(cluster.MissingShardCount)),
+			strconv.FormatInt(cluster.MaxLagTaskIds, 10),
+			strconv.FormatInt(cluster.P99LagTaskIds, 10),
+			timestamp.DurationValue(cluster.MaxLag).Round(time.Millisecond).String(),
+			timestamp.DurationValue(cluster.P99Lag).Round(time.Millisecond).String(),
+			formatDLQSize(cluster.DlqSize, cluster.DlqSizeIsLowerBound),
+		})
+	}
+	table.Render()
+
+	for _, cluster := range resp.Clusters {
+		if len(cluster.Shards) == 0 {
+			continue
+		}
+		fmt.Printf("\nShards of cluster %s:\n", cluster.ClusterName)
+		table := newReplicationStatusTable([]string{"Shard", "Max Task ID", "Acked Task ID", "Lag (tasks)", "Lag", "DLQ Size"})
+		for _, shard := range cluster.Shards {
+			ackedTaskID := strconv.FormatInt(shard.AckedTaskId, 10)
+			if shard.Missing {
+				ackedTaskID = "missing"
+			}
+			table.Append([]string{
+				strconv.Itoa(int(shard.ShardId)),
+				strconv.FormatInt(shard.MaxTaskId, 10),
+				ackedTaskID,
+				strconv.FormatInt(shard.LagTaskIds, 10),
+				timestamp.DurationValue(shard.Lag).Round(time.Millisecond).String(),
+				formatDLQSize(shard.DlqSize, shard.DlqSizeIsLowerBound),
+			})
+		}
+		table.Render()
+	}
+	return nil
+}
+
+func newReplicationStatusTable(header []string) *tablewriter.Table {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader(header)
+	table.SetHeaderLine(false)
+	return table
+}
+
+func formatDLQSize(size int64, isLowerBound bool) string {
+	if isLowerBound {
+		return ">" + strconv.FormatInt(size, 10)
+	}
+	return strconv.FormatInt(size, 10)
+}
@@ -35,6 +35,11 @@ var commands = []*cli.Command{
 		Usage:       "Run admin operation on workflow",
 		Subcommands: newAdminWorkflowCommands(),
 	},
+	{
+		Name:        "namespace",
+		Usage:       "Run admin operation on namespace",
+		Subcommands: newAdminNamespaceCommands(),
+	},
 	{
 		Name:        "shard",
 		Aliases:     []string{"s"},
@@ -64,6 +69,12 @@ var commands = []*cli.Command{
 		Usage:       "Run admin operation on DLQ",
 		Subcommands: newAdminDLQCommands(),
 	},
+	{
+		Name:        "replication",
+		Aliases:     []string{"r"},
+		Usage:       "Run admin operation on replication",
+		Subcommands: newAdminReplicationCommands(),
+	},
 	{
 		Name:        "decode",
 		Usage:       "Decode payload",
@@ -172,6 +183,40 @@ func newAdminWorkflowCommands() []*cli.Command {
 				return AdminRebuildMutableState(c)
 			},
 		},
+		{
+			Name:    "rearchive",
+			Aliases: []string{},
+			Usage:   "Re-archive a closed workflow whose history is still in the primary store",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRearchiveWorkflow(c)
+			},
+		},
+		{
+			Name:  "count",
+			Usage: "Count workflow executions matching a visibility query, which may end with a GROUP BY clause",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagQuery,
+					Aliases: FlagQueryAlias,
+					Usage:   "Visibility query, e.g. \"WorkflowType = 'wf' GROUP BY ExecutionStatus\"",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminCountWorkflows(c)
+			},
+		},
 		{
 			Name:    "delete",
 			Aliases: []string{"del"},
@@ -195,6 +240,38 @@ func newAdminWorkflowCommands() []*cli.Command {
 	}
 }
 
+func newAdminNamespaceCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "rename",
+			Usage: "Rename a namespace, optionally keeping the previous name as an alias",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagNewName,
+					Usage:    "New name of the namespace",
+					Required: true,
+				},
+				&cli.BoolFlag{
+					Name:  FlagKeepAlias,
+					Usage: "Keep the previous name as an alias of the namespace",
+					Value: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagHistoryArchivalURI,
+					Usage: "Optional new history archival URI",
+				},
+				&cli.StringFlag{
+					Name:  FlagVisibilityArchivalURI,
+					Usage: "Optional new visibility archival URI",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRenameNamespace(c)
+			},
+		},
+	}
+}
+
 func newAdminShardManagementCommands() []*cli.Command {
 	return []*cli.Command{
 		{
@@ -348,6 +425,36 @@ func newAdminMembershipCommands() []*cli.Command {
 	}
 }
 
+func newAdminReplicationCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "status",
+			Usage: "Show the replication lag and DLQ size towards each remote cluster",
+			Flags: []cli.Flag{
+				&cli.StringSliceFlag{
+					Name:  FlagCluster,
+					Usage: "Remote cluster to show, can be repeated (default: all remote clusters)",
+				},
+				&cli.BoolFlag{
+					Name:  FlagShowShards,
+					Usage: "Show the status of every shard",
+				},
+				&cli.IntFlag{
+					Name:  FlagMaxDLQSize,
+					Usage: "Max number of DLQ tasks to count per shard and cluster (default: server defined)",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Usage: "Print the raw response as JSON",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminGetReplicationStatus(c)
+			},
+		},
+	}
+}
+
 func newAdminHistoryHostCommands() []*cli.Command {
 	return []*cli.Command{
 		{